@@ -9,6 +9,7 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	"github.com/financial-manager/api/cmd/api/handlers/account/response"
+	"github.com/financial-manager/api/internal/application/account/balanceon"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
@@ -17,14 +18,19 @@ type useCase interface {
 	Execute(ctx context.Context, id string) (domainaccount.Account, error)
 }
 
+type balanceOnUseCase interface {
+	Execute(ctx context.Context, id, date string) (balanceon.Balance, error)
+}
+
 // Handler handles GET /api/v1/accounts/{id}/balance.
 type Handler struct {
-	uc useCase
+	uc        useCase
+	balanceOn balanceOnUseCase
 }
 
-// New creates a Handler with its required use case dependency.
-func New(uc useCase) *Handler {
-	return &Handler{uc: uc}
+// New creates a Handler with its required use case dependencies.
+func New(uc useCase, balanceOn balanceOnUseCase) *Handler {
+	return &Handler{uc: uc, balanceOn: balanceOn}
 }
 
 type balanceResponse struct {
@@ -32,10 +38,17 @@ type balanceResponse struct {
 	CurrentBalance float64 `json:"current_balance"`
 }
 
-// Handle processes GET /api/v1/accounts/{id}/balance and returns 200 with the current balance.
+// Handle processes GET /api/v1/accounts/{id}/balance and returns 200 with the
+// current balance, or with the balance at the end of the day given by the
+// optional date query parameter (YYYY-MM-DD).
 func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 
+	if date := r.URL.Query().Get("date"); date != "" {
+		h.handleDate(w, r, id, date)
+		return
+	}
+
 	acc, err := h.uc.Execute(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, domainshared.ErrNotFound) {
@@ -51,3 +64,21 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		CurrentBalance: acc.CurrentBalance,
 	})
 }
+
+// handleDate answers a balance request for a past or future date.
+func (h *Handler) handleDate(w http.ResponseWriter, r *http.Request, id, date string) {
+	out, err := h.balanceOn.Execute(r.Context(), id, date)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainaccount.ErrInvalidBalanceDate):
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domainshared.ErrNotFound):
+			response.WriteError(w, http.StatusNotFound, "account not found")
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, out)
+}
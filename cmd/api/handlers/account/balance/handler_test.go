@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -15,6 +16,8 @@ import (
 
 	"github.com/financial-manager/api/cmd/api/handlers/account/balance"
 	"github.com/financial-manager/api/cmd/api/handlers/account/response"
+	"github.com/financial-manager/api/internal/application/account/balanceon"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
@@ -66,7 +69,7 @@ func TestHandler_Handle(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			h := balance.New(tc.uc)
+			h := balance.New(tc.uc, &fakeBalanceOn{})
 
 			req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts/"+tc.id+"/balance", nil)
 			rctx := chi.NewRouteContext()
@@ -89,3 +92,63 @@ func decodeAs(t *testing.T, rec *httptest.ResponseRecorder, want any) any {
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(ptr.Interface()))
 	return ptr.Elem().Interface()
 }
+
+func TestHandler_Handle_Date(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		balanceOn  *fakeBalanceOn
+		wantStatus int
+		wantBody   any
+	}{
+		{
+			name: "balance on the date returns 200",
+			balanceOn: &fakeBalanceOn{out: balanceon.Balance{
+				AccountID: "acc-1", Date: "2026-03-15", Balance: 750.0, Currency: "USD",
+			}},
+			wantStatus: http.StatusOK,
+			wantBody:   balanceon.Balance{AccountID: "acc-1", Date: "2026-03-15", Balance: 750.0, Currency: "USD"},
+		},
+		{
+			name:       "invalid date returns 400",
+			balanceOn:  &fakeBalanceOn{err: domainaccount.ErrInvalidBalanceDate},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Error{Error: domainaccount.ErrInvalidBalanceDate.Error()},
+		},
+		{
+			name:       "nonexistent account returns 404",
+			balanceOn:  &fakeBalanceOn{err: fmt.Errorf("get balance on date: %w", domainshared.ErrNotFound)},
+			wantStatus: http.StatusNotFound,
+			wantBody:   response.Error{Error: "account not found"},
+		},
+		{
+			name:       "repository error returns 500",
+			balanceOn:  &fakeBalanceOn{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   response.Error{Error: "internal server error"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			current := &fakeUseCase{}
+			h := balance.New(current, tc.balanceOn)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts/acc-1/balance?date=2026-03-15", nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "acc-1")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantBody, decodeAs(t, rec, tc.wantBody))
+			assert.Equal(t, "acc-1", tc.balanceOn.gotID)
+			assert.Equal(t, "2026-03-15", tc.balanceOn.gotDate)
+		})
+	}
+}
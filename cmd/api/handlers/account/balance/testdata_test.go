@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/financial-manager/api/internal/application/account/balanceon"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 )
 
@@ -18,6 +19,18 @@ func (f *fakeUseCase) Execute(_ context.Context, _ string) (domainaccount.Accoun
 	return f.out, f.err
 }
 
+type fakeBalanceOn struct {
+	out     balanceon.Balance
+	err     error
+	gotID   string
+	gotDate string
+}
+
+func (f *fakeBalanceOn) Execute(_ context.Context, id, date string) (balanceon.Balance, error) {
+	f.gotID, f.gotDate = id, date
+	return f.out, f.err
+}
+
 func buildDomainAccount(id, name string) domainaccount.Account {
 	t, _ := time.Parse("2006-01-02T15:04:05Z", fixedTimestamp)
 	return domainaccount.Account{
@@ -3,14 +3,17 @@ package list
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/financial-manager/api/cmd/api/handlers/account/response"
-	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	"github.com/financial-manager/api/cmd/api/handlers/pagination"
+	accountlist "github.com/financial-manager/api/internal/application/account/list"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 type lister interface {
-	Execute(ctx context.Context) ([]domainaccount.Account, error)
+	Execute(ctx context.Context, in accountlist.Input) (accountlist.Output, error)
 }
 
 type balanceGetter interface {
@@ -31,12 +34,24 @@ func New(lister lister, balanceGetter balanceGetter) *Handler {
 type listResponse struct {
 	Accounts      []response.Account `json:"accounts"`
 	GlobalBalance float64            `json:"global_balance"`
+	NextCursor    string             `json:"next_cursor"`
 }
 
-// Handle processes GET /api/v1/accounts and returns 200 with all accounts and the global balance.
+// Handle processes GET /api/v1/accounts and returns 200 with a page of accounts,
+// the cursor of the next page and the global balance. An invalid page returns 400.
 func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
-	accounts, err := h.lister.Execute(r.Context())
+	page, err := pagination.Parse(r)
 	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	out, err := h.lister.Execute(r.Context(), accountlist.Input{Page: page})
+	if err != nil {
+		if errors.Is(err, domainshared.ErrInvalidPage) {
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		response.WriteError(w, http.StatusInternalServerError, "internal server error")
 		return
 	}
@@ -47,13 +62,14 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := make([]response.Account, 0, len(accounts))
-	for _, a := range accounts {
+	resp := make([]response.Account, 0, len(out.Accounts))
+	for _, a := range out.Accounts {
 		resp = append(resp, response.ToAccount(a))
 	}
 
 	response.WriteJSON(w, http.StatusOK, listResponse{
 		Accounts:      resp,
 		GlobalBalance: total,
+		NextCursor:    out.NextCursor,
 	})
 }
@@ -3,6 +3,7 @@ package list_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -13,12 +14,16 @@ import (
 
 	"github.com/financial-manager/api/cmd/api/handlers/account/list"
 	"github.com/financial-manager/api/cmd/api/handlers/account/response"
+	accountlist "github.com/financial-manager/api/internal/application/account/list"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 // listResponse mirrors the handler's unexported listResponse for test decoding.
 type listResponse struct {
 	Accounts      []response.Account `json:"accounts"`
 	GlobalBalance float64            `json:"global_balance"`
+	NextCursor    string             `json:"next_cursor"`
 }
 
 func TestHandler_Handle(t *testing.T) {
@@ -93,3 +98,56 @@ func decodeAs(t *testing.T, rec *httptest.ResponseRecorder, want any) any {
 	require.NoError(t, json.NewDecoder(rec.Body).Decode(ptr.Interface()))
 	return ptr.Elem().Interface()
 }
+
+func TestHandler_Pagination(t *testing.T) {
+	t.Parallel()
+
+	account := buildDomainAccount("a1", "Cash")
+
+	t.Run("page parameters are passed on and the next cursor returned", func(t *testing.T) {
+		t.Parallel()
+
+		uc := &fakeLister{out: accountlist.Output{Accounts: []domainaccount.Account{account}, NextCursor: "next-page"}}
+		h := list.New(uc, &fakeBalanceGetter{out: buildBalanceOutput(1000.0)})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts?limit=1&cursor=abc&sort=name&order=desc", nil)
+		rec := httptest.NewRecorder()
+
+		h.Handle(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, domainshared.PageQuery{Limit: 1, Cursor: "abc", Sort: "name", Direction: domainshared.SortDesc}, uc.gotIn.Page)
+
+		var body listResponse
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, []response.Account{response.ToAccount(account)}, body.Accounts)
+		assert.Equal(t, "next-page", body.NextCursor)
+	})
+
+	t.Run("non-integer limit returns 400", func(t *testing.T) {
+		t.Parallel()
+
+		h := list.New(&fakeLister{}, &fakeBalanceGetter{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts?limit=all", nil)
+		rec := httptest.NewRecorder()
+
+		h.Handle(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid page returns 400", func(t *testing.T) {
+		t.Parallel()
+
+		uc := &fakeLister{err: fmt.Errorf("list accounts: %w", domainshared.ErrInvalidPage)}
+		h := list.New(uc, &fakeBalanceGetter{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts?sort=type", nil)
+		rec := httptest.NewRecorder()
+
+		h.Handle(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -4,17 +4,20 @@ import (
 	"context"
 	"time"
 
+	accountlist "github.com/financial-manager/api/internal/application/account/list"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 )
 
 const fixedTimestamp = "2026-02-23T10:00:00Z"
 
 type fakeLister struct {
-	out []domainaccount.Account
-	err error
+	out   accountlist.Output
+	err   error
+	gotIn accountlist.Input
 }
 
-func (f *fakeLister) Execute(_ context.Context) ([]domainaccount.Account, error) {
+func (f *fakeLister) Execute(_ context.Context, in accountlist.Input) (accountlist.Output, error) {
+	f.gotIn = in
 	return f.out, f.err
 }
 
@@ -44,8 +47,8 @@ func buildDomainAccount(id, name string) domainaccount.Account {
 	}
 }
 
-func buildListOutput(accounts ...domainaccount.Account) []domainaccount.Account {
-	return accounts
+func buildListOutput(accounts ...domainaccount.Account) accountlist.Output {
+	return accountlist.Output{Accounts: accounts}
 }
 
 func buildBalanceOutput(total float64) float64 {
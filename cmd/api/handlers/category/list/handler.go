@@ -3,15 +3,18 @@ package list
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/financial-manager/api/cmd/api/handlers/category/response"
+	"github.com/financial-manager/api/cmd/api/handlers/pagination"
 	appList "github.com/financial-manager/api/internal/application/category/list"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 type useCase interface {
-	Execute(ctx context.Context, in appList.Input) ([]domaincategory.Category, error)
+	Execute(ctx context.Context, in appList.Input) (appList.Output, error)
 }
 
 // Handler handles GET /api/v1/categories.
@@ -24,25 +27,42 @@ func New(uc useCase) *Handler {
 	return &Handler{uc: uc}
 }
 
-// Handle processes GET /api/v1/categories and returns all active categories.
+type listResponse struct {
+	Categories []response.CategoryResponse `json:"categories"`
+	NextCursor string                      `json:"next_cursor"`
+}
+
+// Handle processes GET /api/v1/categories and returns a page of active
+// categories with the cursor of the next page. An unknown type or an invalid
+// page returns 400; any other failure returns 500.
 func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	page, err := pagination.Parse(r)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	categoryType := r.URL.Query().Get("type")
 
-	var input appList.Input
+	input := appList.Input{Page: page}
 	if categoryType != "" {
 		input.Type = &categoryType
 	}
 
-	categories, err := h.uc.Execute(r.Context(), input)
+	out, err := h.uc.Execute(r.Context(), input)
 	if err != nil {
-		response.WriteError(w, http.StatusBadRequest, err.Error())
+		if errors.Is(err, domainshared.ErrInvalidPage) || errors.Is(err, domaincategory.ErrInvalidType) {
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		response.WriteError(w, http.StatusInternalServerError, "internal server error")
 		return
 	}
 
-	resp := make([]response.CategoryResponse, len(categories))
-	for i, c := range categories {
+	resp := make([]response.CategoryResponse, len(out.Categories))
+	for i, c := range out.Categories {
 		resp[i] = response.ToCategory(c)
 	}
 
-	response.WriteJSON(w, http.StatusOK, resp)
+	response.WriteJSON(w, http.StatusOK, listResponse{Categories: resp, NextCursor: out.NextCursor})
 }
@@ -3,6 +3,7 @@ package list_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -14,8 +15,15 @@ import (
 	"github.com/financial-manager/api/cmd/api/handlers/category/list"
 	"github.com/financial-manager/api/cmd/api/handlers/category/response"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
+// listResponse mirrors the handler's unexported listResponse for test decoding.
+type listResponse struct {
+	Categories []response.CategoryResponse `json:"categories"`
+	NextCursor string                      `json:"next_cursor"`
+}
+
 func TestHandler_Handle(t *testing.T) {
 	t.Parallel()
 
@@ -37,28 +45,42 @@ func TestHandler_Handle(t *testing.T) {
 			query:      "",
 			uc:         &fakeUseCase{out: categories},
 			wantStatus: http.StatusOK,
-			wantBody:   categoriesResp,
+			wantBody:   listResponse{Categories: categoriesResp},
 		},
 		{
 			name:       "list with type filter returns 200 with filtered categories",
 			query:      "?type=expense",
 			uc:         &fakeUseCase{out: categories[:1]},
 			wantStatus: http.StatusOK,
-			wantBody:   []response.CategoryResponse{categoriesResp[0]},
+			wantBody:   listResponse{Categories: []response.CategoryResponse{categoriesResp[0]}},
 		},
 		{
-			name:       "use case error returns 400",
+			name:       "repository error returns 500",
 			query:      "",
 			uc:         buildFailingUseCase(errors.New("db error")),
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   response.Error{Error: "internal server error"},
+		},
+		{
+			name:       "unknown type returns 400",
+			query:      "?type=other",
+			uc:         buildFailingUseCase(fmt.Errorf("list categories: %w", domaincategory.ErrInvalidType)),
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Error{Error: "list categories: " + domaincategory.ErrInvalidType.Error()},
+		},
+		{
+			name:       "invalid page returns 400",
+			query:      "?cursor=bad",
+			uc:         buildFailingUseCase(fmt.Errorf("list categories: %w", domainshared.ErrInvalidPage)),
 			wantStatus: http.StatusBadRequest,
-			wantBody:   response.Error{Error: "db error"},
+			wantBody:   response.Error{Error: "list categories: " + domainshared.ErrInvalidPage.Error()},
 		},
 		{
 			name:       "empty list returns 200 with empty array",
 			query:      "",
 			uc:         &fakeUseCase{out: []domaincategory.Category{}},
 			wantStatus: http.StatusOK,
-			wantBody:   []response.CategoryResponse{},
+			wantBody:   listResponse{Categories: []response.CategoryResponse{}},
 		},
 	}
 
@@ -79,6 +101,67 @@ func TestHandler_Handle(t *testing.T) {
 	}
 }
 
+func TestHandler_Pagination(t *testing.T) {
+	t.Parallel()
+
+	categories := buildDomainCategories()
+
+	t.Run("page parameters are passed on and the next cursor returned", func(t *testing.T) {
+		t.Parallel()
+
+		uc := &fakeUseCase{out: categories[:1], next: "next-page"}
+		h := list.New(uc)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/categories?type=expense&limit=1&cursor=abc&sort=name&order=desc", nil)
+		rec := httptest.NewRecorder()
+
+		h.Handle(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, domainshared.PageQuery{Limit: 1, Cursor: "abc", Sort: "name", Direction: domainshared.SortDesc}, uc.gotIn.Page)
+		require.NotNil(t, uc.gotIn.Type)
+		assert.Equal(t, "expense", *uc.gotIn.Type)
+
+		var body listResponse
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, []response.CategoryResponse{response.ToCategory(categories[0])}, body.Categories)
+		assert.Equal(t, "next-page", body.NextCursor)
+	})
+
+	t.Run("response is an object with the page and its next cursor", func(t *testing.T) {
+		t.Parallel()
+
+		uc := &fakeUseCase{out: categories[:1]}
+		h := list.New(uc)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/categories", nil)
+		rec := httptest.NewRecorder()
+
+		h.Handle(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, domainshared.PageQuery{}, uc.gotIn.Page)
+		var body map[string]json.RawMessage
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Len(t, body, 2)
+		assert.Contains(t, body, "categories")
+		assert.JSONEq(t, `""`, string(body["next_cursor"]))
+	})
+
+	t.Run("non-integer limit returns 400", func(t *testing.T) {
+		t.Parallel()
+
+		h := list.New(&fakeUseCase{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/categories?limit=all", nil)
+		rec := httptest.NewRecorder()
+
+		h.Handle(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
 // decodeAs decodes the recorder body into a new value of the same type as want.
 func decodeAs(t *testing.T, rec *httptest.ResponseRecorder, want any) any {
 	t.Helper()
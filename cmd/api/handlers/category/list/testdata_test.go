@@ -11,12 +11,15 @@ import (
 const fixedTimestamp = "2026-02-23T10:00:00Z"
 
 type fakeUseCase struct {
-	out []domaincategory.Category
-	err error
+	out   []domaincategory.Category
+	next  string
+	err   error
+	gotIn appList.Input
 }
 
-func (f *fakeUseCase) Execute(_ context.Context, _ appList.Input) ([]domaincategory.Category, error) {
-	return f.out, f.err
+func (f *fakeUseCase) Execute(_ context.Context, in appList.Input) (appList.Output, error) {
+	f.gotIn = in
+	return appList.Output{Categories: f.out, NextCursor: f.next}, f.err
 }
 
 func buildDomainCategories() []domaincategory.Category {
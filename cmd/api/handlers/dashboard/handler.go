@@ -4,27 +4,53 @@ package dashboard
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 
 	appDashboard "github.com/financial-manager/api/internal/application/dashboard"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
 )
 
 // Response represents the dashboard JSON response.
 type Response struct {
-	GlobalBalance      float64             `json:"global_balance"`
-	MonthlySummary     MonthlySummary      `json:"monthly_summary"`
-	ExpensesByCategory []ExpenseByCategory `json:"expenses_by_category"`
-	RecentTransactions []RecentTransaction `json:"recent_transactions"`
+	GlobalBalance            float64             `json:"global_balance"`
+	ProjectedMonthEndBalance float64             `json:"projected_month_end_balance"`
+	Period                   Range               `json:"period"`
+	MonthlySummary           MonthlySummary      `json:"monthly_summary"`
+	Comparison               Comparison          `json:"comparison"`
+	ExpensesByCategory       []ExpenseByCategory `json:"expenses_by_category"`
+	RecentTransactions       []RecentTransaction `json:"recent_transactions"`
 }
 
-// MonthlySummary represents the monthly financial summary.
+// Range represents an inclusive range of YYYY-MM-DD dates, labelled when it
+// is a whole period.
+type Range struct {
+	Label string `json:"label,omitempty"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// MonthlySummary represents the financial summary of the selected period.
 type MonthlySummary struct {
 	TotalIncome  float64 `json:"total_income"`
 	TotalExpense float64 `json:"total_expense"`
 	NetBalance   float64 `json:"net_balance"`
 }
 
+// Comparison represents the previous period's summary and the change from it.
+type Comparison struct {
+	Period               Range    `json:"period"`
+	TotalIncome          float64  `json:"total_income"`
+	TotalExpense         float64  `json:"total_expense"`
+	NetBalance           float64  `json:"net_balance"`
+	IncomeChange         float64  `json:"income_change"`
+	ExpenseChange        float64  `json:"expense_change"`
+	NetBalanceChange     float64  `json:"net_balance_change"`
+	IncomeChangePercent  *float64 `json:"income_change_percent"`
+	ExpenseChangePercent *float64 `json:"expense_change_percent"`
+}
+
 // ExpenseByCategory represents expense breakdown by category.
 type ExpenseByCategory struct {
 	CategoryID   string  `json:"category_id"`
@@ -44,7 +70,7 @@ type RecentTransaction struct {
 }
 
 type useCase interface {
-	Execute(ctx context.Context) (appDashboard.Output, error)
+	Execute(ctx context.Context, in appDashboard.Input) (appDashboard.Output, error)
 }
 
 // Handler handles GET /api/v1/dashboard.
@@ -57,10 +83,20 @@ func New(uc useCase) *Handler {
 	return &Handler{uc: uc}
 }
 
-// Handle processes GET /api/v1/dashboard.
+// Handle processes GET /api/v1/dashboard with the optional period, from and to query parameters.
 func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
-	out, err := h.uc.Execute(r.Context())
+	q := r.URL.Query()
+	out, err := h.uc.Execute(r.Context(), appDashboard.Input{
+		Period: q.Get("period"),
+		From:   q.Get("from"),
+		To:     q.Get("to"),
+	})
+	if errors.Is(err, domainreport.ErrInvalidQuery) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err != nil {
+		log.Printf("handlers/dashboard: %v", err)
 		writeError(w, http.StatusInternalServerError, "internal server error")
 		return
 	}
@@ -89,12 +125,25 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	resp := Response{
-		GlobalBalance: out.GlobalBalance,
+		GlobalBalance:            out.GlobalBalance,
+		ProjectedMonthEndBalance: out.ProjectedMonthEndBalance,
+		Period:                   Range{Label: out.Period.Label, From: out.Period.From, To: out.Period.To},
 		MonthlySummary: MonthlySummary{
 			TotalIncome:  out.MonthlySummary.TotalIncome,
 			TotalExpense: out.MonthlySummary.TotalExpense,
 			NetBalance:   out.MonthlySummary.NetBalance,
 		},
+		Comparison: Comparison{
+			Period:               Range{Label: out.Comparison.Period.Label, From: out.Comparison.Period.From, To: out.Comparison.Period.To},
+			TotalIncome:          out.Comparison.TotalIncome,
+			TotalExpense:         out.Comparison.TotalExpense,
+			NetBalance:           out.Comparison.NetBalance,
+			IncomeChange:         out.Comparison.IncomeChange,
+			ExpenseChange:        out.Comparison.ExpenseChange,
+			NetBalanceChange:     out.Comparison.NetBalanceChange,
+			IncomeChangePercent:  out.Comparison.IncomeChangePercent,
+			ExpenseChangePercent: out.Comparison.ExpenseChangePercent,
+		},
 		ExpensesByCategory: expensesByCategory,
 		RecentTransactions: recentTransactions,
 	}
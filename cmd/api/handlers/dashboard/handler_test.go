@@ -2,15 +2,19 @@
 package dashboard_test
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/financial-manager/api/cmd/api/handlers/dashboard"
 	appDashboard "github.com/financial-manager/api/internal/application/dashboard"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
 )
 
 func TestHandler_Handle(t *testing.T) {
@@ -73,3 +77,64 @@ func TestHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_Handle_ProjectedMonthEndBalance(t *testing.T) {
+	t.Parallel()
+
+	h := dashboard.New(&fakeUseCase{out: appDashboard.Output{GlobalBalance: 100, ProjectedMonthEndBalance: 80.5}})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/dashboard", nil)
+	rec := httptest.NewRecorder()
+
+	h.Handle(rec, req)
+
+	var resp dashboard.Response
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, 80.5, resp.ProjectedMonthEndBalance)
+}
+
+func TestHandler_Handle_PeriodAndComparison(t *testing.T) {
+	t.Parallel()
+
+	up := 50.0
+	uc := &fakeUseCase{out: appDashboard.Output{
+		Period: appDashboard.Range{Label: "25 Feb – 24 Mar", From: "2026-02-25", To: "2026-03-24"},
+		Comparison: appDashboard.Comparison{
+			Period:              appDashboard.Range{Label: "25 Jan – 24 Feb", From: "2026-01-25", To: "2026-02-24"},
+			TotalIncome:         200,
+			IncomeChange:        100,
+			IncomeChangePercent: &up,
+		},
+	}}
+	h := dashboard.New(uc)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/dashboard?from=2026-03-01&to=2026-03-10", nil)
+	rec := httptest.NewRecorder()
+
+	h.Handle(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, appDashboard.Input{From: "2026-03-01", To: "2026-03-10"}, uc.in)
+	var resp dashboard.Response
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, dashboard.Range{Label: "25 Feb – 24 Mar", From: "2026-02-25", To: "2026-03-24"}, resp.Period)
+	assert.Equal(t, dashboard.Comparison{
+		Period:              dashboard.Range{Label: "25 Jan – 24 Feb", From: "2026-01-25", To: "2026-02-24"},
+		TotalIncome:         200,
+		IncomeChange:        100,
+		IncomeChangePercent: &up,
+	}, resp.Comparison)
+}
+
+func TestHandler_Handle_InvalidPeriodReturns400(t *testing.T) {
+	t.Parallel()
+
+	uc := &fakeUseCase{err: fmt.Errorf("%w: period must be 'week', 'month', 'quarter' or 'year'", domainreport.ErrInvalidQuery)}
+	h := dashboard.New(uc)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/dashboard?period=day", nil)
+	rec := httptest.NewRecorder()
+
+	h.Handle(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, appDashboard.Input{Period: "day"}, uc.in)
+	assert.JSONEq(t, `{"error":"invalid report query: period must be 'week', 'month', 'quarter' or 'year'"}`, rec.Body.String())
+}
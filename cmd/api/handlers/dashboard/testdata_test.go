@@ -7,10 +7,12 @@ import (
 )
 
 type fakeUseCase struct {
+	in  appDashboard.Input
 	out appDashboard.Output
 	err error
 }
 
-func (f *fakeUseCase) Execute(_ context.Context) (appDashboard.Output, error) {
+func (f *fakeUseCase) Execute(_ context.Context, in appDashboard.Input) (appDashboard.Output, error) {
+	f.in = in
 	return f.out, f.err
 }
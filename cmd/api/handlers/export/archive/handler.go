@@ -0,0 +1,49 @@
+// Package archive handles GET /api/v1/export/archive.
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/financial-manager/api/cmd/api/handlers/export/stream"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, w io.Writer) error
+}
+
+// Handler handles GET /api/v1/export/archive.
+type Handler struct {
+	uc  useCase
+	enc stream.Encrypter
+}
+
+// New creates a Handler with its required use case dependency. enc encrypts
+// archives requested with a passphrase.
+func New(uc useCase, enc stream.Encrypter) *Handler {
+	return &Handler{uc: uc, enc: enc}
+}
+
+// Handle processes GET /api/v1/export/archive. The ZIP archive is streamed to
+// the client as it is built, encrypted when the request carries
+// stream.PassphraseHeader.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	filename := "backup_" + time.Now().Format("2006-01-02") + ".zip"
+	sw := stream.New(w, "application/zip", filename)
+	out, err := sw.Output(r, h.enc)
+	if err != nil {
+		body, _ := json.Marshal(map[string]string{"error": err.Error()})
+		http.Error(w, string(body), http.StatusBadRequest)
+		return
+	}
+	if err := h.uc.Execute(r.Context(), out); err != nil {
+		sw.Fail("archive", err)
+		return
+	}
+	if err := out.Close(); err != nil {
+		sw.Fail("archive", err)
+	}
+}
@@ -0,0 +1,90 @@
+package archive_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/export/archive"
+	"github.com/financial-manager/api/cmd/api/handlers/export/stream"
+	"github.com/financial-manager/api/internal/platform/crypt"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		uc              *fakeUseCase
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "success streams the archive",
+			uc:              &fakeUseCase{body: "PK\x03\x04zip"},
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/zip",
+			wantBody:        "PK\x03\x04zip",
+		},
+		{
+			name:       "error before output returns 500",
+			uc:         &fakeUseCase{err: errors.New("snapshot failed")},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/export/archive", nil)
+			rec := httptest.NewRecorder()
+
+			archive.New(tc.uc, fastCipher).Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			if tc.wantStatus == http.StatusOK {
+				assert.Equal(t, tc.wantContentType, rec.Header().Get("Content-Type"))
+				assert.Contains(t, rec.Header().Get("Content-Disposition"), `filename="backup_`)
+				assert.Contains(t, rec.Header().Get("Content-Disposition"), `.zip"`)
+				assert.Equal(t, tc.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Handle_Encrypted(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/export/archive", nil)
+	req.Header.Set(stream.PassphraseHeader, "correct horse battery")
+	rec := httptest.NewRecorder()
+
+	archive.New(&fakeUseCase{body: "PK\x03\x04zip"}, fastCipher).Handle(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), `.zip.enc"`)
+	r, err := crypt.Decrypt(rec.Body, "correct horse battery")
+	require.NoError(t, err)
+	plain, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "PK\x03\x04zip", string(plain))
+}
+
+func TestHandler_StreamErrorAbortsResponse(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/export/archive", nil)
+	rec := httptest.NewRecorder()
+	h := archive.New(&fakeUseCase{body: "PK\x03\x04", err: errors.New("disk full")}, fastCipher)
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() { h.Handle(rec, req) })
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
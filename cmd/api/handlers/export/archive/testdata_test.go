@@ -0,0 +1,27 @@
+package archive_test
+
+import (
+	"context"
+	"io"
+
+	"github.com/financial-manager/api/internal/platform/crypt"
+)
+
+// fakeUseCase writes body to the writer and then returns err, so an error
+// with a non-empty body simulates a failure in the middle of the stream.
+type fakeUseCase struct {
+	body string
+	err  error
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, w io.Writer) error {
+	if f.body != "" {
+		if _, err := io.WriteString(w, f.body); err != nil {
+			return err
+		}
+	}
+	return f.err
+}
+
+// fastCipher encrypts with cheap Argon2 parameters to keep the tests quick.
+var fastCipher = crypt.Cipher{Params: crypt.Params{Time: 1, Memory: 64, Threads: 1}}
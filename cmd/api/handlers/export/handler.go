@@ -1,73 +1,232 @@
-// Package export handles GET /api/v1/export/csv and GET /api/v1/export/json.
+// Package export handles GET /api/v1/export/csv, GET /api/v1/export/json and GET /api/v1/export/xlsx.
 package export
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/financial-manager/api/internal/application/export"
+	"github.com/financial-manager/api/cmd/api/handlers/export/stream"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 type csvUseCase interface {
-	ExportCSV(ctx context.Context, filters export.CSVFilters) (string, error)
+	ExportCSV(ctx context.Context, w io.Writer, opts domainexport.CSVOptions) error
 }
 
 type jsonUseCase interface {
-	ExportJSON(ctx context.Context) ([]byte, error)
+	ExportJSON(ctx context.Context, w io.Writer) error
 }
 
+type xlsxUseCase interface {
+	ExportXLSX(ctx context.Context, filters domainexport.Filters) ([]byte, error)
+}
+
+type presetUseCase interface {
+	Execute(ctx context.Context, name string) (domainexport.Preset, error)
+}
+
+// xlsxContentType is the media type of an Office Open XML workbook.
+const xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
 // Handler handles export endpoints.
 type Handler struct {
-	csvUC  csvUseCase
-	jsonUC jsonUseCase
+	csvUC    csvUseCase
+	jsonUC   jsonUseCase
+	xlsxUC   xlsxUseCase
+	presetUC presetUseCase
+	enc      stream.Encrypter
 }
 
-// New creates a Handler with its required use case dependencies.
-func New(csvUC csvUseCase, jsonUC jsonUseCase) *Handler {
-	return &Handler{csvUC: csvUC, jsonUC: jsonUC}
+// New creates a Handler with its required use case dependencies. enc encrypts
+// CSV and JSON exports requested with a passphrase.
+func New(csvUC csvUseCase, jsonUC jsonUseCase, xlsxUC xlsxUseCase, presetUC presetUseCase, enc stream.Encrypter) *Handler {
+	return &Handler{csvUC: csvUC, jsonUC: jsonUC, xlsxUC: xlsxUC, presetUC: presetUC, enc: enc}
 }
 
-// HandleCSV processes GET /api/v1/export/csv.
+// HandleCSV processes GET /api/v1/export/csv. Rows are streamed to the client
+// as they are read from the database. The optional preset query parameter
+// loads saved options; any other option in the query overrides the preset.
+// The file is encrypted when the request carries stream.PassphraseHeader.
 func (h *Handler) HandleCSV(w http.ResponseWriter, r *http.Request) {
-	filters := export.CSVFilters{
-		StartDate: r.URL.Query().Get("date_from"),
-		EndDate:   r.URL.Query().Get("date_to"),
-		Type:      r.URL.Query().Get("type"),
+	var opts domainexport.CSVOptions
+	if name := r.URL.Query().Get("preset"); name != "" {
+		preset, err := h.presetUC.Execute(r.Context(), name)
+		if err != nil {
+			if errors.Is(err, domainshared.ErrNotFound) {
+				writeError(w, http.StatusNotFound, "export preset not found")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "internal server error")
+			return
+		}
+		opts = preset.Options
 	}
 
-	csvData, err := h.csvUC.ExportCSV(r.Context(), filters)
+	opts, err := parseCSVOptions(r, opts)
 	if err != nil {
-		http.Error(w, `{"error":"internal server error"}`, http.StatusInternalServerError)
+		writeError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	filename := fmt.Sprintf("transactions_%s.csv", time.Now().Format("2006-01"))
-	w.Header().Set("Content-Type", "text/csv")
-	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
-	w.WriteHeader(http.StatusOK)
-	if _, err := w.Write([]byte(csvData)); err != nil {
-		log.Printf("write csv response: %v", err)
+	sw := stream.New(w, "text/csv", filename)
+	out, err := sw.Output(r, h.enc)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := h.csvUC.ExportCSV(r.Context(), out, opts); err != nil {
+		if !sw.Started() && errors.Is(err, domainexport.ErrInvalidOptions) {
+			writeError(w, http.StatusBadRequest, errors.Unwrap(err).Error())
+			return
+		}
+		sw.Fail("csv", err)
 		return
 	}
+	if err := out.Close(); err != nil {
+		sw.Fail("csv", err)
+	}
 }
 
-// HandleJSON processes GET /api/v1/export/json.
+// HandleJSON processes GET /api/v1/export/json. Transactions are streamed to
+// the client as they are read from the database. The file is encrypted when
+// the request carries stream.PassphraseHeader.
 func (h *Handler) HandleJSON(w http.ResponseWriter, r *http.Request) {
-	jsonData, err := h.jsonUC.ExportJSON(r.Context())
+	filename := fmt.Sprintf("backup_%s.json", time.Now().Format("2006-01-02"))
+	sw := stream.New(w, "application/json", filename)
+	out, err := sw.Output(r, h.enc)
 	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := h.jsonUC.ExportJSON(r.Context(), out); err != nil {
+		sw.Fail("json", err)
+		return
+	}
+	if err := out.Close(); err != nil {
+		sw.Fail("json", err)
+	}
+}
+
+// HandleXLSX processes GET /api/v1/export/xlsx.
+func (h *Handler) HandleXLSX(w http.ResponseWriter, r *http.Request) {
+	filters, err := parseFilters(r, domainexport.Filters{})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	xlsxData, err := h.xlsxUC.ExportXLSX(r.Context(), filters)
+	if err != nil {
+		if errors.Is(err, domainexport.ErrInvalidOptions) {
+			writeError(w, http.StatusBadRequest, errors.Unwrap(err).Error())
+			return
+		}
 		http.Error(w, `{"error":"internal server error"}`, http.StatusInternalServerError)
 		return
 	}
 
-	filename := fmt.Sprintf("backup_%s.json", time.Now().Format("2006-01-02"))
-	w.Header().Set("Content-Type", "application/json")
+	filename := fmt.Sprintf("transactions_%s.xlsx", time.Now().Format("2006-01"))
+	w.Header().Set("Content-Type", xlsxContentType)
 	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
 	w.WriteHeader(http.StatusOK)
-	if _, err := w.Write(jsonData); err != nil {
-		log.Printf("write json response: %v", err)
+	if _, err := w.Write(xlsxData); err != nil {
+		log.Printf("write xlsx response: %v", err)
 		return
 	}
 }
+
+// parseFilters reads the date_from, date_to, type, account_id, category_id,
+// min_amount and max_amount query parameters shared by the tabular exports.
+// Parameters missing from the query keep their value from base.
+func parseFilters(r *http.Request, base domainexport.Filters) (domainexport.Filters, error) {
+	q := r.URL.Query()
+	f := base
+	for param, field := range map[string]*string{
+		"date_from":   &f.StartDate,
+		"date_to":     &f.EndDate,
+		"type":        &f.Type,
+		"account_id":  &f.AccountID,
+		"category_id": &f.CategoryID,
+	} {
+		if q.Has(param) {
+			*field = q.Get(param)
+		}
+	}
+	for param, field := range map[string]**float64{
+		"min_amount": &f.MinAmount,
+		"max_amount": &f.MaxAmount,
+	} {
+		if !q.Has(param) {
+			continue
+		}
+		v, err := strconv.ParseFloat(q.Get(param), 64)
+		if err != nil {
+			return domainexport.Filters{}, fmt.Errorf("%s must be a number", param)
+		}
+		*field = &v
+	}
+	return f, nil
+}
+
+// parseCSVOptions reads the filters plus the columns, delimiter, decimal,
+// date_format, bom and signed query parameters of the CSV export. Parameters
+// missing from the query keep their value from base.
+func parseCSVOptions(r *http.Request, base domainexport.CSVOptions) (domainexport.CSVOptions, error) {
+	q := r.URL.Query()
+	opts := base
+
+	filters, err := parseFilters(r, base.Filters)
+	if err != nil {
+		return domainexport.CSVOptions{}, err
+	}
+	opts.Filters = filters
+
+	if q.Has("columns") {
+		opts.Columns = nil
+		for _, col := range strings.Split(q.Get("columns"), ",") {
+			opts.Columns = append(opts.Columns, domainexport.Column(strings.TrimSpace(col)))
+		}
+	}
+	if q.Has("delimiter") {
+		opts.Delimiter = q.Get("delimiter")
+		if opts.Delimiter == "tab" {
+			opts.Delimiter = "\t"
+		}
+	}
+	if q.Has("decimal") {
+		opts.DecimalSeparator = q.Get("decimal")
+	}
+	if q.Has("date_format") {
+		opts.DateFormat = q.Get("date_format")
+	}
+	for param, field := range map[string]*bool{
+		"bom":    &opts.BOM,
+		"signed": &opts.SignedAmounts,
+	} {
+		if !q.Has(param) {
+			continue
+		}
+		v, err := strconv.ParseBool(q.Get(param))
+		if err != nil {
+			return domainexport.CSVOptions{}, fmt.Errorf("%s must be true or false", param)
+		}
+		*field = v
+	}
+	return opts, nil
+}
+
+// writeError writes a JSON error body with the given status code.
+func writeError(w http.ResponseWriter, status int, msg string) {
+	body, _ := json.Marshal(map[string]string{"error": msg})
+	http.Error(w, string(body), status)
+}
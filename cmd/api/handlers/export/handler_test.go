@@ -3,13 +3,20 @@ package export_test
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/financial-manager/api/cmd/api/handlers/export"
+	"github.com/financial-manager/api/cmd/api/handlers/export/stream"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	"github.com/financial-manager/api/internal/platform/crypt"
 )
 
 func TestHandler_HandleCSV(t *testing.T) {
@@ -39,7 +46,7 @@ func TestHandler_HandleCSV(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			h := export.New(tc.csvUC, nil)
+			h := export.New(tc.csvUC, nil, nil, nil, fastCipher)
 
 			req := httptest.NewRequest(http.MethodGet, "/api/v1/export/csv", nil)
 			rec := httptest.NewRecorder()
@@ -50,6 +57,7 @@ func TestHandler_HandleCSV(t *testing.T) {
 			if tc.wantHeader != "" {
 				assert.Equal(t, tc.wantHeader, rec.Header().Get("Content-Type"))
 				assert.Contains(t, rec.Header().Get("Content-Disposition"), "attachment")
+				assert.Equal(t, tc.csvUC.csv, rec.Body.String())
 			}
 		})
 	}
@@ -82,7 +90,7 @@ func TestHandler_HandleJSON(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			h := export.New(nil, tc.jsonUC)
+			h := export.New(nil, tc.jsonUC, nil, nil, fastCipher)
 
 			req := httptest.NewRequest(http.MethodGet, "/api/v1/export/json", nil)
 			rec := httptest.NewRecorder()
@@ -93,6 +101,290 @@ func TestHandler_HandleJSON(t *testing.T) {
 			if tc.wantHeader != "" {
 				assert.Equal(t, tc.wantHeader, rec.Header().Get("Content-Type"))
 				assert.Contains(t, rec.Header().Get("Content-Disposition"), "attachment")
+				assert.Equal(t, string(tc.jsonUC.json), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_EncryptedExports(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		handle   func(h *export.Handler) http.HandlerFunc
+		h        *export.Handler
+		wantBody string
+	}{
+		{
+			name:     "csv",
+			handle:   func(h *export.Handler) http.HandlerFunc { return h.HandleCSV },
+			h:        export.New(&fakeCSVUseCase{csv: "date,type,amount\n"}, nil, nil, nil, fastCipher),
+			wantBody: "date,type,amount\n",
+		},
+		{
+			name:     "json",
+			handle:   func(h *export.Handler) http.HandlerFunc { return h.HandleJSON },
+			h:        export.New(nil, &fakeJSONUseCase{json: []byte(`{"accounts": []}`)}, nil, nil, fastCipher),
+			wantBody: `{"accounts": []}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/export/"+tc.name, nil)
+			req.Header.Set(stream.PassphraseHeader, "correct horse battery")
+			rec := httptest.NewRecorder()
+
+			tc.handle(tc.h)(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+			assert.Contains(t, rec.Header().Get("Content-Disposition"), "."+tc.name+".enc")
+			r, err := crypt.Decrypt(rec.Body, "correct horse battery")
+			require.NoError(t, err)
+			plain, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantBody, string(plain))
+		})
+	}
+}
+
+func TestHandler_ShortPassphraseReturns400(t *testing.T) {
+	t.Parallel()
+
+	h := export.New(&fakeCSVUseCase{csv: "id\n"}, &fakeJSONUseCase{json: []byte("{}")}, nil, nil, fastCipher)
+	for _, handle := range []http.HandlerFunc{h.HandleCSV, h.HandleJSON} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/export", nil)
+		req.Header.Set(stream.PassphraseHeader, "short")
+		rec := httptest.NewRecorder()
+
+		handle(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "passphrase must be at least 8 characters")
+	}
+}
+
+func TestHandler_StreamErrorAbortsResponse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		handle func(h *export.Handler) http.HandlerFunc
+		h      *export.Handler
+	}{
+		{
+			name:   "csv",
+			handle: func(h *export.Handler) http.HandlerFunc { return h.HandleCSV },
+			h:      export.New(&fakeCSVUseCase{csv: "date,type,amount\n", err: errors.New("db error")}, nil, nil, nil, fastCipher),
+		},
+		{
+			name:   "json",
+			handle: func(h *export.Handler) http.HandlerFunc { return h.HandleJSON },
+			h:      export.New(nil, &fakeJSONUseCase{json: []byte(`{"accounts": [`), err: errors.New("db error")}, nil, nil, fastCipher),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/export/"+tc.name, nil)
+			rec := httptest.NewRecorder()
+
+			assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+				tc.handle(tc.h)(rec, req)
+			})
+			assert.Equal(t, http.StatusOK, rec.Code)
+		})
+	}
+}
+
+func TestHandler_HandleXLSX(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		xlsxUC      *fakeXLSXUseCase
+		url         string
+		wantStatus  int
+		wantHeader  string
+		wantFilters domainexport.Filters
+	}{
+		{
+			name:       "exports XLSX successfully",
+			xlsxUC:     &fakeXLSXUseCase{xlsx: []byte("PK")},
+			url:        "/api/v1/export/xlsx",
+			wantStatus: http.StatusOK,
+			wantHeader: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		},
+		{
+			name:        "passes filters to the use case",
+			xlsxUC:      &fakeXLSXUseCase{xlsx: []byte("PK")},
+			url:         "/api/v1/export/xlsx?date_from=2026-01-01&date_to=2026-01-31&type=expense",
+			wantStatus:  http.StatusOK,
+			wantHeader:  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			wantFilters: domainexport.Filters{StartDate: "2026-01-01", EndDate: "2026-01-31", Type: "expense"},
+		},
+		{
+			name:        "invalid filters return 400",
+			xlsxUC:      &fakeXLSXUseCase{err: fmt.Errorf("export xlsx: %w", fmt.Errorf("%w: bad type", domainexport.ErrInvalidOptions))},
+			url:         "/api/v1/export/xlsx?type=transfer",
+			wantStatus:  http.StatusBadRequest,
+			wantFilters: domainexport.Filters{Type: "transfer"},
+		},
+		{
+			name:       "malformed amount returns 400",
+			xlsxUC:     &fakeXLSXUseCase{},
+			url:        "/api/v1/export/xlsx?max_amount=lots",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "use case error returns 500",
+			xlsxUC:     &fakeXLSXUseCase{err: errors.New("db error")},
+			url:        "/api/v1/export/xlsx",
+			wantStatus: http.StatusInternalServerError,
+			wantHeader: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := export.New(nil, nil, tc.xlsxUC, nil, fastCipher)
+
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			rec := httptest.NewRecorder()
+
+			h.HandleXLSX(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantFilters, tc.xlsxUC.gotFilters)
+			if tc.wantHeader != "" {
+				assert.Equal(t, tc.wantHeader, rec.Header().Get("Content-Type"))
+				assert.Contains(t, rec.Header().Get("Content-Disposition"), ".xlsx")
+			}
+		})
+	}
+}
+
+func TestHandler_HandleCSV_Options(t *testing.T) {
+	t.Parallel()
+
+	minAmount, maxAmount := 10.0, 99.5
+	preset := domainexport.Preset{
+		Name: "Spanish",
+		Options: domainexport.CSVOptions{
+			Filters:          domainexport.Filters{Type: "expense", AccountID: "acc-1"},
+			Delimiter:        ";",
+			DecimalSeparator: ",",
+			BOM:              true,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		url        string
+		presetUC   *fakePresetUseCase
+		csvUC      *fakeCSVUseCase
+		wantStatus int
+		wantOpts   domainexport.CSVOptions
+		wantBody   string
+	}{
+		{
+			name:       "parses format and filter parameters",
+			url:        "/api/v1/export/csv?columns=date,amount&delimiter=tab&decimal=,&date_format=DD/MM/YYYY&bom=true&signed=1&account_id=acc-1&category_id=cat-1&min_amount=10&max_amount=99.5",
+			csvUC:      &fakeCSVUseCase{csv: "date\tamount\n"},
+			wantStatus: http.StatusOK,
+			wantOpts: domainexport.CSVOptions{
+				Filters: domainexport.Filters{
+					AccountID:  "acc-1",
+					CategoryID: "cat-1",
+					MinAmount:  &minAmount,
+					MaxAmount:  &maxAmount,
+				},
+				Columns:          []domainexport.Column{domainexport.ColumnDate, domainexport.ColumnAmount},
+				Delimiter:        "\t",
+				DecimalSeparator: ",",
+				DateFormat:       "DD/MM/YYYY",
+				BOM:              true,
+				SignedAmounts:    true,
+			},
+		},
+		{
+			name:       "query parameters override the preset",
+			url:        "/api/v1/export/csv?preset=Spanish&bom=false&date_from=2026-01-01",
+			presetUC:   &fakePresetUseCase{preset: preset},
+			csvUC:      &fakeCSVUseCase{csv: "date;type\n"},
+			wantStatus: http.StatusOK,
+			wantOpts: domainexport.CSVOptions{
+				Filters:          domainexport.Filters{Type: "expense", AccountID: "acc-1", StartDate: "2026-01-01"},
+				Delimiter:        ";",
+				DecimalSeparator: ",",
+			},
+		},
+		{
+			name:       "unknown preset returns 404",
+			url:        "/api/v1/export/csv?preset=Missing",
+			presetUC:   &fakePresetUseCase{err: fmt.Errorf("preset not found: %w", domainshared.ErrNotFound)},
+			csvUC:      &fakeCSVUseCase{},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error":"export preset not found"}`,
+		},
+		{
+			name:       "preset lookup error returns 500",
+			url:        "/api/v1/export/csv?preset=Spanish",
+			presetUC:   &fakePresetUseCase{err: errors.New("db error")},
+			csvUC:      &fakeCSVUseCase{},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal server error"}`,
+		},
+		{
+			name:       "malformed amount returns 400",
+			url:        "/api/v1/export/csv?min_amount=ten",
+			csvUC:      &fakeCSVUseCase{},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"min_amount must be a number"}`,
+		},
+		{
+			name:       "malformed boolean returns 400",
+			url:        "/api/v1/export/csv?bom=maybe",
+			csvUC:      &fakeCSVUseCase{},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"bom must be true or false"}`,
+		},
+		{
+			name:       "invalid options return 400",
+			url:        "/api/v1/export/csv?delimiter=:",
+			csvUC:      &fakeCSVUseCase{err: fmt.Errorf("export csv: %w", fmt.Errorf("%w: bad delimiter", domainexport.ErrInvalidOptions))},
+			wantStatus: http.StatusBadRequest,
+			wantOpts:   domainexport.CSVOptions{Delimiter: ":"},
+			wantBody:   `{"error":"invalid export options: bad delimiter"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := export.New(tc.csvUC, nil, nil, tc.presetUC, fastCipher)
+
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			rec := httptest.NewRecorder()
+
+			h.HandleCSV(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantOpts, tc.csvUC.gotOpts)
+			if tc.presetUC != nil {
+				assert.Equal(t, req.URL.Query().Get("preset"), tc.presetUC.gotName)
+			}
+			if tc.wantBody != "" {
+				assert.JSONEq(t, tc.wantBody, rec.Body.String())
 			}
 		})
 	}
@@ -0,0 +1,58 @@
+// Package ledger handles GET /api/v1/export/ledger.
+package ledger
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/financial-manager/api/internal/application/export"
+)
+
+type useCase interface {
+	ExportLedger(ctx context.Context, format export.LedgerFormat) (string, error)
+}
+
+// Handler handles GET /api/v1/export/ledger.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+// Handle processes GET /api/v1/export/ledger?format=ledger|hledger|beancount.
+// The format defaults to ledger.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	format := export.LedgerFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = export.LedgerFormatLedger
+	}
+
+	journal, err := h.uc.ExportLedger(r.Context(), format)
+	if err != nil {
+		if errors.Is(err, export.ErrUnsupportedLedgerFormat) {
+			http.Error(w, `{"error":"format must be 'ledger', 'hledger' or 'beancount'"}`, http.StatusBadRequest)
+			return
+		}
+		http.Error(w, `{"error":"internal server error"}`, http.StatusInternalServerError)
+		return
+	}
+
+	extension := "journal"
+	if format == export.LedgerFormatBeancount {
+		extension = "beancount"
+	}
+	filename := "books_" + time.Now().Format("2006-01-02") + "." + extension
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(journal)); err != nil {
+		log.Printf("write ledger response: %v", err)
+		return
+	}
+}
@@ -0,0 +1,80 @@
+// Package ledger_test contains tests for the ledger export handler.
+package ledger_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/cmd/api/handlers/export/ledger"
+	"github.com/financial-manager/api/internal/application/export"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		uc            *fakeUseCase
+		url           string
+		wantStatus    int
+		wantFormat    export.LedgerFormat
+		wantExtension string
+	}{
+		{
+			name:          "defaults to ledger format",
+			uc:            &fakeUseCase{journal: "account Assets:Bank:Banco\n"},
+			url:           "/api/v1/export/ledger",
+			wantStatus:    http.StatusOK,
+			wantFormat:    export.LedgerFormatLedger,
+			wantExtension: ".journal",
+		},
+		{
+			name:          "exports beancount",
+			uc:            &fakeUseCase{journal: "2026-01-01 open Assets:Bank:Banco USD\n"},
+			url:           "/api/v1/export/ledger?format=beancount",
+			wantStatus:    http.StatusOK,
+			wantFormat:    export.LedgerFormatBeancount,
+			wantExtension: ".beancount",
+		},
+		{
+			name:       "unsupported format returns 400",
+			uc:         &fakeUseCase{err: fmt.Errorf("export ledger: %w", export.ErrUnsupportedLedgerFormat)},
+			url:        "/api/v1/export/ledger?format=qif",
+			wantStatus: http.StatusBadRequest,
+			wantFormat: "qif",
+		},
+		{
+			name:       "use case error returns 500",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			url:        "/api/v1/export/ledger?format=hledger",
+			wantStatus: http.StatusInternalServerError,
+			wantFormat: export.LedgerFormatHledger,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := ledger.New(tc.uc)
+
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantFormat, tc.uc.gotFormat)
+			if tc.wantExtension != "" {
+				assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+				assert.Contains(t, rec.Header().Get("Content-Disposition"), tc.wantExtension)
+				assert.Equal(t, tc.uc.journal, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package ledger_test
+
+import (
+	"context"
+
+	"github.com/financial-manager/api/internal/application/export"
+)
+
+type fakeUseCase struct {
+	journal   string
+	err       error
+	gotFormat export.LedgerFormat
+}
+
+func (f *fakeUseCase) ExportLedger(_ context.Context, format export.LedgerFormat) (string, error) {
+	f.gotFormat = format
+	return f.journal, f.err
+}
@@ -4,8 +4,10 @@ package pdf
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/financial-manager/api/internal/application/pdfexport"
@@ -47,11 +49,25 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, `{"error":"invalid month format, expected YYYY-MM"}`, http.StatusBadRequest)
 			return
 		}
+		if errors.Is(err, pdfexport.ErrInvalidPeriod) {
+			http.Error(w, `{"error":"invalid period, expected a positive year or start_date <= end_date as YYYY-MM-DD"}`, http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, pdfexport.ErrUnsupportedLocale) {
+			http.Error(w, `{"error":"locale must be 'en' or 'es'"}`, http.StatusBadRequest)
+			return
+		}
 		http.Error(w, `{"error":"internal server error"}`, http.StatusInternalServerError)
 		return
 	}
 
 	filename := "report_" + input.Month + ".pdf"
+	switch {
+	case input.StartDate != "" || input.EndDate != "":
+		filename = "report_" + input.StartDate + "_" + input.EndDate + ".pdf"
+	case input.Year != 0:
+		filename = "report_" + strconv.Itoa(input.Year) + ".pdf"
+	}
 	w.Header().Set("Content-Type", "application/pdf")
 	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
 	w.WriteHeader(http.StatusOK)
@@ -3,6 +3,7 @@ package pdf_test
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/financial-manager/api/cmd/api/handlers/export/pdf"
+	"github.com/financial-manager/api/internal/application/pdfexport"
 )
 
 func TestHandler_Handle(t *testing.T) {
@@ -22,6 +24,7 @@ func TestHandler_Handle(t *testing.T) {
 		body       string
 		wantStatus int
 		wantHeader string
+		wantFile   string
 	}{
 		{
 			name:       "generates PDF successfully",
@@ -44,6 +47,36 @@ func TestHandler_Handle(t *testing.T) {
 			wantStatus: http.StatusBadRequest,
 			wantHeader: "",
 		},
+		{
+			name:       "yearly report is named after the year",
+			uc:         &fakeUseCase{pdf: []byte("%PDF-1.4 test")},
+			body:       `{"year":2025}`,
+			wantStatus: http.StatusOK,
+			wantHeader: "application/pdf",
+			wantFile:   "report_2025.pdf",
+		},
+		{
+			name:       "range report is named after its dates",
+			uc:         &fakeUseCase{pdf: []byte("%PDF-1.4 test")},
+			body:       `{"start_date":"2026-01-15","end_date":"2026-03-31"}`,
+			wantStatus: http.StatusOK,
+			wantHeader: "application/pdf",
+			wantFile:   "report_2026-01-15_2026-03-31.pdf",
+		},
+		{
+			name:       "invalid period returns 400",
+			uc:         &fakeUseCase{err: fmt.Errorf("export pdf: %w", pdfexport.ErrInvalidPeriod)},
+			body:       `{"start_date":"2026-03-31","end_date":"2026-01-15"}`,
+			wantStatus: http.StatusBadRequest,
+			wantHeader: "",
+		},
+		{
+			name:       "unsupported locale returns 400",
+			uc:         &fakeUseCase{err: fmt.Errorf("export pdf: %w", pdfexport.ErrUnsupportedLocale)},
+			body:       `{"month":"2026-02","locale":"fr"}`,
+			wantStatus: http.StatusBadRequest,
+			wantHeader: "",
+		},
 		{
 			name:       "use case error returns 500",
 			uc:         &fakeUseCase{err: errors.New("db error")},
@@ -75,6 +108,9 @@ func TestHandler_Handle(t *testing.T) {
 				assert.Equal(t, tc.wantHeader, rec.Header().Get("Content-Type"))
 				assert.Contains(t, rec.Header().Get("Content-Disposition"), "attachment")
 			}
+			if tc.wantFile != "" {
+				assert.Contains(t, rec.Header().Get("Content-Disposition"), tc.wantFile)
+			}
 		})
 	}
 }
@@ -0,0 +1,87 @@
+// Package stream provides the response writer shared by the streaming export handlers.
+package stream
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+)
+
+// PassphraseHeader is the request header carrying the optional passphrase an
+// export is encrypted with. A header keeps it out of URLs and access logs.
+const PassphraseHeader = "X-Export-Passphrase"
+
+// Encrypter is the port for passphrase-based stream encryption.
+type Encrypter interface {
+	Encrypt(w io.Writer, passphrase string) (io.WriteCloser, error)
+}
+
+// Writer sends the attachment headers and a 200 status on the first write,
+// so an export that fails before producing any output can still be answered
+// with a 500.
+type Writer struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+// New creates a Writer that streams an attachment named filename to w.
+func New(w http.ResponseWriter, contentType, filename string) *Writer {
+	return &Writer{w: w, contentType: contentType, filename: filename}
+}
+
+// Write implements io.Writer.
+func (s *Writer) Write(p []byte) (int, error) {
+	if !s.started {
+		s.started = true
+		s.w.Header().Set("Content-Type", s.contentType)
+		s.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, s.filename))
+		s.w.WriteHeader(http.StatusOK)
+	}
+	return s.w.Write(p)
+}
+
+// Output returns the writer an export should be written to. When r carries a
+// passphrase the export is encrypted with enc and sent as an opaque
+// "<filename>.enc" attachment; otherwise it is written to s as is. The
+// returned writer must be closed once the export is complete.
+func (s *Writer) Output(r *http.Request, enc Encrypter) (io.WriteCloser, error) {
+	passphrase := r.Header.Get(PassphraseHeader)
+	if passphrase == "" {
+		return nopCloser{s}, nil
+	}
+
+	out, err := enc.Encrypt(s, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	s.contentType = "application/octet-stream"
+	s.filename += ".enc"
+	return out, nil
+}
+
+// Started reports whether the status and headers have been sent.
+func (s *Writer) Started() bool {
+	return s.started
+}
+
+// Fail reports a failed export. Before the first write it responds with a 500;
+// afterwards the status is already sent, so the connection is aborted to keep
+// the client from mistaking a truncated file for a complete one.
+func (s *Writer) Fail(format string, err error) {
+	if !s.started {
+		http.Error(s.w, `{"error":"internal server error"}`, http.StatusInternalServerError)
+		return
+	}
+	log.Printf("stream %s response: %v", format, err)
+	panic(http.ErrAbortHandler)
+}
+
+// nopCloser adds a no-op Close to a Writer.
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
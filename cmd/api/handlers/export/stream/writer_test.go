@@ -0,0 +1,112 @@
+package stream_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/export/stream"
+	"github.com/financial-manager/api/internal/platform/crypt"
+)
+
+const testPassphrase = "correct horse battery"
+
+var fastCipher = crypt.Cipher{Params: crypt.Params{Time: 1, Memory: 64, Threads: 1}}
+
+func TestWriter_Output(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		passphrase      string
+		wantContentType string
+		wantFilename    string
+		wantEncrypted   bool
+	}{
+		{
+			name:            "without passphrase writes plain output",
+			wantContentType: "application/json",
+			wantFilename:    `attachment; filename="backup.json"`,
+		},
+		{
+			name:            "with passphrase encrypts output",
+			passphrase:      testPassphrase,
+			wantContentType: "application/octet-stream",
+			wantFilename:    `attachment; filename="backup.json.enc"`,
+			wantEncrypted:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.passphrase != "" {
+				req.Header.Set(stream.PassphraseHeader, tc.passphrase)
+			}
+			rec := httptest.NewRecorder()
+			sw := stream.New(rec, "application/json", "backup.json")
+
+			out, err := sw.Output(req, fastCipher)
+			require.NoError(t, err)
+			_, err = io.WriteString(out, `{"accounts": []}`)
+			require.NoError(t, err)
+			require.NoError(t, out.Close())
+
+			assert.Equal(t, tc.wantContentType, rec.Header().Get("Content-Type"))
+			assert.Equal(t, tc.wantFilename, rec.Header().Get("Content-Disposition"))
+			body := rec.Body.Bytes()
+			if tc.wantEncrypted {
+				r, err := crypt.Decrypt(bytes.NewReader(body), tc.passphrase)
+				require.NoError(t, err)
+				body, err = io.ReadAll(r)
+				require.NoError(t, err)
+			}
+			assert.JSONEq(t, `{"accounts": []}`, string(body))
+		})
+	}
+}
+
+func TestWriter_Output_RejectsShortPassphrase(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(stream.PassphraseHeader, "short")
+	rec := httptest.NewRecorder()
+
+	_, err := stream.New(rec, "text/csv", "t.csv").Output(req, fastCipher)
+
+	assert.ErrorIs(t, err, crypt.ErrPassphraseTooShort)
+	assert.Zero(t, rec.Body.Len())
+}
+
+func TestWriter_Fail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("before the first write responds with 500", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+		stream.New(rec, "text/csv", "t.csv").Fail("csv", errors.New("boom"))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("after the first write aborts the response", func(t *testing.T) {
+		t.Parallel()
+
+		rec := httptest.NewRecorder()
+		sw := stream.New(rec, "text/csv", "t.csv")
+		_, err := sw.Write([]byte("id\n"))
+		require.NoError(t, err)
+
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() { sw.Fail("csv", errors.New("boom")) })
+	})
+}
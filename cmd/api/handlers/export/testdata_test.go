@@ -2,24 +2,66 @@ package export_test
 
 import (
 	"context"
+	"io"
 
-	"github.com/financial-manager/api/internal/application/export"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	"github.com/financial-manager/api/internal/platform/crypt"
 )
 
+// fakeCSVUseCase writes csv to the writer and then returns err, so an error
+// with a non-empty csv simulates a failure in the middle of the stream.
 type fakeCSVUseCase struct {
-	csv string
-	err error
+	csv     string
+	err     error
+	gotOpts domainexport.CSVOptions
 }
 
-func (f *fakeCSVUseCase) ExportCSV(_ context.Context, _ export.CSVFilters) (string, error) {
-	return f.csv, f.err
+func (f *fakeCSVUseCase) ExportCSV(_ context.Context, w io.Writer, opts domainexport.CSVOptions) error {
+	f.gotOpts = opts
+	if f.csv != "" {
+		if _, err := io.WriteString(w, f.csv); err != nil {
+			return err
+		}
+	}
+	return f.err
 }
 
+// fakeJSONUseCase writes json to the writer and then returns err.
 type fakeJSONUseCase struct {
 	json []byte
 	err  error
 }
 
-func (f *fakeJSONUseCase) ExportJSON(_ context.Context) ([]byte, error) {
-	return f.json, f.err
+func (f *fakeJSONUseCase) ExportJSON(_ context.Context, w io.Writer) error {
+	if len(f.json) > 0 {
+		if _, err := w.Write(f.json); err != nil {
+			return err
+		}
+	}
+	return f.err
 }
+
+type fakeXLSXUseCase struct {
+	xlsx       []byte
+	err        error
+	gotFilters domainexport.Filters
+}
+
+func (f *fakeXLSXUseCase) ExportXLSX(_ context.Context, filters domainexport.Filters) ([]byte, error) {
+	f.gotFilters = filters
+	return f.xlsx, f.err
+}
+
+type fakePresetUseCase struct {
+	preset  domainexport.Preset
+	err     error
+	gotName string
+}
+
+func (f *fakePresetUseCase) Execute(_ context.Context, name string) (domainexport.Preset, error) {
+	f.gotName = name
+	return f.preset, f.err
+}
+
+// fastCipher encrypts with cheap Argon2 parameters to keep the tests quick.
+var fastCipher = crypt.Cipher{Params: crypt.Params{Time: 1, Memory: 64, Threads: 1}}
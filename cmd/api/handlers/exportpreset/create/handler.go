@@ -0,0 +1,57 @@
+// Package create handles POST /api/v1/export/presets.
+package create
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/exportpreset/response"
+	appCreate "github.com/financial-manager/api/internal/application/exportpreset/create"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appCreate.Input) (domainexport.Preset, error)
+}
+
+// Handler handles POST /api/v1/export/presets.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+type createRequest struct {
+	Name    string           `json:"name"`
+	Options response.Options `json:"options"`
+}
+
+// Handle processes POST /api/v1/export/presets and returns 201 with the saved preset.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req createRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	preset, err := h.uc.Execute(r.Context(), appCreate.Input{
+		Name:    req.Name,
+		Options: req.Options.ToDomain(),
+	})
+	if err != nil {
+		if errors.Is(err, domainexport.ErrPresetNameTaken) {
+			response.WriteError(w, http.StatusConflict, err.Error())
+			return
+		}
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.WriteJSON(w, http.StatusCreated, response.ToPreset(preset))
+}
@@ -0,0 +1,105 @@
+package create_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/exportpreset/create"
+	"github.com/financial-manager/api/cmd/api/handlers/exportpreset/response"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	preset := buildDomainPreset("p-1", "Spanish")
+
+	tests := []struct {
+		name        string
+		body        any
+		uc          *fakeUseCase
+		wantStatus  int
+		wantBody    any
+		wantOptions domainexport.CSVOptions
+	}{
+		{
+			name: "valid body returns 201 with saved preset",
+			body: map[string]any{
+				"name": "Spanish",
+				"options": map[string]any{
+					"columns": []string{"date", "amount"}, "delimiter": ";", "decimal_separator": ",",
+					"account_ids": []string{"acc-1", "acc-2"}, "category_ids": []string{"cat-1"}, "query": "rent",
+				},
+			},
+			uc:          &fakeUseCase{out: preset},
+			wantStatus:  http.StatusCreated,
+			wantBody:    response.ToPreset(preset),
+			wantOptions: preset.Options,
+		},
+		{
+			name:       "invalid JSON body returns 400",
+			body:       "not-json",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Error{Error: "invalid request body"},
+		},
+		{
+			name:        "invalid options return 400",
+			body:        map[string]any{"name": "Bad", "options": map[string]any{"delimiter": ":"}},
+			uc:          &fakeUseCase{err: fmt.Errorf("%w: bad delimiter", domainexport.ErrInvalidOptions)},
+			wantStatus:  http.StatusBadRequest,
+			wantBody:    response.Error{Error: "invalid export options: bad delimiter"},
+			wantOptions: domainexport.CSVOptions{Delimiter: ":"},
+		},
+		{
+			name:       "taken name returns 409",
+			body:       map[string]any{"name": "Spanish"},
+			uc:         &fakeUseCase{err: domainexport.ErrPresetNameTaken},
+			wantStatus: http.StatusConflict,
+			wantBody:   response.Error{Error: domainexport.ErrPresetNameTaken.Error()},
+		},
+		{
+			name:       "other use case error returns 400",
+			body:       map[string]any{"name": ""},
+			uc:         &fakeUseCase{err: errors.New("preset name is required")},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Error{Error: "preset name is required"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := create.New(tc.uc)
+
+			bodyBytes, _ := json.Marshal(tc.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/export/presets", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantOptions, tc.uc.gotIn.Options)
+			assert.Equal(t, tc.wantBody, decodeAs(t, rec, tc.wantBody))
+		})
+	}
+}
+
+// decodeAs decodes the recorder body into a new value of the same type as want.
+func decodeAs(t *testing.T, rec *httptest.ResponseRecorder, want any) any {
+	t.Helper()
+	ptr := reflect.New(reflect.TypeOf(want))
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(ptr.Interface()))
+	return ptr.Elem().Interface()
+}
@@ -0,0 +1,40 @@
+package create_test
+
+import (
+	"context"
+	"time"
+
+	appCreate "github.com/financial-manager/api/internal/application/exportpreset/create"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+type fakeUseCase struct {
+	out   domainexport.Preset
+	err   error
+	gotIn appCreate.Input
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appCreate.Input) (domainexport.Preset, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
+
+func buildDomainPreset(id, name string) domainexport.Preset {
+	t := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	return domainexport.Preset{
+		ID:   id,
+		Name: name,
+		Options: domainexport.CSVOptions{
+			Columns:          []domainexport.Column{domainexport.ColumnDate, domainexport.ColumnAmount},
+			Delimiter:        ";",
+			DecimalSeparator: ",",
+			Filters: domainexport.Filters{
+				AccountIDs:  []string{"acc-1", "acc-2"},
+				CategoryIDs: []string{"cat-1"},
+				Query:       "rent",
+			},
+		},
+		CreatedAt: t,
+		UpdatedAt: t,
+	}
+}
@@ -0,0 +1,40 @@
+// Package list handles GET /api/v1/export/presets.
+package list
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/exportpreset/response"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+type useCase interface {
+	Execute(ctx context.Context) ([]domainexport.Preset, error)
+}
+
+// Handler handles GET /api/v1/export/presets.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+// Handle processes GET /api/v1/export/presets and returns every saved preset.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	presets, err := h.uc.Execute(r.Context())
+	if err != nil {
+		response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	resp := make([]response.PresetResponse, len(presets))
+	for i, p := range presets {
+		resp[i] = response.ToPreset(p)
+	}
+
+	response.WriteJSON(w, http.StatusOK, resp)
+}
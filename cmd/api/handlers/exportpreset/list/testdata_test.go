@@ -0,0 +1,16 @@
+package list_test
+
+import (
+	"context"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+type fakeUseCase struct {
+	out []domainexport.Preset
+	err error
+}
+
+func (f *fakeUseCase) Execute(_ context.Context) ([]domainexport.Preset, error) {
+	return f.out, f.err
+}
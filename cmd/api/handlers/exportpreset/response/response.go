@@ -0,0 +1,121 @@
+// Package response provides shared HTTP request and response types and helpers
+// for the export preset handler sub-packages.
+package response
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+const timestampLayout = "2006-01-02T15:04:05Z"
+
+// Options is the JSON representation of the CSV export options of a preset,
+// used in both requests and responses.
+type Options struct {
+	Columns          []string `json:"columns,omitempty"`
+	Delimiter        string   `json:"delimiter,omitempty"`
+	DecimalSeparator string   `json:"decimal_separator,omitempty"`
+	DateFormat       string   `json:"date_format,omitempty"`
+	BOM              bool     `json:"bom"`
+	SignedAmounts    bool     `json:"signed_amounts"`
+	Type             string   `json:"type,omitempty"`
+	DateFrom         string   `json:"date_from,omitempty"`
+	DateTo           string   `json:"date_to,omitempty"`
+	AccountID        string   `json:"account_id,omitempty"`
+	CategoryID       string   `json:"category_id,omitempty"`
+	MinAmount        *float64 `json:"min_amount,omitempty"`
+	MaxAmount        *float64 `json:"max_amount,omitempty"`
+	AccountIDs       []string `json:"account_ids,omitempty"`
+	CategoryIDs      []string `json:"category_ids,omitempty"`
+	Query            string   `json:"query,omitempty"`
+}
+
+// PresetResponse is the JSON representation of a preset returned by all endpoints.
+type PresetResponse struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Options   Options `json:"options"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// Error is the JSON response body for error cases.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// ToDomain converts request options into domain CSV options.
+func (o Options) ToDomain() domainexport.CSVOptions {
+	opts := domainexport.CSVOptions{
+		Filters: domainexport.Filters{
+			Type:        o.Type,
+			StartDate:   o.DateFrom,
+			EndDate:     o.DateTo,
+			AccountID:   o.AccountID,
+			CategoryID:  o.CategoryID,
+			MinAmount:   o.MinAmount,
+			MaxAmount:   o.MaxAmount,
+			AccountIDs:  o.AccountIDs,
+			CategoryIDs: o.CategoryIDs,
+			Query:       o.Query,
+		},
+		Delimiter:        o.Delimiter,
+		DecimalSeparator: o.DecimalSeparator,
+		DateFormat:       o.DateFormat,
+		BOM:              o.BOM,
+		SignedAmounts:    o.SignedAmounts,
+	}
+	for _, col := range o.Columns {
+		opts.Columns = append(opts.Columns, domainexport.Column(col))
+	}
+	return opts
+}
+
+// ToPreset converts a domain preset into its HTTP response representation.
+func ToPreset(p domainexport.Preset) PresetResponse {
+	o := p.Options
+	opts := Options{
+		Delimiter:        o.Delimiter,
+		DecimalSeparator: o.DecimalSeparator,
+		DateFormat:       o.DateFormat,
+		BOM:              o.BOM,
+		SignedAmounts:    o.SignedAmounts,
+		Type:             o.Type,
+		DateFrom:         o.StartDate,
+		DateTo:           o.EndDate,
+		AccountID:        o.AccountID,
+		CategoryID:       o.CategoryID,
+		MinAmount:        o.MinAmount,
+		MaxAmount:        o.MaxAmount,
+		AccountIDs:       o.AccountIDs,
+		CategoryIDs:      o.CategoryIDs,
+		Query:            o.Query,
+	}
+	for _, col := range o.Columns {
+		opts.Columns = append(opts.Columns, string(col))
+	}
+	return PresetResponse{
+		ID:        p.ID,
+		Name:      p.Name,
+		Options:   opts,
+		CreatedAt: p.CreatedAt.UTC().Format(timestampLayout),
+		UpdatedAt: p.UpdatedAt.UTC().Format(timestampLayout),
+	}
+}
+
+// WriteJSON encodes v as JSON and writes it with the given status code.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("handlers/exportpreset: failed to encode response: %v", err)
+	}
+}
+
+// WriteError writes a JSON error response with the given status code and message.
+func WriteError(w http.ResponseWriter, status int, msg string) {
+	WriteJSON(w, status, Error{Error: msg})
+}
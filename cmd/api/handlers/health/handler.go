@@ -28,9 +28,10 @@ type (
 
 	// response is the JSON shape returned by the health endpoint.
 	response struct {
-		Status    string `json:"status"`
-		Timestamp string `json:"timestamp"`
-		Version   string `json:"version"`
+		Status     string `json:"status"`
+		Timestamp  string `json:"timestamp"`
+		Version    string `json:"version"`
+		LastBackup string `json:"last_backup,omitempty"`
 	}
 )
 
@@ -52,6 +53,9 @@ func (h *Handler) Check(w http.ResponseWriter, r *http.Request) {
 		Timestamp: result.Timestamp.Format(timestampLayout),
 		Version:   result.Version,
 	}
+	if !result.LastBackup.IsZero() {
+		resp.LastBackup = result.LastBackup.UTC().Format(timestampLayout)
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
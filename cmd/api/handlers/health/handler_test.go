@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,22 @@ func TestHandler_Check(t *testing.T) {
 			},
 			wantTimestamp: "2026-02-23T10:00:00Z",
 		},
+		{
+			name: "includes the last successful backup",
+			buildChecker: func(t *testing.T) *mocks.Checker {
+				result := newHealthResult(domainhealth.StatusUp, "1.0.0")
+				result.LastBackup = time.Date(2026, 2, 23, 3, 0, 0, 0, time.UTC)
+				return buildMockChecker(t, result)
+			},
+			wantStatus: http.StatusOK,
+			wantCT:     "application/json",
+			wantBody: map[string]string{
+				"status":      "up",
+				"version":     "1.0.0",
+				"last_backup": "2026-02-23T03:00:00Z",
+			},
+			wantTimestamp: "2026-02-23T10:00:00Z",
+		},
 		{
 			name: "returns 503 when checker returns error",
 			buildChecker: func(t *testing.T) *mocks.Checker {
@@ -85,6 +102,9 @@ func TestHandler_Check(t *testing.T) {
 				}
 
 				assert.Equal(t, tc.wantTimestamp, body["timestamp"])
+				if _, ok := tc.wantBody["last_backup"]; !ok {
+					assert.NotContains(t, body, "last_backup")
+				}
 			}
 
 			checker.AssertExpectations(t)
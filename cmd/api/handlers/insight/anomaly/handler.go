@@ -0,0 +1,100 @@
+// Package anomaly handles GET /api/v1/insights/anomalies.
+package anomaly
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	appList "github.com/financial-manager/api/internal/application/insight/anomaly/list"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+const timestampLayout = "2006-01-02T15:04:05Z"
+const dateLayout = "2006-01-02"
+
+type useCase interface {
+	Execute(ctx context.Context, in appList.Input) ([]domaininsight.Anomaly, error)
+}
+
+// Handler handles GET /api/v1/insights/anomalies.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+// Anomaly is the JSON representation of a flagged transaction.
+type Anomaly struct {
+	TransactionID string   `json:"transaction_id"`
+	AccountID     string   `json:"account_id"`
+	CategoryID    string   `json:"category_id"`
+	Amount        float64  `json:"amount"`
+	Description   string   `json:"description"`
+	Date          string   `json:"date"`
+	Score         float64  `json:"score"`
+	Reasons       []Reason `json:"reasons"`
+	CreatedAt     string   `json:"created_at"`
+}
+
+// Reason is the JSON representation of why a transaction was flagged.
+type Reason struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Handle processes GET /api/v1/insights/anomalies with optional start_date and end_date filters.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	anomalies, err := h.uc.Execute(r.Context(), appList.Input{
+		StartDate: q.Get("start_date"),
+		EndDate:   q.Get("end_date"),
+	})
+	if err != nil {
+		log.Printf("handlers/insight: list anomalies: %v", err)
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	resp := make([]Anomaly, 0, len(anomalies))
+	for _, a := range anomalies {
+		reasons := make([]Reason, 0, len(a.Reasons))
+		for _, reason := range a.Reasons {
+			reasons = append(reasons, Reason{Code: string(reason.Code), Message: reason.Message})
+		}
+		resp = append(resp, Anomaly{
+			TransactionID: a.TransactionID,
+			AccountID:     a.AccountID,
+			CategoryID:    a.CategoryID,
+			Amount:        a.Amount,
+			Description:   a.Description,
+			Date:          a.Date.Format(dateLayout),
+			Score:         a.Score,
+			Reasons:       reasons,
+			CreatedAt:     a.CreatedAt.UTC().Format(timestampLayout),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"anomalies": resp,
+	})
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("encode response: %v", err)
+	}
+}
+
+// writeError writes an error response.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
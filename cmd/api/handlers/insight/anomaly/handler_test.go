@@ -0,0 +1,71 @@
+package anomaly_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/cmd/api/handlers/insight/anomaly"
+	appList "github.com/financial-manager/api/internal/application/insight/anomaly/list"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		query      string
+		uc         *fakeUseCase
+		wantStatus int
+		wantInput  appList.Input
+		wantBody   string
+	}{
+		{
+			name:       "returns the anomalies with their reasons",
+			query:      "?start_date=2026-02-01&end_date=2026-02-28",
+			uc:         &fakeUseCase{out: []domaininsight.Anomaly{buildAnomaly("tx-1")}},
+			wantStatus: http.StatusOK,
+			wantInput:  appList.Input{StartDate: "2026-02-01", EndDate: "2026-02-28"},
+			wantBody: `{"anomalies":[{
+				"transaction_id":"tx-1","account_id":"acc-001","category_id":"cat-001",
+				"amount":480,"description":"Electronics store","date":"2026-02-28","score":1.5,
+				"reasons":[{"code":"new_payee","message":"first expense for this payee"}],
+				"created_at":"2026-02-28T10:00:00Z"
+			}]}`,
+		},
+		{
+			name:       "no anomalies returns empty list",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"anomalies":[]}`,
+		},
+		{
+			name:       "use case error returns 500",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := anomaly.New(tc.uc)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/insights/anomalies"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+			if tc.wantStatus == http.StatusOK {
+				assert.Equal(t, tc.wantInput, tc.uc.in)
+			}
+		})
+	}
+}
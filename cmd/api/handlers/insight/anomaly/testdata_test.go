@@ -0,0 +1,36 @@
+package anomaly_test
+
+import (
+	"context"
+	"time"
+
+	appList "github.com/financial-manager/api/internal/application/insight/anomaly/list"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+type fakeUseCase struct {
+	in  appList.Input
+	out []domaininsight.Anomaly
+	err error
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appList.Input) ([]domaininsight.Anomaly, error) {
+	f.in = in
+	return f.out, f.err
+}
+
+func buildAnomaly(transactionID string) domaininsight.Anomaly {
+	return domaininsight.Anomaly{
+		TransactionID: transactionID,
+		AccountID:     "acc-001",
+		CategoryID:    "cat-001",
+		Amount:        480,
+		Description:   "Electronics store",
+		Date:          time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+		Score:         1.5,
+		Reasons: []domaininsight.Reason{
+			{Code: domaininsight.ReasonNewPayee, Message: "first expense for this payee"},
+		},
+		CreatedAt: time.Date(2026, 2, 28, 10, 0, 0, 0, time.UTC),
+	}
+}
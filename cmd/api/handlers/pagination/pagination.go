@@ -0,0 +1,32 @@
+// Package pagination reads the keyset pagination parameters shared by the list endpoints.
+package pagination
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+// Parse reads the limit, cursor, sort and order query parameters of r. Only a
+// limit that is not an integer is rejected here, with an error wrapping
+// domainshared.ErrInvalidPage; the use cases validate the rest.
+func Parse(r *http.Request) (domainshared.PageQuery, error) {
+	q := r.URL.Query()
+	page := domainshared.PageQuery{
+		Cursor:    q.Get("cursor"),
+		Sort:      q.Get("sort"),
+		Direction: domainshared.SortDirection(q.Get("order")),
+	}
+
+	if raw := q.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			return domainshared.PageQuery{}, fmt.Errorf("%w: limit must be an integer", domainshared.ErrInvalidPage)
+		}
+		page.Limit = limit
+	}
+
+	return page, nil
+}
@@ -0,0 +1,51 @@
+package pagination_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/cmd/api/handlers/pagination"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		query   string
+		want    domainshared.PageQuery
+		wantErr error
+	}{
+		{
+			name:  "no parameters",
+			query: "",
+			want:  domainshared.PageQuery{},
+		},
+		{
+			name:  "all parameters",
+			query: "?limit=20&cursor=abc&sort=amount&order=asc",
+			want:  domainshared.PageQuery{Limit: 20, Cursor: "abc", Sort: "amount", Direction: domainshared.SortAsc},
+		},
+		{
+			name:    "non-integer limit",
+			query:   "?limit=ten",
+			wantErr: domainshared.ErrInvalidPage,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts"+tc.query, nil)
+			got, err := pagination.Parse(req)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Package cashflow handles GET /api/v1/reports/cashflow.
+package cashflow
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/report/response"
+	appcashflow "github.com/financial-manager/api/internal/application/report/cashflow"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appcashflow.Input) (appcashflow.Output, error)
+}
+
+// Handler handles GET /api/v1/reports/cashflow.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+// Handle processes GET /api/v1/reports/cashflow?from=&to=&interval=&group_by=.
+// interval is week, month, quarter or year; group_by is account or category.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	out, err := h.uc.Execute(r.Context(), appcashflow.Input{
+		From:     q.Get("from"),
+		To:       q.Get("to"),
+		Interval: q.Get("interval"),
+		GroupBy:  q.Get("group_by"),
+	})
+	if err != nil {
+		response.WriteUseCaseError(w, err)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, out)
+}
@@ -0,0 +1,81 @@
+package cashflow_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/report/cashflow"
+	appcashflow "github.com/financial-manager/api/internal/application/report/cashflow"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	report := appcashflow.Output{
+		From: "2026-01-01", To: "2026-01-31", Interval: "month",
+		Periods: []appcashflow.Period{{Start: "2026-01-01", End: "2026-01-31", Income: 10, Net: 10, CumulativeNet: 10}},
+	}
+
+	tests := []struct {
+		name       string
+		uc         *fakeUseCase
+		url        string
+		wantStatus int
+		wantIn     appcashflow.Input
+		wantBody   string
+	}{
+		{
+			name:       "passes query parameters to the use case",
+			uc:         &fakeUseCase{out: report},
+			url:        "/api/v1/reports/cashflow?from=2026-01-01&to=2026-01-31&interval=month&group_by=account",
+			wantStatus: http.StatusOK,
+			wantIn:     appcashflow.Input{From: "2026-01-01", To: "2026-01-31", Interval: "month", GroupBy: "account"},
+		},
+		{
+			name:       "invalid query returns 400 with the reason",
+			uc:         &fakeUseCase{err: fmt.Errorf("cash flow report: %w: interval must be 'week', 'month', 'quarter' or 'year'", domainreport.ErrInvalidQuery)},
+			url:        "/api/v1/reports/cashflow?interval=day",
+			wantStatus: http.StatusBadRequest,
+			wantIn:     appcashflow.Input{Interval: "day"},
+			wantBody:   `{"error":"invalid report query: interval must be 'week', 'month', 'quarter' or 'year'"}`,
+		},
+		{
+			name:       "use case error returns 500",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			url:        "/api/v1/reports/cashflow",
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := cashflow.New(tc.uc)
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantIn, tc.uc.gotIn)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			if tc.wantBody != "" {
+				assert.JSONEq(t, tc.wantBody, rec.Body.String())
+				return
+			}
+			var got appcashflow.Output
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+			assert.Equal(t, report, got)
+		})
+	}
+}
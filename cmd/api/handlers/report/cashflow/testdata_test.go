@@ -0,0 +1,18 @@
+package cashflow_test
+
+import (
+	"context"
+
+	appcashflow "github.com/financial-manager/api/internal/application/report/cashflow"
+)
+
+type fakeUseCase struct {
+	out   appcashflow.Output
+	err   error
+	gotIn appcashflow.Input
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appcashflow.Input) (appcashflow.Output, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
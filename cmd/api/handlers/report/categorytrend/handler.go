@@ -0,0 +1,45 @@
+// Package categorytrend handles GET /api/v1/reports/categories.
+package categorytrend
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/report/response"
+	appcategorytrend "github.com/financial-manager/api/internal/application/report/categorytrend"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appcategorytrend.Input) (appcategorytrend.Output, error)
+}
+
+// Handler handles GET /api/v1/reports/categories.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+// Handle processes GET /api/v1/reports/categories?from=&to=&compare=month|year
+// &compare_from=&compare_to=&type=expense|income&threshold=.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	out, err := h.uc.Execute(r.Context(), appcategorytrend.Input{
+		From:        q.Get("from"),
+		To:          q.Get("to"),
+		Compare:     q.Get("compare"),
+		CompareFrom: q.Get("compare_from"),
+		CompareTo:   q.Get("compare_to"),
+		Type:        q.Get("type"),
+		Threshold:   q.Get("threshold"),
+	})
+	if err != nil {
+		response.WriteUseCaseError(w, err)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, out)
+}
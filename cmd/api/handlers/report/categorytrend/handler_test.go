@@ -0,0 +1,84 @@
+package categorytrend_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/report/categorytrend"
+	appcategorytrend "github.com/financial-manager/api/internal/application/report/categorytrend"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	report := appcategorytrend.Output{
+		Type: "expense", Threshold: 20,
+		Current:  appcategorytrend.Span{From: "2026-03-01", To: "2026-03-31"},
+		Previous: appcategorytrend.Span{From: "2026-02-01", To: "2026-02-28"},
+		Items:    []appcategorytrend.Item{{CategoryID: "cat-1", CategoryName: "Transporte", AboveAverage: []int{3}}},
+	}
+
+	tests := []struct {
+		name       string
+		uc         *fakeUseCase
+		url        string
+		wantStatus int
+		wantIn     appcategorytrend.Input
+		wantBody   string
+	}{
+		{
+			name:       "passes query parameters to the use case",
+			uc:         &fakeUseCase{out: report},
+			url:        "/api/v1/reports/categories?from=2026-03-01&to=2026-03-31&compare=year&compare_from=2025-03-01&compare_to=2025-03-31&type=income&threshold=15",
+			wantStatus: http.StatusOK,
+			wantIn: appcategorytrend.Input{
+				From: "2026-03-01", To: "2026-03-31", Compare: "year", CompareFrom: "2025-03-01", CompareTo: "2025-03-31", Type: "income", Threshold: "15",
+			},
+		},
+		{
+			name:       "invalid query returns 400",
+			uc:         &fakeUseCase{err: fmt.Errorf("category trend report: %w: compare must be 'month' or 'year'", domainreport.ErrInvalidQuery)},
+			url:        "/api/v1/reports/categories?compare=week",
+			wantStatus: http.StatusBadRequest,
+			wantIn:     appcategorytrend.Input{Compare: "week"},
+			wantBody:   `{"error":"invalid report query: compare must be 'month' or 'year'"}`,
+		},
+		{
+			name:       "use case error returns 500",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			url:        "/api/v1/reports/categories",
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := categorytrend.New(tc.uc)
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantIn, tc.uc.gotIn)
+			if tc.wantBody != "" {
+				assert.JSONEq(t, tc.wantBody, rec.Body.String())
+				return
+			}
+			var got appcategorytrend.Output
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+			assert.Equal(t, report, got)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package categorytrend_test
+
+import (
+	"context"
+
+	appcategorytrend "github.com/financial-manager/api/internal/application/report/categorytrend"
+)
+
+type fakeUseCase struct {
+	out   appcategorytrend.Output
+	err   error
+	gotIn appcategorytrend.Input
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appcategorytrend.Input) (appcategorytrend.Output, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
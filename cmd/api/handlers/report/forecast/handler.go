@@ -0,0 +1,36 @@
+// Package forecast handles GET /api/v1/reports/forecast.
+package forecast
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/report/response"
+	appforecast "github.com/financial-manager/api/internal/application/report/forecast"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appforecast.Input) (appforecast.Output, error)
+}
+
+// Handler handles GET /api/v1/reports/forecast.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+// Handle processes GET /api/v1/reports/forecast?months=, where months is the
+// number of months to forecast after the current one.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	out, err := h.uc.Execute(r.Context(), appforecast.Input{Months: r.URL.Query().Get("months")})
+	if err != nil {
+		response.WriteUseCaseError(w, err)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, out)
+}
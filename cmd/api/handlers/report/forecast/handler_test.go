@@ -0,0 +1,81 @@
+package forecast_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/report/forecast"
+	appforecast "github.com/financial-manager/api/internal/application/report/forecast"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	report := appforecast.Output{
+		AsOf:  "2026-04-15",
+		Month: appforecast.Month{Start: "2026-04-01", End: "2026-04-30", DaysElapsed: 15, DaysInMonth: 30},
+		Total: appforecast.AccountProjection{CurrentBalance: 100, ProjectedBalance: 90, Low: 80, High: 100},
+	}
+
+	tests := []struct {
+		name       string
+		uc         *fakeUseCase
+		url        string
+		wantStatus int
+		wantIn     appforecast.Input
+		wantBody   string
+	}{
+		{
+			name:       "passes months to the use case",
+			uc:         &fakeUseCase{out: report},
+			url:        "/api/v1/reports/forecast?months=2",
+			wantStatus: http.StatusOK,
+			wantIn:     appforecast.Input{Months: "2"},
+		},
+		{
+			name:       "invalid months returns 400",
+			uc:         &fakeUseCase{err: fmt.Errorf("forecast: %w: months must be between 0 and 6", domainreport.ErrInvalidQuery)},
+			url:        "/api/v1/reports/forecast?months=40",
+			wantStatus: http.StatusBadRequest,
+			wantIn:     appforecast.Input{Months: "40"},
+			wantBody:   `{"error":"invalid report query: months must be between 0 and 6"}`,
+		},
+		{
+			name:       "use case error returns 500",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			url:        "/api/v1/reports/forecast",
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := forecast.New(tc.uc)
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantIn, tc.uc.gotIn)
+			if tc.wantBody != "" {
+				assert.JSONEq(t, tc.wantBody, rec.Body.String())
+				return
+			}
+			var got appforecast.Output
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+			assert.Equal(t, report, got)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package forecast_test
+
+import (
+	"context"
+
+	appforecast "github.com/financial-manager/api/internal/application/report/forecast"
+)
+
+type fakeUseCase struct {
+	out   appforecast.Output
+	err   error
+	gotIn appforecast.Input
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appforecast.Input) (appforecast.Output, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
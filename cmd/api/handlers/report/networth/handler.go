@@ -0,0 +1,42 @@
+// Package networth handles GET /api/v1/reports/networth.
+package networth
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/report/response"
+	appnetworth "github.com/financial-manager/api/internal/application/report/networth"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appnetworth.Input) (appnetworth.Output, error)
+}
+
+// Handler handles GET /api/v1/reports/networth.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+// Handle processes GET /api/v1/reports/networth?from=&to=&interval=&currency=.
+// interval is week, month, quarter or year; currency defaults to the base currency.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	out, err := h.uc.Execute(r.Context(), appnetworth.Input{
+		From:     q.Get("from"),
+		To:       q.Get("to"),
+		Interval: q.Get("interval"),
+		Currency: q.Get("currency"),
+	})
+	if err != nil {
+		response.WriteUseCaseError(w, err)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, out)
+}
@@ -0,0 +1,87 @@
+package networth_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/report/networth"
+	appnetworth "github.com/financial-manager/api/internal/application/report/networth"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	report := appnetworth.Output{
+		From: "2026-01-01", To: "2026-01-31", Interval: "month", Currency: "EUR",
+		Periods: []appnetworth.Period{{Start: "2026-01-01", End: "2026-01-31", Assets: 10, NetWorth: 10, Accounts: []appnetworth.AccountBalance{}}},
+	}
+
+	tests := []struct {
+		name       string
+		uc         *fakeUseCase
+		url        string
+		wantStatus int
+		wantIn     appnetworth.Input
+		wantBody   string
+	}{
+		{
+			name:       "passes query parameters to the use case",
+			uc:         &fakeUseCase{out: report},
+			url:        "/api/v1/reports/networth?from=2026-01-01&to=2026-01-31&interval=month&currency=EUR",
+			wantStatus: http.StatusOK,
+			wantIn:     appnetworth.Input{From: "2026-01-01", To: "2026-01-31", Interval: "month", Currency: "EUR"},
+		},
+		{
+			name:       "invalid query returns 400",
+			uc:         &fakeUseCase{err: fmt.Errorf("net worth report: %w: from must be YYYY-MM-DD", domainreport.ErrInvalidQuery)},
+			url:        "/api/v1/reports/networth?from=jan",
+			wantStatus: http.StatusBadRequest,
+			wantIn:     appnetworth.Input{From: "jan"},
+			wantBody:   `{"error":"invalid report query: from must be YYYY-MM-DD"}`,
+		},
+		{
+			name:       "missing exchange rate returns 422",
+			uc:         &fakeUseCase{err: fmt.Errorf("net worth report: convert ARS to USD: currency: ARS to USD: %w for ARS", domainreport.ErrNoExchangeRate)},
+			url:        "/api/v1/reports/networth",
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"error":"no exchange rate for ARS"}`,
+		},
+		{
+			name:       "use case error returns 500",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			url:        "/api/v1/reports/networth",
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := networth.New(tc.uc)
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantIn, tc.uc.gotIn)
+			if tc.wantBody != "" {
+				assert.JSONEq(t, tc.wantBody, rec.Body.String())
+				return
+			}
+			var got appnetworth.Output
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+			assert.Equal(t, report, got)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package networth_test
+
+import (
+	"context"
+
+	appnetworth "github.com/financial-manager/api/internal/application/report/networth"
+)
+
+type fakeUseCase struct {
+	out   appnetworth.Output
+	err   error
+	gotIn appnetworth.Input
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appnetworth.Input) (appnetworth.Output, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
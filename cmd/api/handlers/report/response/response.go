@@ -0,0 +1,57 @@
+// Package response provides shared HTTP response helpers for the report
+// handler sub-packages.
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// Error is the JSON body of an error response.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// WriteJSON writes v as a JSON response with the given status code.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("handlers/report: failed to encode response: %v", err)
+	}
+}
+
+// WriteError writes a JSON error response with the given status code and message.
+func WriteError(w http.ResponseWriter, status int, msg string) {
+	WriteJSON(w, status, Error{Error: msg})
+}
+
+// WriteUseCaseError maps a report use case error to a response: invalid
+// queries are a 400 and missing exchange rates a 422, both carrying the
+// detail that follows the domain error; anything else is a 500.
+func WriteUseCaseError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domainreport.ErrInvalidQuery):
+		WriteError(w, http.StatusBadRequest, detail(err, domainreport.ErrInvalidQuery))
+	case errors.Is(err, domainreport.ErrNoExchangeRate):
+		WriteError(w, http.StatusUnprocessableEntity, detail(err, domainreport.ErrNoExchangeRate))
+	default:
+		log.Printf("handlers/report: %v", err)
+		WriteError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+// detail returns the part of err's message starting at target, dropping the
+// operation prefixes added while the error was wrapped.
+func detail(err, target error) string {
+	msg := err.Error()
+	if i := strings.Index(msg, target.Error()); i >= 0 {
+		return msg[i:]
+	}
+	return msg
+}
@@ -0,0 +1,89 @@
+// Package monthstart handles GET and PUT /api/v1/settings/month-start.
+package monthstart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	updatemonthstart "github.com/financial-manager/api/internal/application/setting/monthstart/update"
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+)
+
+type getter interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+type updater interface {
+	Execute(ctx context.Context, in updatemonthstart.Input) (int, error)
+}
+
+// Handler handles GET and PUT /api/v1/settings/month-start.
+type Handler struct {
+	getUC    getter
+	updateUC updater
+}
+
+// New creates a Handler with its required use case dependencies.
+func New(getUC getter, updateUC updater) *Handler {
+	return &Handler{getUC: getUC, updateUC: updateUC}
+}
+
+// Response is the JSON representation of the month start day setting.
+type Response struct {
+	Day int `json:"day"`
+}
+
+type updateRequest struct {
+	Day int `json:"day"`
+}
+
+// HandleGet processes GET /api/v1/settings/month-start.
+func (h *Handler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	day, err := h.getUC.Execute(r.Context())
+	if err != nil {
+		log.Printf("handlers/setting: get month start day: %v", err)
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Response{Day: day})
+}
+
+// HandleUpdate processes PUT /api/v1/settings/month-start.
+func (h *Handler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	var req updateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	day, err := h.updateUC.Execute(r.Context(), updatemonthstart.Input{Day: req.Day})
+	if errors.Is(err, domainsetting.ErrInvalidMonthStartDay) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("handlers/setting: update month start day: %v", err)
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Response{Day: day})
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("encode response: %v", err)
+	}
+}
+
+// writeError writes an error response.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
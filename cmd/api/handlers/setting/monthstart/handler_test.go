@@ -0,0 +1,115 @@
+package monthstart_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/cmd/api/handlers/setting/monthstart"
+	updatemonthstart "github.com/financial-manager/api/internal/application/setting/monthstart/update"
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+)
+
+func TestHandler_HandleGet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		getter     *fakeGetter
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "returns the month start day",
+			getter:     &fakeGetter{out: 25},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"day":25}`,
+		},
+		{
+			name:       "use case error returns 500",
+			getter:     &fakeGetter{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := monthstart.New(tc.getter, &fakeUpdater{})
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/settings/month-start", nil)
+			rec := httptest.NewRecorder()
+
+			h.HandleGet(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+		})
+	}
+}
+
+func TestHandler_HandleUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		body       string
+		updater    *fakeUpdater
+		wantStatus int
+		wantInput  updatemonthstart.Input
+		wantBody   string
+	}{
+		{
+			name:       "stores the month start day",
+			body:       `{"day":25}`,
+			updater:    &fakeUpdater{out: 25},
+			wantStatus: http.StatusOK,
+			wantInput:  updatemonthstart.Input{Day: 25},
+			wantBody:   `{"day":25}`,
+		},
+		{
+			name:       "invalid JSON body returns 400",
+			body:       `{"day":"25th"}`,
+			updater:    &fakeUpdater{},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"invalid request body"}`,
+		},
+		{
+			name:       "day out of range returns 400",
+			body:       `{"day":31}`,
+			updater:    &fakeUpdater{err: domainsetting.ErrInvalidMonthStartDay},
+			wantStatus: http.StatusBadRequest,
+			wantInput:  updatemonthstart.Input{Day: 31},
+			wantBody:   `{"error":"month start day must be between 1 and 28"}`,
+		},
+		{
+			name:       "use case error returns 500",
+			body:       `{"day":1}`,
+			updater:    &fakeUpdater{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantInput:  updatemonthstart.Input{Day: 1},
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := monthstart.New(&fakeGetter{}, tc.updater)
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/settings/month-start", strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+
+			h.HandleUpdate(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+			assert.Equal(t, tc.wantInput, tc.updater.in)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package monthstart_test
+
+import (
+	"context"
+
+	updatemonthstart "github.com/financial-manager/api/internal/application/setting/monthstart/update"
+)
+
+type fakeGetter struct {
+	out int
+	err error
+}
+
+func (f *fakeGetter) Execute(_ context.Context) (int, error) {
+	return f.out, f.err
+}
+
+type fakeUpdater struct {
+	in  updatemonthstart.Input
+	out int
+	err error
+}
+
+func (f *fakeUpdater) Execute(_ context.Context, in updatemonthstart.Input) (int, error) {
+	f.in = in
+	return f.out, f.err
+}
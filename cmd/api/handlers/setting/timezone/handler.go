@@ -0,0 +1,90 @@
+// Package timezone handles GET and PUT /api/v1/settings/timezone.
+package timezone
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	updatetimezone "github.com/financial-manager/api/internal/application/setting/timezone/update"
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+)
+
+type getter interface {
+	Execute(ctx context.Context) (*time.Location, error)
+}
+
+type updater interface {
+	Execute(ctx context.Context, in updatetimezone.Input) (*time.Location, error)
+}
+
+// Handler handles GET and PUT /api/v1/settings/timezone.
+type Handler struct {
+	getUC    getter
+	updateUC updater
+}
+
+// New creates a Handler with its required use case dependencies.
+func New(getUC getter, updateUC updater) *Handler {
+	return &Handler{getUC: getUC, updateUC: updateUC}
+}
+
+// Response is the JSON representation of the timezone setting.
+type Response struct {
+	Timezone string `json:"timezone"`
+}
+
+type updateRequest struct {
+	Timezone string `json:"timezone"`
+}
+
+// HandleGet processes GET /api/v1/settings/timezone.
+func (h *Handler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	loc, err := h.getUC.Execute(r.Context())
+	if err != nil {
+		log.Printf("handlers/setting: get timezone: %v", err)
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Response{Timezone: loc.String()})
+}
+
+// HandleUpdate processes PUT /api/v1/settings/timezone.
+func (h *Handler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	var req updateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	loc, err := h.updateUC.Execute(r.Context(), updatetimezone.Input{Timezone: req.Timezone})
+	if errors.Is(err, domainsetting.ErrInvalidTimezone) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("handlers/setting: update timezone: %v", err)
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Response{Timezone: loc.String()})
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("encode response: %v", err)
+	}
+}
+
+// writeError writes an error response.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
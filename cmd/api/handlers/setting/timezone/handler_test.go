@@ -0,0 +1,124 @@
+package timezone_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/setting/timezone"
+	updatetimezone "github.com/financial-manager/api/internal/application/setting/timezone/update"
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+)
+
+func TestHandler_HandleGet(t *testing.T) {
+	t.Parallel()
+
+	bogota, err := time.LoadLocation("America/Bogota")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		getter     *fakeGetter
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "returns the timezone",
+			getter:     &fakeGetter{out: bogota},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"timezone":"America/Bogota"}`,
+		},
+		{
+			name:       "use case error returns 500",
+			getter:     &fakeGetter{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := timezone.New(tc.getter, &fakeUpdater{})
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/settings/timezone", nil)
+			rec := httptest.NewRecorder()
+
+			h.HandleGet(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+		})
+	}
+}
+
+func TestHandler_HandleUpdate(t *testing.T) {
+	t.Parallel()
+
+	bogota, err := time.LoadLocation("America/Bogota")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		body       string
+		updater    *fakeUpdater
+		wantStatus int
+		wantInput  updatetimezone.Input
+		wantBody   string
+	}{
+		{
+			name:       "stores the timezone",
+			body:       `{"timezone":"America/Bogota"}`,
+			updater:    &fakeUpdater{out: bogota},
+			wantStatus: http.StatusOK,
+			wantInput:  updatetimezone.Input{Timezone: "America/Bogota"},
+			wantBody:   `{"timezone":"America/Bogota"}`,
+		},
+		{
+			name:       "invalid JSON body returns 400",
+			body:       "not-json",
+			updater:    &fakeUpdater{},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"invalid request body"}`,
+		},
+		{
+			name:       "unknown timezone returns 400",
+			body:       `{"timezone":"Mars/Olympus"}`,
+			updater:    &fakeUpdater{err: fmt.Errorf("%w: Mars/Olympus", domainsetting.ErrInvalidTimezone)},
+			wantStatus: http.StatusBadRequest,
+			wantInput:  updatetimezone.Input{Timezone: "Mars/Olympus"},
+			wantBody:   `{"error":"invalid timezone: Mars/Olympus"}`,
+		},
+		{
+			name:       "use case error returns 500",
+			body:       `{"timezone":"UTC"}`,
+			updater:    &fakeUpdater{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantInput:  updatetimezone.Input{Timezone: "UTC"},
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := timezone.New(&fakeGetter{}, tc.updater)
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/settings/timezone", strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+
+			h.HandleUpdate(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+			assert.Equal(t, tc.wantInput, tc.updater.in)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package timezone_test
+
+import (
+	"context"
+	"time"
+
+	updatetimezone "github.com/financial-manager/api/internal/application/setting/timezone/update"
+)
+
+type fakeGetter struct {
+	out *time.Location
+	err error
+}
+
+func (f *fakeGetter) Execute(_ context.Context) (*time.Location, error) {
+	return f.out, f.err
+}
+
+type fakeUpdater struct {
+	in  updatetimezone.Input
+	out *time.Location
+	err error
+}
+
+func (f *fakeUpdater) Execute(_ context.Context, in updatetimezone.Input) (*time.Location, error) {
+	f.in = in
+	return f.out, f.err
+}
@@ -0,0 +1,94 @@
+// Package bulk handles POST /api/v1/transactions/bulk.
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appbulk "github.com/financial-manager/api/internal/application/transaction/bulk"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// maxBodyBytes bounds the request body; MaxOperations operations fit well within it.
+const maxBodyBytes = 1 << 20
+
+type useCase interface {
+	Execute(ctx context.Context, in appbulk.Input) ([]appbulk.Result, error)
+}
+
+// Handler handles POST /api/v1/transactions/bulk.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+type bulkResponse struct {
+	Results []resultResponse `json:"results"`
+}
+
+type resultResponse struct {
+	Action      string               `json:"action"`
+	Transaction response.Transaction `json:"transaction"`
+	Warnings    []response.Warning   `json:"warnings,omitempty"`
+}
+
+// errorResponse lists the failed operations, by their position in the request.
+type errorResponse struct {
+	Error string              `json:"error"`
+	Items []itemErrorResponse `json:"items,omitempty"`
+}
+
+type itemErrorResponse struct {
+	Index int    `json:"index"`
+	Error string `json:"error"`
+}
+
+// Handle processes POST /api/v1/transactions/bulk. The operations are applied
+// in order and atomically. It returns 200 with the resulting transaction of
+// each operation, warning on created expenses flagged as unusual, and 400
+// listing every failed operation when any is invalid or targets a missing
+// transaction or account, in which case nothing is applied.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	var in appbulk.Input
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes)).Decode(&in); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	results, err := h.uc.Execute(r.Context(), in)
+	if err != nil {
+		var bulkErr *domaintransaction.BulkError
+		switch {
+		case errors.As(err, &bulkErr):
+			resp := errorResponse{Error: domaintransaction.ErrInvalidBulk.Error()}
+			for _, item := range bulkErr.Items {
+				resp.Items = append(resp.Items, itemErrorResponse{Index: item.Index, Error: item.Err.Error()})
+			}
+			response.WriteJSON(w, http.StatusBadRequest, resp)
+		case errors.Is(err, domaintransaction.ErrInvalidBulk):
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			log.Printf("bulk transactions: %v", err)
+			response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	resp := bulkResponse{Results: make([]resultResponse, len(results))}
+	for i, res := range results {
+		resp.Results[i] = resultResponse{Action: res.Action, Transaction: response.ToTransaction(res.Transaction)}
+		if res.Anomaly != nil {
+			resp.Results[i].Warnings = []response.Warning{response.UnusualWarning(*res.Anomaly)}
+		}
+	}
+
+	response.WriteJSON(w, http.StatusOK, resp)
+}
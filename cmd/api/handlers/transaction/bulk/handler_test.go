@@ -0,0 +1,152 @@
+package bulk_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/bulk"
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appbulk "github.com/financial-manager/api/internal/application/transaction/bulk"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+type resultBody struct {
+	Action      string               `json:"action"`
+	Transaction response.Transaction `json:"transaction"`
+	Warnings    []response.Warning   `json:"warnings"`
+}
+
+type body struct {
+	Results []resultBody `json:"results"`
+	Error   string       `json:"error"`
+	Items   []struct {
+		Index int    `json:"index"`
+		Error string `json:"error"`
+	} `json:"items"`
+}
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	moved := buildTransaction("tx-1", "cat-new")
+	created := buildTransaction("tx-2", "cat-001")
+	unusual := domaininsight.Anomaly{
+		TransactionID: "tx-2",
+		Amount:        42,
+		Score:         1,
+		Reasons:       []domaininsight.Reason{{Code: domaininsight.ReasonNewPayee, Message: "first expense for this payee"}},
+	}
+	bulkErr := &domaintransaction.BulkError{Items: []domaintransaction.BulkItemError{
+		{Index: 0, Err: domaintransaction.ErrTransactionNotFound},
+		{Index: 2, Err: errors.New("category_id is required")},
+	}}
+
+	tests := []struct {
+		name       string
+		body       string
+		uc         *fakeUseCase
+		wantStatus int
+		check      func(t *testing.T, got body)
+		wantIn     appbulk.Input
+	}{
+		{
+			name:       "applied operations return 200 with their results",
+			body:       `{"operations":[{"action":"recategorize","id":"tx-1","category_id":"cat-new"}]}`,
+			uc:         &fakeUseCase{out: []appbulk.Result{{Action: "recategorize", Transaction: moved}}},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, got body) {
+				assert.Equal(t, []resultBody{{Action: "recategorize", Transaction: response.ToTransaction(moved)}}, got.Results)
+			},
+			wantIn: appbulk.Input{Operations: []appbulk.Operation{{Action: "recategorize", ID: "tx-1", CategoryID: "cat-new"}}},
+		},
+		{
+			name:       "created expenses flagged as unusual carry a warning",
+			body:       `{"operations":[{"action":"create","type":"expense","account_id":"acc-001","amount":42,"date":"2026-02-28"}]}`,
+			uc:         &fakeUseCase{out: []appbulk.Result{{Action: "create", Transaction: created, Anomaly: &unusual}}},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, got body) {
+				require.Len(t, got.Results, 1)
+				assert.Equal(t, []response.Warning{response.UnusualWarning(unusual)}, got.Results[0].Warnings)
+			},
+			wantIn: appbulk.Input{Operations: []appbulk.Operation{{Action: "create", Type: "expense", AccountID: "acc-001", Amount: 42, Date: "2026-02-28"}}},
+		},
+		{
+			name:       "invalid JSON body returns 400",
+			body:       "not-json",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+			check: func(t *testing.T, got body) {
+				assert.Equal(t, "invalid request body", got.Error)
+			},
+		},
+		{
+			name:       "failed operations return 400 listing each one",
+			body:       `{"operations":[{"action":"delete","id":"missing"},{"action":"delete","id":"tx-2"},{"action":"recategorize","id":"tx-3"}]}`,
+			uc:         &fakeUseCase{err: fmt.Errorf("bulk transactions: %w", bulkErr)},
+			wantStatus: http.StatusBadRequest,
+			check: func(t *testing.T, got body) {
+				assert.Equal(t, "invalid bulk request", got.Error)
+				require.Len(t, got.Items, 2)
+				assert.Equal(t, 0, got.Items[0].Index)
+				assert.Equal(t, "transaction not found", got.Items[0].Error)
+				assert.Equal(t, 2, got.Items[1].Index)
+				assert.Equal(t, "category_id is required", got.Items[1].Error)
+			},
+			wantIn: appbulk.Input{Operations: []appbulk.Operation{
+				{Action: "delete", ID: "missing"},
+				{Action: "delete", ID: "tx-2"},
+				{Action: "recategorize", ID: "tx-3"},
+			}},
+		},
+		{
+			name:       "invalid request returns 400",
+			body:       `{"operations":[]}`,
+			uc:         &fakeUseCase{err: fmt.Errorf("bulk transactions: %w: operations are required", domaintransaction.ErrInvalidBulk)},
+			wantStatus: http.StatusBadRequest,
+			check: func(t *testing.T, got body) {
+				assert.Equal(t, "bulk transactions: invalid bulk request: operations are required", got.Error)
+				assert.Empty(t, got.Items)
+			},
+			wantIn: appbulk.Input{Operations: []appbulk.Operation{}},
+		},
+		{
+			name:       "other use case error returns 500",
+			body:       `{"operations":[{"action":"delete","id":"tx-1"}]}`,
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			check: func(t *testing.T, got body) {
+				assert.Equal(t, "internal server error", got.Error)
+			},
+			wantIn: appbulk.Input{Operations: []appbulk.Operation{{Action: "delete", ID: "tx-1"}}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := bulk.New(tc.uc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/transactions/bulk", bytes.NewReader([]byte(tc.body)))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantIn, tc.uc.gotIn)
+			var got body
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+			tc.check(t, got)
+		})
+	}
+}
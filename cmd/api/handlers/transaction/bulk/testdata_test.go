@@ -0,0 +1,36 @@
+package bulk_test
+
+import (
+	"context"
+	"time"
+
+	appbulk "github.com/financial-manager/api/internal/application/transaction/bulk"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+type fakeUseCase struct {
+	out   []appbulk.Result
+	err   error
+	gotIn appbulk.Input
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appbulk.Input) ([]appbulk.Result, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
+
+// buildTransaction returns a valid Transaction for use in tests.
+func buildTransaction(id, categoryID string) domaintransaction.Transaction {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	return domaintransaction.Transaction{
+		ID:         id,
+		AccountID:  "acc-001",
+		CategoryID: categoryID,
+		Type:       domaintransaction.TransactionTypeExpense,
+		Amount:     42,
+		Date:       time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+		IsActive:   true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
@@ -0,0 +1,72 @@
+// Package report handles GET /api/v1/transactions/duplicates.
+package report
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appReport "github.com/financial-manager/api/internal/application/transaction/duplicate/report"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appReport.Input) ([]appReport.Group, error)
+}
+
+// Handler handles GET /api/v1/transactions/duplicates.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+// group is the JSON representation of a suspected duplicate group.
+type group struct {
+	KeepID       string                 `json:"keep_id"`
+	Transactions []response.Transaction `json:"transactions"`
+}
+
+// Handle processes GET /api/v1/transactions/duplicates.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	in := appReport.Input{
+		StartDate: q.Get("start_date"),
+		EndDate:   q.Get("end_date"),
+	}
+
+	if v := q.Get("window_days"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days <= 0 {
+			response.WriteError(w, http.StatusBadRequest, "window_days must be a positive integer")
+			return
+		}
+		in.WindowDays = days
+	}
+	if v := q.Get("min_similarity"); v != "" {
+		sim, err := strconv.ParseFloat(v, 64)
+		if err != nil || sim <= 0 || sim > 1 {
+			response.WriteError(w, http.StatusBadRequest, "min_similarity must be a number in (0, 1]")
+			return
+		}
+		in.MinSimilarity = sim
+	}
+
+	groups, err := h.uc.Execute(r.Context(), in)
+	if err != nil {
+		response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	resp := make([]group, 0, len(groups))
+	for _, g := range groups {
+		resp = append(resp, group{KeepID: g.KeepID, Transactions: response.ToTransactions(g.Transactions)})
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"groups": resp,
+	})
+}
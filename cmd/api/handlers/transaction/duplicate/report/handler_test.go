@@ -0,0 +1,95 @@
+package report_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/duplicate/report"
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appReport "github.com/financial-manager/api/internal/application/transaction/duplicate/report"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	tx1, tx2 := buildDomainTransaction("tx-1"), buildDomainTransaction("tx-2")
+
+	tests := []struct {
+		name       string
+		query      string
+		uc         *fakeUseCase
+		wantStatus int
+		wantInput  appReport.Input
+		wantBody   string
+	}{
+		{
+			name:  "returns groups with their suggested survivor",
+			query: "?start_date=2026-02-01&window_days=5&min_similarity=0.8",
+			uc: &fakeUseCase{out: []appReport.Group{
+				{KeepID: "tx-1", Transactions: []domaintransaction.Transaction{tx1, tx2}},
+			}},
+			wantStatus: http.StatusOK,
+			wantInput:  appReport.Input{StartDate: "2026-02-01", WindowDays: 5, MinSimilarity: 0.8},
+			wantBody:   mustJSON(t, map[string]any{"groups": []any{map[string]any{"keep_id": "tx-1", "transactions": response.ToTransactions([]domaintransaction.Transaction{tx1, tx2})}}}),
+		},
+		{
+			name:       "no groups returns empty list",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"groups":[]}`,
+		},
+		{
+			name:       "invalid window_days returns 400",
+			query:      "?window_days=abc",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"window_days must be a positive integer"}`,
+		},
+		{
+			name:       "out of range min_similarity returns 400",
+			query:      "?min_similarity=2",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"min_similarity must be a number in (0, 1]"}`,
+		},
+		{
+			name:       "use case error returns 500",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"internal server error"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := report.New(tc.uc)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/duplicates"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.JSONEq(t, tc.wantBody, rec.Body.String())
+			if tc.wantStatus == http.StatusOK {
+				assert.Equal(t, tc.wantInput, tc.uc.in)
+			}
+		})
+	}
+}
+
+// mustJSON marshals v and fails the test on error.
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(b)
+}
@@ -0,0 +1,39 @@
+package report_test
+
+import (
+	"context"
+	"time"
+
+	appReport "github.com/financial-manager/api/internal/application/transaction/duplicate/report"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const fixedTimestamp = "2026-02-28T10:00:00Z"
+
+type fakeUseCase struct {
+	in  appReport.Input
+	out []appReport.Group
+	err error
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appReport.Input) ([]appReport.Group, error) {
+	f.in = in
+	return f.out, f.err
+}
+
+func buildDomainTransaction(id string) domaintransaction.Transaction {
+	t, _ := time.Parse("2006-01-02T15:04:05Z", fixedTimestamp)
+	date, _ := time.Parse("2006-01-02", "2026-02-28")
+	return domaintransaction.Transaction{
+		ID:          id,
+		AccountID:   "acc-001",
+		CategoryID:  "cat-001",
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      25.0,
+		Description: "Cinema",
+		Date:        date,
+		IsActive:    true,
+		CreatedAt:   t,
+		UpdatedAt:   t,
+	}
+}
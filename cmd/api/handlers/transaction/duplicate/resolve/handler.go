@@ -0,0 +1,65 @@
+// Package resolve handles POST /api/v1/transactions/duplicates/resolve.
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appResolve "github.com/financial-manager/api/internal/application/transaction/duplicate/resolve"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appResolve.Input) (domaintransaction.Transaction, error)
+}
+
+// Handler handles POST /api/v1/transactions/duplicates/resolve.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+type resolveRequest struct {
+	KeepID    string   `json:"keep_id"`
+	RemoveIDs []string `json:"remove_ids"`
+	Merge     bool     `json:"merge"`
+}
+
+// Handle processes POST /api/v1/transactions/duplicates/resolve and returns the kept transaction.
+// It returns 400 for an invalid request, 404 when a transaction does not exist and 500 otherwise.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req resolveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	tx, err := h.uc.Execute(r.Context(), appResolve.Input{
+		KeepID:    req.KeepID,
+		RemoveIDs: req.RemoveIDs,
+		Merge:     req.Merge,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainshared.ErrNotFound):
+			response.WriteError(w, http.StatusNotFound, "transaction not found")
+		case errors.Is(err, domaintransaction.ErrInvalidResolve):
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			log.Printf("resolve duplicates: %v", err)
+			response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, response.ToTransaction(tx))
+}
@@ -0,0 +1,98 @@
+package resolve_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/duplicate/resolve"
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appResolve "github.com/financial-manager/api/internal/application/transaction/duplicate/resolve"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	kept := buildDomainTransaction("tx-1")
+
+	tests := []struct {
+		name       string
+		body       any
+		uc         *fakeUseCase
+		wantStatus int
+		wantBody   any
+	}{
+		{
+			name:       "valid body returns 200 with the kept transaction",
+			body:       map[string]any{"keep_id": "tx-1", "remove_ids": []string{"tx-2"}, "merge": true},
+			uc:         &fakeUseCase{out: kept},
+			wantStatus: http.StatusOK,
+			wantBody:   response.ToTransaction(kept),
+		},
+		{
+			name:       "invalid JSON body returns 400",
+			body:       "not-json",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Error{Error: "invalid request body"},
+		},
+		{
+			name:       "unknown transaction returns 404",
+			body:       map[string]any{"keep_id": "missing", "remove_ids": []string{"tx-2"}},
+			uc:         &fakeUseCase{err: domainshared.ErrNotFound},
+			wantStatus: http.StatusNotFound,
+			wantBody:   response.Error{Error: "transaction not found"},
+		},
+		{
+			name:       "validation error returns 400",
+			body:       map[string]any{"keep_id": "tx-1"},
+			uc:         &fakeUseCase{err: fmt.Errorf("%w: remove_ids must not be empty", domaintransaction.ErrInvalidResolve)},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Error{Error: "invalid duplicate resolution: remove_ids must not be empty"},
+		},
+		{
+			name:       "repository error returns 500",
+			body:       map[string]any{"keep_id": "tx-1", "remove_ids": []string{"tx-2"}},
+			uc:         &fakeUseCase{err: fmt.Errorf("resolve duplicates: %w", errors.New("database is locked"))},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   response.Error{Error: "internal server error"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := resolve.New(tc.uc)
+			bodyBytes, _ := json.Marshal(tc.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/transactions/duplicates/resolve", bytes.NewReader(bodyBytes))
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantBody, decodeAs(t, rec, tc.wantBody))
+			if tc.wantStatus == http.StatusOK {
+				assert.Equal(t, appResolve.Input{KeepID: "tx-1", RemoveIDs: []string{"tx-2"}, Merge: true}, tc.uc.in)
+			}
+		})
+	}
+}
+
+// decodeAs decodes the recorder body into a new value of the same type as want.
+func decodeAs(t *testing.T, rec *httptest.ResponseRecorder, want any) any {
+	t.Helper()
+	ptr := reflect.New(reflect.TypeOf(want))
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(ptr.Interface()))
+	return ptr.Elem().Interface()
+}
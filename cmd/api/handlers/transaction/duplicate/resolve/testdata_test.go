@@ -0,0 +1,39 @@
+package resolve_test
+
+import (
+	"context"
+	"time"
+
+	appResolve "github.com/financial-manager/api/internal/application/transaction/duplicate/resolve"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const fixedTimestamp = "2026-02-28T10:00:00Z"
+
+type fakeUseCase struct {
+	in  appResolve.Input
+	out domaintransaction.Transaction
+	err error
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appResolve.Input) (domaintransaction.Transaction, error) {
+	f.in = in
+	return f.out, f.err
+}
+
+func buildDomainTransaction(id string) domaintransaction.Transaction {
+	t, _ := time.Parse("2006-01-02T15:04:05Z", fixedTimestamp)
+	date, _ := time.Parse("2006-01-02", "2026-02-28")
+	return domaintransaction.Transaction{
+		ID:          id,
+		AccountID:   "acc-001",
+		CategoryID:  "cat-001",
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      25.0,
+		Description: "Cinema",
+		Date:        date,
+		IsActive:    true,
+		CreatedAt:   t,
+		UpdatedAt:   t,
+	}
+}
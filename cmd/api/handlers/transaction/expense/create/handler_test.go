@@ -14,6 +14,9 @@ import (
 
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/expense/create"
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appCreate "github.com/financial-manager/api/internal/application/transaction/expense/create"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
 func TestHandler_Handle(t *testing.T) {
@@ -21,6 +24,12 @@ func TestHandler_Handle(t *testing.T) {
 
 	tx := buildDomainTransaction("tx-1", "acc-001", 100.0)
 	txResp := response.ToTransaction(tx)
+	dup := buildDomainTransaction("tx-0", "acc-001", 100.0)
+	anomaly := domaininsight.Anomaly{
+		TransactionID: tx.ID,
+		Score:         1,
+		Reasons:       []domaininsight.Reason{{Code: domaininsight.ReasonNewPayee, Message: "first expense for this payee"}},
+	}
 
 	tests := []struct {
 		name       string
@@ -38,9 +47,29 @@ func TestHandler_Handle(t *testing.T) {
 				"description": "Groceries",
 				"date":        "2026-02-28",
 			},
-			uc:         &fakeUseCase{out: tx},
+			uc:         &fakeUseCase{out: appCreate.Output{Transaction: tx}},
 			wantStatus: http.StatusCreated,
-			wantBody:   txResp,
+			wantBody:   response.Created{Transaction: txResp},
+		},
+		{
+			name:       "likely duplicate adds a warning with the candidates",
+			body:       map[string]any{"account_id": "acc-001", "amount": 100.0, "date": "2026-02-28"},
+			uc:         &fakeUseCase{out: appCreate.Output{Transaction: tx, Duplicates: []domaintransaction.Transaction{dup}}},
+			wantStatus: http.StatusCreated,
+			wantBody: response.Created{
+				Transaction: txResp,
+				Warnings:    []response.Warning{response.DuplicateWarning([]domaintransaction.Transaction{dup})},
+			},
+		},
+		{
+			name:       "unusual expense adds a warning with the reasons",
+			body:       map[string]any{"account_id": "acc-001", "amount": 100.0, "date": "2026-02-28"},
+			uc:         &fakeUseCase{out: appCreate.Output{Transaction: tx, Anomaly: &anomaly}},
+			wantStatus: http.StatusCreated,
+			wantBody: response.Created{
+				Transaction: txResp,
+				Warnings:    []response.Warning{response.UnusualWarning(anomaly)},
+			},
 		},
 		{
 			name:       "invalid JSON body returns 400",
@@ -0,0 +1,77 @@
+// Package fulltext handles GET /api/v1/transactions/search.
+package fulltext
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appfulltext "github.com/financial-manager/api/internal/application/transaction/fulltext"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appfulltext.Input) ([]domaintransaction.SearchHit, error)
+}
+
+// Handler handles GET /api/v1/transactions/search.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+type searchResponse struct {
+	Results []hitResponse `json:"results"`
+}
+
+type hitResponse struct {
+	Transaction response.Transaction `json:"transaction"`
+	Snippet     string               `json:"snippet"`
+	Rank        float64              `json:"rank"`
+}
+
+// Handle processes GET /api/v1/transactions/search?q=&limit= and returns 200
+// with the matching transactions, most relevant first, each with a
+// highlighted snippet of its description. A blank q or an invalid limit
+// returns 400.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	in := appfulltext.Input{Query: r.URL.Query().Get("q")}
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			response.WriteError(w, http.StatusBadRequest, fmt.Sprintf("%v: limit must be an integer", domaintransaction.ErrInvalidSearch))
+			return
+		}
+		in.Limit = limit
+	}
+
+	hits, err := h.uc.Execute(r.Context(), in)
+	if errors.Is(err, domaintransaction.ErrInvalidSearch) {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("transaction full text search: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	results := make([]hitResponse, 0, len(hits))
+	for _, hit := range hits {
+		results = append(results, hitResponse{
+			Transaction: response.ToTransaction(hit.Transaction),
+			Snippet:     hit.Snippet,
+			Rank:        hit.Rank,
+		})
+	}
+
+	response.WriteJSON(w, http.StatusOK, searchResponse{Results: results})
+}
@@ -0,0 +1,109 @@
+package fulltext_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/fulltext"
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appfulltext "github.com/financial-manager/api/internal/application/transaction/fulltext"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// searchResponse mirrors the handler's unexported searchResponse for test decoding.
+type searchResponse struct {
+	Results []struct {
+		Transaction response.Transaction `json:"transaction"`
+		Snippet     string               `json:"snippet"`
+		Rank        float64              `json:"rank"`
+	} `json:"results"`
+}
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	hit := buildSearchHit()
+	uc := &fakeUseCase{out: []domaintransaction.SearchHit{hit}}
+	h := fulltext.New(uc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/search?q=farm&limit=5", nil)
+	rec := httptest.NewRecorder()
+
+	h.Handle(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, appfulltext.Input{Query: "farm", Limit: 5}, uc.gotIn)
+
+	var body searchResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Len(t, body.Results, 1)
+	assert.Equal(t, response.ToTransaction(hit.Transaction), body.Results[0].Transaction)
+	assert.Equal(t, hit.Snippet, body.Results[0].Snippet)
+	assert.Equal(t, hit.Rank, body.Results[0].Rank)
+}
+
+func TestHandler_Handle_NoResults(t *testing.T) {
+	t.Parallel()
+
+	h := fulltext.New(&fakeUseCase{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/search?q=zzz", nil)
+	rec := httptest.NewRecorder()
+
+	h.Handle(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"results":[]}`, rec.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		query      string
+		uc         *fakeUseCase
+		wantStatus int
+	}{
+		{
+			name:       "non-integer limit returns 400",
+			query:      "?q=farm&limit=many",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid search returns 400",
+			query:      "?q=",
+			uc:         &fakeUseCase{err: fmt.Errorf("%w: query is required", domaintransaction.ErrInvalidSearch)},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "use case error returns 500",
+			query:      "?q=farm",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := fulltext.New(tc.uc)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/search"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
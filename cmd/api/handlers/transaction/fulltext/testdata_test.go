@@ -0,0 +1,43 @@
+package fulltext_test
+
+import (
+	"context"
+	"time"
+
+	appfulltext "github.com/financial-manager/api/internal/application/transaction/fulltext"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const fixedTimestamp = "2026-03-05T10:00:00Z"
+
+type fakeUseCase struct {
+	out   []domaintransaction.SearchHit
+	err   error
+	gotIn appfulltext.Input
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appfulltext.Input) ([]domaintransaction.SearchHit, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
+
+func buildSearchHit() domaintransaction.SearchHit {
+	t, _ := time.Parse("2006-01-02T15:04:05Z", fixedTimestamp)
+	date, _ := time.Parse("2006-01-02", "2026-03-05")
+	return domaintransaction.SearchHit{
+		Transaction: domaintransaction.Transaction{
+			ID:          "tx-1",
+			AccountID:   "acc-001",
+			CategoryID:  "cat-001",
+			Type:        domaintransaction.TransactionTypeExpense,
+			Amount:      12.5,
+			Description: "Farmacia del Centro",
+			Date:        date,
+			IsActive:    true,
+			CreatedAt:   t,
+			UpdatedAt:   t,
+		},
+		Snippet: "<mark>Farmacia</mark> del Centro",
+		Rank:    -1.5,
+	}
+}
@@ -8,11 +8,10 @@ import (
 
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
 	appCreate "github.com/financial-manager/api/internal/application/transaction/income/create"
-	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
 type useCase interface {
-	Execute(ctx context.Context, in appCreate.Input) (domaintransaction.Transaction, error)
+	Execute(ctx context.Context, in appCreate.Input) (appCreate.Output, error)
 }
 
 // Handler handles POST /api/v1/transactions/incomes.
@@ -33,7 +32,8 @@ type createRequest struct {
 	Date        string  `json:"date"`
 }
 
-// Handle processes POST /api/v1/transactions/incomes and returns 201 with the created transaction.
+// Handle processes POST /api/v1/transactions/incomes and returns 201 with the created transaction
+// and a warning listing likely duplicates, if any.
 func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	var req createRequest
 
@@ -42,7 +42,7 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tx, err := h.uc.Execute(r.Context(), appCreate.Input{
+	out, err := h.uc.Execute(r.Context(), appCreate.Input{
 		AccountID:   req.AccountID,
 		CategoryID:  req.CategoryID,
 		Amount:      req.Amount,
@@ -54,5 +54,10 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response.WriteJSON(w, http.StatusCreated, response.ToTransaction(tx))
+	resp := response.Created{Transaction: response.ToTransaction(out.Transaction)}
+	if len(out.Duplicates) > 0 {
+		resp.Warnings = append(resp.Warnings, response.DuplicateWarning(out.Duplicates))
+	}
+
+	response.WriteJSON(w, http.StatusCreated, resp)
 }
@@ -14,6 +14,8 @@ import (
 
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/income/create"
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appCreate "github.com/financial-manager/api/internal/application/transaction/income/create"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
 func TestHandler_Handle(t *testing.T) {
@@ -21,6 +23,7 @@ func TestHandler_Handle(t *testing.T) {
 
 	tx := buildDomainTransaction("tx-1", "acc-001", 1000.0)
 	txResp := response.ToTransaction(tx)
+	dup := buildDomainTransaction("tx-0", "acc-001", 1000.0)
 
 	tests := []struct {
 		name       string
@@ -38,9 +41,19 @@ func TestHandler_Handle(t *testing.T) {
 				"description": "Salary",
 				"date":        "2026-02-28",
 			},
-			uc:         &fakeUseCase{out: tx},
+			uc:         &fakeUseCase{out: appCreate.Output{Transaction: tx}},
 			wantStatus: http.StatusCreated,
-			wantBody:   txResp,
+			wantBody:   response.Created{Transaction: txResp},
+		},
+		{
+			name:       "likely duplicate adds a warning with the candidates",
+			body:       map[string]any{"account_id": "acc-001", "amount": 1000.0, "date": "2026-02-28"},
+			uc:         &fakeUseCase{out: appCreate.Output{Transaction: tx, Duplicates: []domaintransaction.Transaction{dup}}},
+			wantStatus: http.StatusCreated,
+			wantBody: response.Created{
+				Transaction: txResp,
+				Warnings:    []response.Warning{response.DuplicateWarning([]domaintransaction.Transaction{dup})},
+			},
 		},
 		{
 			name:       "invalid JSON body returns 400",
@@ -11,11 +11,11 @@ import (
 const fixedTimestamp = "2026-02-28T10:00:00Z"
 
 type fakeUseCase struct {
-	out domaintransaction.Transaction
+	out appCreate.Output
 	err error
 }
 
-func (f *fakeUseCase) Execute(_ context.Context, _ appCreate.Input) (domaintransaction.Transaction, error) {
+func (f *fakeUseCase) Execute(_ context.Context, _ appCreate.Input) (appCreate.Output, error) {
 	return f.out, f.err
 }
 
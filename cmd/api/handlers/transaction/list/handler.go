@@ -3,20 +3,23 @@ package list
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"github.com/financial-manager/api/cmd/api/handlers/pagination"
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
 	expenselist "github.com/financial-manager/api/internal/application/transaction/expense/list"
 	incomelist "github.com/financial-manager/api/internal/application/transaction/income/list"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
 type incomeLister interface {
-	Execute(ctx context.Context, in incomelist.Input) ([]domaintransaction.Transaction, error)
+	Execute(ctx context.Context, in incomelist.Input) (incomelist.Output, error)
 }
 
 type expenseLister interface {
-	Execute(ctx context.Context, in expenselist.Input) ([]domaintransaction.Transaction, error)
+	Execute(ctx context.Context, in expenselist.Input) (expenselist.Output, error)
 }
 
 // Handler handles GET /api/v1/transactions/incomes and GET /api/v1/transactions/expenses.
@@ -32,44 +35,57 @@ func New(incomeUC incomeLister, expenseUC expenseLister) *Handler {
 
 // HandleIncomes processes GET /api/v1/transactions/incomes.
 func (h *Handler) HandleIncomes(w http.ResponseWriter, r *http.Request) {
+	page, err := pagination.Parse(r)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	in := incomelist.Input{
 		AccountID:  r.URL.Query().Get("account_id"),
 		CategoryID: r.URL.Query().Get("category_id"),
 		StartDate:  r.URL.Query().Get("start_date"),
 		EndDate:    r.URL.Query().Get("end_date"),
+		Page:       page,
 	}
 
-	txs, err := h.incomeUC.Execute(r.Context(), in)
+	out, err := h.incomeUC.Execute(r.Context(), in)
 	if err != nil {
-		response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		writeListError(w, err)
 		return
 	}
 
-	resp := make([]response.Transaction, 0, len(txs))
-	for _, tx := range txs {
-		resp = append(resp, response.ToTransaction(tx))
-	}
-
-	response.WriteJSON(w, http.StatusOK, map[string]interface{}{
-		"transactions": resp,
-	})
+	writePage(w, out.Transactions, out.NextCursor)
 }
 
 // HandleExpenses processes GET /api/v1/transactions/expenses.
 func (h *Handler) HandleExpenses(w http.ResponseWriter, r *http.Request) {
+	page, err := pagination.Parse(r)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	in := expenselist.Input{
 		AccountID:  r.URL.Query().Get("account_id"),
 		CategoryID: r.URL.Query().Get("category_id"),
 		StartDate:  r.URL.Query().Get("start_date"),
 		EndDate:    r.URL.Query().Get("end_date"),
+		Page:       page,
 	}
 
-	txs, err := h.expenseUC.Execute(r.Context(), in)
+	out, err := h.expenseUC.Execute(r.Context(), in)
 	if err != nil {
-		response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		writeListError(w, err)
 		return
 	}
 
+	writePage(w, out.Transactions, out.NextCursor)
+}
+
+// writePage writes a page of transactions with the cursor of the next page,
+// empty on the last one.
+func writePage(w http.ResponseWriter, txs []domaintransaction.Transaction, nextCursor string) {
 	resp := make([]response.Transaction, 0, len(txs))
 	for _, tx := range txs {
 		resp = append(resp, response.ToTransaction(tx))
@@ -77,5 +93,15 @@ func (h *Handler) HandleExpenses(w http.ResponseWriter, r *http.Request) {
 
 	response.WriteJSON(w, http.StatusOK, map[string]interface{}{
 		"transactions": resp,
+		"next_cursor":  nextCursor,
 	})
 }
+
+// writeListError maps a list use case error to its HTTP response.
+func writeListError(w http.ResponseWriter, err error) {
+	if errors.Is(err, domainshared.ErrInvalidPage) {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.WriteError(w, http.StatusInternalServerError, "internal server error")
+}
@@ -1,15 +1,19 @@
 package list_test
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/list"
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -34,6 +38,7 @@ func TestHandler_HandleIncomes(t *testing.T) {
 					response.ToTransaction(income1),
 					response.ToTransaction(income2),
 				},
+				"next_cursor": "",
 			},
 		},
 		{
@@ -42,6 +47,7 @@ func TestHandler_HandleIncomes(t *testing.T) {
 			wantStatus: http.StatusOK,
 			wantBody: map[string]any{
 				"transactions": []response.Transaction{},
+				"next_cursor":  "",
 			},
 		},
 		{
@@ -89,6 +95,7 @@ func TestHandler_HandleExpenses(t *testing.T) {
 					response.ToTransaction(expense1),
 					response.ToTransaction(expense2),
 				},
+				"next_cursor": "",
 			},
 		},
 		{
@@ -97,6 +104,7 @@ func TestHandler_HandleExpenses(t *testing.T) {
 			wantStatus: http.StatusOK,
 			wantBody: map[string]any{
 				"transactions": []response.Transaction{},
+				"next_cursor":  "",
 			},
 		},
 		{
@@ -122,3 +130,60 @@ func TestHandler_HandleExpenses(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_Pagination(t *testing.T) {
+	t.Parallel()
+
+	income := buildDomainTransaction("tx-1", "acc-001", domaintransaction.TransactionTypeIncome)
+
+	t.Run("page parameters are passed on and the next cursor returned", func(t *testing.T) {
+		t.Parallel()
+
+		uc := &fakeIncomeLister{out: []domaintransaction.Transaction{income}, next: "next-page"}
+		h := list.New(uc, &fakeExpenseLister{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/incomes?account_id=acc-001&limit=1&cursor=abc&sort=amount&order=asc", nil)
+		rec := httptest.NewRecorder()
+
+		h.HandleIncomes(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, domainshared.PageQuery{Limit: 1, Cursor: "abc", Sort: "amount", Direction: domainshared.SortAsc}, uc.gotIn.Page)
+		assert.Equal(t, "acc-001", uc.gotIn.AccountID)
+
+		var body struct {
+			Transactions []response.Transaction `json:"transactions"`
+			NextCursor   string                 `json:"next_cursor"`
+		}
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+		assert.Equal(t, []response.Transaction{response.ToTransaction(income)}, body.Transactions)
+		assert.Equal(t, "next-page", body.NextCursor)
+	})
+
+	t.Run("non-integer limit returns 400", func(t *testing.T) {
+		t.Parallel()
+
+		h := list.New(&fakeIncomeLister{}, &fakeExpenseLister{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/expenses?limit=all", nil)
+		rec := httptest.NewRecorder()
+
+		h.HandleExpenses(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid page returns 400", func(t *testing.T) {
+		t.Parallel()
+
+		uc := &fakeExpenseLister{err: fmt.Errorf("list expenses: %w", domainshared.ErrInvalidPage)}
+		h := list.New(&fakeIncomeLister{}, uc)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/expenses?sort=payee", nil)
+		rec := httptest.NewRecorder()
+
+		h.HandleExpenses(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -12,21 +12,27 @@ import (
 const fixedTimestamp = "2026-02-28T10:00:00Z"
 
 type fakeIncomeLister struct {
-	out []domaintransaction.Transaction
-	err error
+	out   []domaintransaction.Transaction
+	next  string
+	err   error
+	gotIn incomelist.Input
 }
 
-func (f *fakeIncomeLister) Execute(_ context.Context, _ incomelist.Input) ([]domaintransaction.Transaction, error) {
-	return f.out, f.err
+func (f *fakeIncomeLister) Execute(_ context.Context, in incomelist.Input) (incomelist.Output, error) {
+	f.gotIn = in
+	return incomelist.Output{Transactions: f.out, NextCursor: f.next}, f.err
 }
 
 type fakeExpenseLister struct {
-	out []domaintransaction.Transaction
-	err error
+	out   []domaintransaction.Transaction
+	next  string
+	err   error
+	gotIn expenselist.Input
 }
 
-func (f *fakeExpenseLister) Execute(_ context.Context, _ expenselist.Input) ([]domaintransaction.Transaction, error) {
-	return f.out, f.err
+func (f *fakeExpenseLister) Execute(_ context.Context, in expenselist.Input) (expenselist.Output, error) {
+	f.gotIn = in
+	return expenselist.Output{Transactions: f.out, NextCursor: f.next}, f.err
 }
 
 func buildDomainTransaction(id, accountID string, txType domaintransaction.TransactionType) domaintransaction.Transaction {
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -27,11 +28,36 @@ type Transaction struct {
 	UpdatedAt   string  `json:"updated_at"`
 }
 
-// Summary is the JSON response for the transaction summary endpoint.
+// Created is the JSON response for the create endpoints: the created transaction
+// plus any warnings the client should surface to the user.
+type Created struct {
+	Transaction
+	Warnings []Warning `json:"warnings,omitempty"`
+}
+
+// Warning describes a non-blocking issue detected while handling a request.
+type Warning struct {
+	Code       string        `json:"code"`
+	Message    string        `json:"message"`
+	Candidates []Transaction `json:"candidates,omitempty"`
+	Reasons    []Reason      `json:"reasons,omitempty"`
+}
+
+// Reason is the JSON representation of why a transaction was flagged as unusual.
+type Reason struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Summary is the JSON response for the transaction summary endpoint. Label,
+// StartDate and EndDate are set when a month was requested.
 type Summary struct {
 	TotalIncome  float64 `json:"total_income"`
 	TotalExpense float64 `json:"total_expense"`
 	Balance      float64 `json:"balance"`
+	Label        string  `json:"label,omitempty"`
+	StartDate    string  `json:"start_date,omitempty"`
+	EndDate      string  `json:"end_date,omitempty"`
 }
 
 // Error is the JSON response body for error cases.
@@ -55,6 +81,37 @@ func ToTransaction(t domaintransaction.Transaction) Transaction {
 	}
 }
 
+// ToTransactions converts a slice of domain transactions into their HTTP response representation.
+func ToTransactions(txs []domaintransaction.Transaction) []Transaction {
+	resp := make([]Transaction, 0, len(txs))
+	for _, tx := range txs {
+		resp = append(resp, ToTransaction(tx))
+	}
+	return resp
+}
+
+// DuplicateWarning builds the warning returned when a created transaction has likely duplicates.
+func DuplicateWarning(candidates []domaintransaction.Transaction) Warning {
+	return Warning{
+		Code:       "possible_duplicate",
+		Message:    "this transaction looks like a duplicate of an existing one",
+		Candidates: ToTransactions(candidates),
+	}
+}
+
+// UnusualWarning builds the warning returned when a created transaction was flagged as unusual.
+func UnusualWarning(a domaininsight.Anomaly) Warning {
+	reasons := make([]Reason, 0, len(a.Reasons))
+	for _, r := range a.Reasons {
+		reasons = append(reasons, Reason{Code: string(r.Code), Message: r.Message})
+	}
+	return Warning{
+		Code:    "unusual",
+		Message: "this transaction is unusual compared to your history",
+		Reasons: reasons,
+	}
+}
+
 // WriteJSON encodes v as JSON and writes it with the given status code.
 func WriteJSON(w http.ResponseWriter, status int, v any) {
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,147 @@
+// Package search handles GET /api/v1/transactions.
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/financial-manager/api/cmd/api/handlers/pagination"
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appsearch.Input) (appsearch.Output, error)
+}
+
+// Handler handles GET /api/v1/transactions.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+type searchResponse struct {
+	Transactions []response.Transaction `json:"transactions"`
+	NextCursor   string                 `json:"next_cursor"`
+	Totals       totalsResponse         `json:"totals"`
+}
+
+type totalsResponse struct {
+	Income  float64 `json:"income"`
+	Expense float64 `json:"expense"`
+	Net     float64 `json:"net"`
+	Count   int     `json:"count"`
+}
+
+// Handle processes GET /api/v1/transactions. The type, account_id and
+// category_id parameters take several values, repeated or comma-separated.
+// It returns 200 with a page of matching transactions, the cursor of the next
+// page and the totals of every match, and 400 for invalid filters.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	in, err := parseInput(r)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	out, err := h.uc.Execute(r.Context(), in)
+	if errors.Is(err, domaintransaction.ErrInvalidSearch) || errors.Is(err, domainshared.ErrInvalidPage) {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("transaction search: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, searchResponse{
+		Transactions: response.ToTransactions(out.Transactions),
+		NextCursor:   out.NextCursor,
+		Totals: totalsResponse{
+			Income:  out.Totals.Income,
+			Expense: out.Totals.Expense,
+			Net:     out.Totals.Net,
+			Count:   out.Totals.Count,
+		},
+	})
+}
+
+// parseInput reads the search filters and page of r.
+func parseInput(r *http.Request) (appsearch.Input, error) {
+	q := r.URL.Query()
+	if q.Has("tags") || q.Has("payee") {
+		return appsearch.Input{}, fmt.Errorf("%w: transactions have no tags or payee to filter by", domaintransaction.ErrInvalidSearch)
+	}
+
+	page, err := pagination.Parse(r)
+	if err != nil {
+		return appsearch.Input{}, err
+	}
+
+	in := appsearch.Input{
+		Types:       list(q, "type"),
+		AccountIDs:  list(q, "account_id"),
+		CategoryIDs: list(q, "category_id"),
+		Query:       q.Get("q"),
+		StartDate:   q.Get("start_date"),
+		EndDate:     q.Get("end_date"),
+		Page:        page,
+	}
+
+	if in.MinAmount, err = amount(q, "min_amount"); err != nil {
+		return appsearch.Input{}, err
+	}
+	if in.MaxAmount, err = amount(q, "max_amount"); err != nil {
+		return appsearch.Input{}, err
+	}
+
+	if raw := q.Get("include_deleted"); raw != "" {
+		in.IncludeDeleted, err = strconv.ParseBool(raw)
+		if err != nil {
+			return appsearch.Input{}, fmt.Errorf("%w: include_deleted must be true or false", domaintransaction.ErrInvalidSearch)
+		}
+	}
+
+	return in, nil
+}
+
+// list returns the non-empty values of the key parameter of q, splitting
+// comma-separated values.
+func list(q url.Values, key string) []string {
+	var values []string
+	for _, raw := range q[key] {
+		for _, v := range strings.Split(raw, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// amount returns the key parameter of q as a number, or nil when it is absent.
+func amount(q url.Values, key string) (*float64, error) {
+	raw := q.Get(key)
+	if raw == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, fmt.Errorf("%w: %s must be a number", domaintransaction.ErrInvalidSearch, key)
+	}
+	return &v, nil
+}
@@ -0,0 +1,163 @@
+package search_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	"github.com/financial-manager/api/cmd/api/handlers/transaction/search"
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// searchResponse mirrors the handler's unexported searchResponse for test decoding.
+type searchResponse struct {
+	Transactions []response.Transaction `json:"transactions"`
+	NextCursor   string                 `json:"next_cursor"`
+	Totals       struct {
+		Income  float64 `json:"income"`
+		Expense float64 `json:"expense"`
+		Net     float64 `json:"net"`
+		Count   int     `json:"count"`
+	} `json:"totals"`
+}
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	rent := buildDomainTransaction("tx-1", domaintransaction.TransactionTypeExpense, 400)
+	uc := &fakeUseCase{out: appsearch.Output{
+		Transactions: []domaintransaction.Transaction{rent},
+		NextCursor:   "next-page",
+		Totals:       appsearch.Totals{Income: 1000, Expense: 400, Net: 600, Count: 3},
+	}}
+	h := search.New(uc)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions?type=income,expense&account_id=acc-001&account_id=acc-002"+
+		"&category_id=cat-001&min_amount=10&max_amount=500.5&q=rent&start_date=2026-02-01&end_date=2026-02-28"+
+		"&include_deleted=true&limit=1&sort=amount&order=asc", nil)
+	rec := httptest.NewRecorder()
+
+	h.Handle(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	minAmount, maxAmount := 10.0, 500.5
+	assert.Equal(t, appsearch.Input{
+		Types:          []string{"income", "expense"},
+		AccountIDs:     []string{"acc-001", "acc-002"},
+		CategoryIDs:    []string{"cat-001"},
+		MinAmount:      &minAmount,
+		MaxAmount:      &maxAmount,
+		Query:          "rent",
+		StartDate:      "2026-02-01",
+		EndDate:        "2026-02-28",
+		IncludeDeleted: true,
+		Page:           domainshared.PageQuery{Limit: 1, Sort: "amount", Direction: domainshared.SortAsc},
+	}, uc.gotIn)
+
+	var body searchResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.Equal(t, []response.Transaction{response.ToTransaction(rent)}, body.Transactions)
+	assert.Equal(t, "next-page", body.NextCursor)
+	assert.Equal(t, 1000.0, body.Totals.Income)
+	assert.Equal(t, 400.0, body.Totals.Expense)
+	assert.Equal(t, 600.0, body.Totals.Net)
+	assert.Equal(t, 3, body.Totals.Count)
+}
+
+func TestHandler_Handle_Empty(t *testing.T) {
+	t.Parallel()
+
+	h := search.New(&fakeUseCase{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions", nil)
+	rec := httptest.NewRecorder()
+
+	h.Handle(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"transactions":[],"next_cursor":"","totals":{"income":0,"expense":0,"net":0,"count":0}}`, rec.Body.String())
+}
+
+func TestHandler_Handle_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		query      string
+		uc         *fakeUseCase
+		wantStatus int
+	}{
+		{
+			name:       "non-numeric amount returns 400",
+			query:      "?min_amount=ten",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "NaN amount returns 400",
+			query:      "?max_amount=NaN",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "non-boolean include_deleted returns 400",
+			query:      "?include_deleted=maybe",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "tags filter returns 400",
+			query:      "?tags=food",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "non-integer limit returns 400",
+			query:      "?limit=all",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid search returns 400",
+			query:      "?type=transfer",
+			uc:         &fakeUseCase{err: fmt.Errorf("search transactions: %w", domaintransaction.ErrInvalidSearch)},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid page returns 400",
+			query:      "?sort=payee",
+			uc:         &fakeUseCase{err: fmt.Errorf("search transactions: %w", domainshared.ErrInvalidPage)},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "use case error returns 500",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := search.New(tc.uc)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
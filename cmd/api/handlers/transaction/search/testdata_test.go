@@ -0,0 +1,39 @@
+package search_test
+
+import (
+	"context"
+	"time"
+
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const fixedTimestamp = "2026-02-28T10:00:00Z"
+
+type fakeUseCase struct {
+	out   appsearch.Output
+	err   error
+	gotIn appsearch.Input
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appsearch.Input) (appsearch.Output, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
+
+func buildDomainTransaction(id string, txType domaintransaction.TransactionType, amount float64) domaintransaction.Transaction {
+	t, _ := time.Parse("2006-01-02T15:04:05Z", fixedTimestamp)
+	date, _ := time.Parse("2006-01-02", "2026-02-28")
+	return domaintransaction.Transaction{
+		ID:          id,
+		AccountID:   "acc-001",
+		CategoryID:  "cat-001",
+		Type:        txType,
+		Amount:      amount,
+		Description: "Test transaction",
+		Date:        date,
+		IsActive:    true,
+		CreatedAt:   t,
+		UpdatedAt:   t,
+	}
+}
@@ -3,10 +3,13 @@ package summary
 
 import (
 	"context"
+	"errors"
+	"log"
 	"net/http"
 
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
 	appSummary "github.com/financial-manager/api/internal/application/transaction/summary"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
 )
 
 type useCase interface {
@@ -29,10 +32,16 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		AccountID: r.URL.Query().Get("account_id"),
 		StartDate: r.URL.Query().Get("start_date"),
 		EndDate:   r.URL.Query().Get("end_date"),
+		Month:     r.URL.Query().Get("month"),
 	}
 
 	sum, err := h.uc.Execute(r.Context(), input)
+	if errors.Is(err, domainreport.ErrInvalidQuery) {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err != nil {
+		log.Printf("transaction summary: %v", err)
 		response.WriteError(w, http.StatusInternalServerError, "internal server error")
 		return
 	}
@@ -41,5 +50,8 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		TotalIncome:  sum.TotalIncome,
 		TotalExpense: sum.TotalExpense,
 		Balance:      sum.Balance,
+		Label:        sum.Label,
+		StartDate:    sum.StartDate,
+		EndDate:      sum.EndDate,
 	})
 }
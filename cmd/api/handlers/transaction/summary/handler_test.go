@@ -1,16 +1,20 @@
 package summary_test
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/response"
 	"github.com/financial-manager/api/cmd/api/handlers/transaction/summary"
 	appsummary "github.com/financial-manager/api/internal/application/transaction/summary"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
 )
 
 func TestHandler_Handle(t *testing.T) {
@@ -50,6 +54,30 @@ func TestHandler_Handle(t *testing.T) {
 				Balance:      0,
 			},
 		},
+		{
+			name: "month summary carries its period",
+			uc: &fakeUseCase{out: appsummary.Summary{
+				TotalIncome: 3000.0,
+				Balance:     3000.0,
+				Label:       "25 Jan – 24 Feb",
+				StartDate:   "2026-01-25",
+				EndDate:     "2026-02-24",
+			}},
+			wantStatus: http.StatusOK,
+			wantBody: response.Summary{
+				TotalIncome: 3000.0,
+				Balance:     3000.0,
+				Label:       "25 Jan – 24 Feb",
+				StartDate:   "2026-01-25",
+				EndDate:     "2026-02-24",
+			},
+		},
+		{
+			name:       "invalid month returns 400",
+			uc:         &fakeUseCase{err: fmt.Errorf("%w: month must be YYYY-MM", domainreport.ErrInvalidQuery)},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Summary{},
+		},
 		{
 			name:       "use case error returns 500",
 			uc:         &fakeUseCase{err: errors.New("db error")},
@@ -64,12 +92,18 @@ func TestHandler_Handle(t *testing.T) {
 
 			h := summary.New(tc.uc)
 
-			req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/summary", nil)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/transactions/summary?month=2026-01", nil)
 			rec := httptest.NewRecorder()
 
 			h.Handle(rec, req)
 
 			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, "2026-01", tc.uc.in.Month)
+			if tc.wantStatus == http.StatusOK {
+				var got response.Summary
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+				assert.Equal(t, tc.wantBody, got)
+			}
 		})
 	}
 }
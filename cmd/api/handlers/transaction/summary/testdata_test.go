@@ -7,10 +7,12 @@ import (
 )
 
 type fakeUseCase struct {
+	in  appsummary.Input
 	out appsummary.Summary
 	err error
 }
 
-func (f *fakeUseCase) Execute(_ context.Context, _ appsummary.Input) (appsummary.Summary, error) {
+func (f *fakeUseCase) Execute(_ context.Context, in appsummary.Input) (appsummary.Summary, error) {
+	f.in = in
 	return f.out, f.err
 }
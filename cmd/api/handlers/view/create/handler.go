@@ -0,0 +1,62 @@
+// Package create handles POST /api/v1/views.
+package create
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	appCreate "github.com/financial-manager/api/internal/application/view/create"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, in appCreate.Input) (domainview.View, error)
+}
+
+// Handler handles POST /api/v1/views.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+type createRequest struct {
+	Name   string          `json:"name"`
+	Filter response.Filter `json:"filter"`
+}
+
+// Handle processes POST /api/v1/views and returns 201 with the saved view.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req createRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	view, err := h.uc.Execute(r.Context(), appCreate.Input{
+		Name:   req.Name,
+		Filter: req.Filter.ToDomain(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainview.ErrViewNameTaken):
+			response.WriteError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, domainview.ErrInvalidView):
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			log.Printf("create view: %v", err)
+			response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusCreated, response.ToView(view))
+}
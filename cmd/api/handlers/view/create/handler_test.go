@@ -0,0 +1,104 @@
+package create_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/view/create"
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	view := buildDomainView("v-1", "Groceries")
+
+	tests := []struct {
+		name       string
+		body       any
+		uc         *fakeUseCase
+		wantStatus int
+		wantBody   any
+		wantFilter domainview.Filter
+	}{
+		{
+			name: "valid body returns 201 with saved view",
+			body: map[string]any{
+				"name": "Groceries",
+				"filter": map[string]any{
+					"types": []string{"expense"}, "category_ids": []string{"cat-food"}, "range": "this_quarter",
+				},
+			},
+			uc:         &fakeUseCase{out: view},
+			wantStatus: http.StatusCreated,
+			wantBody:   response.ToView(view),
+			wantFilter: view.Filter,
+		},
+		{
+			name:       "invalid JSON body returns 400",
+			body:       "not-json",
+			uc:         &fakeUseCase{},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Error{Error: "invalid request body"},
+		},
+		{
+			name:       "invalid filter returns 400",
+			body:       map[string]any{"name": "Bad", "filter": map[string]any{"range": "next_week"}},
+			uc:         &fakeUseCase{err: fmt.Errorf("%w: unknown range %q", domainview.ErrInvalidView, "next_week")},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Error{Error: `invalid view: unknown range "next_week"`},
+			wantFilter: domainview.Filter{Range: "next_week"},
+		},
+		{
+			name:       "taken name returns 409",
+			body:       map[string]any{"name": "Groceries"},
+			uc:         &fakeUseCase{err: domainview.ErrViewNameTaken},
+			wantStatus: http.StatusConflict,
+			wantBody:   response.Error{Error: domainview.ErrViewNameTaken.Error()},
+		},
+		{
+			name:       "other use case error returns 500",
+			body:       map[string]any{"name": "Groceries"},
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   response.Error{Error: "internal server error"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := create.New(tc.uc)
+
+			bodyBytes, _ := json.Marshal(tc.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/views", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantFilter, tc.uc.gotIn.Filter)
+			assert.Equal(t, tc.wantBody, decodeAs(t, rec, tc.wantBody))
+		})
+	}
+}
+
+// decodeAs decodes the recorder body into a new value of the same type as want.
+func decodeAs(t *testing.T, rec *httptest.ResponseRecorder, want any) any {
+	t.Helper()
+	ptr := reflect.New(reflect.TypeOf(want))
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(ptr.Interface()))
+	return ptr.Elem().Interface()
+}
@@ -0,0 +1,35 @@
+package create_test
+
+import (
+	"context"
+	"time"
+
+	appCreate "github.com/financial-manager/api/internal/application/view/create"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+type fakeUseCase struct {
+	out   domainview.View
+	err   error
+	gotIn appCreate.Input
+}
+
+func (f *fakeUseCase) Execute(_ context.Context, in appCreate.Input) (domainview.View, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
+
+func buildDomainView(id, name string) domainview.View {
+	t := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	return domainview.View{
+		ID:   id,
+		Name: name,
+		Filter: domainview.Filter{
+			Types:       []string{"expense"},
+			CategoryIDs: []string{"cat-food"},
+			Range:       domainview.RangeThisQuarter,
+		},
+		CreatedAt: t,
+		UpdatedAt: t,
+	}
+}
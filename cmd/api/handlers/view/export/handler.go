@@ -0,0 +1,139 @@
+// Package export handles GET /api/v1/views/{id}/export/csv and
+// POST /api/v1/views/{id}/export/pdf.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/financial-manager/api/cmd/api/handlers/export/stream"
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	"github.com/financial-manager/api/internal/application/pdfexport"
+	"github.com/financial-manager/api/internal/application/view/resolve"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+type resolver interface {
+	Execute(ctx context.Context, id string) (resolve.Output, error)
+}
+
+type csvUseCase interface {
+	ExportCSV(ctx context.Context, w io.Writer, opts domainexport.CSVOptions) error
+}
+
+type pdfUseCase interface {
+	Execute(ctx context.Context, in pdfexport.Input) ([]byte, error)
+}
+
+// Handler handles the view export endpoints.
+type Handler struct {
+	resolver resolver
+	csvUC    csvUseCase
+	pdfUC    pdfUseCase
+	enc      stream.Encrypter
+}
+
+// New creates a Handler with its required use case dependencies. enc encrypts
+// CSV exports requested with a passphrase.
+func New(resolver resolver, csvUC csvUseCase, pdfUC pdfUseCase, enc stream.Encrypter) *Handler {
+	return &Handler{resolver: resolver, csvUC: csvUC, pdfUC: pdfUC, enc: enc}
+}
+
+// pdfRequest is the optional body of a PDF export; the period and the
+// filters come from the view.
+type pdfRequest struct {
+	IncludeCharts bool             `json:"include_charts"`
+	Locale        pdfexport.Locale `json:"locale"`
+	UserPassword  string           `json:"user_password"`
+	OwnerPassword string           `json:"owner_password"`
+}
+
+// HandleCSV processes GET /api/v1/views/{id}/export/csv. The transactions of
+// the view are streamed with the default CSV layout, and encrypted when the
+// request carries stream.PassphraseHeader.
+func (h *Handler) HandleCSV(w http.ResponseWriter, r *http.Request) {
+	view, err := h.resolver.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		response.WriteResolveError(w, err)
+		return
+	}
+
+	sw := stream.New(w, "text/csv", fmt.Sprintf("view_%s.csv", view.View.ID))
+	out, err := sw.Output(r, h.enc)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	opts := domainexport.CSVOptions{Filters: view.ExportFilters()}
+	if err := h.csvUC.ExportCSV(r.Context(), out, opts); err != nil {
+		if !sw.Started() && errors.Is(err, domainexport.ErrInvalidOptions) {
+			response.WriteError(w, http.StatusBadRequest, errors.Unwrap(err).Error())
+			return
+		}
+		sw.Fail("csv", err)
+		return
+	}
+	if err := out.Close(); err != nil {
+		sw.Fail("csv", err)
+	}
+}
+
+// HandlePDF processes POST /api/v1/views/{id}/export/pdf. The report covers
+// the dates of the view, so views without both a start and an end date are
+// answered with a 400.
+func (h *Handler) HandlePDF(w http.ResponseWriter, r *http.Request) {
+	var req pdfRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			response.WriteError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	view, err := h.resolver.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		response.WriteResolveError(w, err)
+		return
+	}
+	if view.StartDate == "" || view.EndDate == "" {
+		response.WriteError(w, http.StatusBadRequest, "view needs a date range to export as PDF")
+		return
+	}
+
+	pdfData, err := h.pdfUC.Execute(r.Context(), pdfexport.Input{
+		StartDate:     view.StartDate,
+		EndDate:       view.EndDate,
+		IncludeCharts: req.IncludeCharts,
+		Locale:        req.Locale,
+		UserPassword:  req.UserPassword,
+		OwnerPassword: req.OwnerPassword,
+		Filters:       view.ExportFilters(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pdfexport.ErrInvalidPeriod):
+			response.WriteError(w, http.StatusBadRequest, "invalid period, expected start_date <= end_date")
+		case errors.Is(err, pdfexport.ErrUnsupportedLocale):
+			response.WriteError(w, http.StatusBadRequest, "locale must be 'en' or 'es'")
+		default:
+			log.Printf("export view pdf: %v", err)
+			response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	filename := fmt.Sprintf("view_%s_%s_%s.pdf", view.View.ID, view.StartDate, view.EndDate)
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(pdfData); err != nil {
+		log.Printf("write pdf response: %v", err)
+	}
+}
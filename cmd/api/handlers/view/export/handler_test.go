@@ -0,0 +1,198 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/view/export"
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	"github.com/financial-manager/api/internal/application/pdfexport"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+func TestHandler_HandleCSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		resolver    *fakeResolver
+		csvUC       *fakeCSVUseCase
+		wantStatus  int
+		wantBody    string
+		wantOptions domainexport.CSVOptions
+	}{
+		{
+			name:        "streams the transactions of the view",
+			resolver:    &fakeResolver{out: groceries},
+			csvUC:       &fakeCSVUseCase{csv: "date,type,amount\n"},
+			wantStatus:  http.StatusOK,
+			wantBody:    "date,type,amount",
+			wantOptions: domainexport.CSVOptions{Filters: groceries.ExportFilters()},
+		},
+		{
+			name:       "missing view returns 404",
+			resolver:   &fakeResolver{err: fmt.Errorf("view not found: %w", domainshared.ErrNotFound)},
+			csvUC:      &fakeCSVUseCase{},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"error":"view not found"}`,
+		},
+		{
+			name:        "export error before writing returns 500",
+			resolver:    &fakeResolver{out: groceries},
+			csvUC:       &fakeCSVUseCase{err: errors.New("db error")},
+			wantStatus:  http.StatusInternalServerError,
+			wantOptions: domainexport.CSVOptions{Filters: groceries.ExportFilters()},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := export.New(tc.resolver, tc.csvUC, nil, nil)
+			rec := httptest.NewRecorder()
+
+			h.HandleCSV(rec, withID(httptest.NewRequest(http.MethodGet, "/api/v1/views/v-1/export/csv", nil)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantOptions, tc.csvUC.gotOpts)
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, strings.TrimSpace(rec.Body.String()))
+			}
+			if tc.wantStatus == http.StatusOK {
+				assert.Equal(t, `attachment; filename="view_v-1.csv"`, rec.Header().Get("Content-Disposition"))
+			}
+		})
+	}
+}
+
+func TestHandler_HandlePDF(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		body       string
+		resolver   *fakeResolver
+		pdfUC      *fakePDFUseCase
+		wantStatus int
+		wantError  string
+		wantIn     pdfexport.Input
+	}{
+		{
+			name:       "reports the dates and filters of the view",
+			body:       `{"locale":"es","include_charts":true}`,
+			resolver:   &fakeResolver{out: groceries},
+			pdfUC:      &fakePDFUseCase{pdf: []byte("%PDF-1.4")},
+			wantStatus: http.StatusOK,
+			wantIn: pdfexport.Input{
+				StartDate:     "2026-03-01",
+				EndDate:       "2026-03-18",
+				IncludeCharts: true,
+				Locale:        pdfexport.LocaleSpanish,
+				Filters:       groceries.ExportFilters(),
+			},
+		},
+		{
+			name:       "empty body uses the defaults",
+			resolver:   &fakeResolver{out: groceries},
+			pdfUC:      &fakePDFUseCase{pdf: []byte("%PDF-1.4")},
+			wantStatus: http.StatusOK,
+			wantIn: pdfexport.Input{
+				StartDate: "2026-03-01",
+				EndDate:   "2026-03-18",
+				Filters:   groceries.ExportFilters(),
+			},
+		},
+		{
+			name:       "invalid body returns 400",
+			body:       "not-json",
+			resolver:   &fakeResolver{out: groceries},
+			pdfUC:      &fakePDFUseCase{},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid request body",
+		},
+		{
+			name:       "view without dates returns 400",
+			resolver:   &fakeResolver{out: undated},
+			pdfUC:      &fakePDFUseCase{},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "view needs a date range to export as PDF",
+		},
+		{
+			name:       "missing view returns 404",
+			resolver:   &fakeResolver{err: fmt.Errorf("view not found: %w", domainshared.ErrNotFound)},
+			pdfUC:      &fakePDFUseCase{},
+			wantStatus: http.StatusNotFound,
+			wantError:  "view not found",
+		},
+		{
+			name:       "unsupported locale returns 400",
+			body:       `{"locale":"fr"}`,
+			resolver:   &fakeResolver{out: groceries},
+			pdfUC:      &fakePDFUseCase{err: fmt.Errorf("export pdf: %w", pdfexport.ErrUnsupportedLocale)},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "locale must be 'en' or 'es'",
+			wantIn: pdfexport.Input{
+				StartDate: "2026-03-01",
+				EndDate:   "2026-03-18",
+				Locale:    "fr",
+				Filters:   groceries.ExportFilters(),
+			},
+		},
+		{
+			name:       "use case error returns 500",
+			resolver:   &fakeResolver{out: groceries},
+			pdfUC:      &fakePDFUseCase{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantError:  "internal server error",
+			wantIn: pdfexport.Input{
+				StartDate: "2026-03-01",
+				EndDate:   "2026-03-18",
+				Filters:   groceries.ExportFilters(),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := export.New(tc.resolver, nil, tc.pdfUC, nil)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/views/v-1/export/pdf", bytes.NewReader([]byte(tc.body)))
+			rec := httptest.NewRecorder()
+
+			h.HandlePDF(rec, withID(req))
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantIn, tc.pdfUC.gotIn)
+			if tc.wantError != "" {
+				var got response.Error
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+				assert.Equal(t, tc.wantError, got.Error)
+				return
+			}
+			assert.Equal(t, "application/pdf", rec.Header().Get("Content-Type"))
+			assert.Equal(t, `attachment; filename="view_v-1_2026-03-01_2026-03-18.pdf"`, rec.Header().Get("Content-Disposition"))
+			assert.Equal(t, "%PDF-1.4", rec.Body.String())
+		})
+	}
+}
+
+// withID routes req to the view with id "v-1".
+func withID(req *http.Request) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "v-1")
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
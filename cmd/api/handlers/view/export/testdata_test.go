@@ -0,0 +1,65 @@
+package export_test
+
+import (
+	"context"
+	"io"
+
+	"github.com/financial-manager/api/internal/application/pdfexport"
+	"github.com/financial-manager/api/internal/application/view/resolve"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+type fakeResolver struct {
+	out resolve.Output
+	err error
+}
+
+func (f *fakeResolver) Execute(_ context.Context, _ string) (resolve.Output, error) {
+	return f.out, f.err
+}
+
+// fakeCSVUseCase writes csv to the writer and then returns err.
+type fakeCSVUseCase struct {
+	csv     string
+	err     error
+	gotOpts domainexport.CSVOptions
+}
+
+func (f *fakeCSVUseCase) ExportCSV(_ context.Context, w io.Writer, opts domainexport.CSVOptions) error {
+	f.gotOpts = opts
+	if f.csv != "" {
+		if _, err := io.WriteString(w, f.csv); err != nil {
+			return err
+		}
+	}
+	return f.err
+}
+
+type fakePDFUseCase struct {
+	pdf   []byte
+	err   error
+	gotIn pdfexport.Input
+}
+
+func (f *fakePDFUseCase) Execute(_ context.Context, in pdfexport.Input) ([]byte, error) {
+	f.gotIn = in
+	return f.pdf, f.err
+}
+
+// groceries is a view of the expenses of two categories this month.
+var groceries = resolve.Output{
+	View: domainview.View{ID: "v-1", Name: "Groceries", Filter: domainview.Filter{
+		Types:       []string{"expense"},
+		CategoryIDs: []string{"cat-food", "cat-home"},
+		Range:       domainview.RangeThisMonth,
+		Query:       "mercadona",
+	}},
+	StartDate: "2026-03-01",
+	EndDate:   "2026-03-18",
+}
+
+// undated is a view without a date range.
+var undated = resolve.Output{
+	View: domainview.View{ID: "v-2", Name: "Cash", Filter: domainview.Filter{AccountIDs: []string{"acc-cash"}}},
+}
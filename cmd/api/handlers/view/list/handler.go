@@ -0,0 +1,40 @@
+// Package list handles GET /api/v1/views.
+package list
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+type useCase interface {
+	Execute(ctx context.Context) ([]domainview.View, error)
+}
+
+// Handler handles GET /api/v1/views.
+type Handler struct {
+	uc useCase
+}
+
+// New creates a Handler with its required use case dependency.
+func New(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+// Handle processes GET /api/v1/views and returns every saved view.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	views, err := h.uc.Execute(r.Context())
+	if err != nil {
+		response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	resp := make([]response.ViewResponse, len(views))
+	for i, v := range views {
+		resp[i] = response.ToView(v)
+	}
+
+	response.WriteJSON(w, http.StatusOK, resp)
+}
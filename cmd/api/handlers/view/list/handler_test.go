@@ -0,0 +1,81 @@
+package list_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/view/list"
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	view := domainview.View{
+		ID:        "v-1",
+		Name:      "Groceries",
+		Filter:    domainview.Filter{Types: []string{"expense"}, Range: domainview.RangeLast30Days},
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+
+	tests := []struct {
+		name       string
+		uc         *fakeUseCase
+		wantStatus int
+		wantBody   any
+	}{
+		{
+			name:       "returns 200 with views",
+			uc:         &fakeUseCase{out: []domainview.View{view}},
+			wantStatus: http.StatusOK,
+			wantBody:   []response.ViewResponse{response.ToView(view)},
+		},
+		{
+			name:       "returns 200 with empty array",
+			uc:         &fakeUseCase{out: []domainview.View{}},
+			wantStatus: http.StatusOK,
+			wantBody:   []response.ViewResponse{},
+		},
+		{
+			name:       "use case error returns 500",
+			uc:         &fakeUseCase{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   response.Error{Error: "internal server error"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := list.New(tc.uc)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/views", nil)
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantBody, decodeAs(t, rec, tc.wantBody))
+		})
+	}
+}
+
+// decodeAs decodes the recorder body into a new value of the same type as want.
+func decodeAs(t *testing.T, rec *httptest.ResponseRecorder, want any) any {
+	t.Helper()
+	ptr := reflect.New(reflect.TypeOf(want))
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(ptr.Interface()))
+	return ptr.Elem().Interface()
+}
@@ -0,0 +1,16 @@
+package list_test
+
+import (
+	"context"
+
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+type fakeUseCase struct {
+	out []domainview.View
+	err error
+}
+
+func (f *fakeUseCase) Execute(_ context.Context) ([]domainview.View, error) {
+	return f.out, f.err
+}
@@ -0,0 +1,120 @@
+// Package response provides shared HTTP request and response types and helpers
+// for the view handler sub-packages.
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+const timestampLayout = "2006-01-02T15:04:05Z"
+
+// Filter is the JSON representation of the filters of a view, used in both
+// requests and responses. Range is a relative range such as "last_30_days"
+// or "this_quarter", exclusive with the fixed StartDate and EndDate.
+type Filter struct {
+	Types       []string `json:"types,omitempty"`
+	AccountIDs  []string `json:"account_ids,omitempty"`
+	CategoryIDs []string `json:"category_ids,omitempty"`
+	Range       string   `json:"range,omitempty"`
+	StartDate   string   `json:"start_date,omitempty"`
+	EndDate     string   `json:"end_date,omitempty"`
+	MinAmount   *float64 `json:"min_amount,omitempty"`
+	MaxAmount   *float64 `json:"max_amount,omitempty"`
+	Query       string   `json:"query,omitempty"`
+}
+
+// ViewResponse is the JSON representation of a view returned by all endpoints.
+type ViewResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Filter    Filter `json:"filter"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// Totals is the JSON representation of the totals of the transactions of a view.
+type Totals struct {
+	Income  float64 `json:"income"`
+	Expense float64 `json:"expense"`
+	Net     float64 `json:"net"`
+	Count   int     `json:"count"`
+}
+
+// Error is the JSON response body for error cases.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// ToDomain converts a request filter into a domain view filter.
+func (f Filter) ToDomain() domainview.Filter {
+	return domainview.Filter{
+		Types:       f.Types,
+		AccountIDs:  f.AccountIDs,
+		CategoryIDs: f.CategoryIDs,
+		Range:       domainview.Range(f.Range),
+		StartDate:   f.StartDate,
+		EndDate:     f.EndDate,
+		MinAmount:   f.MinAmount,
+		MaxAmount:   f.MaxAmount,
+		Query:       f.Query,
+	}
+}
+
+// ToView converts a domain view into its HTTP response representation.
+func ToView(v domainview.View) ViewResponse {
+	f := v.Filter
+	return ViewResponse{
+		ID:   v.ID,
+		Name: v.Name,
+		Filter: Filter{
+			Types:       f.Types,
+			AccountIDs:  f.AccountIDs,
+			CategoryIDs: f.CategoryIDs,
+			Range:       string(f.Range),
+			StartDate:   f.StartDate,
+			EndDate:     f.EndDate,
+			MinAmount:   f.MinAmount,
+			MaxAmount:   f.MaxAmount,
+			Query:       f.Query,
+		},
+		CreatedAt: v.CreatedAt.UTC().Format(timestampLayout),
+		UpdatedAt: v.UpdatedAt.UTC().Format(timestampLayout),
+	}
+}
+
+// ToTotals converts search totals into their HTTP response representation.
+func ToTotals(t appsearch.Totals) Totals {
+	return Totals{Income: t.Income, Expense: t.Expense, Net: t.Net, Count: t.Count}
+}
+
+// WriteResolveError writes the response for an error resolving a view: 404
+// when the view does not exist and 500 otherwise.
+func WriteResolveError(w http.ResponseWriter, err error) {
+	if errors.Is(err, domainshared.ErrNotFound) {
+		WriteError(w, http.StatusNotFound, "view not found")
+		return
+	}
+	log.Printf("resolve view: %v", err)
+	WriteError(w, http.StatusInternalServerError, "internal server error")
+}
+
+// WriteJSON encodes v as JSON and writes it with the given status code.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("handlers/view: failed to encode response: %v", err)
+	}
+}
+
+// WriteError writes a JSON error response with the given status code and message.
+func WriteError(w http.ResponseWriter, status int, msg string) {
+	WriteJSON(w, status, Error{Error: msg})
+}
@@ -0,0 +1,70 @@
+// Package totals handles GET /api/v1/views/{id}/totals.
+package totals
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	"github.com/financial-manager/api/internal/application/view/resolve"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+type resolver interface {
+	Execute(ctx context.Context, id string) (resolve.Output, error)
+}
+
+type totaler interface {
+	Totals(ctx context.Context, in appsearch.Input) (appsearch.Totals, error)
+}
+
+// Handler handles GET /api/v1/views/{id}/totals.
+type Handler struct {
+	resolver resolver
+	totaler  totaler
+}
+
+// New creates a Handler with its required use case dependencies.
+func New(resolver resolver, totaler totaler) *Handler {
+	return &Handler{resolver: resolver, totaler: totaler}
+}
+
+type totalsResponse struct {
+	StartDate string          `json:"start_date"`
+	EndDate   string          `json:"end_date"`
+	Totals    response.Totals `json:"totals"`
+}
+
+// Handle processes GET /api/v1/views/{id}/totals. It returns 200 with the
+// dates the view resolved to and the totals of its transactions, and 404
+// when the view does not exist.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	view, err := h.resolver.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		response.WriteResolveError(w, err)
+		return
+	}
+
+	totals, err := h.totaler.Totals(r.Context(), view.Search(domainshared.PageQuery{}))
+	if errors.Is(err, domaintransaction.ErrInvalidSearch) {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("view totals: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, totalsResponse{
+		StartDate: view.StartDate,
+		EndDate:   view.EndDate,
+		Totals:    response.ToTotals(totals),
+	})
+}
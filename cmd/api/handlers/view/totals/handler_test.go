@@ -0,0 +1,96 @@
+package totals_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	"github.com/financial-manager/api/cmd/api/handlers/view/totals"
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+type body struct {
+	StartDate string          `json:"start_date"`
+	EndDate   string          `json:"end_date"`
+	Totals    response.Totals `json:"totals"`
+}
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		resolver   *fakeResolver
+		totaler    *fakeTotaler
+		wantStatus int
+		wantBody   any
+		wantIn     appsearch.Input
+	}{
+		{
+			name:       "returns 200 with the totals of the view",
+			resolver:   &fakeResolver{out: salaries},
+			totaler:    &fakeTotaler{out: appsearch.Totals{Income: 9000, Net: 9000, Count: 3}},
+			wantStatus: http.StatusOK,
+			wantBody: body{
+				StartDate: "2025-10-01",
+				EndDate:   "2025-12-31",
+				Totals:    response.Totals{Income: 9000, Net: 9000, Count: 3},
+			},
+			wantIn: salaries.Search(domainshared.PageQuery{}),
+		},
+		{
+			name:       "missing view returns 404",
+			resolver:   &fakeResolver{err: fmt.Errorf("view not found: %w", domainshared.ErrNotFound)},
+			totaler:    &fakeTotaler{},
+			wantStatus: http.StatusNotFound,
+			wantBody:   response.Error{Error: "view not found"},
+		},
+		{
+			name:       "totals error returns 500",
+			resolver:   &fakeResolver{out: salaries},
+			totaler:    &fakeTotaler{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   response.Error{Error: "internal server error"},
+			wantIn:     salaries.Search(domainshared.PageQuery{}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := totals.New(tc.resolver, tc.totaler)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/views/v-1/totals", nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "v-1")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantIn, tc.totaler.gotIn)
+			assert.Equal(t, tc.wantBody, decodeAs(t, rec, tc.wantBody))
+		})
+	}
+}
+
+// decodeAs decodes the recorder body into a new value of the same type as want.
+func decodeAs(t *testing.T, rec *httptest.ResponseRecorder, want any) any {
+	t.Helper()
+	ptr := reflect.New(reflect.TypeOf(want))
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(ptr.Interface()))
+	return ptr.Elem().Interface()
+}
@@ -0,0 +1,40 @@
+package totals_test
+
+import (
+	"context"
+
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	"github.com/financial-manager/api/internal/application/view/resolve"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+type fakeResolver struct {
+	out resolve.Output
+	err error
+}
+
+func (f *fakeResolver) Execute(_ context.Context, _ string) (resolve.Output, error) {
+	return f.out, f.err
+}
+
+type fakeTotaler struct {
+	out   appsearch.Totals
+	err   error
+	gotIn appsearch.Input
+}
+
+func (f *fakeTotaler) Totals(_ context.Context, in appsearch.Input) (appsearch.Totals, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
+
+// salaries is a view of the incomes of one account last quarter.
+var salaries = resolve.Output{
+	View: domainview.View{ID: "v-1", Name: "Salaries", Filter: domainview.Filter{
+		Types:      []string{"income"},
+		AccountIDs: []string{"acc-1"},
+		Range:      domainview.RangeLastQuarter,
+	}},
+	StartDate: "2025-10-01",
+	EndDate:   "2025-12-31",
+}
@@ -0,0 +1,83 @@
+// Package transactions handles GET /api/v1/views/{id}/transactions.
+package transactions
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/financial-manager/api/cmd/api/handlers/pagination"
+	txresponse "github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	"github.com/financial-manager/api/internal/application/view/resolve"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+type resolver interface {
+	Execute(ctx context.Context, id string) (resolve.Output, error)
+}
+
+type searcher interface {
+	Execute(ctx context.Context, in appsearch.Input) (appsearch.Output, error)
+}
+
+// Handler handles GET /api/v1/views/{id}/transactions.
+type Handler struct {
+	resolver resolver
+	searcher searcher
+}
+
+// New creates a Handler with its required use case dependencies.
+func New(resolver resolver, searcher searcher) *Handler {
+	return &Handler{resolver: resolver, searcher: searcher}
+}
+
+type transactionsResponse struct {
+	StartDate    string                   `json:"start_date"`
+	EndDate      string                   `json:"end_date"`
+	Transactions []txresponse.Transaction `json:"transactions"`
+	NextCursor   string                   `json:"next_cursor"`
+	Totals       response.Totals          `json:"totals"`
+}
+
+// Handle processes GET /api/v1/views/{id}/transactions. It returns 200 with
+// the dates the view resolved to, a page of its transactions, the cursor of
+// the next page and the totals of all of them; 400 for an invalid page and
+// 404 when the view does not exist.
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	page, err := pagination.Parse(r)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	view, err := h.resolver.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		response.WriteResolveError(w, err)
+		return
+	}
+
+	out, err := h.searcher.Execute(r.Context(), view.Search(page))
+	if errors.Is(err, domaintransaction.ErrInvalidSearch) || errors.Is(err, domainshared.ErrInvalidPage) {
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("view transactions: %v", err)
+		response.WriteError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, transactionsResponse{
+		StartDate:    view.StartDate,
+		EndDate:      view.EndDate,
+		Transactions: txresponse.ToTransactions(out.Transactions),
+		NextCursor:   out.NextCursor,
+		Totals:       response.ToTotals(out.Totals),
+	})
+}
@@ -0,0 +1,136 @@
+package transactions_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	txresponse "github.com/financial-manager/api/cmd/api/handlers/transaction/response"
+	"github.com/financial-manager/api/cmd/api/handlers/view/response"
+	"github.com/financial-manager/api/cmd/api/handlers/view/transactions"
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+type body struct {
+	StartDate    string                   `json:"start_date"`
+	EndDate      string                   `json:"end_date"`
+	Transactions []txresponse.Transaction `json:"transactions"`
+	NextCursor   string                   `json:"next_cursor"`
+	Totals       response.Totals          `json:"totals"`
+}
+
+func TestHandler_Handle(t *testing.T) {
+	t.Parallel()
+
+	tx := buildTransaction("tx-1")
+	found := appsearch.Output{
+		Transactions: []domaintransaction.Transaction{tx},
+		NextCursor:   "next",
+		Totals:       appsearch.Totals{Expense: 42.5, Net: -42.5, Count: 1},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		resolver   *fakeResolver
+		searcher   *fakeSearcher
+		wantStatus int
+		wantBody   any
+		wantIn     appsearch.Input
+	}{
+		{
+			name:       "returns 200 with the page and totals of the view",
+			query:      "?limit=1",
+			resolver:   &fakeResolver{out: groceries},
+			searcher:   &fakeSearcher{out: found},
+			wantStatus: http.StatusOK,
+			wantBody: body{
+				StartDate:    "2026-03-01",
+				EndDate:      "2026-03-18",
+				Transactions: txresponse.ToTransactions([]domaintransaction.Transaction{tx}),
+				NextCursor:   "next",
+				Totals:       response.Totals{Expense: 42.5, Net: -42.5, Count: 1},
+			},
+			wantIn: groceries.Search(domainshared.PageQuery{Limit: 1}),
+		},
+		{
+			name:       "invalid page returns 400",
+			query:      "?limit=abc",
+			resolver:   &fakeResolver{},
+			searcher:   &fakeSearcher{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing view returns 404",
+			resolver:   &fakeResolver{err: fmt.Errorf("view not found: %w", domainshared.ErrNotFound)},
+			searcher:   &fakeSearcher{},
+			wantStatus: http.StatusNotFound,
+			wantBody:   response.Error{Error: "view not found"},
+		},
+		{
+			name:       "resolver error returns 500",
+			resolver:   &fakeResolver{err: errors.New("db error")},
+			searcher:   &fakeSearcher{},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   response.Error{Error: "internal server error"},
+		},
+		{
+			name:       "invalid search returns 400",
+			resolver:   &fakeResolver{out: groceries},
+			searcher:   &fakeSearcher{err: fmt.Errorf("search transactions: %w", domaintransaction.ErrInvalidSearch)},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   response.Error{Error: "search transactions: invalid search"},
+			wantIn:     groceries.Search(domainshared.PageQuery{}),
+		},
+		{
+			name:       "search error returns 500",
+			resolver:   &fakeResolver{out: groceries},
+			searcher:   &fakeSearcher{err: errors.New("db error")},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   response.Error{Error: "internal server error"},
+			wantIn:     groceries.Search(domainshared.PageQuery{}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := transactions.New(tc.resolver, tc.searcher)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/views/v-1/transactions"+tc.query, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "v-1")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			rec := httptest.NewRecorder()
+
+			h.Handle(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			assert.Equal(t, tc.wantIn, tc.searcher.gotIn)
+			if tc.wantBody != nil {
+				assert.Equal(t, "v-1", tc.resolver.gotID)
+				assert.Equal(t, tc.wantBody, decodeAs(t, rec, tc.wantBody))
+			}
+		})
+	}
+}
+
+// decodeAs decodes the recorder body into a new value of the same type as want.
+func decodeAs(t *testing.T, rec *httptest.ResponseRecorder, want any) any {
+	t.Helper()
+	ptr := reflect.New(reflect.TypeOf(want))
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(ptr.Interface()))
+	return ptr.Elem().Interface()
+}
@@ -0,0 +1,56 @@
+package transactions_test
+
+import (
+	"context"
+	"time"
+
+	appsearch "github.com/financial-manager/api/internal/application/transaction/search"
+	"github.com/financial-manager/api/internal/application/view/resolve"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+type fakeResolver struct {
+	out   resolve.Output
+	err   error
+	gotID string
+}
+
+func (f *fakeResolver) Execute(_ context.Context, id string) (resolve.Output, error) {
+	f.gotID = id
+	return f.out, f.err
+}
+
+type fakeSearcher struct {
+	out   appsearch.Output
+	err   error
+	gotIn appsearch.Input
+}
+
+func (f *fakeSearcher) Execute(_ context.Context, in appsearch.Input) (appsearch.Output, error) {
+	f.gotIn = in
+	return f.out, f.err
+}
+
+// groceries is a view of the expenses of two categories this month.
+var groceries = resolve.Output{
+	View: domainview.View{ID: "v-1", Name: "Groceries", Filter: domainview.Filter{
+		Types:       []string{"expense"},
+		CategoryIDs: []string{"cat-food", "cat-home"},
+		Range:       domainview.RangeThisMonth,
+	}},
+	StartDate: "2026-03-01",
+	EndDate:   "2026-03-18",
+}
+
+func buildTransaction(id string) domaintransaction.Transaction {
+	return domaintransaction.Transaction{
+		ID:         id,
+		AccountID:  "acc-1",
+		CategoryID: "cat-food",
+		Type:       domaintransaction.TransactionTypeExpense,
+		Amount:     42.5,
+		Date:       time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+		IsActive:   true,
+	}
+}
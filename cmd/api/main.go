@@ -3,6 +3,8 @@ package main
 
 import (
 	"log"
+	// Embedded so the timezone setting works on hosts without a zoneinfo database.
+	_ "time/tzdata"
 
 	"github.com/financial-manager/api/internal/platform/config"
 )
@@ -16,7 +18,10 @@ func main() {
 	}
 	defer closeDatabases(dbs)
 
-	svc := buildServices(dbs)
+	svc := buildServices(cfg, dbs)
+
+	stopBackups := startBackups(cfg, svc)
+	defer stopBackups()
 
 	run(cfg, svc)
 }
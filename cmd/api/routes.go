@@ -15,14 +15,37 @@ import (
 	categoryupdate "github.com/financial-manager/api/cmd/api/handlers/category/update"
 	dashboardhandler "github.com/financial-manager/api/cmd/api/handlers/dashboard"
 	exporthandler "github.com/financial-manager/api/cmd/api/handlers/export"
+	archivehandler "github.com/financial-manager/api/cmd/api/handlers/export/archive"
+	ledgerhandler "github.com/financial-manager/api/cmd/api/handlers/export/ledger"
 	pdfhandler "github.com/financial-manager/api/cmd/api/handlers/export/pdf"
+	presetcreate "github.com/financial-manager/api/cmd/api/handlers/exportpreset/create"
+	presetdelete "github.com/financial-manager/api/cmd/api/handlers/exportpreset/delete"
+	presetlist "github.com/financial-manager/api/cmd/api/handlers/exportpreset/list"
 	healthhandler "github.com/financial-manager/api/cmd/api/handlers/health"
+	anomalyhandler "github.com/financial-manager/api/cmd/api/handlers/insight/anomaly"
+	cashflowhandler "github.com/financial-manager/api/cmd/api/handlers/report/cashflow"
+	categorytrendhandler "github.com/financial-manager/api/cmd/api/handlers/report/categorytrend"
+	forecasthandler "github.com/financial-manager/api/cmd/api/handlers/report/forecast"
+	networthhandler "github.com/financial-manager/api/cmd/api/handlers/report/networth"
+	monthstarthandler "github.com/financial-manager/api/cmd/api/handlers/setting/monthstart"
+	timezonehandler "github.com/financial-manager/api/cmd/api/handlers/setting/timezone"
+	transactionbulk "github.com/financial-manager/api/cmd/api/handlers/transaction/bulk"
 	transactiondelete "github.com/financial-manager/api/cmd/api/handlers/transaction/delete"
+	duplicatereport "github.com/financial-manager/api/cmd/api/handlers/transaction/duplicate/report"
+	duplicateresolve "github.com/financial-manager/api/cmd/api/handlers/transaction/duplicate/resolve"
 	transactionexpensecreate "github.com/financial-manager/api/cmd/api/handlers/transaction/expense/create"
+	transactionfulltext "github.com/financial-manager/api/cmd/api/handlers/transaction/fulltext"
 	transactionincomecreate "github.com/financial-manager/api/cmd/api/handlers/transaction/income/create"
 	transactionlist "github.com/financial-manager/api/cmd/api/handlers/transaction/list"
+	transactionsearch "github.com/financial-manager/api/cmd/api/handlers/transaction/search"
 	transactionsummary "github.com/financial-manager/api/cmd/api/handlers/transaction/summary"
 	transactionupdate "github.com/financial-manager/api/cmd/api/handlers/transaction/update"
+	viewcreate "github.com/financial-manager/api/cmd/api/handlers/view/create"
+	viewdelete "github.com/financial-manager/api/cmd/api/handlers/view/delete"
+	viewexport "github.com/financial-manager/api/cmd/api/handlers/view/export"
+	viewlist "github.com/financial-manager/api/cmd/api/handlers/view/list"
+	viewtotals "github.com/financial-manager/api/cmd/api/handlers/view/totals"
+	viewtransactions "github.com/financial-manager/api/cmd/api/handlers/view/transactions"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 )
@@ -37,6 +60,10 @@ func registerRoutes(svc *services) http.Handler {
 	registerTransactionRoutes(r, svc)
 	registerDashboardRoutes(r, svc)
 	registerExportRoutes(r, svc)
+	registerViewRoutes(r, svc)
+	registerReportRoutes(r, svc)
+	registerInsightRoutes(r, svc)
+	registerSettingRoutes(r, svc)
 	return r
 }
 
@@ -60,7 +87,7 @@ func registerAccountRoutes(r *chi.Mux, svc *services) {
 	getHandler := accountget.New(svc.Accounts.Getter)
 	updateHandler := accountupdate.New(svc.Accounts.Updater)
 	deleteHandler := accountdelete.New(svc.Accounts.Deleter)
-	balanceHandler := accountbalance.New(svc.Accounts.Getter)
+	balanceHandler := accountbalance.New(svc.Accounts.Getter, svc.Accounts.BalanceOn)
 
 	r.Route("/api/v1/accounts", func(r chi.Router) {
 		r.Post("/", createHandler.Handle)
@@ -92,16 +119,26 @@ func registerTransactionRoutes(r *chi.Mux, svc *services) {
 	incomeCreateHandler := transactionincomecreate.New(svc.Transactions.IncomeCreator)
 	expenseCreateHandler := transactionexpensecreate.New(svc.Transactions.ExpenseCreator)
 	listHandler := transactionlist.New(svc.Transactions.IncomeLister, svc.Transactions.ExpenseLister)
+	searchHandler := transactionsearch.New(svc.Transactions.Searcher)
+	fullTextHandler := transactionfulltext.New(svc.Transactions.FullText)
 	summaryHandler := transactionsummary.New(svc.Transactions.Summary)
 	updateHandler := transactionupdate.New(svc.Transactions.Updater)
 	deleteHandler := transactiondelete.New(svc.Transactions.Deleter)
+	duplicateReportHandler := duplicatereport.New(svc.Transactions.DuplicateList)
+	duplicateResolveHandler := duplicateresolve.New(svc.Transactions.DuplicateFixer)
+	bulkHandler := transactionbulk.New(svc.Transactions.Bulk)
 
 	r.Route("/api/v1/transactions", func(r chi.Router) {
+		r.Get("/", searchHandler.Handle)
 		r.Post("/incomes", incomeCreateHandler.Handle)
 		r.Post("/expenses", expenseCreateHandler.Handle)
 		r.Get("/incomes", listHandler.HandleIncomes)
 		r.Get("/expenses", listHandler.HandleExpenses)
 		r.Get("/summary", summaryHandler.Handle)
+		r.Get("/search", fullTextHandler.Handle)
+		r.Get("/duplicates", duplicateReportHandler.Handle)
+		r.Post("/duplicates/resolve", duplicateResolveHandler.Handle)
+		r.Post("/bulk", bulkHandler.Handle)
 		r.Put("/{id}", updateHandler.Handle)
 		r.Delete("/{id}", deleteHandler.Handle)
 	})
@@ -115,9 +152,69 @@ func registerDashboardRoutes(r *chi.Mux, svc *services) {
 
 // registerExportRoutes mounts the /api/v1/export endpoints.
 func registerExportRoutes(r *chi.Mux, svc *services) {
-	exportHandler := exporthandler.New(svc.Export.Exporter, svc.Export.Exporter)
+	exportHandler := exporthandler.New(svc.Export.Exporter, svc.Export.Exporter, svc.Export.Exporter, svc.Export.PresetGetter, svc.Export.Encrypter)
+	ledgerExportHandler := ledgerhandler.New(svc.Export.Exporter)
 	pdfExportHandler := pdfhandler.New(svc.Export.PDFExporter)
+	archiveHandler := archivehandler.New(svc.Export.Archiver, svc.Export.Encrypter)
+	presetCreateHandler := presetcreate.New(svc.Export.PresetCreator)
+	presetListHandler := presetlist.New(svc.Export.PresetLister)
+	presetDeleteHandler := presetdelete.New(svc.Export.PresetDeleter)
 	r.Get("/api/v1/export/csv", exportHandler.HandleCSV)
 	r.Get("/api/v1/export/json", exportHandler.HandleJSON)
+	r.Get("/api/v1/export/xlsx", exportHandler.HandleXLSX)
+	r.Get("/api/v1/export/ledger", ledgerExportHandler.Handle)
 	r.Post("/api/v1/export/pdf", pdfExportHandler.Handle)
+	r.Get("/api/v1/export/archive", archiveHandler.Handle)
+	r.Post("/api/v1/export/presets", presetCreateHandler.Handle)
+	r.Get("/api/v1/export/presets", presetListHandler.Handle)
+	r.Delete("/api/v1/export/presets/{id}", presetDeleteHandler.Handle)
+}
+
+// registerViewRoutes mounts the /api/v1/views route group.
+func registerViewRoutes(r *chi.Mux, svc *services) {
+	createHandler := viewcreate.New(svc.Views.Creator)
+	listHandler := viewlist.New(svc.Views.Lister)
+	deleteHandler := viewdelete.New(svc.Views.Deleter)
+	transactionsHandler := viewtransactions.New(svc.Views.Resolver, svc.Transactions.Searcher)
+	totalsHandler := viewtotals.New(svc.Views.Resolver, svc.Transactions.Searcher)
+	exportHandler := viewexport.New(svc.Views.Resolver, svc.Export.Exporter, svc.Export.PDFExporter, svc.Export.Encrypter)
+
+	r.Route("/api/v1/views", func(r chi.Router) {
+		r.Post("/", createHandler.Handle)
+		r.Get("/", listHandler.Handle)
+		r.Delete("/{id}", deleteHandler.Handle)
+		r.Get("/{id}/transactions", transactionsHandler.Handle)
+		r.Get("/{id}/totals", totalsHandler.Handle)
+		r.Get("/{id}/export/csv", exportHandler.HandleCSV)
+		r.Post("/{id}/export/pdf", exportHandler.HandlePDF)
+	})
+}
+
+// registerReportRoutes mounts the /api/v1/reports endpoints.
+func registerReportRoutes(r *chi.Mux, svc *services) {
+	cashFlowHandler := cashflowhandler.New(svc.Reports.CashFlow)
+	netWorthHandler := networthhandler.New(svc.Reports.NetWorth)
+	categoryTrendHandler := categorytrendhandler.New(svc.Reports.Trends)
+	forecastHandler := forecasthandler.New(svc.Reports.Forecast)
+	r.Get("/api/v1/reports/cashflow", cashFlowHandler.Handle)
+	r.Get("/api/v1/reports/networth", netWorthHandler.Handle)
+	r.Get("/api/v1/reports/categories", categoryTrendHandler.Handle)
+	r.Get("/api/v1/reports/forecast", forecastHandler.Handle)
+}
+
+// registerInsightRoutes mounts the /api/v1/insights endpoints.
+func registerInsightRoutes(r *chi.Mux, svc *services) {
+	anomalyHandler := anomalyhandler.New(svc.Insights.Anomalies)
+	r.Get("/api/v1/insights/anomalies", anomalyHandler.Handle)
+}
+
+// registerSettingRoutes mounts the /api/v1/settings endpoints.
+func registerSettingRoutes(r *chi.Mux, svc *services) {
+	timezoneHandler := timezonehandler.New(svc.Settings.TimezoneGetter, svc.Settings.TimezoneUpdater)
+	r.Get("/api/v1/settings/timezone", timezoneHandler.HandleGet)
+	r.Put("/api/v1/settings/timezone", timezoneHandler.HandleUpdate)
+
+	monthStartHandler := monthstarthandler.New(svc.Settings.MonthStartGetter, svc.Settings.MonthStartUpdater)
+	r.Get("/api/v1/settings/month-start", monthStartHandler.HandleGet)
+	r.Put("/api/v1/settings/month-start", monthStartHandler.HandleUpdate)
 }
@@ -32,6 +32,28 @@ func closeDatabases(dbs *database.Databases) {
 	}
 }
 
+// startBackups runs the backup scheduler in the background. The returned
+// function stops it and waits for a backup in progress to finish, so it must
+// be called before the databases are closed.
+func startBackups(cfg *config.Config, svc *services) func() {
+	if cfg.BackupInterval <= 0 {
+		return func() {}
+	}
+	log.Printf("backups every %s to %s", cfg.BackupInterval, cfg.BackupDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		svc.Backup.Scheduler.Run(ctx)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
 // run starts the HTTP server and blocks until a termination signal is received.
 func run(cfg *config.Config, svc *services) {
 	addr := fmt.Sprintf(":%s", cfg.Port)
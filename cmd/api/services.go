@@ -1,35 +1,77 @@
 package main
 
 import (
+	"log"
+	"time"
+
+	"github.com/financial-manager/api/internal/application/account/balanceon"
 	"github.com/financial-manager/api/internal/application/account/create"
 	accountdelete "github.com/financial-manager/api/internal/application/account/delete"
 	"github.com/financial-manager/api/internal/application/account/get"
 	"github.com/financial-manager/api/internal/application/account/globalbalance"
 	accountlist "github.com/financial-manager/api/internal/application/account/list"
 	"github.com/financial-manager/api/internal/application/account/update"
+	"github.com/financial-manager/api/internal/application/archive"
+	"github.com/financial-manager/api/internal/application/backup"
 	categorycreate "github.com/financial-manager/api/internal/application/category/create"
 	categorydelete "github.com/financial-manager/api/internal/application/category/delete"
 	categorylist "github.com/financial-manager/api/internal/application/category/list"
 	categoryupdate "github.com/financial-manager/api/internal/application/category/update"
 	"github.com/financial-manager/api/internal/application/dashboard"
 	appexport "github.com/financial-manager/api/internal/application/export"
+	presetcreate "github.com/financial-manager/api/internal/application/exportpreset/create"
+	presetdelete "github.com/financial-manager/api/internal/application/exportpreset/delete"
+	presetget "github.com/financial-manager/api/internal/application/exportpreset/get"
+	presetlist "github.com/financial-manager/api/internal/application/exportpreset/list"
 	"github.com/financial-manager/api/internal/application/health"
+	"github.com/financial-manager/api/internal/application/insight/anomaly"
+	anomalylist "github.com/financial-manager/api/internal/application/insight/anomaly/list"
+	anomalyscore "github.com/financial-manager/api/internal/application/insight/anomaly/score"
 	"github.com/financial-manager/api/internal/application/pdfexport"
+	"github.com/financial-manager/api/internal/application/report/cashflow"
+	"github.com/financial-manager/api/internal/application/report/categorytrend"
+	"github.com/financial-manager/api/internal/application/report/forecast"
+	"github.com/financial-manager/api/internal/application/report/networth"
+	getmonthstart "github.com/financial-manager/api/internal/application/setting/monthstart/get"
+	updatemonthstart "github.com/financial-manager/api/internal/application/setting/monthstart/update"
+	gettimezone "github.com/financial-manager/api/internal/application/setting/timezone/get"
+	updatetimezone "github.com/financial-manager/api/internal/application/setting/timezone/update"
+	transactionbulk "github.com/financial-manager/api/internal/application/transaction/bulk"
 	transactiondelete "github.com/financial-manager/api/internal/application/transaction/delete"
+	"github.com/financial-manager/api/internal/application/transaction/duplicate"
+	duplicatecheck "github.com/financial-manager/api/internal/application/transaction/duplicate/check"
+	duplicatereport "github.com/financial-manager/api/internal/application/transaction/duplicate/report"
+	duplicateresolve "github.com/financial-manager/api/internal/application/transaction/duplicate/resolve"
 	expensecreate "github.com/financial-manager/api/internal/application/transaction/expense/create"
 	expenselist "github.com/financial-manager/api/internal/application/transaction/expense/list"
+	transactionfulltext "github.com/financial-manager/api/internal/application/transaction/fulltext"
 	incomecreate "github.com/financial-manager/api/internal/application/transaction/income/create"
 	incomelist "github.com/financial-manager/api/internal/application/transaction/income/list"
+	transactionsearch "github.com/financial-manager/api/internal/application/transaction/search"
 	transactionsummary "github.com/financial-manager/api/internal/application/transaction/summary"
 	transactionupdate "github.com/financial-manager/api/internal/application/transaction/update"
+	viewcreate "github.com/financial-manager/api/internal/application/view/create"
+	viewdelete "github.com/financial-manager/api/internal/application/view/delete"
+	viewlist "github.com/financial-manager/api/internal/application/view/list"
+	viewresolve "github.com/financial-manager/api/internal/application/view/resolve"
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
 	"github.com/financial-manager/api/internal/platform/account/sqlite"
 	categorysqlite "github.com/financial-manager/api/internal/platform/category/sqlite"
 	"github.com/financial-manager/api/internal/platform/clock"
+	"github.com/financial-manager/api/internal/platform/config"
+	"github.com/financial-manager/api/internal/platform/crypt"
+	"github.com/financial-manager/api/internal/platform/currency"
 	dashboardsqlite "github.com/financial-manager/api/internal/platform/dashboard/sqlite"
 	"github.com/financial-manager/api/internal/platform/database"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
 	exportsqlite "github.com/financial-manager/api/internal/platform/export/sqlite"
+	exportpresetsqlite "github.com/financial-manager/api/internal/platform/exportpreset/sqlite"
 	"github.com/financial-manager/api/internal/platform/idgen"
+	insightsqlite "github.com/financial-manager/api/internal/platform/insight/sqlite"
+	reportsqlite "github.com/financial-manager/api/internal/platform/report/sqlite"
+	settingsqlite "github.com/financial-manager/api/internal/platform/setting/sqlite"
 	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+	viewsqlite "github.com/financial-manager/api/internal/platform/view/sqlite"
 )
 
 type (
@@ -38,6 +80,11 @@ type (
 		Checker *health.CheckUseCase
 	}
 
+	// backupServices groups the scheduled backup runner.
+	backupServices struct {
+		Scheduler *backup.Scheduler
+	}
+
 	// accountServices groups all use cases for the accounts resource.
 	accountServices struct {
 		Creator       *create.UseCase
@@ -46,6 +93,7 @@ type (
 		Updater       *update.UseCase
 		Deleter       *accountdelete.UseCase
 		BalanceGetter *globalbalance.UseCase
+		BalanceOn     *balanceon.UseCase
 	}
 
 	// categoryServices groups all use cases for the categories resource.
@@ -62,9 +110,14 @@ type (
 		IncomeLister   *incomelist.UseCase
 		ExpenseCreator *expensecreate.UseCase
 		ExpenseLister  *expenselist.UseCase
+		Searcher       *transactionsearch.UseCase
+		FullText       *transactionfulltext.UseCase
 		Updater        *transactionupdate.UseCase
 		Deleter        *transactiondelete.UseCase
 		Summary        *transactionsummary.UseCase
+		DuplicateList  *duplicatereport.UseCase
+		DuplicateFixer *duplicateresolve.UseCase
+		Bulk           *transactionbulk.UseCase
 	}
 
 	// dashboardServices groups all use cases for the dashboard resource.
@@ -74,32 +127,98 @@ type (
 
 	// exportServices groups all use cases for the export resource.
 	exportServices struct {
-		Exporter    *appexport.UseCase
-		PDFExporter *pdfexport.UseCase
+		Exporter      *appexport.UseCase
+		PDFExporter   *pdfexport.UseCase
+		Archiver      *archive.UseCase
+		Encrypter     crypt.Cipher
+		PresetCreator *presetcreate.UseCase
+		PresetLister  *presetlist.UseCase
+		PresetGetter  *presetget.UseCase
+		PresetDeleter *presetdelete.UseCase
+	}
+
+	// reportServices groups all use cases for the reports resource.
+	reportServices struct {
+		CashFlow *cashflow.UseCase
+		NetWorth *networth.UseCase
+		Trends   *categorytrend.UseCase
+		Forecast *forecast.UseCase
+	}
+
+	// settingServices groups all use cases for the settings resource.
+	settingServices struct {
+		TimezoneGetter    *gettimezone.UseCase
+		TimezoneUpdater   *updatetimezone.UseCase
+		MonthStartGetter  *getmonthstart.UseCase
+		MonthStartUpdater *updatemonthstart.UseCase
+	}
+
+	// viewServices groups all use cases for the saved views resource.
+	viewServices struct {
+		Creator  *viewcreate.UseCase
+		Lister   *viewlist.UseCase
+		Deleter  *viewdelete.UseCase
+		Resolver *viewresolve.UseCase
+	}
+
+	// insightServices groups all use cases for the insights resource.
+	insightServices struct {
+		Anomalies *anomalylist.UseCase
 	}
 
 	// services holds all use case groups ready to be injected into the HTTP layer.
 	services struct {
 		Health       healthServices
+		Backup       backupServices
 		Accounts     accountServices
 		Categories   categoryServices
 		Transactions transactionServices
 		Dashboard    dashboardServices
 		Export       exportServices
+		Views        viewServices
+		Reports      reportServices
+		Insights     insightServices
+		Settings     settingServices
 	}
 )
 
 // buildServices wires all use cases with their dependencies.
-func buildServices(dbs *database.Databases) *services {
+func buildServices(cfg *config.Config, dbs *database.Databases) *services {
 	accountRepo := sqlite.NewAccountRepository(dbs.Accounts)
 	categoryRepo := categorysqlite.NewCategoryRepository(dbs.Categories)
 	transactionRepo := transactionsqlite.NewTransactionRepository(dbs.Transactions)
 	dashboardRepo := dashboardsqlite.NewDashboardRepository(dbs.Accounts, dbs.Transactions, dbs.Categories)
 	exportRepo := exportsqlite.NewExportRepository(dbs.Accounts, dbs.Categories, dbs.Transactions)
+	reportRepo := reportsqlite.NewReportRepository(dbs.Accounts, dbs.Categories, dbs.Transactions)
+	anomalyRepo := insightsqlite.NewAnomalyRepository(dbs.Transactions)
+	presetRepo := exportpresetsqlite.NewPresetRepository(dbs.Settings)
+	viewRepo := viewsqlite.NewViewRepository(dbs.Settings)
+	settingRepo := settingsqlite.NewSettingRepository(dbs.Settings)
+	timezone := gettimezone.New(settingRepo, time.Local)
+	monthStart := getmonthstart.New(settingRepo)
+	forecaster := forecast.New(reportRepo, timezone, monthStart, clock.WallClock{})
+	exporter := appexport.New(exportRepo)
+	duplicateMatcher := duplicate.NewMatcher(cfg.DuplicateWindowDays, duplicate.DefaultMinSimilarity)
+	duplicateCheck := duplicatecheck.New(transactionRepo, duplicateMatcher)
+	anomalyScore := anomalyscore.New(transactionRepo, anomalyRepo, anomaly.NewDetector(0, 0, 0), clock.WallClock{})
+	cipher := crypt.Cipher{Params: crypt.DefaultParams()}
+	backupScheduler := backup.NewScheduler(dbs, cipher, clock.WallClock{}, backup.Policy{
+		Dir:      backupDir(cfg),
+		Interval: cfg.BackupInterval,
+		Retention: domainbackup.Retention{
+			KeepLast:    cfg.BackupKeepLast,
+			KeepDaily:   cfg.BackupKeepDaily,
+			KeepMonthly: cfg.BackupKeepMonthly,
+		},
+		Passphrase: cfg.BackupPassphrase,
+	})
 
 	return &services{
 		Health: healthServices{
-			Checker: health.NewCheckUseCase(),
+			Checker: health.NewCheckUseCase(backupScheduler),
+		},
+		Backup: backupServices{
+			Scheduler: backupScheduler,
 		},
 		Accounts: accountServices{
 			Creator:       create.New(accountRepo, idgen.UUIDGenerator{}, clock.WallClock{}),
@@ -108,6 +227,7 @@ func buildServices(dbs *database.Databases) *services {
 			Updater:       update.New(accountRepo, clock.WallClock{}),
 			Deleter:       accountdelete.New(accountRepo),
 			BalanceGetter: globalbalance.New(accountRepo),
+			BalanceOn:     balanceon.New(accountRepo, transactionRepo),
 		},
 		Categories: categoryServices{
 			Creator: categorycreate.New(categoryRepo, idgen.UUIDGenerator{}, clock.WallClock{}),
@@ -116,20 +236,74 @@ func buildServices(dbs *database.Databases) *services {
 			Deleter: categorydelete.New(categoryRepo),
 		},
 		Transactions: transactionServices{
-			IncomeCreator:  incomecreate.New(transactionRepo, idgen.UUIDGenerator{}, clock.WallClock{}),
+			IncomeCreator:  incomecreate.New(transactionRepo, idgen.UUIDGenerator{}, clock.WallClock{}, duplicateCheck),
 			IncomeLister:   incomelist.New(transactionRepo),
-			ExpenseCreator: expensecreate.New(transactionRepo, idgen.UUIDGenerator{}, clock.WallClock{}),
+			ExpenseCreator: expensecreate.New(transactionRepo, idgen.UUIDGenerator{}, clock.WallClock{}, duplicateCheck, anomalyScore),
 			ExpenseLister:  expenselist.New(transactionRepo),
-			Updater:        transactionupdate.New(transactionRepo, clock.WallClock{}),
+			Searcher:       transactionsearch.New(transactionRepo),
+			FullText:       transactionfulltext.New(transactionRepo),
+			Updater:        transactionupdate.New(transactionRepo, accountRepo, clock.WallClock{}),
 			Deleter:        transactiondelete.New(transactionRepo, clock.WallClock{}),
-			Summary:        transactionsummary.New(transactionRepo),
+			Summary:        transactionsummary.New(transactionRepo, monthStart),
+			DuplicateList:  duplicatereport.New(transactionRepo, duplicateMatcher),
+			DuplicateFixer: duplicateresolve.New(transactionRepo, accountRepo, clock.WallClock{}),
+			Bulk:           transactionbulk.New(transactionRepo, accountRepo, idgen.UUIDGenerator{}, clock.WallClock{}, anomalyScore),
 		},
 		Dashboard: dashboardServices{
-			Getter: dashboard.New(dashboardRepo),
+			Getter: dashboard.New(dashboardRepo, transactionRepo, forecaster, timezone, monthStart, clock.WallClock{}),
 		},
 		Export: exportServices{
-			Exporter:    appexport.New(exportRepo),
-			PDFExporter: pdfexport.New(exportRepo),
+			Exporter:      exporter,
+			Archiver:      archive.New(dbs, snapshotExporter{connector: dbsqlite.NewConnector()}, clock.WallClock{}, health.AppVersion),
+			Encrypter:     cipher,
+			PDFExporter:   pdfexport.New(exportRepo, monthStart, pdfexport.Locale(cfg.ReportLocale)),
+			PresetCreator: presetcreate.New(presetRepo, idgen.UUIDGenerator{}, clock.WallClock{}),
+			PresetLister:  presetlist.New(presetRepo),
+			PresetGetter:  presetget.New(presetRepo),
+			PresetDeleter: presetdelete.New(presetRepo),
+		},
+		Views: viewServices{
+			Creator:  viewcreate.New(viewRepo, idgen.UUIDGenerator{}, clock.WallClock{}),
+			Lister:   viewlist.New(viewRepo),
+			Deleter:  viewdelete.New(viewRepo),
+			Resolver: viewresolve.New(viewRepo, timezone, monthStart, clock.WallClock{}),
+		},
+		Reports: reportServices{
+			CashFlow: cashflow.New(reportRepo, monthStart, clock.WallClock{}),
+			NetWorth: networth.New(reportRepo, exchangeRates(cfg), monthStart, clock.WallClock{}, cfg.BaseCurrency),
+			Trends:   categorytrend.New(reportRepo, monthStart, clock.WallClock{}, cfg.CategoryTrendThreshold),
+			Forecast: forecaster,
 		},
+		Insights: insightServices{
+			Anomalies: anomalylist.New(anomalyRepo),
+		},
+		Settings: settingServices{
+			TimezoneGetter:    timezone,
+			TimezoneUpdater:   updatetimezone.New(settingRepo),
+			MonthStartGetter:  monthStart,
+			MonthStartUpdater: updatemonthstart.New(settingRepo),
+		},
+	}
+}
+
+// exchangeRates returns the fixed exchange rates configured for reports. If
+// they cannot be parsed, only the base currency can be reported on.
+func exchangeRates(cfg *config.Config) currency.Rates {
+	rates, err := currency.ParseRates(cfg.BaseCurrency, cfg.ExchangeRates)
+	if err != nil {
+		log.Printf("reports: %v", err)
+		rates, _ = currency.ParseRates(cfg.BaseCurrency, "")
+	}
+	return rates
+}
+
+// backupDir returns cfg.BackupDir with a leading ~ expanded. If the home
+// directory cannot be resolved the path is used as configured.
+func backupDir(cfg *config.Config) string {
+	dir, err := dbsqlite.ExpandTilde(cfg.BackupDir)
+	if err != nil {
+		log.Printf("backup: expand %s: %v", cfg.BackupDir, err)
+		return cfg.BackupDir
 	}
+	return dir
 }
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	appexport "github.com/financial-manager/api/internal/application/export"
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
+	exportsqlite "github.com/financial-manager/api/internal/platform/export/sqlite"
+)
+
+// snapshotExporter writes the JSON backup of a set of database snapshots by
+// running the export use case against the snapshot files instead of the live
+// databases.
+type snapshotExporter struct {
+	connector *dbsqlite.Connector
+}
+
+// ExportJSON opens the accounts, categories and transactions snapshots and
+// writes their JSON backup to w.
+func (e snapshotExporter) ExportJSON(ctx context.Context, snapshots []domainbackup.Snapshot, w io.Writer) error {
+	paths := make(map[string]string, len(snapshots))
+	for _, s := range snapshots {
+		paths[s.Name] = s.Path
+	}
+
+	dbs := make(map[string]*sql.DB, 3)
+	for _, name := range []string{"accounts.db", "categories.db", "transactions.db"} {
+		path, ok := paths[name]
+		if !ok {
+			return fmt.Errorf("snapshot of %s is missing", name)
+		}
+		db, err := e.connector.Open(ctx, path)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		dbs[name] = db
+	}
+
+	repo := exportsqlite.NewExportRepository(dbs["accounts.db"], dbs["categories.db"], dbs["transactions.db"])
+	return appexport.New(repo).ExportJSON(ctx, w)
+}
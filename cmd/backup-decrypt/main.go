@@ -0,0 +1,67 @@
+// Package main decrypts an encrypted export, archive or scheduled backup file
+// so it can be restored. The passphrase is read from the BACKUP_PASSPHRASE
+// environment variable. The output is only kept if the whole input
+// authenticates.
+//
+// Usage:
+//
+//	backup-decrypt <input.enc> <output>
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/financial-manager/api/internal/platform/crypt"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: backup-decrypt <input.enc> <output>")
+		os.Exit(2)
+	}
+
+	if err := run(os.Args[1], os.Args[2]); err != nil {
+		log.Fatalf("decrypt: %v", err)
+	}
+	fmt.Printf("%s -> %s\n", os.Args[1], os.Args[2])
+}
+
+func run(in, out string) error {
+	passphrase := os.Getenv("BACKUP_PASSPHRASE")
+	if passphrase == "" {
+		return errors.New("set BACKUP_PASSPHRASE")
+	}
+
+	src, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	plain, err := crypt.Decrypt(src, passphrase)
+	if err != nil {
+		return err
+	}
+
+	// Write next to the destination and rename, so a wrong passphrase or a
+	// corrupted file never leaves a partial output behind.
+	tmp, err := os.CreateTemp(filepath.Dir(out), ".backup-decrypt-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, plain); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), out)
+}
@@ -0,0 +1,53 @@
+// Package main recomputes the daily balance snapshots from the transactions,
+// for example after transactions were edited outside the API or restored from
+// a backup. It opens the databases in DB_DIR, applying pending migrations, and
+// should not run while the API is writing transactions.
+//
+// Usage:
+//
+//	snapshot-rebuild
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/financial-manager/api/internal/application/account/rebuildsnapshots"
+	"github.com/financial-manager/api/internal/platform/config"
+	"github.com/financial-manager/api/internal/platform/database"
+	"github.com/financial-manager/api/internal/platform/database/migrator"
+	"github.com/financial-manager/api/internal/platform/database/sqlite"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+)
+
+func main() {
+	if len(os.Args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: snapshot-rebuild")
+		os.Exit(2)
+	}
+
+	if err := run(config.Load()); err != nil {
+		log.Fatalf("rebuild: %v", err)
+	}
+}
+
+func run(cfg *config.Config) error {
+	ctx := context.Background()
+
+	dbs := database.New(sqlite.NewConnector(), migrator.New())
+	if err := dbs.Open(ctx, cfg.DatabaseDir); err != nil {
+		return err
+	}
+	defer dbs.Close()
+
+	uc := rebuildsnapshots.New(transactionsqlite.NewTransactionRepository(dbs.Transactions))
+	n, err := uc.Execute(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d balance snapshots rebuilt in %s\n", n, cfg.DatabaseDir)
+	return nil
+}
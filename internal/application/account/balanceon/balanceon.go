@@ -0,0 +1,55 @@
+// Package balanceon implements the get account balance on a date use case.
+package balanceon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+)
+
+// UseCase implements the get account balance on a date use case.
+type UseCase struct {
+	repo      Repository
+	snapshots Snapshots
+}
+
+// New creates a new UseCase.
+func New(repo Repository, snapshots Snapshots) *UseCase {
+	return &UseCase{repo: repo, snapshots: snapshots}
+}
+
+// Balance is the balance of an account at the end of a day.
+type Balance struct {
+	AccountID string  `json:"account_id"`
+	Date      string  `json:"date"`
+	Balance   float64 `json:"balance"`
+	Currency  string  `json:"currency"`
+}
+
+// Execute returns the balance of account id at the end of date (YYYY-MM-DD):
+// its initial balance plus the net of its transactions up to that day, read
+// from the balance snapshots rather than replayed.
+func (uc *UseCase) Execute(ctx context.Context, id, date string) (Balance, error) {
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return Balance{}, domainaccount.ErrInvalidBalanceDate
+	}
+
+	acc, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return Balance{}, fmt.Errorf("get balance on date: %w", err)
+	}
+
+	net, err := uc.snapshots.BalanceOn(ctx, id, date)
+	if err != nil {
+		return Balance{}, fmt.Errorf("get balance on date: %w", err)
+	}
+
+	return Balance{
+		AccountID: acc.ID,
+		Date:      date,
+		Balance:   acc.InitialBalance + net,
+		Currency:  acc.Currency,
+	}, nil
+}
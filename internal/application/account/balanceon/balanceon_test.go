@@ -0,0 +1,86 @@
+package balanceon_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/account/balanceon"
+	"github.com/financial-manager/api/internal/application/account/balanceon/mocks"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		id        string
+		date      string
+		repo      *mocks.Repository
+		snapshots *mocks.Snapshots
+		wantErr   error
+		wantOut   balanceon.Balance
+	}{
+		{
+			name:      "adds the snapshot net to the initial balance",
+			id:        "acc-1",
+			date:      "2026-03-15",
+			repo:      buildMockRepo("acc-1", bankAccount, nil),
+			snapshots: buildMockSnapshots("acc-1", "2026-03-15", 250.0, nil),
+			wantOut:   balanceon.Balance{AccountID: "acc-1", Date: "2026-03-15", Balance: 750.0, Currency: "EUR"},
+		},
+		{
+			name:      "negative net below the initial balance",
+			id:        "acc-1",
+			date:      "2026-01-01",
+			repo:      buildMockRepo("acc-1", bankAccount, nil),
+			snapshots: buildMockSnapshots("acc-1", "2026-01-01", -600.0, nil),
+			wantOut:   balanceon.Balance{AccountID: "acc-1", Date: "2026-01-01", Balance: -100.0, Currency: "EUR"},
+		},
+		{
+			name:      "malformed date",
+			id:        "acc-1",
+			date:      "15/03/2026",
+			repo:      &mocks.Repository{},
+			snapshots: &mocks.Snapshots{},
+			wantErr:   domainaccount.ErrInvalidBalanceDate,
+		},
+		{
+			name:      "nonexistent account returns ErrNotFound",
+			id:        "missing",
+			date:      "2026-03-15",
+			repo:      buildMockRepo("missing", domainaccount.Account{}, domainshared.ErrNotFound),
+			snapshots: &mocks.Snapshots{},
+			wantErr:   fmt.Errorf("get balance on date: %w", domainshared.ErrNotFound),
+		},
+		{
+			name:      "snapshot error is propagated",
+			id:        "acc-1",
+			date:      "2026-03-15",
+			repo:      buildMockRepo("acc-1", bankAccount, nil),
+			snapshots: buildMockSnapshots("acc-1", "2026-03-15", 0.0, dbErr),
+			wantErr:   fmt.Errorf("get balance on date: %w", dbErr),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := balanceon.New(tc.repo, tc.snapshots)
+			out, err := uc.Execute(context.Background(), tc.id, tc.date)
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantOut, out)
+			tc.repo.AssertExpectations(t)
+			tc.snapshots.AssertExpectations(t)
+		})
+	}
+}
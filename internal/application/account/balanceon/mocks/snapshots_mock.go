@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Snapshots is a testify mock for the balanceon.Snapshots interface.
+type Snapshots struct {
+	mock.Mock
+}
+
+// BalanceOn mocks Snapshots.BalanceOn.
+func (m *Snapshots) BalanceOn(ctx context.Context, accountID, date string) (float64, error) {
+	args := m.Called(ctx, accountID, date)
+	return args.Get(0).(float64), args.Error(1)
+}
@@ -0,0 +1,18 @@
+package balanceon
+
+import (
+	"context"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+)
+
+// Repository is the narrow read port for the account.
+type Repository interface {
+	GetByID(ctx context.Context, id string) (domainaccount.Account, error)
+}
+
+// Snapshots is the port for the daily balance snapshots, which return the net
+// amount of an account's transactions up to and including a date.
+type Snapshots interface {
+	BalanceOn(ctx context.Context, accountID, date string) (float64, error)
+}
@@ -0,0 +1,35 @@
+package balanceon_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/account/balanceon/mocks"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+)
+
+// buildMockRepo creates a mocks.Repository pre-configured to return the given
+// account and error for one GetByID call with the specified id.
+func buildMockRepo(id string, account domainaccount.Account, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("GetByID", mock.Anything, id).Return(account, err).Once()
+	return m
+}
+
+// buildMockSnapshots creates a mocks.Snapshots pre-configured to return net
+// and err for one BalanceOn call with accountID and date.
+func buildMockSnapshots(accountID, date string, net float64, err error) *mocks.Snapshots {
+	m := &mocks.Snapshots{}
+	m.On("BalanceOn", mock.Anything, accountID, date).Return(net, err).Once()
+	return m
+}
+
+// bankAccount is the account fixture of the balanceon tests.
+var bankAccount = domainaccount.Account{
+	ID:             "acc-1",
+	Name:           "Banco",
+	Type:           domainaccount.AccountTypeBank,
+	InitialBalance: 500.0,
+	CurrentBalance: 900.0,
+	Currency:       "EUR",
+	IsActive:       true,
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 // UseCase implements the list accounts use case (US-AC-003).
@@ -18,11 +19,30 @@ func New(repo Repository) *UseCase {
 	return &UseCase{repo: repo}
 }
 
-// Execute returns all active accounts.
-func (uc *UseCase) Execute(ctx context.Context) ([]domainaccount.Account, error) {
-	accounts, err := uc.repo.List(ctx)
+// Input selects the page of accounts; it is sorted by creation, oldest
+// first, by default.
+type Input struct {
+	Page domainshared.PageQuery
+}
+
+// Output is one page of accounts and the cursor of the next one, empty on
+// the last page.
+type Output struct {
+	Accounts   []domainaccount.Account
+	NextCursor string
+}
+
+// Execute returns a page of active accounts. An invalid page returns an error
+// wrapping domainshared.ErrInvalidPage.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
+	page, err := in.Page.Normalize(domainaccount.SortCreatedAt, domainshared.SortAsc, domainaccount.SortFields()...)
+	if err != nil {
+		return Output{}, fmt.Errorf("list accounts: %w", err)
+	}
+
+	accounts, next, err := uc.repo.ListPage(ctx, page)
 	if err != nil {
-		return nil, fmt.Errorf("list accounts: %w", err)
+		return Output{}, fmt.Errorf("list accounts: %w", err)
 	}
-	return accounts, nil
+	return Output{Accounts: accounts, NextCursor: next}, nil
 }
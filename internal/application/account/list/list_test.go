@@ -11,30 +11,40 @@ import (
 	"github.com/financial-manager/api/internal/application/account/list"
 	"github.com/financial-manager/api/internal/application/account/list/mocks"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 func TestUseCase_Execute(t *testing.T) {
 	t.Parallel()
 
+	byName := domainshared.PageQuery{Limit: 1, Cursor: "abc", Sort: domainaccount.SortName, Direction: domainshared.SortDesc}
+
 	tests := []struct {
 		name    string
 		repo    *mocks.Repository
+		input   list.Input
 		wantErr error
-		wantOut []domainaccount.Account
+		wantOut list.Output
 	}{
 		{
 			name:    "empty repository returns nil without error",
-			repo:    buildMockRepo(nil, nil),
-			wantOut: nil,
+			repo:    buildMockRepo(defaultPage, nil, "", nil),
+			wantOut: list.Output{},
 		},
 		{
 			name:    "returns accounts from repository",
-			repo:    buildMockRepo([]domainaccount.Account{cashAccount, bankAccount}, nil),
-			wantOut: []domainaccount.Account{cashAccount, bankAccount},
+			repo:    buildMockRepo(defaultPage, []domainaccount.Account{cashAccount, bankAccount}, "", nil),
+			wantOut: list.Output{Accounts: []domainaccount.Account{cashAccount, bankAccount}},
+		},
+		{
+			name:    "requested page is passed on with the next cursor",
+			repo:    buildMockRepo(byName, []domainaccount.Account{cashAccount}, "def", nil),
+			input:   list.Input{Page: byName},
+			wantOut: list.Output{Accounts: []domainaccount.Account{cashAccount}, NextCursor: "def"},
 		},
 		{
 			name:    "repository error is propagated",
-			repo:    buildMockRepo(nil, errors.New("db error")),
+			repo:    buildMockRepo(defaultPage, nil, "", errors.New("db error")),
 			wantErr: fmt.Errorf("list accounts: %w", errors.New("db error")),
 		},
 	}
@@ -44,7 +54,7 @@ func TestUseCase_Execute(t *testing.T) {
 			t.Parallel()
 
 			uc := list.New(tc.repo)
-			out, err := uc.Execute(context.Background())
+			out, err := uc.Execute(context.Background(), tc.input)
 
 			assert.Equal(t, tc.wantErr, err)
 			assert.Equal(t, tc.wantOut, out)
@@ -52,3 +62,15 @@ func TestUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCase_Execute_InvalidPage(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.Repository{}
+	uc := list.New(repo)
+
+	_, err := uc.Execute(context.Background(), list.Input{Page: domainshared.PageQuery{Sort: "type"}})
+
+	assert.ErrorIs(t, err, domainshared.ErrInvalidPage)
+	repo.AssertNotCalled(t, "ListPage")
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 // Repository is a testify mock for the list.Repository interface.
@@ -14,9 +15,9 @@ type Repository struct {
 	mock.Mock
 }
 
-// List mocks Repository.List.
-func (m *Repository) List(ctx context.Context) ([]domainaccount.Account, error) {
-	args := m.Called(ctx)
+// ListPage mocks Repository.ListPage.
+func (m *Repository) ListPage(ctx context.Context, page domainshared.PageQuery) ([]domainaccount.Account, string, error) {
+	args := m.Called(ctx, page)
 	accounts, _ := args.Get(0).([]domainaccount.Account)
-	return accounts, args.Error(1)
+	return accounts, args.String(1), args.Error(2)
 }
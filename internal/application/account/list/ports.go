@@ -4,9 +4,10 @@ import (
 	"context"
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 // Repository is the narrow read port required by this use case.
 type Repository interface {
-	List(ctx context.Context) ([]domainaccount.Account, error)
+	ListPage(ctx context.Context, page domainshared.PageQuery) ([]domainaccount.Account, string, error)
 }
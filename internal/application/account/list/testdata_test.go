@@ -5,13 +5,21 @@ import (
 
 	"github.com/financial-manager/api/internal/application/account/list/mocks"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
+// defaultPage is the page the use case asks for when the input sets none.
+var defaultPage = domainshared.PageQuery{
+	Limit:     domainshared.DefaultPageLimit,
+	Sort:      domainaccount.SortCreatedAt,
+	Direction: domainshared.SortAsc,
+}
+
 // buildMockRepo creates a mocks.Repository pre-configured to return the given
-// accounts and error for one List call.
-func buildMockRepo(accounts []domainaccount.Account, err error) *mocks.Repository {
+// accounts, next cursor and error for one ListPage call with page.
+func buildMockRepo(page domainshared.PageQuery, accounts []domainaccount.Account, next string, err error) *mocks.Repository {
 	m := &mocks.Repository{}
-	m.On("List", mock.Anything).Return(accounts, err).Once()
+	m.On("ListPage", mock.Anything, page).Return(accounts, next, err).Once()
 	return m
 }
 
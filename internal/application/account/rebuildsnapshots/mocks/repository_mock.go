@@ -0,0 +1,19 @@
+// Package mocks contains testify mock implementations for the rebuildsnapshots use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Repository is a testify mock for the rebuildsnapshots.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// RebuildSnapshots mocks Repository.RebuildSnapshots.
+func (m *Repository) RebuildSnapshots(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,8 @@
+package rebuildsnapshots
+
+import "context"
+
+// Repository is the port that recomputes the daily balance snapshots.
+type Repository interface {
+	RebuildSnapshots(ctx context.Context) (int, error)
+}
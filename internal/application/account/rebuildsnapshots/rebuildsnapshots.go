@@ -0,0 +1,27 @@
+// Package rebuildsnapshots implements the rebuild daily balance snapshots use case.
+package rebuildsnapshots
+
+import (
+	"context"
+	"fmt"
+)
+
+// UseCase implements the rebuild daily balance snapshots use case.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute discards the balance snapshots and recomputes them from the active
+// transactions. It returns the number of snapshots written.
+func (uc *UseCase) Execute(ctx context.Context) (int, error) {
+	n, err := uc.repo.RebuildSnapshots(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("rebuild balance snapshots: %w", err)
+	}
+	return n, nil
+}
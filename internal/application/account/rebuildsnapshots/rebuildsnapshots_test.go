@@ -0,0 +1,48 @@
+package rebuildsnapshots_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/account/rebuildsnapshots"
+	"github.com/financial-manager/api/internal/application/account/rebuildsnapshots/mocks"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		repo    *mocks.Repository
+		wantErr error
+		wantOut int
+	}{
+		{
+			name:    "returns the number of snapshots written",
+			repo:    buildMockRepo(42, nil),
+			wantOut: 42,
+		},
+		{
+			name:    "repository error is propagated",
+			repo:    buildMockRepo(0, errors.New("db error")),
+			wantErr: fmt.Errorf("rebuild balance snapshots: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := rebuildsnapshots.New(tc.repo)
+			out, err := uc.Execute(context.Background())
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantOut, out)
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package rebuildsnapshots_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/account/rebuildsnapshots/mocks"
+)
+
+// buildMockRepo creates a mocks.Repository pre-configured to return n and err
+// for one RebuildSnapshots call.
+func buildMockRepo(n int, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("RebuildSnapshots", mock.Anything).Return(n, err).Once()
+	return m
+}
@@ -0,0 +1,128 @@
+// Package archive builds and verifies full backup archives: a ZIP containing
+// a snapshot of every database, the JSON backup and a checksummed manifest.
+package archive
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// UseCase writes full backup archives.
+type UseCase struct {
+	snapshotter Snapshotter
+	backup      BackupExporter
+	clock       Clock
+	version     string
+}
+
+// New creates a new archive UseCase. version is recorded in each manifest.
+func New(snapshotter Snapshotter, backup BackupExporter, clock Clock, version string) *UseCase {
+	return &UseCase{snapshotter: snapshotter, backup: backup, clock: clock, version: version}
+}
+
+// Execute writes a ZIP archive to w. Database snapshots are staged in a
+// temporary directory that is removed before Execute returns, and the JSON
+// backup is exported from them after they are archived.
+func (uc *UseCase) Execute(ctx context.Context, w io.Writer) error {
+	dir, err := os.MkdirTemp("", "backup-archive-*")
+	if err != nil {
+		return fmt.Errorf("create staging directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	snapshots, err := uc.snapshotter.Snapshot(ctx, dir)
+	if err != nil {
+		return fmt.Errorf("snapshot databases: %w", err)
+	}
+
+	manifest := Manifest{
+		FormatVersion: FormatVersion,
+		AppVersion:    uc.version,
+		CreatedAt:     uc.clock.Now().UTC(),
+		Databases:     make([]Database, 0, len(snapshots)),
+		Files:         make([]File, 0, len(snapshots)+1),
+	}
+
+	zw := zip.NewWriter(w)
+
+	for _, s := range snapshots {
+		name := databasesDir + s.Name
+		file, err := addFile(zw, name, s.Path)
+		if err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, file)
+		manifest.Databases = append(manifest.Databases, Database{Name: s.Name, File: name, Migrations: s.Migrations})
+	}
+
+	file, err := addEntry(zw, backupJSONName, func(w io.Writer) error {
+		return uc.backup.ExportJSON(ctx, snapshots, w)
+	})
+	if err != nil {
+		return err
+	}
+	manifest.Files = append(manifest.Files, file)
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+	mw, err := zw.Create(manifestName)
+	if err != nil {
+		return fmt.Errorf("add %s: %w", manifestName, err)
+	}
+	if _, err := mw.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", manifestName, err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("close archive: %w", err)
+	}
+	return nil
+}
+
+// addFile copies the file at path into the archive as name.
+func addFile(zw *zip.Writer, name, path string) (File, error) {
+	return addEntry(zw, name, func(w io.Writer) error {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(w, f)
+		return err
+	})
+}
+
+// addEntry creates the archive entry name, fills it with write and returns its
+// size and checksum.
+func addEntry(zw *zip.Writer, name string, write func(io.Writer) error) (File, error) {
+	ew, err := zw.Create(name)
+	if err != nil {
+		return File{}, fmt.Errorf("add %s: %w", name, err)
+	}
+
+	h := sha256.New()
+	cw := &countingWriter{}
+	if err := write(io.MultiWriter(ew, h, cw)); err != nil {
+		return File{}, fmt.Errorf("write %s: %w", name, err)
+	}
+
+	return File{Name: name, Size: cw.n, SHA256: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,208 @@
+package archive_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/archive"
+	"github.com/financial-manager/api/internal/application/archive/mocks"
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	snapshots := writeSnapshots(t, databaseNames...)
+	exporter := &mocks.BackupExporter{}
+	exporter.On("ExportJSON", mock.Anything, snapshots, mock.Anything).Return(backupBody, nil).Once()
+	uc := archive.New(buildMockSnapshotter(snapshots, nil), exporter, buildMockClock(), testVersion)
+
+	var buf bytes.Buffer
+	require.NoError(t, uc.Execute(context.Background(), &buf))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	names := make([]string, 0, len(zr.File))
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{
+		"databases/accounts.db", "databases/categories.db", "databases/settings.db", "databases/transactions.db",
+		"backup.json", "manifest.json",
+	}, names)
+
+	manifest, err := archive.Verify(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, archive.FormatVersion, manifest.FormatVersion)
+	assert.Equal(t, testVersion, manifest.AppVersion)
+	assert.Equal(t, fixedTime(), manifest.CreatedAt)
+	assert.Equal(t, []archive.Database{
+		{Name: "accounts.db", File: "databases/accounts.db", Migrations: []string{"001_init"}},
+		{Name: "categories.db", File: "databases/categories.db", Migrations: []string{"001_init"}},
+		{Name: "settings.db", File: "databases/settings.db", Migrations: []string{"001_init"}},
+		{Name: "transactions.db", File: "databases/transactions.db", Migrations: []string{"001_init"}},
+	}, manifest.Databases)
+	require.Len(t, manifest.Files, 5)
+	sum := sha256.Sum256([]byte(backupBody))
+	assert.Equal(t, archive.File{
+		Name:   "backup.json",
+		Size:   int64(len(backupBody)),
+		SHA256: hex.EncodeToString(sum[:]),
+	}, manifest.Files[4])
+}
+
+func TestUseCase_Execute_Errors(t *testing.T) {
+	t.Parallel()
+
+	snapErr := errors.New("disk full")
+	exportErr := errors.New("db locked")
+
+	tests := []struct {
+		name    string
+		uc      func(t *testing.T) *archive.UseCase
+		wantErr error
+	}{
+		{
+			name: "snapshot failure is wrapped",
+			uc: func(_ *testing.T) *archive.UseCase {
+				return archive.New(buildMockSnapshotter(nil, snapErr), buildMockBackupExporter("", nil), buildMockClock(), testVersion)
+			},
+			wantErr: snapErr,
+		},
+		{
+			name: "backup export failure is wrapped",
+			uc: func(t *testing.T) *archive.UseCase {
+				return archive.New(buildMockSnapshotter(writeSnapshots(t, "accounts.db"), nil), buildMockBackupExporter("", exportErr), buildMockClock(), testVersion)
+			},
+			wantErr: exportErr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.uc(t).Execute(context.Background(), &bytes.Buffer{})
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestVerify_RejectsTamperedArchives(t *testing.T) {
+	t.Parallel()
+
+	snapshots := writeSnapshots(t, databaseNames...)
+	uc := archive.New(buildMockSnapshotter(snapshots, nil), buildMockBackupExporter(backupBody, nil), buildMockClock(), testVersion)
+	var buf bytes.Buffer
+	require.NoError(t, uc.Execute(context.Background(), &buf))
+	valid := buf.Bytes()
+
+	keep := func(_ string, data []byte) []byte { return data }
+
+	tests := []struct {
+		name  string
+		edit  func(name string, data []byte) []byte
+		extra map[string][]byte
+	}{
+		{
+			name: "modified file fails its checksum",
+			edit: func(name string, data []byte) []byte {
+				if name == "backup.json" {
+					return []byte(`{"accounts": [1]}`)
+				}
+				return data
+			},
+		},
+		{
+			name: "missing file",
+			edit: func(name string, data []byte) []byte {
+				if name == "databases/accounts.db" {
+					return nil
+				}
+				return data
+			},
+		},
+		{
+			name: "missing manifest",
+			edit: func(name string, data []byte) []byte {
+				if name == "manifest.json" {
+					return nil
+				}
+				return data
+			},
+		},
+		{
+			name: "unsupported format version",
+			edit: func(name string, data []byte) []byte {
+				if name == "manifest.json" {
+					return bytes.Replace(data, []byte(`"format_version": 1`), []byte(`"format_version": 2`), 1)
+				}
+				return data
+			},
+		},
+		{
+			name:  "unlisted entry",
+			edit:  keep,
+			extra: map[string][]byte{"databases/extra.db": fakeDatabase},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := rewriteArchive(t, valid, tc.edit, tc.extra)
+			_, err := archive.Verify(bytes.NewReader(data), int64(len(data)))
+
+			assert.ErrorIs(t, err, domainbackup.ErrInvalidArchive)
+		})
+	}
+}
+
+func TestVerify_RejectsNonSQLiteDatabase(t *testing.T) {
+	t.Parallel()
+
+	snapshots := writeSnapshots(t, databaseNames...)
+	require.NoError(t, os.WriteFile(snapshots[0].Path, []byte("plain text"), 0o600))
+	uc := archive.New(buildMockSnapshotter(snapshots, nil), buildMockBackupExporter(backupBody, nil), buildMockClock(), testVersion)
+	var buf bytes.Buffer
+	require.NoError(t, uc.Execute(context.Background(), &buf))
+
+	_, err := archive.Verify(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+
+	assert.ErrorIs(t, err, domainbackup.ErrInvalidArchive)
+}
+
+func TestVerify_RejectsMissingDatabase(t *testing.T) {
+	t.Parallel()
+
+	snapshots := writeSnapshots(t, "accounts.db", "categories.db", "settings.db")
+	uc := archive.New(buildMockSnapshotter(snapshots, nil), buildMockBackupExporter(backupBody, nil), buildMockClock(), testVersion)
+	var buf bytes.Buffer
+	require.NoError(t, uc.Execute(context.Background(), &buf))
+
+	_, err := archive.Verify(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+
+	assert.ErrorIs(t, err, domainbackup.ErrInvalidArchive)
+	assert.ErrorContains(t, err, "missing database transactions.db")
+}
+
+func TestVerify_RejectsNonZip(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("not a zip")
+	_, err := archive.Verify(bytes.NewReader(data), int64(len(data)))
+
+	assert.ErrorIs(t, err, domainbackup.ErrInvalidArchive)
+}
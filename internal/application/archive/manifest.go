@@ -0,0 +1,39 @@
+package archive
+
+import "time"
+
+// FormatVersion is the manifest format written by UseCase and accepted by Verify.
+const FormatVersion = 1
+
+const (
+	manifestName   = "manifest.json"
+	backupJSONName = "backup.json"
+	databasesDir   = "databases/"
+)
+
+// requiredDatabases names the databases every archive must contain.
+var requiredDatabases = []string{"accounts.db", "categories.db", "settings.db", "transactions.db"}
+
+// Manifest describes the contents of a backup archive. It is stored as
+// manifest.json, the last entry of the archive.
+type Manifest struct {
+	FormatVersion int        `json:"format_version"`
+	AppVersion    string     `json:"app_version"`
+	CreatedAt     time.Time  `json:"created_at"`
+	Databases     []Database `json:"databases"`
+	Files         []File     `json:"files"`
+}
+
+// Database records the schema state of one database snapshot in the archive.
+type Database struct {
+	Name       string   `json:"name"`
+	File       string   `json:"file"`
+	Migrations []string `json:"migrations"`
+}
+
+// File records the size and SHA-256 checksum of one archive entry.
+type File struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
@@ -0,0 +1,27 @@
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/stretchr/testify/mock"
+
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+// BackupExporter is a testify mock for the archive.BackupExporter interface.
+// The first return value, when a string, is written to w.
+type BackupExporter struct {
+	mock.Mock
+}
+
+// ExportJSON mocks BackupExporter.ExportJSON.
+func (m *BackupExporter) ExportJSON(ctx context.Context, snapshots []domainbackup.Snapshot, w io.Writer) error {
+	args := m.Called(ctx, snapshots, w)
+	if body, ok := args.Get(0).(string); ok {
+		if _, err := io.WriteString(w, body); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
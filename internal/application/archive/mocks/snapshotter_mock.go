@@ -0,0 +1,21 @@
+// Package mocks contains testify mock implementations for the archive use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+// Snapshotter is a testify mock for the archive.Snapshotter interface.
+type Snapshotter struct {
+	mock.Mock
+}
+
+// Snapshot mocks Snapshotter.Snapshot.
+func (m *Snapshotter) Snapshot(ctx context.Context, dir string) ([]domainbackup.Snapshot, error) {
+	args := m.Called(ctx, dir)
+	return args.Get(0).([]domainbackup.Snapshot), args.Error(1)
+}
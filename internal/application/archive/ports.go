@@ -0,0 +1,25 @@
+package archive
+
+import (
+	"context"
+	"io"
+	"time"
+
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+// Snapshotter is the port for taking consistent copies of the application databases.
+type Snapshotter interface {
+	Snapshot(ctx context.Context, dir string) ([]domainbackup.Snapshot, error)
+}
+
+// BackupExporter is the port for writing the JSON backup document of a set of
+// database snapshots, so it holds the same data as the snapshot files.
+type BackupExporter interface {
+	ExportJSON(ctx context.Context, snapshots []domainbackup.Snapshot, w io.Writer) error
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
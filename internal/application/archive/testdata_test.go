@@ -0,0 +1,103 @@
+package archive_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/archive/mocks"
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+const (
+	testVersion = "9.9.9"
+	backupBody  = `{"accounts": []}`
+)
+
+// databaseNames are the databases every archive must contain.
+var databaseNames = []string{"accounts.db", "categories.db", "settings.db", "transactions.db"}
+
+// fakeDatabase is the content of every snapshot written by writeSnapshots.
+var fakeDatabase = append([]byte("SQLite format 3\x00"), make([]byte, 84)...)
+
+// writeSnapshots writes a fake database file for each name into a temp dir
+// and returns the matching snapshots.
+func writeSnapshots(t *testing.T, names ...string) []domainbackup.Snapshot {
+	t.Helper()
+	dir := t.TempDir()
+	snapshots := make([]domainbackup.Snapshot, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, fakeDatabase, 0o600))
+		snapshots = append(snapshots, domainbackup.Snapshot{Name: name, Path: path, Migrations: []string{"001_init"}})
+	}
+	return snapshots
+}
+
+// buildMockSnapshotter creates a mocks.Snapshotter returning the given result once.
+func buildMockSnapshotter(snapshots []domainbackup.Snapshot, err error) *mocks.Snapshotter {
+	m := &mocks.Snapshotter{}
+	m.On("Snapshot", mock.Anything, mock.Anything).Return(snapshots, err).Once()
+	return m
+}
+
+// buildMockBackupExporter creates a mocks.BackupExporter writing body and
+// returning err once, for any snapshots.
+func buildMockBackupExporter(body string, err error) *mocks.BackupExporter {
+	m := &mocks.BackupExporter{}
+	m.On("ExportJSON", mock.Anything, mock.Anything, mock.Anything).Return(body, err).Once()
+	return m
+}
+
+// buildMockClock creates a mocks.Clock pre-configured to return fixedTime.
+func buildMockClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(fixedTime())
+	return m
+}
+
+func fixedTime() time.Time {
+	return time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+}
+
+// rewriteArchive copies the archive in src, letting edit replace or drop
+// entries (by returning nil), and appends the entries in extra.
+func rewriteArchive(t *testing.T, src []byte, edit func(name string, data []byte) []byte, extra map[string][]byte) []byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(src), int64(len(src)))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		var data bytes.Buffer
+		_, err = data.ReadFrom(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		out := edit(f.Name, data.Bytes())
+		if out == nil {
+			continue
+		}
+		w, err := zw.Create(f.Name)
+		require.NoError(t, err)
+		_, err = w.Write(out)
+		require.NoError(t, err)
+	}
+	for name, data := range extra {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
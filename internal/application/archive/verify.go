@@ -0,0 +1,140 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+// sqliteHeader is the magic string at the start of every SQLite database file.
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// Verify checks the integrity of the archive read from r before it is
+// restored. Every file listed in the manifest must be present with the
+// recorded size and SHA-256 checksum, no unlisted files may be present, every
+// application database must be included and every database entry must be a
+// SQLite file. Errors wrap domainbackup.ErrInvalidArchive.
+func Verify(r io.ReaderAt, size int64) (Manifest, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("%w: %v", domainbackup.ErrInvalidArchive, err)
+	}
+
+	entries := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		if _, dup := entries[f.Name]; dup {
+			return Manifest{}, fmt.Errorf("%w: duplicate entry %s", domainbackup.ErrInvalidArchive, f.Name)
+		}
+		entries[f.Name] = f
+	}
+
+	manifest, err := readManifest(entries[manifestName])
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	listed := map[string]bool{manifestName: true}
+	for _, file := range manifest.Files {
+		entry, ok := entries[file.Name]
+		if !ok {
+			return Manifest{}, fmt.Errorf("%w: missing %s", domainbackup.ErrInvalidArchive, file.Name)
+		}
+		if err := verifyEntry(entry, file); err != nil {
+			return Manifest{}, err
+		}
+		listed[file.Name] = true
+	}
+
+	for name := range entries {
+		if !listed[name] {
+			return Manifest{}, fmt.Errorf("%w: unexpected entry %s", domainbackup.ErrInvalidArchive, name)
+		}
+	}
+
+	included := make(map[string]bool, len(manifest.Databases))
+	for _, db := range manifest.Databases {
+		included[db.Name] = true
+	}
+	for _, name := range requiredDatabases {
+		if !included[name] {
+			return Manifest{}, fmt.Errorf("%w: missing database %s", domainbackup.ErrInvalidArchive, name)
+		}
+	}
+
+	for _, db := range manifest.Databases {
+		if !listed[db.File] || db.File == manifestName {
+			return Manifest{}, fmt.Errorf("%w: database %s has no checksummed file", domainbackup.ErrInvalidArchive, db.Name)
+		}
+		if err := verifySQLiteHeader(entries[db.File]); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// readManifest decodes manifest.json and checks its format version.
+func readManifest(entry *zip.File) (Manifest, error) {
+	if entry == nil {
+		return Manifest{}, fmt.Errorf("%w: missing %s", domainbackup.ErrInvalidArchive, manifestName)
+	}
+
+	rc, err := entry.Open()
+	if err != nil {
+		return Manifest{}, fmt.Errorf("%w: open %s: %v", domainbackup.ErrInvalidArchive, manifestName, err)
+	}
+	defer rc.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(rc).Decode(&manifest); err != nil {
+		return Manifest{}, fmt.Errorf("%w: decode %s: %v", domainbackup.ErrInvalidArchive, manifestName, err)
+	}
+	if manifest.FormatVersion != FormatVersion {
+		return Manifest{}, fmt.Errorf("%w: unsupported format version %d", domainbackup.ErrInvalidArchive, manifest.FormatVersion)
+	}
+	return manifest, nil
+}
+
+// verifyEntry compares the size and checksum of entry with those recorded in file.
+func verifyEntry(entry *zip.File, file File) error {
+	rc, err := entry.Open()
+	if err != nil {
+		return fmt.Errorf("%w: open %s: %v", domainbackup.ErrInvalidArchive, file.Name, err)
+	}
+	defer rc.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, rc)
+	if err != nil {
+		return fmt.Errorf("%w: read %s: %v", domainbackup.ErrInvalidArchive, file.Name, err)
+	}
+	if n != file.Size {
+		return fmt.Errorf("%w: %s is %d bytes, manifest records %d", domainbackup.ErrInvalidArchive, file.Name, n, file.Size)
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(sum, file.SHA256) {
+		return fmt.Errorf("%w: %s checksum mismatch", domainbackup.ErrInvalidArchive, file.Name)
+	}
+	return nil
+}
+
+// verifySQLiteHeader checks that entry starts with the SQLite magic string.
+func verifySQLiteHeader(entry *zip.File) error {
+	rc, err := entry.Open()
+	if err != nil {
+		return fmt.Errorf("%w: open %s: %v", domainbackup.ErrInvalidArchive, entry.Name, err)
+	}
+	defer rc.Close()
+
+	header := make([]byte, len(sqliteHeader))
+	if _, err := io.ReadFull(rc, header); err != nil || !bytes.Equal(header, sqliteHeader) {
+		return fmt.Errorf("%w: %s is not a SQLite database", domainbackup.ErrInvalidArchive, entry.Name)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	"io"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Encrypter is a testify mock for the backup.Encrypter interface. The first
+// return value is a func(io.Writer) io.WriteCloser that wraps the destination.
+type Encrypter struct {
+	mock.Mock
+}
+
+// Encrypt mocks Encrypter.Encrypt.
+func (m *Encrypter) Encrypt(w io.Writer, passphrase string) (io.WriteCloser, error) {
+	args := m.Called(w, passphrase)
+	if fn, ok := args.Get(0).(func(io.Writer) io.WriteCloser); ok {
+		return fn(w), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
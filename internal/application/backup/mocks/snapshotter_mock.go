@@ -0,0 +1,26 @@
+// Package mocks contains testify mock implementations for the backup use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+// Snapshotter is a testify mock for the backup.Snapshotter interface. The
+// first return value may be a func(dir string) []domainbackup.Snapshot, so a
+// test can write files into the directory it is given.
+type Snapshotter struct {
+	mock.Mock
+}
+
+// Snapshot mocks Snapshotter.Snapshot.
+func (m *Snapshotter) Snapshot(ctx context.Context, dir string) ([]domainbackup.Snapshot, error) {
+	args := m.Called(ctx, dir)
+	if fn, ok := args.Get(0).(func(string) []domainbackup.Snapshot); ok {
+		return fn(dir), args.Error(1)
+	}
+	return args.Get(0).([]domainbackup.Snapshot), args.Error(1)
+}
@@ -0,0 +1,24 @@
+package backup
+
+import (
+	"context"
+	"io"
+	"time"
+
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+// Snapshotter is the port for taking consistent copies of the application databases.
+type Snapshotter interface {
+	Snapshot(ctx context.Context, dir string) ([]domainbackup.Snapshot, error)
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
+
+// Encrypter is the port for passphrase-based stream encryption.
+type Encrypter interface {
+	Encrypt(w io.Writer, passphrase string) (io.WriteCloser, error)
+}
@@ -0,0 +1,280 @@
+// Package backup runs scheduled database backups and prunes old ones
+// according to a retention policy.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+const (
+	// dirPrefix starts the name of every backup directory managed by Scheduler.
+	dirPrefix = "backup-"
+	// stampLayout is the UTC timestamp that follows dirPrefix.
+	stampLayout = "20060102T150405Z"
+	// stagingSuffix marks a backup that is still being written.
+	stagingSuffix = ".tmp"
+	// encryptedSuffix is appended to database files encrypted with a passphrase.
+	encryptedSuffix = ".enc"
+)
+
+// Policy configures where, how often and how securely backups are taken.
+type Policy struct {
+	Dir       string
+	Interval  time.Duration
+	Retention domainbackup.Retention
+	// Passphrase, when set, encrypts every database file in a backup.
+	Passphrase string
+}
+
+// Scheduler takes a snapshot of every database at a fixed interval into its
+// own directory under the policy's Dir, then removes the backups the
+// retention policy no longer keeps.
+type Scheduler struct {
+	snapshotter Snapshotter
+	encrypter   Encrypter
+	clock       Clock
+	dir         string
+	interval    time.Duration
+	retention   domainbackup.Retention
+	passphrase  string
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+// NewScheduler creates a Scheduler. An interval of zero or less disables
+// scheduled backups; RunOnce still works. encrypter is only used when the
+// policy has a passphrase.
+func NewScheduler(snapshotter Snapshotter, encrypter Encrypter, clock Clock, policy Policy) *Scheduler {
+	return &Scheduler{
+		snapshotter: snapshotter,
+		encrypter:   encrypter,
+		clock:       clock,
+		dir:         policy.Dir,
+		interval:    policy.Interval,
+		retention:   policy.Retention,
+		passphrase:  policy.Passphrase,
+	}
+}
+
+// Run backs up on every interval until ctx is canceled. The time of the last
+// successful backup is first recovered from the backups already in dir: when
+// it is a full interval old, or there is none, a backup is taken at once,
+// otherwise the first one is due an interval after it. Failures are logged
+// and retried an interval later.
+func (s *Scheduler) Run(ctx context.Context) {
+	if s.interval <= 0 {
+		return
+	}
+
+	if existing, err := s.list(); err != nil {
+		log.Printf("backup: list %s: %v", s.dir, err)
+	} else if len(existing) > 0 {
+		s.setLast(existing[0])
+	}
+
+	wait := s.interval - s.clock.Now().Sub(s.LastSuccess())
+	if wait <= 0 {
+		s.runLogged(ctx)
+		wait = s.interval
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			s.runLogged(ctx)
+			timer.Reset(s.interval)
+		}
+	}
+}
+
+// runLogged runs RunOnce and logs its error.
+func (s *Scheduler) runLogged(ctx context.Context) {
+	if _, err := s.RunOnce(ctx); err != nil {
+		log.Printf("backup: %v", err)
+	}
+}
+
+// RunOnce takes one backup and prunes expired ones, returning the directory
+// the backup was written to. The snapshot is staged under a temporary name
+// and renamed into place, so dir never holds a partial backup.
+func (s *Scheduler) RunOnce(ctx context.Context) (string, error) {
+	now := s.clock.Now().UTC()
+	name := dirPrefix + now.Format(stampLayout)
+	final := filepath.Join(s.dir, name)
+	staging := final + stagingSuffix
+
+	if err := os.MkdirAll(staging, 0o755); err != nil {
+		return "", fmt.Errorf("create %s: %w", staging, err)
+	}
+	snapshots, err := s.snapshotter.Snapshot(ctx, staging)
+	if err != nil {
+		_ = os.RemoveAll(staging)
+		return "", fmt.Errorf("snapshot databases: %w", err)
+	}
+	if s.passphrase != "" {
+		for _, snap := range snapshots {
+			if err := s.encrypt(snap.Path); err != nil {
+				_ = os.RemoveAll(staging)
+				return "", fmt.Errorf("encrypt %s: %w", snap.Name, err)
+			}
+		}
+	}
+	if err := os.Rename(staging, final); err != nil {
+		_ = os.RemoveAll(staging)
+		return "", fmt.Errorf("publish %s: %w", final, err)
+	}
+	s.setLast(now)
+
+	if err := s.prune(); err != nil {
+		return final, fmt.Errorf("prune backups: %w", err)
+	}
+	return final, nil
+}
+
+// encrypt replaces the file at path with an encrypted copy named path.enc.
+func (s *Scheduler) encrypt(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+encryptedSuffix, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	w, err := s.encrypter.Encrypt(dst, s.passphrase)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, src); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// LastSuccess returns the time of the last successful backup, or the zero
+// time if none is known.
+func (s *Scheduler) LastSuccess() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
+
+func (s *Scheduler) setLast(t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t.After(s.last) {
+		s.last = t
+	}
+}
+
+// prune removes every backup in dir that the retention policy does not keep.
+func (s *Scheduler) prune() error {
+	backups, err := s.list()
+	if err != nil {
+		return err
+	}
+	for _, t := range Expired(backups, s.retention) {
+		path := filepath.Join(s.dir, dirPrefix+t.Format(stampLayout))
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("remove %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// list returns the times of the completed backups in dir, newest first.
+// Entries that do not follow the backup naming scheme are ignored.
+func (s *Scheduler) list() ([]time.Time, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	backups := make([]time.Time, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() || !strings.HasPrefix(e.Name(), dirPrefix) {
+			continue
+		}
+		t, err := time.Parse(stampLayout, strings.TrimPrefix(e.Name(), dirPrefix))
+		if err != nil {
+			continue
+		}
+		backups = append(backups, t)
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].After(backups[j]) })
+	return backups, nil
+}
+
+// Expired returns the backups r does not keep, newest first. Days and months
+// are calendar periods in UTC.
+func Expired(backups []time.Time, r domainbackup.Retention) []time.Time {
+	if r.KeepLast <= 0 && r.KeepDaily <= 0 && r.KeepMonthly <= 0 {
+		return nil
+	}
+
+	sorted := append([]time.Time(nil), backups...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].After(sorted[j]) })
+
+	keep := make([]bool, len(sorted))
+	for i := 0; i < len(sorted) && i < r.KeepLast; i++ {
+		keep[i] = true
+	}
+	keepNewestPerPeriod(sorted, keep, r.KeepDaily, "2006-01-02")
+	keepNewestPerPeriod(sorted, keep, r.KeepMonthly, "2006-01")
+
+	expired := make([]time.Time, 0)
+	for i, t := range sorted {
+		if !keep[i] {
+			expired = append(expired, t)
+		}
+	}
+	return expired
+}
+
+// keepNewestPerPeriod marks the newest backup of each of the n most recent
+// periods, where a period is identified by formatting with layout. sorted must
+// be newest first.
+func keepNewestPerPeriod(sorted []time.Time, keep []bool, n int, layout string) {
+	seen := make(map[string]bool)
+	for i, t := range sorted {
+		if len(seen) >= n {
+			return
+		}
+		period := t.UTC().Format(layout)
+		if seen[period] {
+			continue
+		}
+		seen[period] = true
+		keep[i] = true
+	}
+}
@@ -0,0 +1,208 @@
+package backup_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/backup"
+	"github.com/financial-manager/api/internal/application/backup/mocks"
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+func TestScheduler_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := day(2026, 3, 1, 10)
+	s := backup.NewScheduler(buildMockSnapshotter(nil), &mocks.Encrypter{}, buildMockClock(now), backup.Policy{Dir: dir, Interval: time.Hour})
+
+	path, err := s.RunOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "backup-20260301T100000Z"), path)
+	assert.FileExists(t, filepath.Join(path, "accounts.db"))
+	assert.Equal(t, now, s.LastSuccess())
+	assert.NoDirExists(t, path+".tmp")
+}
+
+func TestScheduler_RunOnce_SnapshotFailure(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	snapErr := errors.New("database is locked")
+	s := backup.NewScheduler(buildMockSnapshotter(snapErr), &mocks.Encrypter{}, buildMockClock(day(2026, 3, 1, 10)), backup.Policy{Dir: dir, Interval: time.Hour})
+
+	_, err := s.RunOnce(context.Background())
+
+	assert.ErrorIs(t, err, snapErr)
+	assert.True(t, s.LastSuccess().IsZero())
+	entries, readErr := os.ReadDir(dir)
+	require.NoError(t, readErr)
+	assert.Empty(t, entries, "failed backup must not leave a staging directory")
+}
+
+func TestScheduler_RunOnce_PrunesExpiredBackups(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	times := []time.Time{day(2026, 3, 1, 10), day(2026, 3, 1, 11), day(2026, 3, 1, 12)}
+	s := backup.NewScheduler(buildMockSnapshotter(nil), &mocks.Encrypter{}, buildMockClock(times...), backup.Policy{Dir: dir, Interval: time.Hour, Retention: domainbackup.Retention{KeepLast: 2}})
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "unrelated"), 0o755))
+
+	for range times {
+		_, err := s.RunOnce(context.Background())
+		require.NoError(t, err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.Equal(t, []string{"backup-20260301T110000Z", "backup-20260301T120000Z", "unrelated"}, names)
+}
+
+func TestScheduler_RunOnce_EncryptsWithPassphrase(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	enc := buildMockEncrypter("correct horse battery")
+	policy := backup.Policy{Dir: dir, Interval: time.Hour, Passphrase: "correct horse battery"}
+	s := backup.NewScheduler(buildMockSnapshotter(nil), enc, buildMockClock(day(2026, 3, 1, 10)), policy)
+
+	path, err := s.RunOnce(context.Background())
+
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(path, "accounts.db"))
+	data, err := os.ReadFile(filepath.Join(path, "accounts.db.enc"))
+	require.NoError(t, err)
+	assert.Equal(t, "sealed:db", string(data))
+	enc.AssertExpectations(t)
+}
+
+func TestScheduler_Run_RecoversLastSuccessFromDisk(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "backup-20260301T100000Z"), 0o755))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "backup-20260302T100000Z"), 0o755))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "backup-20260303T100000Z.tmp"), 0o755))
+	snapshotter := buildMockSnapshotter(nil)
+	now := day(2026, 3, 2, 10).Add(30 * time.Minute)
+	s := backup.NewScheduler(snapshotter, &mocks.Encrypter{}, buildMockClock(now), backup.Policy{Dir: dir, Interval: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.Run(ctx)
+
+	assert.Equal(t, day(2026, 3, 2, 10), s.LastSuccess())
+	snapshotter.AssertNotCalled(t, "Snapshot", mock.Anything, mock.Anything)
+}
+
+func TestScheduler_Run_BacksUpAtOnceWhenOverdue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		existing []string
+	}{
+		{name: "last backup is older than the interval", existing: []string{"backup-20260301T100000Z"}},
+		{name: "no backup yet"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			for _, name := range tc.existing {
+				require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0o755))
+			}
+			now := day(2026, 3, 2, 10)
+			s := backup.NewScheduler(buildMockSnapshotter(nil), &mocks.Encrypter{}, buildMockClock(now, now), backup.Policy{Dir: dir, Interval: time.Hour})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			s.Run(ctx)
+
+			assert.Equal(t, now, s.LastSuccess())
+			assert.DirExists(t, filepath.Join(dir, "backup-20260302T100000Z"))
+		})
+	}
+}
+
+func TestScheduler_Run_DisabledWithoutInterval(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "backup-20260301T100000Z"), 0o755))
+	s := backup.NewScheduler(buildMockSnapshotter(nil), &mocks.Encrypter{}, buildMockClock(), backup.Policy{Dir: dir, Interval: 0})
+
+	s.Run(context.Background())
+
+	assert.True(t, s.LastSuccess().IsZero())
+}
+
+func TestExpired(t *testing.T) {
+	t.Parallel()
+
+	backups := []time.Time{
+		day(2026, 1, 15, 8),
+		day(2026, 2, 10, 8),
+		day(2026, 2, 28, 8),
+		day(2026, 3, 1, 8),
+		day(2026, 3, 1, 20),
+		day(2026, 3, 2, 8),
+	}
+
+	tests := []struct {
+		name      string
+		retention domainbackup.Retention
+		want      []time.Time
+	}{
+		{
+			name:      "no rules keeps everything",
+			retention: domainbackup.Retention{},
+			want:      nil,
+		},
+		{
+			name:      "keep last",
+			retention: domainbackup.Retention{KeepLast: 2},
+			want:      []time.Time{day(2026, 3, 1, 8), day(2026, 2, 28, 8), day(2026, 2, 10, 8), day(2026, 1, 15, 8)},
+		},
+		{
+			name:      "keep daily keeps the newest backup of each day",
+			retention: domainbackup.Retention{KeepDaily: 3},
+			want:      []time.Time{day(2026, 3, 1, 8), day(2026, 2, 10, 8), day(2026, 1, 15, 8)},
+		},
+		{
+			name:      "keep monthly keeps the newest backup of each month",
+			retention: domainbackup.Retention{KeepMonthly: 2},
+			want:      []time.Time{day(2026, 3, 1, 20), day(2026, 3, 1, 8), day(2026, 2, 10, 8), day(2026, 1, 15, 8)},
+		},
+		{
+			name:      "rules combine",
+			retention: domainbackup.Retention{KeepLast: 1, KeepDaily: 2, KeepMonthly: 3},
+			want:      []time.Time{day(2026, 3, 1, 8), day(2026, 2, 10, 8)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := backup.Expired(backups, tc.retention)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
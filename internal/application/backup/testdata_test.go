@@ -0,0 +1,70 @@
+package backup_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/backup/mocks"
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
+)
+
+// buildMockSnapshotter creates a mocks.Snapshotter that writes a file named
+// accounts.db containing "db" into the requested directory and returns err.
+func buildMockSnapshotter(err error) *mocks.Snapshotter {
+	m := &mocks.Snapshotter{}
+	snapshot := func(dir string) []domainbackup.Snapshot {
+		if err != nil {
+			return nil
+		}
+		path := filepath.Join(dir, "accounts.db")
+		_ = os.WriteFile(path, []byte("db"), 0o600)
+		return []domainbackup.Snapshot{{Name: "accounts.db", Path: path}}
+	}
+	m.On("Snapshot", mock.Anything, mock.Anything).Return(snapshot, err)
+	return m
+}
+
+// buildMockEncrypter creates a mocks.Encrypter expecting passphrase whose
+// writers prefix the data with "sealed:".
+func buildMockEncrypter(passphrase string) *mocks.Encrypter {
+	m := &mocks.Encrypter{}
+	seal := func(w io.Writer) io.WriteCloser { return &sealer{w: w} }
+	m.On("Encrypt", mock.Anything, passphrase).Return(seal, nil)
+	return m
+}
+
+// sealer writes "sealed:" before the first byte it is given.
+type sealer struct {
+	w       io.Writer
+	started bool
+}
+
+func (s *sealer) Write(p []byte) (int, error) {
+	if !s.started {
+		s.started = true
+		if _, err := io.WriteString(s.w, "sealed:"); err != nil {
+			return 0, err
+		}
+	}
+	return s.w.Write(p)
+}
+
+func (s *sealer) Close() error { return nil }
+
+// buildMockClock creates a mocks.Clock that returns each of times in turn.
+func buildMockClock(times ...time.Time) *mocks.Clock {
+	m := &mocks.Clock{}
+	for _, t := range times {
+		m.On("Now").Return(t).Once()
+	}
+	return m
+}
+
+// day returns midnight UTC plus hour hours on the given date.
+func day(year int, month time.Month, d, hour int) time.Time {
+	return time.Date(year, month, d, hour, 0, 0, 0, time.UTC)
+}
@@ -6,11 +6,21 @@ import (
 	"fmt"
 
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
-// Input carries optional filters for listing categories.
+// Input carries optional filters for listing categories and the page to
+// return; categories are sorted by creation, oldest first, by default.
 type Input struct {
 	Type *string // nil = all types, "expense" or "income"
+	Page domainshared.PageQuery
+}
+
+// Output is one page of categories and the cursor of the next one, empty on
+// the last page.
+type Output struct {
+	Categories []domaincategory.Category
+	NextCursor string
 }
 
 // UseCase implements the list categories use case (US-CAT-001, US-CAT-006).
@@ -23,21 +33,28 @@ func New(repo Repository) *UseCase {
 	return &UseCase{repo: repo}
 }
 
-// Execute retrieves categories filtered by type (if specified).
-func (uc *UseCase) Execute(ctx context.Context, in Input) ([]domaincategory.Category, error) {
+// Execute retrieves a page of categories filtered by type (if specified). An
+// unknown type returns an error wrapping domaincategory.ErrInvalidType and an
+// invalid page one wrapping domainshared.ErrInvalidPage.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
 	var catType *domaincategory.Type
 	if in.Type != nil {
 		t := domaincategory.Type(*in.Type)
 		if t != domaincategory.TypeExpense && t != domaincategory.TypeIncome {
-			return nil, fmt.Errorf("invalid category type %q: must be expense or income", *in.Type)
+			return Output{}, fmt.Errorf("list categories: %q: %w", *in.Type, domaincategory.ErrInvalidType)
 		}
 		catType = &t
 	}
 
-	categories, err := uc.repo.List(ctx, catType)
+	page, err := in.Page.Normalize(domaincategory.SortCreatedAt, domainshared.SortAsc, domaincategory.SortFields()...)
+	if err != nil {
+		return Output{}, fmt.Errorf("list categories: %w", err)
+	}
+
+	categories, next, err := uc.repo.ListPage(ctx, catType, page)
 	if err != nil {
-		return nil, fmt.Errorf("list categories: %w", err)
+		return Output{}, fmt.Errorf("list categories: %w", err)
 	}
 
-	return categories, nil
+	return Output{Categories: categories, NextCursor: next}, nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/financial-manager/api/internal/application/category/list"
 	"github.com/financial-manager/api/internal/application/category/list/mocks"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 func TestUseCase_Execute(t *testing.T) {
@@ -21,19 +22,20 @@ func TestUseCase_Execute(t *testing.T) {
 	invalidType := "invalid"
 
 	categories := buildCategories()
+	byName := domainshared.PageQuery{Limit: 1, Cursor: "abc", Sort: domaincategory.SortName, Direction: domainshared.SortDesc}
 
 	tests := []struct {
 		name    string
 		repo    *mocks.Repository
 		input   list.Input
 		wantErr error
-		wantOut []domaincategory.Category
+		wantOut list.Output
 	}{
 		{
 			name:    "list all categories returns all active categories",
 			repo:    buildMockRepo(nil, categories, nil),
 			input:   list.Input{},
-			wantOut: categories,
+			wantOut: list.Output{Categories: categories},
 		},
 		{
 			name: "list expense categories returns only expense categories",
@@ -42,7 +44,7 @@ func TestUseCase_Execute(t *testing.T) {
 				return &t
 			}(), []domaincategory.Category{categories[0]}, nil),
 			input:   list.Input{Type: &expenseType},
-			wantOut: []domaincategory.Category{categories[0]},
+			wantOut: list.Output{Categories: []domaincategory.Category{categories[0]}},
 		},
 		{
 			name: "list income categories returns only income categories",
@@ -51,13 +53,13 @@ func TestUseCase_Execute(t *testing.T) {
 				return &t
 			}(), []domaincategory.Category{categories[1]}, nil),
 			input:   list.Input{Type: &incomeType},
-			wantOut: []domaincategory.Category{categories[1]},
+			wantOut: list.Output{Categories: []domaincategory.Category{categories[1]}},
 		},
 		{
 			name:    "invalid category type returns error",
 			repo:    &mocks.Repository{},
 			input:   list.Input{Type: &invalidType},
-			wantErr: fmt.Errorf(`invalid category type "invalid": must be expense or income`),
+			wantErr: fmt.Errorf(`list categories: "invalid": %w`, domaincategory.ErrInvalidType),
 		},
 		{
 			name:    "repository error is wrapped and propagated",
@@ -65,11 +67,17 @@ func TestUseCase_Execute(t *testing.T) {
 			input:   list.Input{},
 			wantErr: fmt.Errorf("list categories: %w", errors.New("db error")),
 		},
+		{
+			name:    "requested page is passed on with the next cursor",
+			repo:    buildMockRepoPage(nil, byName, []domaincategory.Category{categories[0]}, "def", nil),
+			input:   list.Input{Page: byName},
+			wantOut: list.Output{Categories: []domaincategory.Category{categories[0]}, NextCursor: "def"},
+		},
 		{
 			name:    "empty list returns empty slice",
 			repo:    buildMockRepo(nil, []domaincategory.Category{}, nil),
 			input:   list.Input{},
-			wantOut: []domaincategory.Category{},
+			wantOut: list.Output{Categories: []domaincategory.Category{}},
 		},
 	}
 
@@ -86,3 +94,15 @@ func TestUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCase_Execute_InvalidPage(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.Repository{}
+	uc := list.New(repo)
+
+	_, err := uc.Execute(context.Background(), list.Input{Page: domainshared.PageQuery{Sort: "color"}})
+
+	assert.ErrorIs(t, err, domainshared.ErrInvalidPage)
+	repo.AssertNotCalled(t, "ListPage")
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 // Repository is a testify mock for the list.Repository interface.
@@ -14,8 +15,9 @@ type Repository struct {
 	mock.Mock
 }
 
-// List mocks Repository.List.
-func (m *Repository) List(ctx context.Context, categoryType *domaincategory.Type) ([]domaincategory.Category, error) {
-	args := m.Called(ctx, categoryType)
-	return args.Get(0).([]domaincategory.Category), args.Error(1)
+// ListPage mocks Repository.ListPage.
+func (m *Repository) ListPage(ctx context.Context, categoryType *domaincategory.Type, page domainshared.PageQuery) ([]domaincategory.Category, string, error) {
+	args := m.Called(ctx, categoryType, page)
+	categories, _ := args.Get(0).([]domaincategory.Category)
+	return categories, args.String(1), args.Error(2)
 }
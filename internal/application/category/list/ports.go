@@ -4,9 +4,10 @@ import (
 	"context"
 
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
 // Repository is the narrow read port required by this use case.
 type Repository interface {
-	List(ctx context.Context, categoryType *domaincategory.Type) ([]domaincategory.Category, error)
+	ListPage(ctx context.Context, categoryType *domaincategory.Type, page domainshared.PageQuery) ([]domaincategory.Category, string, error)
 }
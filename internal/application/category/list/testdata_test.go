@@ -5,12 +5,27 @@ import (
 
 	"github.com/financial-manager/api/internal/application/category/list/mocks"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 )
 
-// buildMockRepo creates a mocks.Repository pre-configured for one List call.
+// defaultPage is the page the use case asks for when the input sets none.
+var defaultPage = domainshared.PageQuery{
+	Limit:     domainshared.DefaultPageLimit,
+	Sort:      domaincategory.SortCreatedAt,
+	Direction: domainshared.SortAsc,
+}
+
+// buildMockRepo creates a mocks.Repository pre-configured for one ListPage
+// call with the default page.
 func buildMockRepo(categoryType *domaincategory.Type, categories []domaincategory.Category, err error) *mocks.Repository {
+	return buildMockRepoPage(categoryType, defaultPage, categories, "", err)
+}
+
+// buildMockRepoPage creates a mocks.Repository pre-configured for one ListPage
+// call with page.
+func buildMockRepoPage(categoryType *domaincategory.Type, page domainshared.PageQuery, categories []domaincategory.Category, next string, err error) *mocks.Repository {
 	m := &mocks.Repository{}
-	m.On("List", mock.Anything, categoryType).Return(categories, err).Once()
+	m.On("ListPage", mock.Anything, categoryType, page).Return(categories, next, err).Once()
 	return m
 }
 
@@ -4,10 +4,14 @@ package dashboard
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
+	"github.com/financial-manager/api/internal/application/report/period"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -15,31 +19,96 @@ import (
 type Repository interface {
 	ListAccounts(ctx context.Context) ([]domainaccount.Account, error)
 	ListRecentTransactions(ctx context.Context, limit int) ([]domaintransaction.Transaction, error)
-	ListExpenseTransactions(ctx context.Context, accountID, categoryID, startDate, endDate string) ([]domaintransaction.Transaction, error)
-	ListIncomeTransactions(ctx context.Context, accountID, categoryID, startDate, endDate string) ([]domaintransaction.Transaction, error)
 	ListCategories(ctx context.Context) ([]domaincategory.Category, error)
 }
 
+// Totals is the port for the transaction totals of a date range.
+type Totals interface {
+	Totals(ctx context.Context, q domaintransaction.TotalsQuery) ([]domaintransaction.Total, error)
+}
+
+// Projector is the port for the projected end-of-month balance.
+type Projector interface {
+	ProjectedMonthEndBalance(ctx context.Context) (float64, error)
+}
+
+// Timezone is the port for the user's timezone, which decides what "today" is.
+type Timezone interface {
+	Execute(ctx context.Context) (*time.Location, error)
+}
+
+// MonthStart is the port for the day of the month months start on.
+type MonthStart interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Clock provides the current time.
+type Clock interface {
+	Now() time.Time
+}
+
 // UseCase implements the dashboard use case.
 type UseCase struct {
-	repo Repository
+	repo       Repository
+	totals     Totals
+	projector  Projector
+	timezone   Timezone
+	monthStart MonthStart
+	clock      Clock
+}
+
+// Input selects the dashboard period: either a Period containing today
+// ("week", "month", "quarter" or "year"; the month by default) or an
+// inclusive From/To range of YYYY-MM-DD dates, where To defaults to today.
+// Months, quarters and years start on the configured month start day.
+type Input struct {
+	Period string
+	From   string
+	To     string
 }
 
 // Output represents the dashboard response.
 type Output struct {
-	GlobalBalance      float64             `json:"global_balance"`
-	MonthlySummary     MonthlySummary      `json:"monthly_summary"`
-	ExpensesByCategory []ExpenseByCategory `json:"expenses_by_category"`
-	RecentTransactions []RecentTransaction `json:"recent_transactions"`
+	GlobalBalance            float64             `json:"global_balance"`
+	ProjectedMonthEndBalance float64             `json:"projected_month_end_balance"`
+	Period                   Range               `json:"period"`
+	MonthlySummary           MonthlySummary      `json:"monthly_summary"`
+	Comparison               Comparison          `json:"comparison"`
+	ExpensesByCategory       []ExpenseByCategory `json:"expenses_by_category"`
+	RecentTransactions       []RecentTransaction `json:"recent_transactions"`
 }
 
-// MonthlySummary represents the financial summary for the current month.
+// Range is an inclusive range of YYYY-MM-DD dates. Label names it when it is
+// a whole period, for example "Mar 2026" or "25 Jan – 24 Feb".
+type Range struct {
+	Label string `json:"label,omitempty"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// MonthlySummary represents the financial summary for the selected period,
+// the current month by default.
 type MonthlySummary struct {
 	TotalIncome  float64 `json:"total_income"`
 	TotalExpense float64 `json:"total_expense"`
 	NetBalance   float64 `json:"net_balance"`
 }
 
+// Comparison is the summary of the previous period, or of the equally long
+// range before From, and the change to the selected one. Percentages are nil
+// when the previous total is zero.
+type Comparison struct {
+	Period               Range    `json:"period"`
+	TotalIncome          float64  `json:"total_income"`
+	TotalExpense         float64  `json:"total_expense"`
+	NetBalance           float64  `json:"net_balance"`
+	IncomeChange         float64  `json:"income_change"`
+	ExpenseChange        float64  `json:"expense_change"`
+	NetBalanceChange     float64  `json:"net_balance_change"`
+	IncomeChangePercent  *float64 `json:"income_change_percent"`
+	ExpenseChangePercent *float64 `json:"expense_change_percent"`
+}
+
 // ExpenseByCategory represents the expense breakdown by category.
 type ExpenseByCategory struct {
 	CategoryID   string  `json:"category_id"`
@@ -59,12 +128,29 @@ type RecentTransaction struct {
 }
 
 // New creates a new Dashboard UseCase.
-func New(repo Repository) *UseCase {
-	return &UseCase{repo: repo}
+func New(repo Repository, totals Totals, projector Projector, timezone Timezone, monthStart MonthStart, clock Clock) *UseCase {
+	return &UseCase{repo: repo, totals: totals, projector: projector, timezone: timezone, monthStart: monthStart, clock: clock}
 }
 
-// Execute retrieves the dashboard data.
-func (uc *UseCase) Execute(ctx context.Context) (Output, error) {
+// Execute retrieves the dashboard data for the selected period.
+// Invalid input returns an error wrapping domainreport.ErrInvalidQuery.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
+	loc, err := uc.timezone.Execute(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("get dashboard: %w", err)
+	}
+	now := uc.clock.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monthStartDay, err := uc.monthStart.Execute(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("get dashboard: %w", err)
+	}
+
+	current, previous, err := resolvePeriods(in, today, monthStartDay)
+	if err != nil {
+		return Output{}, err
+	}
+
 	// Get global balance
 	accounts, err := uc.repo.ListAccounts(ctx)
 	if err != nil {
@@ -76,31 +162,26 @@ func (uc *UseCase) Execute(ctx context.Context) (Output, error) {
 		globalBalance += acc.CurrentBalance
 	}
 
-	// Get current month period
-	now := time.Now()
-	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-	endOfMonth := startOfMonth.AddDate(0, 1, -1)
-	startDateStr := startOfMonth.Format("2006-01-02")
-	endDateStr := endOfMonth.Format("2006-01-02")
-
-	// Get monthly summary
-	incomes, err := uc.repo.ListIncomeTransactions(ctx, "", "", startDateStr, endDateStr)
+	// Get period summary, broken down by category for the expenses
+	currentTotals, err := uc.totals.Totals(ctx, domaintransaction.TotalsQuery{
+		StartDate: current.From,
+		EndDate:   current.To,
+		GroupBy:   domaintransaction.TotalsByCategory,
+	})
 	if err != nil {
 		return Output{}, fmt.Errorf("get dashboard: %w", err)
 	}
+	totalIncome, totalExpense := sumByType(currentTotals)
 
-	expenses, err := uc.repo.ListExpenseTransactions(ctx, "", "", startDateStr, endDateStr)
+	// Get previous period summary
+	previousTotals, err := uc.totals.Totals(ctx, domaintransaction.TotalsQuery{
+		StartDate: previous.From,
+		EndDate:   previous.To,
+	})
 	if err != nil {
 		return Output{}, fmt.Errorf("get dashboard: %w", err)
 	}
-
-	var totalIncome, totalExpense float64
-	for _, tx := range incomes {
-		totalIncome += tx.Amount
-	}
-	for _, tx := range expenses {
-		totalExpense += tx.Amount
-	}
+	previousIncome, previousExpense := sumByType(previousTotals)
 
 	// Get expenses by category
 	categories, err := uc.repo.ListCategories(ctx)
@@ -114,8 +195,10 @@ func (uc *UseCase) Execute(ctx context.Context) (Output, error) {
 	}
 
 	expenseByCategory := make(map[string]float64)
-	for _, tx := range expenses {
-		expenseByCategory[tx.CategoryID] += tx.Amount
+	for _, t := range currentTotals {
+		if t.Type == domaintransaction.TransactionTypeExpense {
+			expenseByCategory[t.GroupID] += t.Amount
+		}
 	}
 
 	var expensesByCategory []ExpenseByCategory
@@ -135,6 +218,12 @@ func (uc *UseCase) Execute(ctx context.Context) (Output, error) {
 			Percentage:   percentage,
 		})
 	}
+	sort.Slice(expensesByCategory, func(i, j int) bool {
+		if expensesByCategory[i].Total != expensesByCategory[j].Total {
+			return expensesByCategory[i].Total > expensesByCategory[j].Total
+		}
+		return expensesByCategory[i].CategoryName < expensesByCategory[j].CategoryName
+	})
 
 	// Get recent transactions (last 10, mixed income and expense)
 	recentTxs, err := uc.repo.ListRecentTransactions(ctx, 10)
@@ -162,14 +251,106 @@ func (uc *UseCase) Execute(ctx context.Context) (Output, error) {
 		})
 	}
 
+	projected, err := uc.projector.ProjectedMonthEndBalance(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("get dashboard: %w", err)
+	}
+
 	return Output{
-		GlobalBalance: globalBalance,
+		GlobalBalance:            globalBalance,
+		ProjectedMonthEndBalance: projected,
+		Period:                   current,
 		MonthlySummary: MonthlySummary{
 			TotalIncome:  totalIncome,
 			TotalExpense: totalExpense,
 			NetBalance:   totalIncome - totalExpense,
 		},
+		Comparison: Comparison{
+			Period:               previous,
+			TotalIncome:          previousIncome,
+			TotalExpense:         previousExpense,
+			NetBalance:           previousIncome - previousExpense,
+			IncomeChange:         totalIncome - previousIncome,
+			ExpenseChange:        totalExpense - previousExpense,
+			NetBalanceChange:     (totalIncome - totalExpense) - (previousIncome - previousExpense),
+			IncomeChangePercent:  changePercent(totalIncome, previousIncome),
+			ExpenseChangePercent: changePercent(totalExpense, previousExpense),
+		},
 		ExpensesByCategory: expensesByCategory,
 		RecentTransactions: recentTransactions,
 	}, nil
 }
+
+// sumByType adds up the income and expense totals.
+func sumByType(totals []domaintransaction.Total) (income, expense float64) {
+	for _, t := range totals {
+		switch t.Type {
+		case domaintransaction.TransactionTypeIncome:
+			income += t.Amount
+		case domaintransaction.TransactionTypeExpense:
+			expense += t.Amount
+		}
+	}
+	return income, expense
+}
+
+// resolvePeriods returns the selected period and the one it is compared with.
+func resolvePeriods(in Input, today time.Time, monthStartDay int) (Range, Range, error) {
+	if in.From == "" && in.To == "" {
+		interval := domainreport.IntervalMonth
+		if in.Period != "" {
+			interval = domainreport.Interval(in.Period)
+		}
+		switch interval {
+		case domainreport.IntervalWeek, domainreport.IntervalMonth, domainreport.IntervalQuarter, domainreport.IntervalYear:
+		default:
+			return Range{}, Range{}, fmt.Errorf("%w: period must be 'week', 'month', 'quarter' or 'year'", domainreport.ErrInvalidQuery)
+		}
+
+		start := period.Start(today, interval, monthStartDay)
+		previous := period.Start(start.AddDate(0, 0, -1), interval, monthStartDay)
+		current, before := newRange(start, period.Next(start, interval).AddDate(0, 0, -1)), newRange(previous, start.AddDate(0, 0, -1))
+		current.Label = period.Label(start, interval, monthStartDay)
+		before.Label = period.Label(previous, interval, monthStartDay)
+		return current, before, nil
+	}
+
+	if in.Period != "" {
+		return Range{}, Range{}, fmt.Errorf("%w: use either period or from and to", domainreport.ErrInvalidQuery)
+	}
+	if in.From == "" {
+		return Range{}, Range{}, fmt.Errorf("%w: from is required with to", domainreport.ErrInvalidQuery)
+	}
+
+	from, err := time.Parse(period.DateLayout, in.From)
+	if err != nil {
+		return Range{}, Range{}, fmt.Errorf("%w: from must be YYYY-MM-DD", domainreport.ErrInvalidQuery)
+	}
+	to := today
+	if in.To != "" {
+		if to, err = time.Parse(period.DateLayout, in.To); err != nil {
+			return Range{}, Range{}, fmt.Errorf("%w: to must be YYYY-MM-DD", domainreport.ErrInvalidQuery)
+		}
+	}
+	if from.After(to) {
+		return Range{}, Range{}, fmt.Errorf("%w: from must not be after to", domainreport.ErrInvalidQuery)
+	}
+
+	days := int(to.Sub(from).Hours()/24) + 1
+	return newRange(from, to), newRange(from.AddDate(0, 0, -days), from.AddDate(0, 0, -1)), nil
+}
+
+// newRange formats the inclusive range [from, to].
+func newRange(from, to time.Time) Range {
+	return Range{From: from.Format(period.DateLayout), To: to.Format(period.DateLayout)}
+}
+
+// changePercent returns the change from previous to current as a percentage of
+// previous, rounded to two decimals, or nil when previous is zero.
+func changePercent(current, previous float64) *float64 {
+	if previous == 0 {
+		return nil
+	}
+	p := math.Round((current-previous)/math.Abs(previous)*10000) / 100
+	return &p
+}
@@ -10,11 +10,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/financial-manager/api/internal/application/dashboard"
 	"github.com/financial-manager/api/internal/application/dashboard/mocks"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -23,7 +25,7 @@ func TestUseCase_Execute(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		repo    *mocks.Repository
+		repo    repos
 		wantErr error
 		wantOut dashboard.Output
 	}{
@@ -125,14 +127,15 @@ func TestUseCase_Execute(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			uc := dashboard.New(tc.repo)
-			out, err := uc.Execute(context.Background())
+			uc := dashboard.New(tc.repo.repo, tc.repo.totals, buildProjector(1234.5, nil), buildTimezone(time.UTC, nil), buildMonthStart(1, nil), buildClock(fixedNow))
+			out, err := uc.Execute(context.Background(), dashboard.Input{})
 
 			if tc.wantErr != nil {
 				assert.Equal(t, tc.wantErr, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tc.wantOut.GlobalBalance, out.GlobalBalance)
+				assert.Equal(t, 1234.5, out.ProjectedMonthEndBalance)
 				assert.Equal(t, tc.wantOut.MonthlySummary, out.MonthlySummary)
 				assert.Equal(t, len(tc.wantOut.ExpensesByCategory), len(out.ExpensesByCategory))
 				for i, want := range tc.wantOut.ExpensesByCategory {
@@ -158,7 +161,7 @@ func TestUseCase_Execute_RecentTransactionsReturns10(t *testing.T) {
 
 	// Create 10 transactions - repo should limit them, use case just passes them through
 	transactions := make([]domaintransaction.Transaction, 10)
-	now := time.Now()
+	now := fixedNow
 	for i := 0; i < 10; i++ {
 		transactions[i] = domaintransaction.Transaction{
 			ID:       fmt.Sprintf("tx-%d", i+1),
@@ -172,13 +175,247 @@ func TestUseCase_Execute_RecentTransactionsReturns10(t *testing.T) {
 	repo := &mocks.Repository{}
 	repo.On("ListAccounts", mock.Anything).Return([]domainaccount.Account{}, nil).Once()
 	repo.On("ListRecentTransactions", mock.Anything, 10).Return(transactions, nil).Once()
-	repo.On("ListExpenseTransactions", mock.Anything, "", "", mock.Anything, mock.Anything).Return(nil, nil).Once()
-	repo.On("ListIncomeTransactions", mock.Anything, "", "", mock.Anything, mock.Anything).Return(nil, nil).Once()
 	repo.On("ListCategories", mock.Anything).Return(nil, nil).Once()
+	totals := &mocks.Totals{}
+	totals.On("Totals", mock.Anything, mock.Anything).Return(nil, nil).Twice()
 
-	uc := dashboard.New(repo)
-	out, err := uc.Execute(context.Background())
+	uc := dashboard.New(repo, totals, buildProjector(0, nil), buildTimezone(time.UTC, nil), buildMonthStart(1, nil), buildClock(fixedNow))
+	out, err := uc.Execute(context.Background(), dashboard.Input{})
 
 	assert.NoError(t, err)
 	assert.Len(t, out.RecentTransactions, 10)
 }
+
+func TestUseCase_Execute_ProjectorErrorIsPropagated(t *testing.T) {
+	t.Parallel()
+
+	repos := buildMockRepo(nil, nil, nil, nil, nil, nil)
+	uc := dashboard.New(repos.repo, repos.totals, buildProjector(0, errors.New("forecast error")), buildTimezone(time.UTC, nil), buildMonthStart(1, nil), buildClock(fixedNow))
+
+	out, err := uc.Execute(context.Background(), dashboard.Input{})
+
+	assert.Equal(t, fmt.Errorf("get dashboard: %w", errors.New("forecast error")), err)
+	assert.Empty(t, out)
+}
+
+func TestUseCase_Execute_Periods(t *testing.T) {
+	t.Parallel()
+
+	bogota, err := time.LoadLocation("America/Bogota")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		input         dashboard.Input
+		monthStartDay int
+		loc           *time.Location
+		now           time.Time
+		wantPeriod    dashboard.Range
+		wantPrevious  dashboard.Range
+	}{
+		{
+			name:         "default period is the current month",
+			loc:          time.UTC,
+			now:          fixedNow,
+			wantPeriod:   dashboard.Range{Label: "Mar 2026", From: "2026-03-01", To: "2026-03-31"},
+			wantPrevious: dashboard.Range{Label: "Feb 2026", From: "2026-02-01", To: "2026-02-28"},
+		},
+		{
+			name:         "month boundary follows the configured timezone",
+			loc:          bogota,
+			now:          time.Date(2026, 4, 1, 3, 0, 0, 0, time.UTC), // 22:00 on March 31 in Bogota
+			wantPeriod:   dashboard.Range{Label: "Mar 2026", From: "2026-03-01", To: "2026-03-31"},
+			wantPrevious: dashboard.Range{Label: "Feb 2026", From: "2026-02-01", To: "2026-02-28"},
+		},
+		{
+			name:         "week starts on Monday",
+			input:        dashboard.Input{Period: "week"},
+			loc:          time.UTC,
+			now:          fixedNow,
+			wantPeriod:   dashboard.Range{Label: "9 Mar – 15 Mar", From: "2026-03-09", To: "2026-03-15"},
+			wantPrevious: dashboard.Range{Label: "2 Mar – 8 Mar", From: "2026-03-02", To: "2026-03-08"},
+		},
+		{
+			name:         "quarter",
+			input:        dashboard.Input{Period: "quarter"},
+			loc:          time.UTC,
+			now:          fixedNow,
+			wantPeriod:   dashboard.Range{Label: "Q1 2026", From: "2026-01-01", To: "2026-03-31"},
+			wantPrevious: dashboard.Range{Label: "Q4 2025", From: "2025-10-01", To: "2025-12-31"},
+		},
+		{
+			name:         "year",
+			input:        dashboard.Input{Period: "year"},
+			loc:          time.UTC,
+			now:          fixedNow,
+			wantPeriod:   dashboard.Range{Label: "2026", From: "2026-01-01", To: "2026-12-31"},
+			wantPrevious: dashboard.Range{Label: "2025", From: "2025-01-01", To: "2025-12-31"},
+		},
+		{
+			name:          "month follows the configured start day",
+			monthStartDay: 25,
+			loc:           time.UTC,
+			now:           fixedNow,
+			wantPeriod:    dashboard.Range{Label: "25 Feb – 24 Mar", From: "2026-02-25", To: "2026-03-24"},
+			wantPrevious:  dashboard.Range{Label: "25 Jan – 24 Feb", From: "2026-01-25", To: "2026-02-24"},
+		},
+		{
+			name:          "start day opens a new month",
+			monthStartDay: 15,
+			loc:           time.UTC,
+			now:           fixedNow,
+			wantPeriod:    dashboard.Range{Label: "15 Mar – 14 Apr", From: "2026-03-15", To: "2026-04-14"},
+			wantPrevious:  dashboard.Range{Label: "15 Feb – 14 Mar", From: "2026-02-15", To: "2026-03-14"},
+		},
+		{
+			name:          "custom range ignores the start day",
+			input:         dashboard.Input{From: "2026-03-01", To: "2026-03-10"},
+			monthStartDay: 25,
+			loc:           time.UTC,
+			now:           fixedNow,
+			wantPeriod:    dashboard.Range{From: "2026-03-01", To: "2026-03-10"},
+			wantPrevious:  dashboard.Range{From: "2026-02-19", To: "2026-02-28"},
+		},
+		{
+			name:         "custom range is compared with the same number of days before it",
+			input:        dashboard.Input{From: "2026-03-01", To: "2026-03-10"},
+			loc:          time.UTC,
+			now:          fixedNow,
+			wantPeriod:   dashboard.Range{From: "2026-03-01", To: "2026-03-10"},
+			wantPrevious: dashboard.Range{From: "2026-02-19", To: "2026-02-28"},
+		},
+		{
+			name:         "custom range ends today by default",
+			input:        dashboard.Input{From: "2026-03-11"},
+			loc:          time.UTC,
+			now:          fixedNow,
+			wantPeriod:   dashboard.Range{From: "2026-03-11", To: "2026-03-15"},
+			wantPrevious: dashboard.Range{From: "2026-03-06", To: "2026-03-10"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repos := buildPeriodRepo(tc.wantPeriod, tc.wantPrevious, 300, 150, 200, 200)
+			uc := dashboard.New(repos.repo, repos.totals, buildProjector(0, nil), buildTimezone(tc.loc, nil), buildMonthStart(tc.monthStartDay, nil), buildClock(tc.now))
+
+			out, err := uc.Execute(context.Background(), tc.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantPeriod, out.Period)
+			assert.Equal(t, tc.wantPrevious, out.Comparison.Period)
+			repos.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_Comparison(t *testing.T) {
+	t.Parallel()
+
+	current := dashboard.Range{Label: "Mar 2026", From: "2026-03-01", To: "2026-03-31"}
+	previous := dashboard.Range{Label: "Feb 2026", From: "2026-02-01", To: "2026-02-28"}
+	up, down := 50.0, -25.0
+
+	tests := []struct {
+		name string
+		repo repos
+		want dashboard.Comparison
+	}{
+		{
+			name: "reports the previous totals and the change",
+			repo: buildPeriodRepo(current, previous, 300, 150, 200, 200),
+			want: dashboard.Comparison{
+				Period:               previous,
+				TotalIncome:          200,
+				TotalExpense:         200,
+				NetBalance:           0,
+				IncomeChange:         100,
+				ExpenseChange:        -50,
+				NetBalanceChange:     150,
+				IncomeChangePercent:  &up,
+				ExpenseChangePercent: &down,
+			},
+		},
+		{
+			name: "percentages are nil when the previous period is empty",
+			repo: buildPeriodRepo(current, previous, 300, 150, 0, 0),
+			want: dashboard.Comparison{
+				Period:           previous,
+				IncomeChange:     300,
+				ExpenseChange:    150,
+				NetBalanceChange: 150,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := dashboard.New(tc.repo.repo, tc.repo.totals, buildProjector(0, nil), buildTimezone(time.UTC, nil), buildMonthStart(1, nil), buildClock(fixedNow))
+
+			out, err := uc.Execute(context.Background(), dashboard.Input{})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, out.Comparison)
+		})
+	}
+}
+
+func TestUseCase_Execute_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   dashboard.Input
+		wantMsg string
+	}{
+		{name: "unknown period", input: dashboard.Input{Period: "day"}, wantMsg: "period must be 'week', 'month', 'quarter' or 'year'"},
+		{name: "period with a range", input: dashboard.Input{Period: "month", From: "2026-03-01"}, wantMsg: "use either period or from and to"},
+		{name: "to without from", input: dashboard.Input{To: "2026-03-01"}, wantMsg: "from is required with to"},
+		{name: "malformed from", input: dashboard.Input{From: "03/01/2026"}, wantMsg: "from must be YYYY-MM-DD"},
+		{name: "malformed to", input: dashboard.Input{From: "2026-03-01", To: "tomorrow"}, wantMsg: "to must be YYYY-MM-DD"},
+		{name: "from after to", input: dashboard.Input{From: "2026-03-10", To: "2026-03-01"}, wantMsg: "from must not be after to"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.Repository{}
+			uc := dashboard.New(repo, &mocks.Totals{}, buildProjector(0, nil), buildTimezone(time.UTC, nil), buildMonthStart(1, nil), buildClock(fixedNow))
+
+			_, err := uc.Execute(context.Background(), tc.input)
+
+			assert.ErrorIs(t, err, domainreport.ErrInvalidQuery)
+			assert.EqualError(t, err, domainreport.ErrInvalidQuery.Error()+": "+tc.wantMsg)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_TimezoneErrorIsPropagated(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.Repository{}
+	uc := dashboard.New(repo, &mocks.Totals{}, buildProjector(0, nil), buildTimezone(nil, errors.New("db error")), buildMonthStart(1, nil), buildClock(fixedNow))
+
+	_, err := uc.Execute(context.Background(), dashboard.Input{})
+
+	assert.Equal(t, fmt.Errorf("get dashboard: %w", errors.New("db error")), err)
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_MonthStartErrorIsPropagated(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.Repository{}
+	uc := dashboard.New(repo, &mocks.Totals{}, buildProjector(0, nil), buildTimezone(time.UTC, nil), buildMonthStart(0, errors.New("db error")), buildClock(fixedNow))
+
+	_, err := uc.Execute(context.Background(), dashboard.Input{})
+
+	assert.Equal(t, fmt.Errorf("get dashboard: %w", errors.New("db error")), err)
+	repo.AssertExpectations(t)
+}
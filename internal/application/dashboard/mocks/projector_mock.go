@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Projector is a testify mock for the dashboard.Projector interface.
+type Projector struct {
+	mock.Mock
+}
+
+// ProjectedMonthEndBalance mocks Projector.ProjectedMonthEndBalance.
+func (m *Projector) ProjectedMonthEndBalance(ctx context.Context) (float64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(float64), args.Error(1)
+}
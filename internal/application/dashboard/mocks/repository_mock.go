@@ -30,20 +30,6 @@ func (m *Repository) ListRecentTransactions(ctx context.Context, limit int) ([]d
 	return transactions, args.Error(1)
 }
 
-// ListExpenseTransactions mocks Repository.ListExpenseTransactions.
-func (m *Repository) ListExpenseTransactions(ctx context.Context, accountID, categoryID, startDate, endDate string) ([]domaintransaction.Transaction, error) {
-	args := m.Called(ctx, accountID, categoryID, startDate, endDate)
-	transactions, _ := args.Get(0).([]domaintransaction.Transaction)
-	return transactions, args.Error(1)
-}
-
-// ListIncomeTransactions mocks Repository.ListIncomeTransactions.
-func (m *Repository) ListIncomeTransactions(ctx context.Context, accountID, categoryID, startDate, endDate string) ([]domaintransaction.Transaction, error) {
-	args := m.Called(ctx, accountID, categoryID, startDate, endDate)
-	transactions, _ := args.Get(0).([]domaintransaction.Transaction)
-	return transactions, args.Error(1)
-}
-
 // ListCategories mocks Repository.ListCategories.
 func (m *Repository) ListCategories(ctx context.Context) ([]domaincategory.Category, error) {
 	args := m.Called(ctx)
@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Totals is a testify mock for the dashboard.Totals interface.
+type Totals struct {
+	mock.Mock
+}
+
+// Totals mocks Totals.Totals.
+func (m *Totals) Totals(ctx context.Context, q domaintransaction.TotalsQuery) ([]domaintransaction.Total, error) {
+	args := m.Called(ctx, q)
+	totals, _ := args.Get(0).([]domaintransaction.Total)
+	return totals, args.Error(1)
+}
@@ -1,27 +1,38 @@
 package dashboard_test
 
 import (
+	"slices"
 	"time"
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/financial-manager/api/internal/application/dashboard"
 	"github.com/financial-manager/api/internal/application/dashboard/mocks"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
-// Get current month dates for tests
-func getCurrentMonthDates() (string, string) {
-	now := time.Now()
-	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-	endOfMonth := startOfMonth.AddDate(0, 1, -1)
-	return startOfMonth.Format("2006-01-02"), endOfMonth.Format("2006-01-02")
+// fixedNow is the clock time of the tests: mid March 2026, so the default period
+// is March and the previous one February.
+var fixedNow = time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+var currentDate = time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+
+// repos holds the mocks of the dashboard's Repository and Totals ports.
+type repos struct {
+	repo   *mocks.Repository
+	totals *mocks.Totals
 }
 
-var currentDate, _ = time.Parse("2006-01-02", time.Now().Format("2006-01-02"))
+// AssertExpectations asserts the expectations of both mocks.
+func (r repos) AssertExpectations(t mock.TestingT) {
+	r.repo.AssertExpectations(t)
+	r.totals.AssertExpectations(t)
+}
 
-// buildMockRepo creates a mocks.Repository pre-configured with the given data.
+// buildMockRepo creates repos pre-configured with the given data for March 2026
+// and nothing in February 2026.
 func buildMockRepo(
 	accounts []domainaccount.Account,
 	recentTxs []domaintransaction.Transaction,
@@ -29,20 +40,102 @@ func buildMockRepo(
 	summaryIncomes []domaintransaction.Transaction,
 	categories []domaincategory.Category,
 	err error,
-) *mocks.Repository {
+) repos {
 	m := &mocks.Repository{}
+	totals := &mocks.Totals{}
 
 	if err != nil {
 		m.On("ListAccounts", mock.Anything).Return(nil, err).Once()
-		return m
+		return repos{repo: m, totals: totals}
 	}
 
 	m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
 	m.On("ListRecentTransactions", mock.Anything, 10).Return(recentTxs, nil).Once()
-	m.On("ListExpenseTransactions", mock.Anything, "", "", mock.Anything, mock.Anything).Return(expenseTxs, nil).Once()
-	m.On("ListIncomeTransactions", mock.Anything, "", "", mock.Anything, mock.Anything).Return(summaryIncomes, nil).Once()
+	totals.On("Totals", mock.Anything, byCategory("2026-03-01", "2026-03-31")).Return(totalsOf(slices.Concat(summaryIncomes, expenseTxs)), nil).Once()
+	totals.On("Totals", mock.Anything, byType("2026-02-01", "2026-02-28")).Return(nil, nil).Once()
 	m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
 
+	return repos{repo: m, totals: totals}
+}
+
+// buildPeriodRepo creates repos whose only transactions are one income and
+// one expense in each of the current and previous ranges.
+func buildPeriodRepo(current, previous dashboard.Range, income, expense, previousIncome, previousExpense float64) repos {
+	m := &mocks.Repository{}
+	m.On("ListAccounts", mock.Anything).Return(nil, nil).Once()
+	m.On("ListRecentTransactions", mock.Anything, 10).Return(nil, nil).Once()
+	m.On("ListCategories", mock.Anything).Return(nil, nil).Once()
+	totals := &mocks.Totals{}
+	totals.On("Totals", mock.Anything, byCategory(current.From, current.To)).Return(amounts(income, expense), nil).Once()
+	totals.On("Totals", mock.Anything, byType(previous.From, previous.To)).Return(amounts(previousIncome, previousExpense), nil).Once()
+	return repos{repo: m, totals: totals}
+}
+
+// byCategory and byType are the totals queries the dashboard sends for the
+// current and previous ranges.
+func byCategory(from, to string) domaintransaction.TotalsQuery {
+	return domaintransaction.TotalsQuery{StartDate: from, EndDate: to, GroupBy: domaintransaction.TotalsByCategory}
+}
+
+func byType(from, to string) domaintransaction.TotalsQuery {
+	return domaintransaction.TotalsQuery{StartDate: from, EndDate: to}
+}
+
+// totalsOf aggregates txs per type and category the way the repository does.
+func totalsOf(txs []domaintransaction.Transaction) []domaintransaction.Total {
+	var totals []domaintransaction.Total
+	index := make(map[[2]string]int)
+	for _, tx := range txs {
+		key := [2]string{string(tx.Type), tx.CategoryID}
+		i, ok := index[key]
+		if !ok {
+			i = len(totals)
+			index[key] = i
+			totals = append(totals, domaintransaction.Total{Type: tx.Type, GroupID: tx.CategoryID})
+		}
+		totals[i].Amount += tx.Amount
+		totals[i].Count++
+	}
+	return totals
+}
+
+// amounts returns the income and expense totals for the non-zero amounts.
+func amounts(income, expense float64) []domaintransaction.Total {
+	var totals []domaintransaction.Total
+	if income != 0 {
+		totals = append(totals, domaintransaction.Total{Type: domaintransaction.TransactionTypeIncome, Amount: income, Count: 1})
+	}
+	if expense != 0 {
+		totals = append(totals, domaintransaction.Total{Type: domaintransaction.TransactionTypeExpense, Amount: expense, Count: 1})
+	}
+	return totals
+}
+
+// buildTimezone creates a mocks.Timezone returning loc and err.
+func buildTimezone(loc *time.Location, err error) *mocks.Timezone {
+	m := &mocks.Timezone{}
+	m.On("Execute", mock.Anything).Return(loc, err)
+	return m
+}
+
+// buildMonthStart creates a mocks.MonthStart returning day and err.
+func buildMonthStart(day int, err error) *mocks.MonthStart {
+	m := &mocks.MonthStart{}
+	m.On("Execute", mock.Anything).Return(day, err)
+	return m
+}
+
+// buildClock creates a mocks.Clock returning now.
+func buildClock(now time.Time) *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(now)
+	return m
+}
+
+// buildProjector creates a mocks.Projector returning balance and err.
+func buildProjector(balance float64, err error) *mocks.Projector {
+	m := &mocks.Projector{}
+	m.On("ProjectedMonthEndBalance", mock.Anything).Return(balance, err)
 	return m
 }
 
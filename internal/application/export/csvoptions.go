@@ -0,0 +1,190 @@
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// csvDateLayout returns the Go layout of a date format accepted in
+// CSVOptions.DateFormat.
+func csvDateLayout(format string) (string, bool) {
+	switch format {
+	case "YYYY-MM-DD":
+		return "2006-01-02", true
+	case "YYYY/MM/DD":
+		return "2006/01/02", true
+	case "DD/MM/YYYY":
+		return "02/01/2006", true
+	case "MM/DD/YYYY":
+		return "01/02/2006", true
+	case "DD-MM-YYYY":
+		return "02-01-2006", true
+	case "DD.MM.YYYY":
+		return "02.01.2006", true
+	default:
+		return "", false
+	}
+}
+
+// csvDelimiter returns the rune of an accepted CSVOptions.Delimiter value.
+func csvDelimiter(delimiter string) (rune, bool) {
+	switch delimiter {
+	case ",", ";", "\t", "|":
+		return rune(delimiter[0]), true
+	default:
+		return 0, false
+	}
+}
+
+// isCSVColumn reports whether col is an accepted CSVOptions.Columns value.
+func isCSVColumn(col domainexport.Column) bool {
+	switch col {
+	case domainexport.ColumnID, domainexport.ColumnDate, domainexport.ColumnType,
+		domainexport.ColumnAmount, domainexport.ColumnCurrency, domainexport.ColumnCategory,
+		domainexport.ColumnAccount, domainexport.ColumnDescription:
+		return true
+	default:
+		return false
+	}
+}
+
+// csvLayout is a validated CSVOptions with its defaults applied.
+type csvLayout struct {
+	columns    []domainexport.Column
+	delimiter  rune
+	decimal    string
+	dateLayout string
+	signed     bool
+}
+
+// ValidateCSVOptions reports whether opts can be used for a CSV export. The
+// returned error wraps domainexport.ErrInvalidOptions.
+func ValidateCSVOptions(opts domainexport.CSVOptions) error {
+	_, err := newCSVLayout(opts)
+	return err
+}
+
+// newCSVLayout validates opts and resolves its defaults.
+func newCSVLayout(opts domainexport.CSVOptions) (csvLayout, error) {
+	if err := validateFilters(opts.Filters); err != nil {
+		return csvLayout{}, err
+	}
+
+	layout := csvLayout{
+		columns:    opts.Columns,
+		delimiter:  ',',
+		decimal:    ".",
+		dateLayout: "2006-01-02",
+		signed:     opts.SignedAmounts,
+	}
+
+	if len(layout.columns) == 0 {
+		layout.columns = domainexport.DefaultColumns()
+	}
+	seen := make(map[domainexport.Column]bool)
+	for _, col := range layout.columns {
+		if !isCSVColumn(col) {
+			return csvLayout{}, fmt.Errorf("%w: unknown column %q", domainexport.ErrInvalidOptions, col)
+		}
+		if seen[col] {
+			return csvLayout{}, fmt.Errorf("%w: duplicate column %q", domainexport.ErrInvalidOptions, col)
+		}
+		seen[col] = true
+	}
+
+	if opts.Delimiter != "" {
+		d, ok := csvDelimiter(opts.Delimiter)
+		if !ok {
+			return csvLayout{}, fmt.Errorf("%w: delimiter must be one of ',', ';', '|' or a tab", domainexport.ErrInvalidOptions)
+		}
+		layout.delimiter = d
+	}
+
+	switch opts.DecimalSeparator {
+	case "", ".":
+	case ",":
+		layout.decimal = ","
+	default:
+		return csvLayout{}, fmt.Errorf("%w: decimal separator must be '.' or ','", domainexport.ErrInvalidOptions)
+	}
+	if layout.decimal == string(layout.delimiter) {
+		return csvLayout{}, fmt.Errorf("%w: decimal separator and delimiter must differ", domainexport.ErrInvalidOptions)
+	}
+
+	if opts.DateFormat != "" {
+		l, ok := csvDateLayout(opts.DateFormat)
+		if !ok {
+			return csvLayout{}, fmt.Errorf("%w: unsupported date format %q", domainexport.ErrInvalidOptions, opts.DateFormat)
+		}
+		layout.dateLayout = l
+	}
+
+	return layout, nil
+}
+
+// validateFilters checks the transaction type, date and amount range filters.
+func validateFilters(f domainexport.Filters) error {
+	switch f.Type {
+	case "", string(domaintransaction.TransactionTypeIncome), string(domaintransaction.TransactionTypeExpense):
+	default:
+		return fmt.Errorf("%w: type must be 'income' or 'expense'", domainexport.ErrInvalidOptions)
+	}
+	for _, d := range []string{f.StartDate, f.EndDate} {
+		if d == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", d); err != nil {
+			return fmt.Errorf("%w: dates must be YYYY-MM-DD", domainexport.ErrInvalidOptions)
+		}
+	}
+	if f.MinAmount != nil && f.MaxAmount != nil && *f.MinAmount > *f.MaxAmount {
+		return fmt.Errorf("%w: min_amount must not exceed max_amount", domainexport.ErrInvalidOptions)
+	}
+	return nil
+}
+
+// value formats the given column of tx.
+func (l csvLayout) value(
+	col domainexport.Column,
+	tx domaintransaction.Transaction,
+	accounts map[string]domainaccount.Account,
+	categories map[string]string,
+) string {
+	switch col {
+	case domainexport.ColumnID:
+		return tx.ID
+	case domainexport.ColumnDate:
+		return tx.Date.Format(l.dateLayout)
+	case domainexport.ColumnType:
+		return string(tx.Type)
+	case domainexport.ColumnAmount:
+		amount := tx.Amount
+		if l.signed && tx.Type == domaintransaction.TransactionTypeExpense {
+			amount = -amount
+		}
+		return strings.Replace(strconv.FormatFloat(amount, 'f', 2, 64), ".", l.decimal, 1)
+	case domainexport.ColumnCurrency:
+		acc, ok := accounts[tx.AccountID]
+		if !ok || acc.Currency == "" {
+			return defaultCurrency
+		}
+		return acc.Currency
+	case domainexport.ColumnCategory:
+		return categoryName(tx, categories)
+	case domainexport.ColumnAccount:
+		if acc, ok := accounts[tx.AccountID]; ok && acc.Name != "" {
+			return acc.Name
+		}
+		return "Unknown"
+	case domainexport.ColumnDescription:
+		return tx.Description
+	default:
+		return ""
+	}
+}
@@ -2,14 +2,16 @@
 package export
 
 import (
+	"bufio"
 	"context"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
-	"strings"
+	"io"
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -18,6 +20,10 @@ type Repository interface {
 	ListAccounts(ctx context.Context) ([]domainaccount.Account, error)
 	ListCategories(ctx context.Context) ([]domaincategory.Category, error)
 	ListTransactions(ctx context.Context, tType domaintransaction.TransactionType, startDate, endDate string) ([]domaintransaction.Transaction, error)
+	// StreamTransactions calls fn for every transaction matching the filters, in
+	// the same order as ListTransactions, without loading them all into memory.
+	// It stops at the first error returned by fn or when ctx is done.
+	StreamTransactions(ctx context.Context, filters domainexport.Filters, fn func(domaintransaction.Transaction) error) error
 }
 
 // UseCase implements the export use cases.
@@ -25,13 +31,6 @@ type UseCase struct {
 	repo Repository
 }
 
-// CSVFilters represents the filters for CSV export.
-type CSVFilters struct {
-	StartDate string
-	EndDate   string
-	Type      string // "income", "expense", or empty for all
-}
-
 // CSVRow represents a row in the CSV export.
 type CSVRow struct {
 	Date        string  `json:"date"`
@@ -42,7 +41,7 @@ type CSVRow struct {
 	Description string  `json:"description"`
 }
 
-// BackupData represents the full backup data.
+// BackupData represents the full backup document written by ExportJSON.
 type BackupData struct {
 	Accounts     []domainaccount.Account         `json:"accounts"`
 	Categories   []domaincategory.Category       `json:"categories"`
@@ -54,35 +53,33 @@ func New(repo Repository) *UseCase {
 	return &UseCase{repo: repo}
 }
 
-// ExportCSV exports transactions to CSV format.
-func (uc *UseCase) ExportCSV(ctx context.Context, filters CSVFilters) (string, error) {
-	// Get accounts and categories first for name resolution
-	accounts, err := uc.repo.ListAccounts(ctx)
-	if err != nil {
-		return "", fmt.Errorf("export csv: %w", err)
-	}
+// csvFlushRows is how many CSV rows are buffered before they are written out.
+const csvFlushRows = 500
 
-	categories, err := uc.repo.ListCategories(ctx)
+// ExportCSV streams the transactions matching opts to w in CSV format, laid
+// out as opts describes. Options are validated and accounts and categories
+// loaded for name resolution before anything is written, so an error returned
+// before the first write to w means nothing was exported.
+func (uc *UseCase) ExportCSV(ctx context.Context, w io.Writer, opts domainexport.CSVOptions) error {
+	layout, err := newCSVLayout(opts)
 	if err != nil {
-		return "", fmt.Errorf("export csv: %w", err)
+		return fmt.Errorf("export csv: %w", err)
 	}
 
-	var tType domaintransaction.TransactionType
-	switch filters.Type {
-	case "income":
-		tType = domaintransaction.TransactionTypeIncome
-	case "expense":
-		tType = domaintransaction.TransactionTypeExpense
+	// Get accounts and categories first for name resolution
+	accounts, err := uc.repo.ListAccounts(ctx)
+	if err != nil {
+		return fmt.Errorf("export csv: %w", err)
 	}
 
-	transactions, err := uc.repo.ListTransactions(ctx, tType, filters.StartDate, filters.EndDate)
+	categories, err := uc.repo.ListCategories(ctx)
 	if err != nil {
-		return "", fmt.Errorf("export csv: %w", err)
+		return fmt.Errorf("export csv: %w", err)
 	}
 
-	accountMap := make(map[string]string)
+	accountMap := make(map[string]domainaccount.Account)
 	for _, acc := range accounts {
-		accountMap[acc.ID] = acc.Name
+		accountMap[acc.ID] = acc
 	}
 
 	categoryMap := make(map[string]string)
@@ -90,86 +87,140 @@ func (uc *UseCase) ExportCSV(ctx context.Context, filters CSVFilters) (string, e
 		categoryMap[cat.ID] = cat.Name
 	}
 
-	// Build CSV
-	var sb strings.Builder
-	writer := csv.NewWriter(&sb)
+	if opts.BOM {
+		if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+			return fmt.Errorf("export csv: %w", err)
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = layout.delimiter
 
 	// Write header
-	if err := writer.Write([]string{"date", "type", "amount", "category", "account", "description"}); err != nil {
-		return "", fmt.Errorf("export csv: %w", err)
+	header := make([]string, len(layout.columns))
+	for i, col := range layout.columns {
+		header[i] = string(col)
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("export csv: %w", err)
 	}
 
-	// Write rows
-	for _, tx := range transactions {
-		accountName := accountMap[tx.AccountID]
-		if accountName == "" {
-			accountName = "Unknown"
+	// Write rows as they are read, flushing every csvFlushRows rows
+	rows := 0
+	row := make([]string, len(layout.columns))
+	err = uc.repo.StreamTransactions(ctx, opts.Filters, func(tx domaintransaction.Transaction) error {
+		for i, col := range layout.columns {
+			row[i] = layout.value(col, tx, accountMap, categoryMap)
 		}
-		categoryName := categoryMap[tx.CategoryID]
-		if categoryName == "" {
-			if tx.Type == domaintransaction.TransactionTypeIncome {
-				categoryName = "Income"
-			} else {
-				categoryName = "Uncategorized"
-			}
+		if err := writer.Write(row); err != nil {
+			return err
 		}
 
-		row := []string{
-			tx.Date.Format("2006-01-02"),
-			string(tx.Type),
-			fmt.Sprintf("%.2f", tx.Amount),
-			categoryName,
-			accountName,
-			tx.Description,
-		}
-		if err := writer.Write(row); err != nil {
-			return "", fmt.Errorf("export csv: %w", err)
+		rows++
+		if rows%csvFlushRows == 0 {
+			writer.Flush()
+			return writer.Error()
 		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("export csv: %w", err)
 	}
 
 	writer.Flush()
 	if err := writer.Error(); err != nil {
-		return "", fmt.Errorf("export csv: %w", err)
+		return fmt.Errorf("export csv: %w", err)
 	}
 
-	return sb.String(), nil
+	return nil
 }
 
-// ExportJSON exports all data to JSON format.
-func (uc *UseCase) ExportJSON(ctx context.Context) ([]byte, error) {
+// ExportJSON streams all data to w as an indented BackupData document.
+// Accounts and categories are loaded up front; transactions are written as
+// they are read. An error returned before anything was written to w means
+// nothing was exported.
+func (uc *UseCase) ExportJSON(ctx context.Context, w io.Writer) error {
 	accounts, err := uc.repo.ListAccounts(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("export json: %w", err)
+		return fmt.Errorf("export json: %w", err)
 	}
 
 	categories, err := uc.repo.ListCategories(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("export json: %w", err)
+		return fmt.Errorf("export json: %w", err)
 	}
 
-	// Get all transactions (both income and expense)
-	incomes, err := uc.repo.ListTransactions(ctx, domaintransaction.TransactionTypeIncome, "", "")
-	if err != nil {
-		return nil, fmt.Errorf("export json: %w", err)
-	}
+	bw := bufio.NewWriter(w)
 
-	expenses, err := uc.repo.ListTransactions(ctx, domaintransaction.TransactionTypeExpense, "", "")
-	if err != nil {
-		return nil, fmt.Errorf("export json: %w", err)
+	_, _ = bw.WriteString("{\n  \"accounts\": ")
+	arr := jsonArray{w: bw}
+	for _, acc := range accounts {
+		if err := arr.add(acc); err != nil {
+			return fmt.Errorf("export json: %w", err)
+		}
 	}
+	arr.close()
 
-	transactions := append(incomes, expenses...)
+	_, _ = bw.WriteString(",\n  \"categories\": ")
+	arr = jsonArray{w: bw}
+	for _, cat := range categories {
+		if err := arr.add(cat); err != nil {
+			return fmt.Errorf("export json: %w", err)
+		}
+	}
+	arr.close()
+
+	// Stream all transactions, incomes first and then expenses
+	_, _ = bw.WriteString(",\n  \"transactions\": ")
+	arr = jsonArray{w: bw}
+	add := func(tx domaintransaction.Transaction) error { return arr.add(tx) }
+	for _, tType := range []domaintransaction.TransactionType{
+		domaintransaction.TransactionTypeIncome,
+		domaintransaction.TransactionTypeExpense,
+	} {
+		if err := uc.repo.StreamTransactions(ctx, domainexport.Filters{Type: string(tType)}, add); err != nil {
+			return fmt.Errorf("export json: %w", err)
+		}
+	}
+	arr.close()
 
-	data := BackupData{
-		Accounts:     accounts,
-		Categories:   categories,
-		Transactions: transactions,
+	_, _ = bw.WriteString("\n}")
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("export json: %w", err)
 	}
 
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+	return nil
+}
+
+// jsonArray writes a JSON array one element at a time, laid out like a
+// field of a document produced by json.MarshalIndent(v, "", "  ").
+type jsonArray struct {
+	w *bufio.Writer
+	n int
+}
+
+// add writes v as the next element of the array. Write errors are sticky in
+// bufio.Writer, so a failed write surfaces on the next call.
+func (a *jsonArray) add(v any) error {
+	data, err := json.MarshalIndent(v, "    ", "  ")
 	if err != nil {
-		return nil, fmt.Errorf("export json: %w", err)
+		return err
+	}
+	if a.n == 0 {
+		_, _ = a.w.WriteString("[\n    ")
+	} else {
+		_, _ = a.w.WriteString(",\n    ")
 	}
+	a.n++
+	_, err = a.w.Write(data)
+	return err
+}
 
-	return jsonData, nil
+// close ends the array, writing [] when no element was added.
+func (a *jsonArray) close() {
+	if a.n == 0 {
+		_, _ = a.w.WriteString("[]")
+		return
+	}
+	_, _ = a.w.WriteString("\n  ]")
 }
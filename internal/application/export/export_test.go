@@ -2,7 +2,9 @@
 package export_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -10,12 +12,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/financial-manager/api/internal/application/export"
 	"github.com/financial-manager/api/internal/application/export/mocks"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	exportsqlite "github.com/financial-manager/api/internal/platform/export/sqlite"
 )
 
 func TestUseCase_ExportCSV(t *testing.T) {
@@ -24,7 +29,7 @@ func TestUseCase_ExportCSV(t *testing.T) {
 	tests := []struct {
 		name    string
 		repo    *mocks.Repository
-		filters export.CSVFilters
+		opts    domainexport.CSVOptions
 		wantErr error
 		wantCSV string
 	}{
@@ -42,7 +47,6 @@ func TestUseCase_ExportCSV(t *testing.T) {
 				},
 				nil,
 			),
-			filters: export.CSVFilters{},
 			wantCSV: "date,type,amount,category,account,description\n2026-02-28,expense,50.00,Alimentación,Banco,Groceries\n",
 		},
 		{
@@ -63,13 +67,11 @@ func TestUseCase_ExportCSV(t *testing.T) {
 				},
 				nil,
 			),
-			filters: export.CSVFilters{},
 			wantCSV: "date,type,amount,category,account,description\n2026-02-28,income,1000.00,Income,Banco,Salary\n2026-02-28,expense,50.00,Alimentación,Banco,Groceries\n2026-02-28,expense,30.00,Transporte,Efectivo,Bus\n",
 		},
 		{
 			name:    "repository error is propagated",
 			repo:    buildMockRepoForCSV(nil, nil, nil, errors.New("db error")),
-			filters: export.CSVFilters{},
 			wantErr: fmt.Errorf("export csv: %w", errors.New("db error")),
 		},
 		{
@@ -82,7 +84,6 @@ func TestUseCase_ExportCSV(t *testing.T) {
 				},
 				nil,
 			),
-			filters: export.CSVFilters{},
 			wantCSV: "date,type,amount,category,account,description\n2026-02-28,expense,50.00,Uncategorized,Unknown,Groceries\n",
 		},
 		{
@@ -96,7 +97,7 @@ func TestUseCase_ExportCSV(t *testing.T) {
 				nil,
 				"income",
 			),
-			filters: export.CSVFilters{Type: "income"},
+			opts:    domainexport.CSVOptions{Filters: domainexport.Filters{Type: "income"}},
 			wantCSV: "date,type,amount,category,account,description\n2026-02-28,income,1000.00,Income,Banco,Salary\n",
 		},
 		{
@@ -110,7 +111,7 @@ func TestUseCase_ExportCSV(t *testing.T) {
 				nil,
 				"expense",
 			),
-			filters: export.CSVFilters{Type: "expense"},
+			opts:    domainexport.CSVOptions{Filters: domainexport.Filters{Type: "expense"}},
 			wantCSV: "date,type,amount,category,account,description\n2026-02-28,expense,50.00,Food,Banco,Groceries\n",
 		},
 		{
@@ -121,13 +122,11 @@ func TestUseCase_ExportCSV(t *testing.T) {
 				[]domaintransaction.Transaction{},
 				nil,
 			),
-			filters: export.CSVFilters{},
 			wantCSV: "date,type,amount,category,account,description\n",
 		},
 		{
 			name:    "categories error is propagated",
 			repo:    buildMockRepoForCSVWithCategoriesError(),
-			filters: export.CSVFilters{},
 			wantErr: fmt.Errorf("export csv: %w", errors.New("categories error")),
 		},
 	}
@@ -137,19 +136,159 @@ func TestUseCase_ExportCSV(t *testing.T) {
 			t.Parallel()
 
 			uc := export.New(tc.repo)
-			csv, err := uc.ExportCSV(context.Background(), tc.filters)
+			var buf bytes.Buffer
+			err := uc.ExportCSV(context.Background(), &buf, tc.opts)
 
 			if tc.wantErr != nil {
 				assert.Equal(t, tc.wantErr, err)
+				assert.Empty(t, buf.String())
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, normalizeCSV(tc.wantCSV), normalizeCSV(csv))
+				assert.Equal(t, normalizeCSV(tc.wantCSV), normalizeCSV(buf.String()))
 			}
 			tc.repo.AssertExpectations(t)
 		})
 	}
 }
 
+func TestUseCase_ExportCSV_Options(t *testing.T) {
+	t.Parallel()
+
+	accounts := []domainaccount.Account{{ID: "acc-1", Name: "Banco", Currency: "EUR"}}
+	categories := []domaincategory.Category{{ID: "cat-1", Name: "Alimentación"}}
+	transactions := []domaintransaction.Transaction{
+		buildIncome("tx-1", 1000.50, "acc-1", "Nómina"),
+		buildExpense("tx-2", 50.25, "acc-1", "cat-1", "Mercado; frutas"),
+	}
+	minAmount, maxAmount := 100.0, 10.0
+
+	tests := []struct {
+		name    string
+		opts    domainexport.CSVOptions
+		wantCSV string
+		wantErr string
+	}{
+		{
+			name: "spanish spreadsheet layout",
+			opts: domainexport.CSVOptions{
+				Delimiter:        ";",
+				DecimalSeparator: ",",
+				DateFormat:       "DD/MM/YYYY",
+				BOM:              true,
+			},
+			wantCSV: "\uFEFFdate;type;amount;category;account;description\n" +
+				"28/02/2026;income;1000,50;Income;Banco;Nómina\n" +
+				"28/02/2026;expense;50,25;Alimentación;Banco;\"Mercado; frutas\"\n",
+		},
+		{
+			name: "selected columns in the given order with signed amounts",
+			opts: domainexport.CSVOptions{
+				Columns:       []domainexport.Column{domainexport.ColumnAmount, domainexport.ColumnCurrency, domainexport.ColumnID},
+				SignedAmounts: true,
+			},
+			wantCSV: "amount,currency,id\n1000.50,EUR,tx-1\n-50.25,EUR,tx-2\n",
+		},
+		{
+			name:    "tab delimiter",
+			opts:    domainexport.CSVOptions{Delimiter: "\t", Columns: []domainexport.Column{domainexport.ColumnDate, domainexport.ColumnAmount}},
+			wantCSV: "date\tamount\n2026-02-28\t1000.50\n2026-02-28\t50.25\n",
+		},
+		{
+			name:    "unknown column",
+			opts:    domainexport.CSVOptions{Columns: []domainexport.Column{"balance"}},
+			wantErr: `invalid export options: unknown column "balance"`,
+		},
+		{
+			name:    "duplicate column",
+			opts:    domainexport.CSVOptions{Columns: []domainexport.Column{domainexport.ColumnDate, domainexport.ColumnDate}},
+			wantErr: `invalid export options: duplicate column "date"`,
+		},
+		{
+			name:    "unsupported delimiter",
+			opts:    domainexport.CSVOptions{Delimiter: ":"},
+			wantErr: "invalid export options: delimiter must be one of ',', ';', '|' or a tab",
+		},
+		{
+			name:    "unsupported decimal separator",
+			opts:    domainexport.CSVOptions{DecimalSeparator: "'"},
+			wantErr: "invalid export options: decimal separator must be '.' or ','",
+		},
+		{
+			name:    "decimal separator equal to delimiter",
+			opts:    domainexport.CSVOptions{DecimalSeparator: ","},
+			wantErr: "invalid export options: decimal separator and delimiter must differ",
+		},
+		{
+			name:    "unsupported date format",
+			opts:    domainexport.CSVOptions{DateFormat: "YY-M-D"},
+			wantErr: `invalid export options: unsupported date format "YY-M-D"`,
+		},
+		{
+			name:    "unknown transaction type",
+			opts:    domainexport.CSVOptions{Filters: domainexport.Filters{Type: "transfer"}},
+			wantErr: "invalid export options: type must be 'income' or 'expense'",
+		},
+		{
+			name:    "malformed date filter",
+			opts:    domainexport.CSVOptions{Filters: domainexport.Filters{StartDate: "01/02/2026"}},
+			wantErr: "invalid export options: dates must be YYYY-MM-DD",
+		},
+		{
+			name:    "inverted amount range",
+			opts:    domainexport.CSVOptions{Filters: domainexport.Filters{MinAmount: &minAmount, MaxAmount: &maxAmount}},
+			wantErr: "invalid export options: min_amount must not exceed max_amount",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.Repository{}
+			if tc.wantErr == "" {
+				repo = buildMockRepoForCSV(accounts, categories, transactions, nil)
+			}
+
+			var buf bytes.Buffer
+			err := export.New(repo).ExportCSV(context.Background(), &buf, tc.opts)
+
+			if tc.wantErr != "" {
+				assert.ErrorIs(t, err, domainexport.ErrInvalidOptions)
+				assert.EqualError(t, err, "export csv: "+tc.wantErr)
+				assert.Empty(t, buf.String())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantCSV, buf.String())
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_ExportCSV_PassesFiltersToRepository(t *testing.T) {
+	t.Parallel()
+
+	minAmount, maxAmount := 10.0, 100.0
+	filters := domainexport.Filters{
+		Type:       "expense",
+		StartDate:  "2026-01-01",
+		EndDate:    "2026-01-31",
+		AccountID:  "acc-1",
+		CategoryID: "cat-1",
+		MinAmount:  &minAmount,
+		MaxAmount:  &maxAmount,
+	}
+	repo := &mocks.Repository{}
+	repo.On("ListAccounts", mock.Anything).Return([]domainaccount.Account{}, nil).Once()
+	repo.On("ListCategories", mock.Anything).Return([]domaincategory.Category{}, nil).Once()
+	repo.On("StreamTransactions", mock.Anything, filters).Return([]domaintransaction.Transaction{}, nil).Once()
+
+	var buf bytes.Buffer
+	err := export.New(repo).ExportCSV(context.Background(), &buf, domainexport.CSVOptions{Filters: filters})
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
 func TestUseCase_ExportJSON(t *testing.T) {
 	t.Parallel()
 
@@ -223,13 +362,14 @@ func TestUseCase_ExportJSON(t *testing.T) {
 			t.Parallel()
 
 			uc := export.New(tc.repo)
-			json, err := uc.ExportJSON(context.Background())
+			var buf bytes.Buffer
+			err := uc.ExportJSON(context.Background(), &buf)
 
 			if tc.wantErr != nil {
 				assert.Equal(t, tc.wantErr, err)
 			} else {
 				assert.NoError(t, err)
-				jsonStr := string(json)
+				jsonStr := buf.String()
 				for _, want := range tc.wantContains {
 					assert.Contains(t, jsonStr, want)
 				}
@@ -239,7 +379,338 @@ func TestUseCase_ExportJSON(t *testing.T) {
 	}
 }
 
+func TestUseCase_ExportJSON_MatchesIndentedBackup(t *testing.T) {
+	t.Parallel()
+
+	accounts := []domainaccount.Account{{ID: "acc-1", Name: "Banco"}, {ID: "acc-2", Name: "Efectivo"}}
+	categories := []domaincategory.Category{{ID: "cat-1", Name: "Alimentación"}}
+	incomes := []domaintransaction.Transaction{buildIncome("tx-1", 1000.00, "acc-1", "Salary")}
+	expenses := []domaintransaction.Transaction{
+		buildExpense("tx-2", 50.00, "acc-1", "cat-1", "Groceries"),
+		buildExpense("tx-3", 30.00, "acc-2", "cat-1", "Bakery"),
+	}
+	repo := buildMockRepoForJSON(accounts, categories, incomes, expenses, nil)
+
+	var buf bytes.Buffer
+	err := export.New(repo).ExportJSON(context.Background(), &buf)
+	assert.NoError(t, err)
+
+	want, err := json.MarshalIndent(export.BackupData{
+		Accounts:     accounts,
+		Categories:   categories,
+		Transactions: append(incomes, expenses...),
+	}, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), buf.String())
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_ExportCSV_StopsWhenWriterFails(t *testing.T) {
+	t.Parallel()
+
+	transactions := make([]domaintransaction.Transaction, 0, 2000)
+	for i := 0; i < cap(transactions); i++ {
+		transactions = append(transactions, buildExpense(fmt.Sprintf("tx-%d", i), 1, "acc-1", "cat-1", "Coffee"))
+	}
+	repo := buildMockRepoForCSV([]domainaccount.Account{}, []domaincategory.Category{}, transactions, nil)
+	writeErr := errors.New("connection reset")
+
+	err := export.New(repo).ExportCSV(context.Background(), failingWriter{err: writeErr}, domainexport.CSVOptions{})
+	assert.ErrorIs(t, err, writeErr)
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_ExportXLSX(t *testing.T) {
+	t.Parallel()
+
+	march, _ := time.Parse("2006-01-02", "2026-03-05")
+	rent := buildExpense("tx-4", 700.00, "acc-1", "cat-2", "Rent & fees")
+	rent.Date = march
+
+	tests := []struct {
+		name      string
+		repo      *mocks.Repository
+		filters   domainexport.Filters
+		wantErr   error
+		wantParts map[string][]string
+	}{
+		{
+			name: "exports transactions, accounts, categories and monthly sheets",
+			repo: buildMockRepoForCSV(
+				[]domainaccount.Account{
+					{ID: "acc-1", Name: "Banco", Type: domainaccount.AccountTypeBank, Currency: "USD", InitialBalance: 100, CurrentBalance: 1220},
+				},
+				[]domaincategory.Category{
+					{ID: "cat-1", Name: "Food"},
+					{ID: "cat-2", Name: "Housing"},
+				},
+				[]domaintransaction.Transaction{
+					buildIncome("tx-1", 1000.00, "acc-1", "Salary"),
+					buildExpense("tx-2", 50.00, "acc-1", "cat-1", "Groceries"),
+					buildExpense("tx-3", 30.00, "acc-1", "cat-1", "Market"),
+					rent,
+				},
+				nil,
+			),
+			wantParts: map[string][]string{
+				"xl/workbook.xml": {
+					`<sheet name="Transactions" sheetId="1" r:id="rId1"/>`,
+					`<sheet name="Accounts" sheetId="2" r:id="rId2"/>`,
+					`<sheet name="Categories" sheetId="3" r:id="rId3"/>`,
+					`<sheet name="Monthly" sheetId="4" r:id="rId4"/>`,
+				},
+				"xl/worksheets/sheet1.xml": {
+					`<c r="A2" s="2"><v>46081</v></c>`,
+					`<c r="C2" s="3"><v>1000</v></c>`,
+					`<c r="D3" s="0" t="inlineStr"><is><t xml:space="preserve">Food</t></is></c>`,
+					`<t xml:space="preserve">Rent &amp; fees</t>`,
+				},
+				"xl/worksheets/sheet2.xml": {
+					`<t xml:space="preserve">Banco</t>`,
+					`<c r="D2" s="3"><v>100</v></c>`,
+					`<c r="E2" s="3"><v>1220</v></c>`,
+				},
+				"xl/worksheets/sheet3.xml": {
+					`<row r="2"><c r="A2" s="0" t="inlineStr"><is><t xml:space="preserve">Income</t></is></c>`,
+					`<c r="C3" s="0"><v>2</v></c><c r="D3" s="3"><v>80</v></c>`,
+				},
+				"xl/worksheets/sheet4.xml": {
+					`<c r="E1" s="1" t="inlineStr"><is><t xml:space="preserve">Food</t></is></c>`,
+					`<c r="F1" s="1" t="inlineStr"><is><t xml:space="preserve">Housing</t></is></c>`,
+					`<c r="A2" s="0" t="inlineStr"><is><t xml:space="preserve">2026-02</t></is></c><c r="B2" s="3"><v>1000</v></c><c r="C2" s="3"><v>80</v></c><c r="D2" s="3"><v>920</v></c><c r="E2" s="3"><v>80</v></c><c r="F2" s="3"><v>0</v></c>`,
+					`<c r="A3" s="0" t="inlineStr"><is><t xml:space="preserve">2026-03</t></is></c><c r="B3" s="3"><v>0</v></c><c r="C3" s="3"><v>700</v></c><c r="D3" s="3"><v>-700</v></c>`,
+				},
+				"xl/styles.xml": {
+					`formatCode="yyyy-mm-dd"`,
+				},
+			},
+		},
+		{
+			name: "applies the CSV filters",
+			repo: buildMockRepoForXLSXWithFilters(
+				[]domaintransaction.Transaction{buildExpense("tx-1", 50.00, "acc-1", "cat-1", "Groceries")},
+				domainexport.Filters{StartDate: "2026-02-01", EndDate: "2026-02-28", Type: "expense", AccountID: "acc-1"},
+			),
+			filters: domainexport.Filters{StartDate: "2026-02-01", EndDate: "2026-02-28", Type: "expense", AccountID: "acc-1"},
+			wantParts: map[string][]string{
+				"xl/worksheets/sheet1.xml": {`<t xml:space="preserve">Uncategorized</t>`},
+			},
+		},
+		{
+			name:    "repository error is propagated",
+			repo:    buildMockRepoForCSV(nil, nil, nil, errors.New("db error")),
+			wantErr: fmt.Errorf("export xlsx: %w", errors.New("db error")),
+		},
+		{
+			name:    "categories error is propagated",
+			repo:    buildMockRepoForCSVWithCategoriesError(),
+			wantErr: fmt.Errorf("export xlsx: %w", errors.New("categories error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := export.New(tc.repo)
+			data, err := uc.ExportXLSX(context.Background(), tc.filters)
+
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				parts := readXLSXParts(t, data)
+				assert.Contains(t, parts, "[Content_Types].xml")
+				for name, wants := range tc.wantParts {
+					for _, want := range wants {
+						assert.Contains(t, parts[name], want)
+					}
+				}
+			}
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_ExportXLSX_FromSQLite(t *testing.T) {
+	t.Parallel()
+
+	dbs := openTestDatabases(t)
+	seedAccount(t, dbs, "acc-1", "Banco")
+	seedTransaction(t, dbs, "tx-1", "acc-1", "cat-income-01", "income", 1000, "2026-02-27", "Salary")
+	seedTransaction(t, dbs, "tx-2", "acc-1", "cat-expense-01", "expense", 80, "2026-03-14", "Groceries")
+
+	uc := export.New(exportsqlite.NewExportRepository(dbs.Accounts, dbs.Categories, dbs.Transactions))
+	data, err := uc.ExportXLSX(context.Background(), domainexport.Filters{})
+
+	assert.NoError(t, err)
+	parts := readXLSXParts(t, data)
+	// Transactions are listed newest first; 46095 is 2026-03-14 and 46080 is 2026-02-27.
+	assert.Contains(t, parts["xl/worksheets/sheet1.xml"], `<c r="A2" s="2"><v>46095</v></c>`)
+	assert.Contains(t, parts["xl/worksheets/sheet1.xml"], `<c r="A3" s="2"><v>46080</v></c>`)
+	assert.Contains(t, parts["xl/worksheets/sheet4.xml"], `<c r="A2" s="0" t="inlineStr"><is><t xml:space="preserve">2026-02</t></is></c><c r="B2" s="3"><v>1000</v></c>`)
+	assert.Contains(t, parts["xl/worksheets/sheet4.xml"], `<c r="A3" s="0" t="inlineStr"><is><t xml:space="preserve">2026-03</t></is></c><c r="B3" s="3"><v>0</v></c><c r="C3" s="3"><v>80</v></c>`)
+	assert.NotContains(t, parts["xl/worksheets/sheet4.xml"], "0001-01")
+}
+
+func TestUseCase_ExportLedger(t *testing.T) {
+	t.Parallel()
+
+	created, _ := time.Parse("2006-01-02", "2026-01-15")
+	accounts := []domainaccount.Account{
+		{ID: "acc-1", Name: "Banco de Bogotá", Type: domainaccount.AccountTypeBank, Currency: "COP", InitialBalance: 500, CreatedAt: created},
+		{ID: "acc-2", Name: "Visa: Oro", Type: domainaccount.AccountTypeCreditCard, Currency: "usd", CreatedAt: created},
+	}
+	categories := []domaincategory.Category{
+		{ID: "cat-1", Name: "Alimentación", Type: domaincategory.TypeExpense},
+		{ID: "cat-2", Name: "Salario", Type: domaincategory.TypeIncome},
+	}
+	salary := buildIncome("tx-1", 1000.00, "acc-1", "Pago \"quincena\"")
+	salary.CategoryID = "cat-2"
+	transactions := []domaintransaction.Transaction{
+		buildExpense("tx-2", 50.00, "acc-2", "cat-1", "Groceries"),
+		salary,
+	}
+
+	tests := []struct {
+		name        string
+		repo        *mocks.Repository
+		format      export.LedgerFormat
+		wantErr     error
+		wantJournal string
+	}{
+		{
+			name:   "exports ledger journal",
+			repo:   buildMockRepoForLedger(accounts, categories, transactions),
+			format: export.LedgerFormatLedger,
+			wantJournal: "account Assets:Bank:Banco de Bogotá\n" +
+				"account Equity:Opening-Balances\n" +
+				"account Liabilities:CreditCard:Visa- Oro\n" +
+				"account Income:Salario\n" +
+				"account Expenses:Alimentación\n" +
+				"\n" +
+				"2026-01-15 * Opening balance Banco de Bogotá\n" +
+				"    Assets:Bank:Banco de Bogotá                     500.00 COP\n" +
+				"    Equity:Opening-Balances                        -500.00 COP\n" +
+				"\n" +
+				"2026-02-28 * Pago \"quincena\"\n" +
+				"    Income:Salario                                -1000.00 COP\n" +
+				"    Assets:Bank:Banco de Bogotá                    1000.00 COP\n" +
+				"\n" +
+				"2026-02-28 * Groceries\n" +
+				"    Expenses:Alimentación                            50.00 USD\n" +
+				"    Liabilities:CreditCard:Visa- Oro                -50.00 USD\n",
+		},
+		{
+			name:   "exports beancount file",
+			repo:   buildMockRepoForLedger(accounts, categories, transactions),
+			format: export.LedgerFormatBeancount,
+			wantJournal: "option \"operating_currency\" \"USD\"\n" +
+				"\n" +
+				"2026-01-15 open Assets:Bank:Banco-De-Bogota COP\n" +
+				"2026-01-15 open Equity:Opening-Balances\n" +
+				"2026-01-15 open Liabilities:CreditCard:Visa-Oro USD\n" +
+				"2026-01-15 open Income:Salario\n" +
+				"2026-01-15 open Expenses:Alimentacion\n" +
+				"\n" +
+				"2026-01-15 * \"Opening balance Banco de Bogotá\"\n" +
+				"  Assets:Bank:Banco-De-Bogota                     500.00 COP\n" +
+				"  Equity:Opening-Balances                        -500.00 COP\n" +
+				"\n" +
+				"2026-02-28 * \"Pago \\\"quincena\\\"\"\n" +
+				"  Income:Salario                                -1000.00 COP\n" +
+				"  Assets:Bank:Banco-De-Bogota                    1000.00 COP\n" +
+				"\n" +
+				"2026-02-28 * \"Groceries\"\n" +
+				"  Expenses:Alimentacion                            50.00 USD\n" +
+				"  Liabilities:CreditCard:Visa-Oro                 -50.00 USD\n",
+		},
+		{
+			name: "names that sanitize to the same account get a suffix",
+			repo: buildMockRepoForLedger(
+				[]domainaccount.Account{
+					{ID: "acc-1", Name: "Banco Uno", Type: domainaccount.AccountTypeBank, CreatedAt: created},
+					{ID: "acc-2", Name: "Banco: Uno!", Type: domainaccount.AccountTypeBank, CreatedAt: created},
+				},
+				[]domaincategory.Category{
+					{ID: "cat-1", Name: "Café", Type: domaincategory.TypeExpense},
+					{ID: "cat-2", Name: "Cafe", Type: domaincategory.TypeExpense},
+				},
+				[]domaintransaction.Transaction{
+					buildExpense("tx-1", 10.00, "acc-1", "cat-1", "Latte"),
+					buildExpense("tx-2", 20.00, "acc-2", "cat-2", "Beans"),
+				},
+			),
+			format: export.LedgerFormatBeancount,
+			wantJournal: "option \"operating_currency\" \"USD\"\n" +
+				"\n" +
+				"2026-01-15 open Assets:Bank:Banco-Uno USD\n" +
+				"2026-01-15 open Assets:Bank:Banco-Uno-2 USD\n" +
+				"2026-01-15 open Expenses:Cafe\n" +
+				"2026-01-15 open Expenses:Cafe-2\n" +
+				"\n" +
+				"2026-02-28 * \"Latte\"\n" +
+				"  Expenses:Cafe                                    10.00 USD\n" +
+				"  Assets:Bank:Banco-Uno                           -10.00 USD\n" +
+				"\n" +
+				"2026-02-28 * \"Beans\"\n" +
+				"  Expenses:Cafe-2                                  20.00 USD\n" +
+				"  Assets:Bank:Banco-Uno-2                         -20.00 USD\n",
+		},
+		{
+			name:    "unsupported format is rejected",
+			repo:    &mocks.Repository{},
+			format:  "qif",
+			wantErr: fmt.Errorf("export ledger: %w", export.ErrUnsupportedLedgerFormat),
+		},
+		{
+			name:    "repository error is propagated",
+			repo:    buildMockRepoForCSV(nil, nil, nil, errors.New("db error")),
+			format:  export.LedgerFormatHledger,
+			wantErr: fmt.Errorf("export ledger: %w", errors.New("db error")),
+		},
+		{
+			name:    "categories error is propagated",
+			repo:    buildMockRepoForCSVWithCategoriesError(),
+			format:  export.LedgerFormatLedger,
+			wantErr: fmt.Errorf("export ledger: %w", errors.New("categories error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := export.New(tc.repo)
+			journal, err := uc.ExportLedger(context.Background(), tc.format)
+
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantJournal, journal)
+			}
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
+
 // normalizeCSV normalizes CSV string for comparison (handles line endings).
+func TestUseCase_ExportLedger_FromSQLite(t *testing.T) {
+	t.Parallel()
+
+	dbs := openTestDatabases(t)
+	seedAccount(t, dbs, "acc-1", "Banco")
+	seedTransaction(t, dbs, "tx-1", "acc-1", "cat-expense-01", "expense", 80, "2026-03-14", "Groceries")
+
+	uc := export.New(exportsqlite.NewExportRepository(dbs.Accounts, dbs.Categories, dbs.Transactions))
+	journal, err := uc.ExportLedger(context.Background(), export.LedgerFormatLedger)
+
+	assert.NoError(t, err)
+	assert.Contains(t, journal, "2026-03-14 * Groceries\n")
+	assert.NotContains(t, journal, "0001-01-01")
+}
+
 func normalizeCSV(s string) string {
 	return strings.ReplaceAll(s, "\r\n", "\n")
 }
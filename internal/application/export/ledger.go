@@ -0,0 +1,331 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// LedgerFormat selects the plain-text accounting syntax produced by ExportLedger.
+type LedgerFormat string
+
+const (
+	// LedgerFormatLedger produces a ledger journal, which hledger also reads.
+	LedgerFormatLedger LedgerFormat = "ledger"
+	// LedgerFormatHledger is an alias of LedgerFormatLedger.
+	LedgerFormatHledger LedgerFormat = "hledger"
+	// LedgerFormatBeancount produces a beancount file.
+	LedgerFormatBeancount LedgerFormat = "beancount"
+
+	// openingBalancesAccount is the equity account that funds initial balances.
+	openingBalancesAccount = "Equity:Opening-Balances"
+	// defaultCurrency is used for accounts without a currency, matching the accounts schema default.
+	defaultCurrency = "USD"
+)
+
+// ErrUnsupportedLedgerFormat is returned when ExportLedger receives an unknown format.
+var ErrUnsupportedLedgerFormat = errors.New("unsupported ledger format: must be 'ledger', 'hledger' or 'beancount'")
+
+type (
+	// posting is one leg of a journal entry.
+	posting struct {
+		account  string
+		amount   float64
+		currency string
+	}
+
+	// entry is a balanced journal entry.
+	entry struct {
+		date      time.Time
+		narration string
+		postings  []posting
+	}
+
+	// journal is the format-agnostic representation of the books.
+	journal struct {
+		openDate time.Time
+		accounts []journalAccount
+		entries  []entry
+	}
+
+	// journalAccount is an account declaration with the currency it holds.
+	journalAccount struct {
+		name     string
+		currency string
+	}
+
+	// accountNamer builds unique journal account names. Sanitizing can map
+	// different names to the same component, so later owners of a taken name
+	// get a numeric suffix instead of sharing another owner's account.
+	accountNamer struct {
+		name   func(string) string
+		owners map[string]string
+	}
+)
+
+// ExportLedger exports accounts, categories and active transactions as a
+// plain-text accounting journal. Accounts map to Assets: or Liabilities:
+// (credit cards), categories to Expenses: or Income:, and initial balances are
+// posted against Equity:Opening-Balances. Every entry has two postings that
+// sum to zero in the account's currency. Transactions are either income or
+// expenses, so there are no transfers between accounts to render.
+func (uc *UseCase) ExportLedger(ctx context.Context, format LedgerFormat) (string, error) {
+	switch format {
+	case LedgerFormatLedger, LedgerFormatHledger, LedgerFormatBeancount:
+	default:
+		return "", fmt.Errorf("export ledger: %w", ErrUnsupportedLedgerFormat)
+	}
+
+	accounts, err := uc.repo.ListAccounts(ctx)
+	if err != nil {
+		return "", fmt.Errorf("export ledger: %w", err)
+	}
+
+	categories, err := uc.repo.ListCategories(ctx)
+	if err != nil {
+		return "", fmt.Errorf("export ledger: %w", err)
+	}
+
+	transactions, err := uc.repo.ListTransactions(ctx, "", "", "")
+	if err != nil {
+		return "", fmt.Errorf("export ledger: %w", err)
+	}
+
+	name := ledgerName
+	if format == LedgerFormatBeancount {
+		name = beancountName
+	}
+
+	j := buildJournal(accounts, categories, transactions, name)
+	if format == LedgerFormatBeancount {
+		return j.beancount(), nil
+	}
+	return j.ledger(), nil
+}
+
+// buildJournal converts the domain data into balanced entries. name sanitizes
+// a single account name component for the target syntax.
+func buildJournal(
+	accounts []domainaccount.Account,
+	categories []domaincategory.Category,
+	transactions []domaintransaction.Transaction,
+	name func(string) string,
+) journal {
+	sorted := make([]domaintransaction.Transaction, len(transactions))
+	copy(sorted, transactions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].Date.Equal(sorted[j].Date) {
+			return sorted[i].Date.Before(sorted[j].Date)
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	j := journal{openDate: earliestDate(accounts, sorted)}
+
+	declared := make(map[string]bool)
+	declare := func(account, currency string) {
+		if declared[account] {
+			return
+		}
+		declared[account] = true
+		j.accounts = append(j.accounts, journalAccount{name: account, currency: currency})
+	}
+
+	namer := accountNamer{name: name, owners: make(map[string]string)}
+	accountNames := make(map[string]string)
+	currencies := make(map[string]string)
+	for _, acc := range accounts {
+		currency := strings.ToUpper(strings.TrimSpace(acc.Currency))
+		if currency == "" {
+			currency = defaultCurrency
+		}
+		accountNames[acc.ID] = namer.unique(assetAccount(acc.Type), acc.Name, "account:"+acc.ID)
+		currencies[acc.ID] = currency
+		declare(accountNames[acc.ID], currency)
+
+		if acc.InitialBalance != 0 {
+			declare(openingBalancesAccount, "")
+			j.entries = append(j.entries, entry{
+				date:      j.openDate,
+				narration: "Opening balance " + acc.Name,
+				postings: []posting{
+					{account: accountNames[acc.ID], amount: acc.InitialBalance, currency: currency},
+					{account: openingBalancesAccount, amount: -acc.InitialBalance, currency: currency},
+				},
+			})
+		}
+	}
+
+	categoryNames := make(map[string]string)
+	for _, cat := range categories {
+		categoryNames[cat.ID] = cat.Name
+	}
+
+	for _, tx := range sorted {
+		source, ok := accountNames[tx.AccountID]
+		if !ok {
+			source = namer.unique("Assets", "Unknown", "account:unknown")
+			accountNames[tx.AccountID] = source
+			currencies[tx.AccountID] = defaultCurrency
+			declare(source, defaultCurrency)
+		}
+		currency := currencies[tx.AccountID]
+
+		parent := "Expenses"
+		amount := -tx.Amount
+		if tx.Type == domaintransaction.TransactionTypeIncome {
+			parent = "Income"
+			amount = tx.Amount
+		}
+		categoryName, owner := categoryNames[tx.CategoryID], "category:"+tx.CategoryID
+		if categoryName == "" {
+			categoryName, owner = "Uncategorized", "category:uncategorized"
+		}
+		target := namer.unique(parent, categoryName, owner)
+		declare(target, "")
+
+		j.entries = append(j.entries, entry{
+			date:      tx.Date,
+			narration: tx.Description,
+			postings: []posting{
+				{account: target, amount: -amount, currency: currency},
+				{account: source, amount: amount, currency: currency},
+			},
+		})
+	}
+
+	sort.SliceStable(j.entries, func(a, b int) bool {
+		return j.entries[a].date.Before(j.entries[b].date)
+	})
+
+	return j
+}
+
+// ledger renders the journal in ledger syntax, which hledger reads as well.
+func (j journal) ledger() string {
+	var b strings.Builder
+	for _, acc := range j.accounts {
+		fmt.Fprintf(&b, "account %s\n", acc.name)
+	}
+	for _, e := range j.entries {
+		b.WriteString("\n")
+		fmt.Fprintf(&b, "%s * %s\n", e.date.Format("2006-01-02"), singleLine(e.narration))
+		for _, p := range e.postings {
+			fmt.Fprintf(&b, "    %-40s  %12.2f %s\n", p.account, p.amount, p.currency)
+		}
+	}
+	return b.String()
+}
+
+// beancount renders the journal in beancount syntax, opening every account before its first use.
+func (j journal) beancount() string {
+	var b strings.Builder
+	b.WriteString("option \"operating_currency\" \"" + defaultCurrency + "\"\n\n")
+	for _, acc := range j.accounts {
+		fmt.Fprintf(&b, "%s open %s", j.openDate.Format("2006-01-02"), acc.name)
+		if acc.currency != "" {
+			fmt.Fprintf(&b, " %s", acc.currency)
+		}
+		b.WriteString("\n")
+	}
+	for _, e := range j.entries {
+		b.WriteString("\n")
+		narration := strings.ReplaceAll(singleLine(e.narration), `"`, `\"`)
+		fmt.Fprintf(&b, "%s * \"%s\"\n", e.date.Format("2006-01-02"), narration)
+		for _, p := range e.postings {
+			fmt.Fprintf(&b, "  %-40s  %12.2f %s\n", p.account, p.amount, p.currency)
+		}
+	}
+	return b.String()
+}
+
+// assetAccount returns the top-level hierarchy for an account type.
+// Credit cards are liabilities; everything else is an asset.
+func assetAccount(t domainaccount.AccountType) string {
+	switch t {
+	case domainaccount.AccountTypeCreditCard:
+		return "Liabilities:CreditCard"
+	case domainaccount.AccountTypeCash:
+		return "Assets:Cash"
+	case domainaccount.AccountTypeSavings:
+		return "Assets:Savings"
+	case domainaccount.AccountTypeBank:
+		return "Assets:Bank"
+	default:
+		return "Assets"
+	}
+}
+
+// earliestDate returns the first day the journal needs: the earliest account
+// creation or transaction date, or the Unix epoch when there is neither.
+func earliestDate(accounts []domainaccount.Account, sorted []domaintransaction.Transaction) time.Time {
+	var first time.Time
+	for _, acc := range accounts {
+		if !acc.CreatedAt.IsZero() && (first.IsZero() || acc.CreatedAt.Before(first)) {
+			first = acc.CreatedAt
+		}
+	}
+	if len(sorted) > 0 && (first.IsZero() || sorted[0].Date.Before(first)) {
+		first = sorted[0].Date
+	}
+	if first.IsZero() {
+		return time.Unix(0, 0).UTC()
+	}
+	return time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// unique returns parent:component for the sanitized raw name, suffixed with
+// -2, -3 and so on when another owner already uses that account name.
+func (n accountNamer) unique(parent, raw, owner string) string {
+	base := parent + ":" + n.name(raw)
+	account := base
+	for i := 2; ; i++ {
+		taken, ok := n.owners[account]
+		if !ok || taken == owner {
+			break
+		}
+		account = fmt.Sprintf("%s-%d", base, i)
+	}
+	n.owners[account] = owner
+	return account
+}
+
+// ledgerName makes s safe as a ledger account component: colons would start a
+// new level and two consecutive spaces would end the account name.
+func ledgerName(s string) string {
+	s = strings.Join(strings.Fields(strings.ReplaceAll(s, ":", "-")), " ")
+	if s == "" {
+		return "Unnamed"
+	}
+	return s
+}
+
+// beancountName makes s a valid beancount account component: ASCII letters,
+// digits and dashes, starting with an uppercase letter or a digit.
+func beancountName(s string) string {
+	words := strings.FieldsFunc(domainshared.FoldAccents(s), func(r rune) bool {
+		return r > unicode.MaxASCII || (!unicode.IsLetter(r) && !unicode.IsDigit(r))
+	})
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	name := strings.Join(words, "-")
+	if name == "" {
+		return "Unnamed"
+	}
+	return name
+}
+
+// singleLine collapses s onto one line so it cannot break the journal layout.
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
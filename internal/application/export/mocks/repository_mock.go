@@ -8,6 +8,7 @@ import (
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -36,3 +37,16 @@ func (m *Repository) ListTransactions(ctx context.Context, tType domaintransacti
 	transactions, _ := args.Get(0).([]domaintransaction.Transaction)
 	return transactions, args.Error(1)
 }
+
+// StreamTransactions mocks Repository.StreamTransactions. It calls fn for each
+// transaction in the first return value and then returns the second one.
+func (m *Repository) StreamTransactions(ctx context.Context, filters domainexport.Filters, fn func(domaintransaction.Transaction) error) error {
+	args := m.Called(ctx, filters)
+	transactions, _ := args.Get(0).([]domaintransaction.Transaction)
+	for _, tx := range transactions {
+		if err := fn(tx); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
@@ -1,14 +1,24 @@
 package export_test
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
 	"errors"
+	"io"
+	"testing"
 
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/financial-manager/api/internal/application/export/mocks"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	"github.com/financial-manager/api/internal/platform/database"
+	"github.com/financial-manager/api/internal/platform/database/migrator"
+	"github.com/financial-manager/api/internal/platform/database/sqlite"
 )
 
 // buildMockRepoForCSV creates a mocks.Repository pre-configured for CSV export tests.
@@ -27,7 +37,7 @@ func buildMockRepoForCSV(
 
 	m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
 	m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
-	m.On("ListTransactions", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(transactions, nil).Once()
+	m.On("StreamTransactions", mock.Anything, mock.Anything).Return(transactions, nil).Once()
 
 	return m
 }
@@ -44,14 +54,7 @@ func buildMockRepoForCSVWithType(
 
 	m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
 	m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
-
-	var tType domaintransaction.TransactionType
-	if txType == "income" {
-		tType = domaintransaction.TransactionTypeIncome
-	} else if txType == "expense" {
-		tType = domaintransaction.TransactionTypeExpense
-	}
-	m.On("ListTransactions", mock.Anything, tType, "", "").Return(transactions, err).Once()
+	m.On("StreamTransactions", mock.Anything, domainexport.Filters{Type: txType}).Return(transactions, err).Once()
 
 	return m
 }
@@ -81,8 +84,8 @@ func buildMockRepoForJSON(
 
 	m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
 	m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
-	m.On("ListTransactions", mock.Anything, domaintransaction.TransactionTypeIncome, "", "").Return(incomes, nil).Once()
-	m.On("ListTransactions", mock.Anything, domaintransaction.TransactionTypeExpense, "", "").Return(expenses, nil).Once()
+	m.On("StreamTransactions", mock.Anything, domainexport.Filters{Type: "income"}).Return(incomes, nil).Once()
+	m.On("StreamTransactions", mock.Anything, domainexport.Filters{Type: "expense"}).Return(expenses, nil).Once()
 
 	return m
 }
@@ -95,12 +98,102 @@ func buildMockRepoForJSONWithCategoriesError() *mocks.Repository {
 	return m
 }
 
-// buildMockRepoForJSONWithExpensesError creates a mock that returns error on ListTransactions for expenses.
+// buildMockRepoForJSONWithExpensesError creates a mock that returns error on StreamTransactions for expenses.
 func buildMockRepoForJSONWithExpensesError() *mocks.Repository {
 	m := &mocks.Repository{}
 	m.On("ListAccounts", mock.Anything).Return([]domainaccount.Account{{ID: "acc-1", Name: "Banco"}}, nil).Once()
 	m.On("ListCategories", mock.Anything).Return([]domaincategory.Category{{ID: "cat-1", Name: "Food"}}, nil).Once()
-	m.On("ListTransactions", mock.Anything, domaintransaction.TransactionTypeIncome, "", "").Return([]domaintransaction.Transaction{}, nil).Once()
-	m.On("ListTransactions", mock.Anything, domaintransaction.TransactionTypeExpense, "", "").Return([]domaintransaction.Transaction(nil), errors.New("expenses error")).Once()
+	m.On("StreamTransactions", mock.Anything, domainexport.Filters{Type: "income"}).Return([]domaintransaction.Transaction{}, nil).Once()
+	m.On("StreamTransactions", mock.Anything, domainexport.Filters{Type: "expense"}).Return([]domaintransaction.Transaction(nil), errors.New("expenses error")).Once()
+	return m
+}
+
+// buildMockRepoForXLSXWithFilters creates a mocks.Repository for XLSX export expecting the given filters.
+func buildMockRepoForXLSXWithFilters(
+	transactions []domaintransaction.Transaction,
+	filters domainexport.Filters,
+) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("ListAccounts", mock.Anything).Return([]domainaccount.Account{{ID: "acc-1", Name: "Banco"}}, nil).Once()
+	m.On("ListCategories", mock.Anything).Return([]domaincategory.Category{}, nil).Once()
+	m.On("StreamTransactions", mock.Anything, filters).Return(transactions, nil).Once()
+	return m
+}
+
+// buildMockRepoForLedger creates a mocks.Repository for ledger export, which lists every transaction.
+func buildMockRepoForLedger(
+	accounts []domainaccount.Account,
+	categories []domaincategory.Category,
+	transactions []domaintransaction.Transaction,
+) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
+	m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
+	m.On("ListTransactions", mock.Anything, domaintransaction.TransactionType(""), "", "").Return(transactions, nil).Once()
 	return m
 }
+
+// readXLSXParts unzips an XLSX workbook and returns the content of each part keyed by name.
+func readXLSXParts(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("open xlsx: %v", err)
+	}
+
+	parts := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open part %s: %v", f.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatalf("read part %s: %v", f.Name, err)
+		}
+		parts[f.Name] = string(content)
+	}
+	return parts
+}
+
+// failingWriter is an io.Writer whose every write fails with err.
+type failingWriter struct {
+	err error
+}
+
+func (w failingWriter) Write([]byte) (int, error) {
+	return 0, w.err
+}
+
+// openTestDatabases opens and migrates the application databases in a
+// temporary directory, closing them when the test ends.
+func openTestDatabases(t *testing.T) *database.Databases {
+	t.Helper()
+
+	dbs := database.New(sqlite.NewConnector(), migrator.New())
+	require.NoError(t, dbs.Open(context.Background(), t.TempDir()))
+	t.Cleanup(func() { _ = dbs.Close() })
+
+	return dbs
+}
+
+// seedAccount inserts an active account into the accounts database.
+func seedAccount(t *testing.T, dbs *database.Databases, id, name string) {
+	t.Helper()
+
+	_, err := dbs.Accounts.Exec(`INSERT INTO accounts (id, name, type, initial_balance, current_balance, currency, is_active, created_at, updated_at)
+		VALUES (?, ?, 'bank', 0, 0, 'USD', 1, '2026-01-01T00:00:00Z', '2026-01-01T00:00:00Z')`, id, name)
+	require.NoError(t, err)
+}
+
+// seedTransaction inserts an active transaction into the transactions database.
+func seedTransaction(t *testing.T, dbs *database.Databases, id, accountID, categoryID, tType string, amount float64, date, description string) {
+	t.Helper()
+
+	_, err := dbs.Transactions.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, 1, '2026-01-01T00:00:00Z', '2026-01-01T00:00:00Z')`,
+		id, accountID, categoryID, tType, amount, description, date)
+	require.NoError(t, err)
+}
@@ -0,0 +1,234 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// cellKind tells the workbook writer how to encode a cell value.
+	cellKind int
+
+	// cell is a single typed spreadsheet cell.
+	cell struct {
+		kind  cellKind
+		text  string
+		value float64
+		style int
+	}
+
+	// sheet is a named worksheet made of rows of cells.
+	sheet struct {
+		name string
+		rows [][]cell
+	}
+
+	// workbook is a minimal Office Open XML (XLSX) writer. It supports only what
+	// the exports need (inline strings, numbers, dates and a bold header style)
+	// and depends on nothing but the standard library.
+	workbook struct {
+		sheets []*sheet
+	}
+)
+
+const (
+	// kindText stores the cell as an inline string.
+	kindText cellKind = iota
+	// kindNumber stores the cell as a numeric value.
+	kindNumber
+)
+
+const (
+	// styleDefault is the unformatted cell style.
+	styleDefault = 0
+	// styleHeader renders header cells in bold.
+	styleHeader = 1
+	// styleDate renders a date serial as YYYY-MM-DD.
+	styleDate = 2
+	// styleMoney renders numbers with thousands separators and two decimals.
+	styleMoney = 3
+)
+
+// excelEpoch is day zero of the spreadsheet date system (1900 system with the leap year bug).
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// textCell returns a string cell.
+func textCell(s string) cell {
+	return cell{kind: kindText, text: s, style: styleDefault}
+}
+
+// headerCell returns a bold string cell.
+func headerCell(s string) cell {
+	return cell{kind: kindText, text: s, style: styleHeader}
+}
+
+// moneyCell returns a numeric cell formatted as an amount.
+func moneyCell(v float64) cell {
+	return cell{kind: kindNumber, value: v, style: styleMoney}
+}
+
+// intCell returns a plain numeric cell.
+func intCell(v int) cell {
+	return cell{kind: kindNumber, value: float64(v), style: styleDefault}
+}
+
+// dateCell returns a typed date cell so spreadsheets can sort and filter it as a date.
+func dateCell(t time.Time) cell {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return cell{kind: kindNumber, value: day.Sub(excelEpoch).Hours() / 24, style: styleDate}
+}
+
+// addSheet appends a new worksheet with a bold header row and returns it.
+func (wb *workbook) addSheet(name string, header ...string) *sheet {
+	s := &sheet{name: name}
+	if len(header) > 0 {
+		row := make([]cell, len(header))
+		for i, h := range header {
+			row[i] = headerCell(h)
+		}
+		s.rows = append(s.rows, row)
+	}
+	wb.sheets = append(wb.sheets, s)
+	return s
+}
+
+// addRow appends a row of cells to the sheet.
+func (s *sheet) addRow(cells ...cell) {
+	s.rows = append(s.rows, cells)
+}
+
+// write serializes the workbook as an XLSX (zip) package to w.
+func (wb *workbook) write(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", wb.contentTypes()},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", wb.workbookXML()},
+		{"xl/_rels/workbook.xml.rels", wb.workbookRels()},
+		{"xl/styles.xml", stylesXML},
+	}
+	for i, s := range wb.sheets {
+		parts = append(parts, struct {
+			name    string
+			content string
+		}{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), s.xml()})
+	}
+
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return fmt.Errorf("xlsx: create %s: %w", p.name, err)
+		}
+		if _, err := io.WriteString(f, p.content); err != nil {
+			return fmt.Errorf("xlsx: write %s: %w", p.name, err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("xlsx: close: %w", err)
+	}
+	return nil
+}
+
+func (wb *workbook) contentTypes() string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := range wb.sheets {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+func (wb *workbook) workbookXML() string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, s := range wb.sheets {
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escapeXML(s.name), i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	return b.String()
+}
+
+func (wb *workbook) workbookRels() string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := range wb.sheets {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(wb.sheets)+1)
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+func (s *sheet) xml() string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for r, row := range s.rows {
+		fmt.Fprintf(&b, `<row r="%d">`, r+1)
+		for c, cl := range row {
+			ref := columnName(c) + strconv.Itoa(r+1)
+			switch cl.kind {
+			case kindNumber:
+				fmt.Fprintf(&b, `<c r="%s" s="%d"><v>%s</v></c>`, ref, cl.style, strconv.FormatFloat(cl.value, 'f', -1, 64))
+			default:
+				fmt.Fprintf(&b, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, cl.style, escapeXML(cl.text))
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+// columnName converts a zero-based column index into its spreadsheet letters (0 → A, 26 → AA).
+func columnName(i int) string {
+	name := ""
+	for i >= 0 {
+		name = string(rune('A'+i%26)) + name
+		i = i/26 - 1
+	}
+	return name
+}
+
+// escapeXML escapes s for use in XML text and attribute values.
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+const rootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// stylesXML declares the cell formats referenced by the style constants, in order.
+const stylesXML = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs></styleSheet>`
@@ -0,0 +1,209 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// ExportXLSX exports transactions to an XLSX workbook with four sheets:
+// the filtered transactions, accounts with their balances, a per-category
+// summary and a monthly pivot of income, expenses and expense categories.
+func (uc *UseCase) ExportXLSX(ctx context.Context, filters domainexport.Filters) ([]byte, error) {
+	if err := validateFilters(filters); err != nil {
+		return nil, fmt.Errorf("export xlsx: %w", err)
+	}
+
+	accounts, err := uc.repo.ListAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export xlsx: %w", err)
+	}
+
+	categories, err := uc.repo.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export xlsx: %w", err)
+	}
+
+	// The workbook is zipped as a whole, so the transactions are collected.
+	var transactions []domaintransaction.Transaction
+	err = uc.repo.StreamTransactions(ctx, filters, func(tx domaintransaction.Transaction) error {
+		transactions = append(transactions, tx)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export xlsx: %w", err)
+	}
+
+	accountMap := make(map[string]string)
+	for _, acc := range accounts {
+		accountMap[acc.ID] = acc.Name
+	}
+
+	categoryMap := make(map[string]string)
+	for _, cat := range categories {
+		categoryMap[cat.ID] = cat.Name
+	}
+
+	wb := &workbook{}
+	writeTransactionsSheet(wb, transactions, accountMap, categoryMap)
+	writeAccountsSheet(wb, accounts)
+	writeCategoriesSheet(wb, transactions, categoryMap)
+	writeMonthlySheet(wb, transactions, categoryMap)
+
+	var buf bytes.Buffer
+	if err := wb.write(&buf); err != nil {
+		return nil, fmt.Errorf("export xlsx: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeTransactionsSheet adds one row per transaction with typed date and amount cells.
+func writeTransactionsSheet(wb *workbook, transactions []domaintransaction.Transaction, accountMap, categoryMap map[string]string) {
+	s := wb.addSheet("Transactions", "Date", "Type", "Amount", "Category", "Account", "Description")
+	for _, tx := range transactions {
+		accountName := accountMap[tx.AccountID]
+		if accountName == "" {
+			accountName = "Unknown"
+		}
+		s.addRow(
+			dateCell(tx.Date),
+			textCell(string(tx.Type)),
+			moneyCell(tx.Amount),
+			textCell(categoryName(tx, categoryMap)),
+			textCell(accountName),
+			textCell(tx.Description),
+		)
+	}
+}
+
+// writeAccountsSheet adds one row per account with its initial and current balance.
+func writeAccountsSheet(wb *workbook, accounts []domainaccount.Account) {
+	s := wb.addSheet("Accounts", "Name", "Type", "Currency", "Initial Balance", "Current Balance")
+	for _, acc := range accounts {
+		s.addRow(
+			textCell(acc.Name),
+			textCell(string(acc.Type)),
+			textCell(acc.Currency),
+			moneyCell(acc.InitialBalance),
+			moneyCell(acc.CurrentBalance),
+		)
+	}
+}
+
+// writeCategoriesSheet adds the transaction count and total per category and type,
+// sorted by type and then by category name.
+func writeCategoriesSheet(wb *workbook, transactions []domaintransaction.Transaction, categoryMap map[string]string) {
+	type key struct {
+		tType    domaintransaction.TransactionType
+		category string
+	}
+	type totals struct {
+		count int
+		total float64
+	}
+
+	summary := make(map[key]*totals)
+	for _, tx := range transactions {
+		k := key{tType: tx.Type, category: categoryName(tx, categoryMap)}
+		if summary[k] == nil {
+			summary[k] = &totals{}
+		}
+		summary[k].count++
+		summary[k].total += tx.Amount
+	}
+
+	keys := make([]key, 0, len(summary))
+	for k := range summary {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].tType != keys[j].tType {
+			return keys[i].tType > keys[j].tType
+		}
+		return keys[i].category < keys[j].category
+	})
+
+	s := wb.addSheet("Categories", "Category", "Type", "Transactions", "Total")
+	for _, k := range keys {
+		s.addRow(
+			textCell(k.category),
+			textCell(string(k.tType)),
+			intCell(summary[k].count),
+			moneyCell(summary[k].total),
+		)
+	}
+}
+
+// writeMonthlySheet adds one row per month with income, expenses, net and a column
+// for each expense category, so the sheet reads as a month × category pivot.
+func writeMonthlySheet(wb *workbook, transactions []domaintransaction.Transaction, categoryMap map[string]string) {
+	type month struct {
+		income     float64
+		expense    float64
+		byCategory map[string]float64
+	}
+
+	months := make(map[string]*month)
+	categorySet := make(map[string]struct{})
+	for _, tx := range transactions {
+		label := tx.Date.Format("2006-01")
+		if months[label] == nil {
+			months[label] = &month{byCategory: make(map[string]float64)}
+		}
+		m := months[label]
+		if tx.Type == domaintransaction.TransactionTypeIncome {
+			m.income += tx.Amount
+			continue
+		}
+		name := categoryName(tx, categoryMap)
+		m.expense += tx.Amount
+		m.byCategory[name] += tx.Amount
+		categorySet[name] = struct{}{}
+	}
+
+	labels := make([]string, 0, len(months))
+	for label := range months {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	columns := make([]string, 0, len(categorySet))
+	for name := range categorySet {
+		columns = append(columns, name)
+	}
+	sort.Strings(columns)
+
+	header := append([]string{"Month", "Income", "Expense", "Net"}, columns...)
+	s := wb.addSheet("Monthly", header...)
+	for _, label := range labels {
+		m := months[label]
+		row := []cell{
+			textCell(label),
+			moneyCell(m.income),
+			moneyCell(m.expense),
+			moneyCell(m.income - m.expense),
+		}
+		for _, name := range columns {
+			row = append(row, moneyCell(m.byCategory[name]))
+		}
+		s.addRow(row...)
+	}
+}
+
+// categoryName resolves the display name of a transaction's category,
+// falling back to "Income" or "Uncategorized" as the CSV export does.
+func categoryName(tx domaintransaction.Transaction, categoryMap map[string]string) string {
+	if name := categoryMap[tx.CategoryID]; name != "" {
+		return name
+	}
+	if tx.Type == domaintransaction.TransactionTypeIncome {
+		return "Income"
+	}
+	return "Uncategorized"
+}
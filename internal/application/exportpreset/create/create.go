@@ -0,0 +1,65 @@
+// Package create implements the create export preset use case.
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/financial-manager/api/internal/application/export"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+// Input carries the data required to save a new export preset.
+type Input struct {
+	Name    string
+	Options domainexport.CSVOptions
+}
+
+// UseCase implements the create export preset use case.
+type UseCase struct {
+	repo  Repository
+	idGen IDGenerator
+	clock Clock
+}
+
+// New creates a new UseCase.
+func New(repo Repository, idGen IDGenerator, clock Clock) *UseCase {
+	return &UseCase{repo: repo, idGen: idGen, clock: clock}
+}
+
+// Execute validates the options and saves them under a unique name.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (domainexport.Preset, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return domainexport.Preset{}, errors.New("preset name is required")
+	}
+	if err := export.ValidateCSVOptions(in.Options); err != nil {
+		return domainexport.Preset{}, err
+	}
+
+	_, err := uc.repo.GetByName(ctx, name)
+	if err == nil {
+		return domainexport.Preset{}, domainexport.ErrPresetNameTaken
+	}
+	if !errors.Is(err, domainshared.ErrNotFound) {
+		return domainexport.Preset{}, fmt.Errorf("get preset: %w", err)
+	}
+
+	now := uc.clock.Now().UTC()
+	preset := domainexport.Preset{
+		ID:        uc.idGen.NewID(),
+		Name:      name,
+		Options:   in.Options,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := uc.repo.Create(ctx, preset); err != nil {
+		return domainexport.Preset{}, fmt.Errorf("create preset: %w", err)
+	}
+
+	return preset, nil
+}
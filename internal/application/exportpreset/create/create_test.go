@@ -0,0 +1,100 @@
+package create_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/exportpreset/create"
+	"github.com/financial-manager/api/internal/application/exportpreset/create/mocks"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      create.Input
+		repo       *mocks.Repository
+		idGen      *mocks.IDGenerator
+		clock      *mocks.Clock
+		wantErr    error
+		wantErrIs  error
+		wantPreset domainexport.Preset
+	}{
+		{
+			name:       "valid input saves the preset",
+			input:      create.Input{Name: "  Hoja de cálculo ", Options: spanishOptions},
+			repo:       buildMockRepo(validPreset, nil),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			wantPreset: validPreset,
+		},
+		{
+			name:    "empty name returns validation error",
+			input:   create.Input{Name: " "},
+			repo:    &mocks.Repository{},
+			idGen:   &mocks.IDGenerator{},
+			clock:   &mocks.Clock{},
+			wantErr: errors.New("preset name is required"),
+		},
+		{
+			name:      "invalid options return ErrInvalidOptions",
+			input:     create.Input{Name: "Bad", Options: domainexport.CSVOptions{Delimiter: ":"}},
+			repo:      &mocks.Repository{},
+			idGen:     &mocks.IDGenerator{},
+			clock:     &mocks.Clock{},
+			wantErrIs: domainexport.ErrInvalidOptions,
+		},
+		{
+			name:    "taken name returns ErrPresetNameTaken",
+			input:   create.Input{Name: "Hoja de cálculo"},
+			repo:    buildMockRepoForLookup("Hoja de cálculo", validPreset, nil),
+			idGen:   &mocks.IDGenerator{},
+			clock:   &mocks.Clock{},
+			wantErr: domainexport.ErrPresetNameTaken,
+		},
+		{
+			name:    "lookup error is wrapped and propagated",
+			input:   create.Input{Name: "Hoja de cálculo"},
+			repo:    buildMockRepoForLookup("Hoja de cálculo", domainexport.Preset{}, errors.New("db unavailable")),
+			idGen:   &mocks.IDGenerator{},
+			clock:   &mocks.Clock{},
+			wantErr: fmt.Errorf("get preset: %w", errors.New("db unavailable")),
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			input:   create.Input{Name: "Hoja de cálculo", Options: spanishOptions},
+			repo:    buildMockRepo(validPreset, errors.New("db unavailable")),
+			idGen:   buildMockIDGenerator(),
+			clock:   buildMockClock(),
+			wantErr: fmt.Errorf("create preset: %w", errors.New("db unavailable")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := create.New(tc.repo, tc.idGen, tc.clock)
+			got, err := uc.Execute(context.Background(), tc.input)
+
+			switch {
+			case tc.wantErr != nil:
+				assert.Equal(t, tc.wantErr, err)
+			case tc.wantErrIs != nil:
+				assert.ErrorIs(t, err, tc.wantErrIs)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantPreset, got)
+			}
+			tc.repo.AssertExpectations(t)
+			tc.idGen.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+		})
+	}
+}
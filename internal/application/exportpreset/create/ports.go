@@ -0,0 +1,24 @@
+package create
+
+import (
+	"context"
+	"time"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+// Repository is the narrow port required by this use case.
+type Repository interface {
+	GetByName(ctx context.Context, name string) (domainexport.Preset, error)
+	Create(ctx context.Context, preset domainexport.Preset) error
+}
+
+// IDGenerator is the port for unique identifier generation.
+type IDGenerator interface {
+	NewID() string
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
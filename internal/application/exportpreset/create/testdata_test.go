@@ -0,0 +1,64 @@
+package create_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/exportpreset/create/mocks"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+const fixedID = "fixed-uuid-preset001"
+
+// spanishOptions is a valid semicolon and comma-decimal layout.
+var spanishOptions = domainexport.CSVOptions{
+	Columns:          []domainexport.Column{domainexport.ColumnDate, domainexport.ColumnAmount, domainexport.ColumnDescription},
+	Delimiter:        ";",
+	DecimalSeparator: ",",
+	DateFormat:       "DD/MM/YYYY",
+	BOM:              true,
+}
+
+// validPreset is the preset produced by a successful create with spanishOptions.
+var validPreset = domainexport.Preset{
+	ID:        fixedID,
+	Name:      "Hoja de cálculo",
+	Options:   spanishOptions,
+	CreatedAt: fixedTime(),
+	UpdatedAt: fixedTime(),
+}
+
+// buildMockRepo creates a mocks.Repository where name is free and Create returns err.
+func buildMockRepo(preset domainexport.Preset, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("GetByName", mock.Anything, preset.Name).Return(domainexport.Preset{}, domainshared.ErrNotFound).Once()
+	m.On("Create", mock.Anything, preset).Return(err).Once()
+	return m
+}
+
+// buildMockRepoForLookup creates a mocks.Repository whose GetByName returns the given result.
+func buildMockRepoForLookup(name string, existing domainexport.Preset, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("GetByName", mock.Anything, name).Return(existing, err).Once()
+	return m
+}
+
+// buildMockIDGenerator creates a mocks.IDGenerator pre-configured to return fixedID once.
+func buildMockIDGenerator() *mocks.IDGenerator {
+	m := &mocks.IDGenerator{}
+	m.On("NewID").Return(fixedID).Once()
+	return m
+}
+
+// buildMockClock creates a mocks.Clock pre-configured to return fixedTime once.
+func buildMockClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(fixedTime()).Once()
+	return m
+}
+
+func fixedTime() time.Time {
+	return time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,36 @@
+// Package delete implements the delete export preset use case.
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+// UseCase implements the delete export preset use case.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute removes the preset with the given id.
+func (uc *UseCase) Execute(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("preset id is required")
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, domainshared.ErrNotFound) {
+			return fmt.Errorf("preset not found: %w", err)
+		}
+		return fmt.Errorf("delete preset: %w", err)
+	}
+
+	return nil
+}
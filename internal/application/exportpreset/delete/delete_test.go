@@ -0,0 +1,63 @@
+package delete_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/exportpreset/delete"
+	"github.com/financial-manager/api/internal/application/exportpreset/delete/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		id      string
+		repo    *mocks.Repository
+		wantErr error
+	}{
+		{
+			name: "deletes the preset",
+			id:   "p-1",
+			repo: buildMockRepo("p-1", nil),
+		},
+		{
+			name:    "empty id returns validation error",
+			repo:    &mocks.Repository{},
+			wantErr: errors.New("preset id is required"),
+		},
+		{
+			name:    "missing preset wraps ErrNotFound",
+			id:      "p-1",
+			repo:    buildMockRepo("p-1", domainshared.ErrNotFound),
+			wantErr: fmt.Errorf("preset not found: %w", domainshared.ErrNotFound),
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			id:      "p-1",
+			repo:    buildMockRepo("p-1", errors.New("db unavailable")),
+			wantErr: fmt.Errorf("delete preset: %w", errors.New("db unavailable")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := delete.New(tc.repo).Execute(context.Background(), tc.id)
+
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Package mocks contains testify mock implementations for the delete use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Repository is a testify mock for the delete.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// Delete mocks Repository.Delete.
+func (m *Repository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -0,0 +1,14 @@
+package delete_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/exportpreset/delete/mocks"
+)
+
+// buildMockRepo creates a mocks.Repository whose Delete of id returns err once.
+func buildMockRepo(id string, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("Delete", mock.Anything, id).Return(err).Once()
+	return m
+}
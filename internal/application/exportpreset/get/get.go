@@ -0,0 +1,38 @@
+// Package get implements the get export preset by name use case.
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+// UseCase implements the get export preset use case.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute returns the preset saved under name.
+func (uc *UseCase) Execute(ctx context.Context, name string) (domainexport.Preset, error) {
+	if name == "" {
+		return domainexport.Preset{}, errors.New("preset name is required")
+	}
+
+	preset, err := uc.repo.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, domainshared.ErrNotFound) {
+			return domainexport.Preset{}, fmt.Errorf("preset not found: %w", err)
+		}
+		return domainexport.Preset{}, fmt.Errorf("get preset: %w", err)
+	}
+
+	return preset, nil
+}
@@ -0,0 +1,69 @@
+package get_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/exportpreset/get"
+	"github.com/financial-manager/api/internal/application/exportpreset/get/mocks"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	preset := domainexport.Preset{ID: "p-1", Name: "Monthly", Options: domainexport.CSVOptions{Delimiter: ";"}}
+
+	tests := []struct {
+		name       string
+		presetName string
+		repo       *mocks.Repository
+		wantErr    error
+		wantPreset domainexport.Preset
+	}{
+		{
+			name:       "returns the preset",
+			presetName: "Monthly",
+			repo:       buildMockRepo("Monthly", preset, nil),
+			wantPreset: preset,
+		},
+		{
+			name:    "empty name returns validation error",
+			repo:    &mocks.Repository{},
+			wantErr: errors.New("preset name is required"),
+		},
+		{
+			name:       "missing preset wraps ErrNotFound",
+			presetName: "Missing",
+			repo:       buildMockRepo("Missing", domainexport.Preset{}, domainshared.ErrNotFound),
+			wantErr:    fmt.Errorf("preset not found: %w", domainshared.ErrNotFound),
+		},
+		{
+			name:       "repository error is wrapped and propagated",
+			presetName: "Monthly",
+			repo:       buildMockRepo("Monthly", domainexport.Preset{}, errors.New("db unavailable")),
+			wantErr:    fmt.Errorf("get preset: %w", errors.New("db unavailable")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := get.New(tc.repo).Execute(context.Background(), tc.presetName)
+
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantPreset, got)
+			}
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package get
+
+import (
+	"context"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+// Repository is the narrow read port required by this use case.
+type Repository interface {
+	GetByName(ctx context.Context, name string) (domainexport.Preset, error)
+}
@@ -0,0 +1,15 @@
+package get_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/exportpreset/get/mocks"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+// buildMockRepo creates a mocks.Repository returning the given preset and error for name once.
+func buildMockRepo(name string, preset domainexport.Preset, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("GetByName", mock.Anything, name).Return(preset, err).Once()
+	return m
+}
@@ -0,0 +1,29 @@
+// Package list implements the list export presets use case.
+package list
+
+import (
+	"context"
+	"fmt"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+// UseCase implements the list export presets use case.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute returns every saved export preset ordered by name.
+func (uc *UseCase) Execute(ctx context.Context) ([]domainexport.Preset, error) {
+	presets, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list presets: %w", err)
+	}
+
+	return presets, nil
+}
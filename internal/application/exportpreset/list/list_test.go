@@ -0,0 +1,59 @@
+package list_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/exportpreset/list"
+	"github.com/financial-manager/api/internal/application/exportpreset/list/mocks"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	presets := []domainexport.Preset{{ID: "p-1", Name: "Accountant"}, {ID: "p-2", Name: "Yearly"}}
+
+	tests := []struct {
+		name        string
+		repo        *mocks.Repository
+		wantErr     error
+		wantPresets []domainexport.Preset
+	}{
+		{
+			name:        "returns saved presets",
+			repo:        buildMockRepo(presets, nil),
+			wantPresets: presets,
+		},
+		{
+			name:        "returns empty list",
+			repo:        buildMockRepo([]domainexport.Preset{}, nil),
+			wantPresets: []domainexport.Preset{},
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			repo:    buildMockRepo(nil, errors.New("db unavailable")),
+			wantErr: fmt.Errorf("list presets: %w", errors.New("db unavailable")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := list.New(tc.repo).Execute(context.Background())
+
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantPresets, got)
+			}
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Package mocks contains testify mock implementations for the list use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+// Repository is a testify mock for the list.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// List mocks Repository.List.
+func (m *Repository) List(ctx context.Context) ([]domainexport.Preset, error) {
+	args := m.Called(ctx)
+	presets, _ := args.Get(0).([]domainexport.Preset)
+	return presets, args.Error(1)
+}
@@ -0,0 +1,12 @@
+package list
+
+import (
+	"context"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+// Repository is the narrow read port required by this use case.
+type Repository interface {
+	List(ctx context.Context) ([]domainexport.Preset, error)
+}
@@ -0,0 +1,15 @@
+package list_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/exportpreset/list/mocks"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+// buildMockRepo creates a mocks.Repository returning the given presets and error once.
+func buildMockRepo(presets []domainexport.Preset, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("List", mock.Anything).Return(presets, err).Once()
+	return m
+}
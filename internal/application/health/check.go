@@ -8,21 +8,30 @@ import (
 	domainhealth "github.com/financial-manager/api/internal/domain/health"
 )
 
-const appVersion = "1.0.0"
+// AppVersion is the version reported by the health check and recorded in backup archives.
+const AppVersion = "1.0.0"
+
+// BackupStatus is the port reporting when the last scheduled backup succeeded.
+type BackupStatus interface {
+	LastSuccess() time.Time
+}
 
 // CheckUseCase implements the health check use case.
-type CheckUseCase struct{}
+type CheckUseCase struct {
+	backups BackupStatus
+}
 
 // NewCheckUseCase creates a new CheckUseCase.
-func NewCheckUseCase() *CheckUseCase {
-	return &CheckUseCase{}
+func NewCheckUseCase(backups BackupStatus) *CheckUseCase {
+	return &CheckUseCase{backups: backups}
 }
 
 // Execute returns the current health status of the application.
 func (uc *CheckUseCase) Execute(_ context.Context) (domainhealth.Health, error) {
 	return domainhealth.Health{
-		Status:    domainhealth.StatusUp,
-		Timestamp: time.Now().UTC(),
-		Version:   appVersion,
+		Status:     domainhealth.StatusUp,
+		Timestamp:  time.Now().UTC(),
+		Version:    AppVersion,
+		LastBackup: uc.backups.LastSuccess(),
 	}, nil
 }
@@ -15,23 +15,33 @@ import (
 func TestCheckUseCase_Execute(t *testing.T) {
 	t.Parallel()
 
+	lastBackup := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
 	tests := []struct {
-		name       string
-		wantStatus domainhealth.Status
-		wantErr    bool
+		name           string
+		lastBackup     time.Time
+		wantStatus     domainhealth.Status
+		wantErr        bool
+		wantLastBackup time.Time
 	}{
 		{
 			name:       "returns StatusUp with no error",
 			wantStatus: domainhealth.StatusUp,
 			wantErr:    false,
 		},
+		{
+			name:           "reports the last successful backup",
+			lastBackup:     lastBackup,
+			wantStatus:     domainhealth.StatusUp,
+			wantLastBackup: lastBackup,
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			uc := health.NewCheckUseCase()
+			uc := health.NewCheckUseCase(fakeBackupStatus{last: tc.lastBackup})
 			before := time.Now().UTC()
 			got, err := uc.Execute(context.Background())
 			after := time.Now().UTC()
@@ -44,6 +54,7 @@ func TestCheckUseCase_Execute(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, tc.wantStatus, got.Status)
 			assert.NotEmpty(t, got.Version)
+			assert.Equal(t, tc.wantLastBackup, got.LastBackup)
 			assert.True(t,
 				!got.Timestamp.Before(before) && !got.Timestamp.After(after),
 				"Timestamp %v must be between %v and %v", got.Timestamp, before, after,
@@ -51,3 +62,12 @@ func TestCheckUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+// fakeBackupStatus reports a fixed last backup time.
+type fakeBackupStatus struct {
+	last time.Time
+}
+
+func (f fakeBackupStatus) LastSuccess() time.Time {
+	return f.last
+}
@@ -0,0 +1,240 @@
+// Package anomaly contains the detector shared by the anomaly use cases.
+package anomaly
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const (
+	// DefaultAmountThreshold is the modified z-score above which an amount is unusual.
+	DefaultAmountThreshold = 3.5
+	// DefaultFrequencyThreshold is the z-score of the last 30 days' payee count above
+	// which the payee is charged unusually often.
+	DefaultFrequencyThreshold = 3.0
+	// DefaultMinSamples is the number of past expenses needed before anything is scored.
+	DefaultMinSamples = 5
+	// LookbackDays is the length of the history scored against, as twelve 30-day windows.
+	LookbackDays = frequencyWindows * frequencyWindowDays
+
+	frequencyWindows    = 12
+	frequencyWindowDays = 30
+	// minRecentCount is the minimum number of charges in the last window to flag a frequency.
+	minRecentCount = 3
+	// minBaselineWindows is the number of past windows with payee history needed for a baseline.
+	minBaselineWindows = 3
+	// madScale makes the median absolute deviation consistent with the standard deviation.
+	madScale = 0.6745
+	// meanADScale does the same for the mean absolute deviation, used when the MAD is zero.
+	meanADScale = 0.7979
+	// maxZ caps the amount score when the history has no spread at all.
+	maxZ = 100
+	// amountTolerance absorbs floating point noise when comparing amounts.
+	amountTolerance = 0.005
+)
+
+// Detector scores an expense against the history of its category and payee.
+// The payee of an expense is its description; two descriptions name the same
+// payee when duplicate.Similarity considers them alike.
+type Detector struct {
+	AmountThreshold    float64
+	FrequencyThreshold float64
+	MinSamples         int
+}
+
+// NewDetector creates a Detector, falling back to the defaults for non-positive values.
+func NewDetector(amountThreshold, frequencyThreshold float64, minSamples int) Detector {
+	if amountThreshold <= 0 {
+		amountThreshold = DefaultAmountThreshold
+	}
+	if frequencyThreshold <= 0 {
+		frequencyThreshold = DefaultFrequencyThreshold
+	}
+	if minSamples <= 0 {
+		minSamples = DefaultMinSamples
+	}
+	return Detector{AmountThreshold: amountThreshold, FrequencyThreshold: frequencyThreshold, MinSamples: minSamples}
+}
+
+// Window returns the history range [date-LookbackDays+1, date] formatted as YYYY-MM-DD.
+func (d Detector) Window(date time.Time) (string, string) {
+	return date.AddDate(0, 0, 1-LookbackDays).Format("2006-01-02"), date.Format("2006-01-02")
+}
+
+// Score rates t against history and returns the score with the reasons it was
+// flagged. A score of 1 or more always comes with at least one reason. Only
+// expenses are scored, and nothing is flagged until the history holds
+// MinSamples earlier expenses.
+func (d Detector) Score(t domaintransaction.Transaction, history []domaintransaction.Transaction) (float64, []domaininsight.Reason) {
+	if t.Type != domaintransaction.TransactionTypeExpense {
+		return 0, nil
+	}
+
+	past := pastExpenses(t, history)
+	if len(past) < d.MinSamples {
+		return 0, nil
+	}
+
+	var score float64
+	var reasons []domaininsight.Reason
+
+	if amounts := categoryAmounts(t.CategoryID, past); len(amounts) >= d.MinSamples {
+		z, median := amountZ(t.Amount, amounts)
+		score = math.Max(score, z/d.AmountThreshold)
+		if z >= d.AmountThreshold {
+			reasons = append(reasons, domaininsight.Reason{
+				Code:    domaininsight.ReasonUnusualAmount,
+				Message: fmt.Sprintf("amount %.2f is well above the usual %.2f for this category", t.Amount, median),
+			})
+		}
+	}
+
+	if strings.TrimSpace(t.Description) == "" {
+		return round(score), reasons
+	}
+
+	payee := payeeDates(t.Description, past)
+	if len(payee) == 0 {
+		score = math.Max(score, 1)
+		reasons = append(reasons, domaininsight.Reason{
+			Code:    domaininsight.ReasonNewPayee,
+			Message: "first expense for this payee",
+		})
+		return round(score), reasons
+	}
+
+	if recent, z, ok := frequencyZ(t.Date, payee); ok && recent >= minRecentCount {
+		score = math.Max(score, z/d.FrequencyThreshold)
+		if z >= d.FrequencyThreshold {
+			reasons = append(reasons, domaininsight.Reason{
+				Code:    domaininsight.ReasonUnusualFrequency,
+				Message: fmt.Sprintf("%d charges from this payee in the last %d days", recent, frequencyWindowDays),
+			})
+		}
+	}
+
+	return round(score), reasons
+}
+
+// pastExpenses returns the active expenses in history other than t dated no later than t.
+func pastExpenses(t domaintransaction.Transaction, history []domaintransaction.Transaction) []domaintransaction.Transaction {
+	past := make([]domaintransaction.Transaction, 0, len(history))
+	for _, h := range history {
+		if h.ID == t.ID || h.Type != domaintransaction.TransactionTypeExpense || h.Date.After(t.Date) {
+			continue
+		}
+		past = append(past, h)
+	}
+	return past
+}
+
+// categoryAmounts returns the amounts of the expenses in categoryID.
+func categoryAmounts(categoryID string, past []domaintransaction.Transaction) []float64 {
+	amounts := make([]float64, 0)
+	for _, p := range past {
+		if p.CategoryID == categoryID {
+			amounts = append(amounts, p.Amount)
+		}
+	}
+	return amounts
+}
+
+// payeeDates returns the dates of the expenses whose description names the same payee.
+func payeeDates(description string, past []domaintransaction.Transaction) []time.Time {
+	dates := make([]time.Time, 0)
+	for _, p := range past {
+		if duplicate.Similarity(description, p.Description) >= duplicate.DefaultMinSimilarity {
+			dates = append(dates, p.Date)
+		}
+	}
+	return dates
+}
+
+// amountZ returns the modified z-score of amount against amounts and their median.
+// The MAD falls back to the mean absolute deviation when more than half the
+// amounts are equal, and the score is capped when there is no spread at all.
+func amountZ(amount float64, amounts []float64) (float64, float64) {
+	m := median(amounts)
+
+	deviations := make([]float64, len(amounts))
+	var sum float64
+	for i, a := range amounts {
+		deviations[i] = math.Abs(a - m)
+		sum += deviations[i]
+	}
+
+	diff := amount - m
+	if mad := median(deviations); mad > 0 {
+		return madScale * diff / mad, m
+	}
+	if meanAD := sum / float64(len(amounts)); meanAD > 0 {
+		return meanADScale * diff / meanAD, m
+	}
+	if diff > amountTolerance {
+		return maxZ, m
+	}
+	return 0, m
+}
+
+// frequencyZ compares the payee's charges in the last 30-day window, counting the
+// new one, with the earlier windows since the payee first appeared. ok is false
+// when there are too few earlier windows for a baseline.
+func frequencyZ(date time.Time, payee []time.Time) (int, float64, bool) {
+	counts := make([]float64, frequencyWindows)
+	counts[0] = 1
+	oldest := 0
+	for _, p := range payee {
+		w := int(date.Sub(p).Hours()/24) / frequencyWindowDays
+		if w < 0 || w >= frequencyWindows {
+			continue
+		}
+		counts[w]++
+		oldest = max(oldest, w)
+	}
+
+	if oldest < minBaselineWindows {
+		return int(counts[0]), 0, false
+	}
+
+	baseline := counts[1 : oldest+1]
+	mean, sd := meanStddev(baseline)
+	return int(counts[0]), (counts[0] - mean) / math.Max(sd, 1), true
+}
+
+// median returns the median of values without modifying the slice.
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// meanStddev returns the mean and population standard deviation of values.
+func meanStddev(values []float64) (float64, float64) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var sq float64
+	for _, v := range values {
+		sq += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(sq / float64(len(values)))
+}
+
+// round rounds v to two decimal places.
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,144 @@
+package anomaly_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/insight/anomaly"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestNewDetector(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, anomaly.Detector{AmountThreshold: 3.5, FrequencyThreshold: 3, MinSamples: 5}, anomaly.NewDetector(0, -1, 0))
+	assert.Equal(t, anomaly.Detector{AmountThreshold: 2, FrequencyThreshold: 4, MinSamples: 10}, anomaly.NewDetector(2, 4, 10))
+}
+
+func TestDetector_Window(t *testing.T) {
+	t.Parallel()
+
+	start, end := anomaly.NewDetector(0, 0, 0).Window(time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, "2025-07-06", start)
+	assert.Equal(t, "2026-06-30", end)
+}
+
+func TestDetector_Score(t *testing.T) {
+	t.Parallel()
+
+	with := func(extra ...domaintransaction.Transaction) []domaintransaction.Transaction {
+		return append(append([]domaintransaction.Transaction{}, groceries...), extra...)
+	}
+	flat := []domaintransaction.Transaction{
+		buildExpense("f-1", "cat-rent", 50, "", "2026-01-01"),
+		buildExpense("f-2", "cat-rent", 50, "", "2026-02-01"),
+		buildExpense("f-3", "cat-rent", 50, "", "2026-03-01"),
+		buildExpense("f-4", "cat-rent", 50, "", "2026-04-01"),
+		buildExpense("f-5", "cat-rent", 50, "", "2026-05-01"),
+	}
+
+	tests := []struct {
+		name        string
+		tx          domaintransaction.Transaction
+		history     []domaintransaction.Transaction
+		wantScore   float64
+		wantReasons []domaininsight.Reason
+	}{
+		{
+			name:    "too little history is not scored",
+			tx:      buildExpense("new", "cat-food", 400, "Unknown shop", "2026-06-30"),
+			history: groceries[:4],
+		},
+		{
+			name: "income is not scored",
+			tx: domaintransaction.Transaction{
+				ID: "new", CategoryID: "cat-food", Type: domaintransaction.TransactionTypeIncome,
+				Amount: 400, Description: "Unknown", Date: time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC),
+			},
+			history: groceries,
+		},
+		{
+			name:      "usual amount from a known payee is not flagged",
+			tx:        buildExpense("new", "cat-food", 53, "SUPERMARKET", "2026-06-30"),
+			history:   groceries,
+			wantScore: 0.24,
+		},
+		{
+			name:      "amount far above the category median is flagged",
+			tx:        buildExpense("new", "cat-food", 400, "Supermarket", "2026-06-30"),
+			history:   groceries,
+			wantScore: 33.68,
+			wantReasons: []domaininsight.Reason{{
+				Code:    domaininsight.ReasonUnusualAmount,
+				Message: "amount 400.00 is well above the usual 50.50 for this category",
+			}},
+		},
+		{
+			name:      "unusually small amount is not flagged",
+			tx:        buildExpense("new", "cat-food", 1, "Supermarket", "2026-06-30"),
+			history:   groceries,
+			wantScore: 0,
+		},
+		{
+			name:      "first expense for a payee is flagged",
+			tx:        buildExpense("new", "cat-food", 53, "Electronics store", "2026-06-30"),
+			history:   groceries,
+			wantScore: 1,
+			wantReasons: []domaininsight.Reason{{
+				Code:    domaininsight.ReasonNewPayee,
+				Message: "first expense for this payee",
+			}},
+		},
+		{
+			name:      "payee charged far more often than usual is flagged",
+			tx:        buildExpense("new", "cat-subs", 10, "Netflix", "2026-06-30"),
+			history:   append(with(subscriptions...), buildExpense("r-1", "cat-subs", 10, "Netflix", "2026-06-10"), buildExpense("r-2", "cat-subs", 10, "Netflix", "2026-06-20"), buildExpense("r-3", "cat-subs", 10, "Netflix", "2026-06-25")),
+			wantScore: 1,
+			wantReasons: []domaininsight.Reason{{
+				Code:    domaininsight.ReasonUnusualFrequency,
+				Message: "4 charges from this payee in the last 30 days",
+			}},
+		},
+		{
+			name:      "payee charged a little more often than usual is not flagged",
+			tx:        buildExpense("new", "cat-subs", 10, "Netflix", "2026-06-30"),
+			history:   append(with(subscriptions...), buildExpense("r-1", "cat-subs", 10, "Netflix", "2026-06-10"), buildExpense("r-2", "cat-subs", 10, "Netflix", "2026-06-20")),
+			wantScore: 0.67,
+		},
+		{
+			name:    "payee without enough earlier months has no frequency baseline",
+			tx:      buildExpense("new", "cat-food", 50, "Coffee shop", "2026-06-30"),
+			history: with(buildExpense("r-1", "cat-food", 50, "Coffee shop", "2026-06-20"), buildExpense("r-2", "cat-food", 50, "Coffee shop", "2026-06-25"), buildExpense("r-3", "cat-food", 50, "Coffee shop", "2026-06-28")),
+		},
+		{
+			name:      "any increase over a history without spread is flagged",
+			tx:        buildExpense("new", "cat-rent", 60, "", "2026-06-01"),
+			history:   flat,
+			wantScore: 28.57,
+			wantReasons: []domaininsight.Reason{{
+				Code:    domaininsight.ReasonUnusualAmount,
+				Message: "amount 60.00 is well above the usual 50.00 for this category",
+			}},
+		},
+		{
+			name:    "the transaction itself and later expenses are not history",
+			tx:      buildExpense("f-5", "cat-rent", 50, "", "2026-01-15"),
+			history: flat,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			score, reasons := anomaly.NewDetector(0, 0, 0).Score(tc.tx, tc.history)
+
+			assert.InDelta(t, tc.wantScore, score, 0.001)
+			assert.Equal(t, tc.wantReasons, reasons)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Package list implements the use case that lists the stored anomalies.
+package list
+
+import (
+	"context"
+	"fmt"
+
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+// Repository is the narrow read port required by this use case.
+type Repository interface {
+	List(ctx context.Context, startDate, endDate string) ([]domaininsight.Anomaly, error)
+}
+
+// Input carries the optional date range of the flagged transactions.
+type Input struct {
+	StartDate string
+	EndDate   string
+}
+
+// UseCase implements the anomaly listing.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute returns the anomalies of active transactions in the range, most recent first.
+func (uc *UseCase) Execute(ctx context.Context, in Input) ([]domaininsight.Anomaly, error) {
+	anomalies, err := uc.repo.List(ctx, in.StartDate, in.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("list anomalies: %w", err)
+	}
+
+	return anomalies, nil
+}
@@ -0,0 +1,56 @@
+package list_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/insight/anomaly/list"
+	"github.com/financial-manager/api/internal/application/insight/anomaly/list/mocks"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		repo    *mocks.Repository
+		input   list.Input
+		wantOut []domaininsight.Anomaly
+		wantErr error
+	}{
+		{
+			name:    "returns the stored anomalies",
+			repo:    buildMockRepo("", "", []domaininsight.Anomaly{flagged}, nil),
+			wantOut: []domaininsight.Anomaly{flagged},
+		},
+		{
+			name:    "passes the date range to the repository",
+			repo:    buildMockRepo("2026-06-01", "2026-06-30", []domaininsight.Anomaly{}, nil),
+			input:   list.Input{StartDate: "2026-06-01", EndDate: "2026-06-30"},
+			wantOut: []domaininsight.Anomaly{},
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			repo:    buildMockRepo("", "", nil, errors.New("db error")),
+			wantErr: fmt.Errorf("list anomalies: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := list.New(tc.repo)
+			out, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantOut, out)
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
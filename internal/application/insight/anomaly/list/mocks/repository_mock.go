@@ -0,0 +1,22 @@
+// Package mocks contains testify mock implementations for the anomaly list use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+// Repository is a testify mock for the list.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// List mocks Repository.List.
+func (m *Repository) List(ctx context.Context, startDate, endDate string) ([]domaininsight.Anomaly, error) {
+	args := m.Called(ctx, startDate, endDate)
+	anomalies, _ := args.Get(0).([]domaininsight.Anomaly)
+	return anomalies, args.Error(1)
+}
@@ -0,0 +1,31 @@
+package list_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/insight/anomaly/list/mocks"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+// buildMockRepo creates a mocks.Repository pre-configured to return the given
+// anomalies and error for one List call over the given range.
+func buildMockRepo(startDate, endDate string, anomalies []domaininsight.Anomaly, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("List", mock.Anything, startDate, endDate).Return(anomalies, err).Once()
+	return m
+}
+
+// flagged is a canonical anomaly fixture for list tests.
+var flagged = domaininsight.Anomaly{
+	TransactionID: "tx-1",
+	AccountID:     "acc-1",
+	CategoryID:    "cat-food",
+	Amount:        500,
+	Description:   "Supermarket",
+	Date:          time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC),
+	Score:         2.5,
+	Reasons:       []domaininsight.Reason{{Code: domaininsight.ReasonUnusualAmount, Message: "unusual"}},
+	CreatedAt:     time.Date(2026, 6, 30, 12, 0, 0, 0, time.UTC),
+}
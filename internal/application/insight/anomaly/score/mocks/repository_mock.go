@@ -0,0 +1,22 @@
+// Package mocks contains testify mock implementations for the anomaly score use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Repository is a testify mock for the score.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// ListActiveBetween mocks Repository.ListActiveBetween.
+func (m *Repository) ListActiveBetween(ctx context.Context, startDate, endDate string) ([]domaintransaction.Transaction, error) {
+	args := m.Called(ctx, startDate, endDate)
+	txs, _ := args.Get(0).([]domaintransaction.Transaction)
+	return txs, args.Error(1)
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+// Store is a testify mock for the score.Store interface.
+type Store struct {
+	mock.Mock
+}
+
+// Create mocks Store.Create.
+func (m *Store) Create(ctx context.Context, a domaininsight.Anomaly) error {
+	args := m.Called(ctx, a)
+	return args.Error(0)
+}
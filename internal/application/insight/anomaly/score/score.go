@@ -0,0 +1,73 @@
+// Package score implements the use case that scores a new expense and stores it when unusual.
+package score
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/financial-manager/api/internal/application/insight/anomaly"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Repository is the narrow read port for the transaction history.
+type Repository interface {
+	ListActiveBetween(ctx context.Context, startDate, endDate string) ([]domaintransaction.Transaction, error)
+}
+
+// Store persists flagged anomalies.
+type Store interface {
+	Create(ctx context.Context, a domaininsight.Anomaly) error
+}
+
+// Clock provides the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// UseCase implements the anomaly scoring run when an expense is created.
+type UseCase struct {
+	repo     Repository
+	store    Store
+	detector anomaly.Detector
+	clock    Clock
+}
+
+// New creates a new UseCase using the given detector.
+func New(repo Repository, store Store, detector anomaly.Detector, clock Clock) *UseCase {
+	return &UseCase{repo: repo, store: store, detector: detector, clock: clock}
+}
+
+// Execute scores t against its history. When t is unusual the anomaly is stored
+// and returned with true; otherwise the returned anomaly only carries the score.
+func (uc *UseCase) Execute(ctx context.Context, t domaintransaction.Transaction) (domaininsight.Anomaly, bool, error) {
+	startDate, endDate := uc.detector.Window(t.Date)
+
+	history, err := uc.repo.ListActiveBetween(ctx, startDate, endDate)
+	if err != nil {
+		return domaininsight.Anomaly{}, false, fmt.Errorf("score anomaly: %w", err)
+	}
+
+	score, reasons := uc.detector.Score(t, history)
+	a := domaininsight.Anomaly{
+		TransactionID: t.ID,
+		AccountID:     t.AccountID,
+		CategoryID:    t.CategoryID,
+		Amount:        t.Amount,
+		Description:   t.Description,
+		Date:          t.Date,
+		Score:         score,
+		Reasons:       reasons,
+	}
+	if len(reasons) == 0 {
+		return a, false, nil
+	}
+
+	a.CreatedAt = uc.clock.Now().UTC()
+	if err := uc.store.Create(ctx, a); err != nil {
+		return domaininsight.Anomaly{}, false, fmt.Errorf("score anomaly: %w", err)
+	}
+
+	return a, true, nil
+}
@@ -0,0 +1,100 @@
+package score_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/insight/anomaly"
+	"github.com/financial-manager/api/internal/application/insight/anomaly/score"
+	"github.com/financial-manager/api/internal/application/insight/anomaly/score/mocks"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	unusual := buildExpense("tx-1", 500, "Supermarket", "2026-06-30")
+	flagged := domaininsight.Anomaly{
+		TransactionID: unusual.ID,
+		AccountID:     unusual.AccountID,
+		CategoryID:    unusual.CategoryID,
+		Amount:        500,
+		Description:   "Supermarket",
+		Date:          unusual.Date,
+		Score:         128.23,
+		Reasons: []domaininsight.Reason{{
+			Code:    domaininsight.ReasonUnusualAmount,
+			Message: "amount 500.00 is well above the usual 50.00 for this category",
+		}},
+		CreatedAt: fixedNow,
+	}
+
+	usual := buildExpense("tx-2", 50, "Supermarket", "2026-06-30")
+
+	tests := []struct {
+		name        string
+		tx          domaintransaction.Transaction
+		repo        *mocks.Repository
+		store       *mocks.Store
+		want        domaininsight.Anomaly
+		wantFlagged bool
+		wantErr     error
+	}{
+		{
+			name:        "unusual expense is stored and returned",
+			tx:          unusual,
+			repo:        buildMockRepo(history, nil),
+			store:       buildMockStore(&flagged, nil),
+			want:        flagged,
+			wantFlagged: true,
+		},
+		{
+			name:  "usual expense is not stored",
+			tx:    usual,
+			repo:  buildMockRepo(history, nil),
+			store: buildMockStore(nil, nil),
+			want: domaininsight.Anomaly{
+				TransactionID: usual.ID,
+				AccountID:     usual.AccountID,
+				CategoryID:    usual.CategoryID,
+				Amount:        50,
+				Description:   "Supermarket",
+				Date:          usual.Date,
+			},
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			tx:      unusual,
+			repo:    buildMockRepo(nil, errors.New("db error")),
+			store:   buildMockStore(nil, nil),
+			wantErr: fmt.Errorf("score anomaly: %w", errors.New("db error")),
+		},
+		{
+			name:    "store error is wrapped and propagated",
+			tx:      unusual,
+			repo:    buildMockRepo(history, nil),
+			store:   buildMockStore(&flagged, errors.New("db error")),
+			wantErr: fmt.Errorf("score anomaly: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := score.New(tc.repo, tc.store, anomaly.NewDetector(0, 0, 0), buildMockClock())
+			got, gotFlagged, err := uc.Execute(context.Background(), tc.tx)
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantFlagged, gotFlagged)
+			tc.repo.AssertExpectations(t)
+			tc.store.AssertExpectations(t)
+		})
+	}
+}
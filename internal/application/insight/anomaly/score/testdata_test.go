@@ -0,0 +1,60 @@
+package score_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/insight/anomaly/score/mocks"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+var fixedNow = time.Date(2026, 6, 30, 12, 0, 0, 0, time.UTC)
+
+// buildExpense returns an expense fixture dated on the given YYYY-MM-DD day.
+func buildExpense(id string, amount float64, desc, date string) domaintransaction.Transaction {
+	d, _ := time.Parse("2006-01-02", date)
+	return domaintransaction.Transaction{
+		ID:          id,
+		AccountID:   "acc-1",
+		CategoryID:  "cat-food",
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      amount,
+		Description: desc,
+		Date:        d,
+		IsActive:    true,
+	}
+}
+
+// history is a steady grocery history with a median of 50.
+var history = []domaintransaction.Transaction{
+	buildExpense("h-1", 50, "Supermarket", "2026-02-10"),
+	buildExpense("h-2", 52, "Supermarket", "2026-03-10"),
+	buildExpense("h-3", 48, "Supermarket", "2026-04-10"),
+	buildExpense("h-4", 50, "Supermarket", "2026-05-10"),
+	buildExpense("h-5", 50, "Supermarket", "2026-06-10"),
+}
+
+// buildMockRepo creates a mocks.Repository returning txs and err for the history window of 2026-06-30.
+func buildMockRepo(txs []domaintransaction.Transaction, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("ListActiveBetween", mock.Anything, "2025-07-06", "2026-06-30").Return(txs, err).Once()
+	return m
+}
+
+// buildMockStore creates a mocks.Store expecting want to be stored, or nothing when want is nil.
+func buildMockStore(want *domaininsight.Anomaly, err error) *mocks.Store {
+	m := &mocks.Store{}
+	if want != nil {
+		m.On("Create", mock.Anything, *want).Return(err).Once()
+	}
+	return m
+}
+
+// buildMockClock creates a mocks.Clock that returns fixedNow.
+func buildMockClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(fixedNow).Maybe()
+	return m
+}
@@ -0,0 +1,42 @@
+package anomaly_test
+
+import (
+	"time"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// buildExpense returns an expense fixture dated on the given YYYY-MM-DD day.
+func buildExpense(id, categoryID string, amount float64, desc, date string) domaintransaction.Transaction {
+	d, _ := time.Parse("2006-01-02", date)
+	return domaintransaction.Transaction{
+		ID:          id,
+		AccountID:   "acc-1",
+		CategoryID:  categoryID,
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      amount,
+		Description: desc,
+		Date:        d,
+		IsActive:    true,
+	}
+}
+
+// groceries is a steady grocery history with a median of 50.50 and a MAD of 2.
+var groceries = []domaintransaction.Transaction{
+	buildExpense("g-1", "cat-food", 50, "Supermarket", "2026-01-10"),
+	buildExpense("g-2", "cat-food", 52, "Supermarket", "2026-02-10"),
+	buildExpense("g-3", "cat-food", 48, "Supermarket", "2026-03-10"),
+	buildExpense("g-4", "cat-food", 55, "Supermarket", "2026-04-10"),
+	buildExpense("g-5", "cat-food", 45, "Supermarket", "2026-05-10"),
+	buildExpense("g-6", "cat-food", 51, "Supermarket", "2026-06-10"),
+}
+
+// subscriptions is a monthly streaming charge of 10 for the five months before
+// 2026-06-30, with no charge in the last 30 days.
+var subscriptions = []domaintransaction.Transaction{
+	buildExpense("s-1", "cat-subs", 10, "Netflix", "2026-01-16"),
+	buildExpense("s-2", "cat-subs", 10, "Netflix", "2026-02-15"),
+	buildExpense("s-3", "cat-subs", 10, "Netflix", "2026-03-17"),
+	buildExpense("s-4", "cat-subs", 10, "Netflix", "2026-04-16"),
+	buildExpense("s-5", "cat-subs", 10, "Netflix", "2026-05-16"),
+}
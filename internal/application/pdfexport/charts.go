@@ -0,0 +1,463 @@
+package pdfexport
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const (
+	// maxPieSlices is the number of categories drawn before the rest are grouped as "Other".
+	maxPieSlices = 8
+	// pageBottom is the lowest y coordinate (mm) charts may draw on before breaking the page.
+	pageBottom = 270
+
+	// incomeColor fills income bars and positive balances.
+	incomeColor rgb = 0x2EA043
+	// expenseColor fills expense bars and negative balances.
+	expenseColor rgb = 0xDC3545
+	// axisColor draws the chart axes and the "Other" pie slice.
+	axisColor rgb = 0x787878
+)
+
+type (
+	// rgb is a color packed as 0xRRGGBB.
+	rgb uint32
+
+	// pieSlice is one category of the expenses pie chart.
+	pieSlice struct {
+		label    string
+		amount   float64
+		color    rgb
+		hasColor bool
+	}
+)
+
+// fallbackPalette returns the colors of categories whose stored color is
+// missing or invalid.
+func fallbackPalette() []rgb {
+	return []rgb{0x4E79A7, 0xF28E2B, 0xE15759, 0x76B7B2, 0x59A14F, 0xEDC948, 0xB07AA1, 0xFF9DA7}
+}
+
+// r returns the 0..255 red component of c.
+func (c rgb) r() int { return int(c >> 16 & 0xFF) }
+
+// g returns the 0..255 green component of c.
+func (c rgb) g() int { return int(c >> 8 & 0xFF) }
+
+// b returns the 0..255 blue component of c.
+func (c rgb) b() int { return int(c & 0xFF) }
+
+// chartSet is the data charted for one currency: the expenses pie, the
+// income vs expense bars and the balances of the accounts in that currency.
+type chartSet struct {
+	currency string
+	slices   []pieSlice
+	buckets  barBuckets
+	accounts []domainaccount.Account
+}
+
+// drawCharts adds a charts page per currency, so amounts in different
+// currencies are never added up or scaled against each other.
+func (r *report) drawCharts(
+	p period,
+	categories []domaincategory.Category,
+	incomes, expenses []domaintransaction.Transaction,
+	accounts []domainaccount.Account,
+	currencyOf func(domaintransaction.Transaction) string,
+) {
+	sets := r.chartSets(p, categories, incomes, expenses, accounts, currencyOf)
+	for _, set := range sets {
+		title := r.labels.charts
+		if len(sets) > 1 {
+			title += " (" + set.currency + ")"
+		}
+		r.pdf.AddPage()
+		r.pdf.SetFont(fontFamily, "B", 14)
+		r.pdf.Cell(0, 10, title)
+		r.pdf.Ln(12)
+
+		r.sectionTitle(10, 24, r.labels.byCategory)
+		r.drawPieChart(50, 70, 35, set.slices, set.currency)
+
+		if p.singleMonth() {
+			r.sectionTitle(10, 115, r.labels.dailyChart)
+		} else {
+			r.sectionTitle(10, 115, r.labels.monthlyChart)
+		}
+		r.drawBars(20, 125, 180, 60, set.buckets)
+
+		r.sectionTitle(10, 200, r.labels.accountsChart)
+		r.drawAccountBars(10, 210, 190, set.accounts)
+	}
+}
+
+// chartSets splits the charted data by currency, sorted by currency code.
+// There is always at least one set, so an empty report still gets a page.
+func (r *report) chartSets(
+	p period,
+	categories []domaincategory.Category,
+	incomes, expenses []domaintransaction.Transaction,
+	accounts []domainaccount.Account,
+	currencyOf func(domaintransaction.Transaction) string,
+) []chartSet {
+	incomeByCurrency := make(map[string][]domaintransaction.Transaction)
+	expenseByCurrency := make(map[string][]domaintransaction.Transaction)
+	accountsByCurrency := make(map[string][]domainaccount.Account)
+	currencySet := make(map[string]struct{})
+	for _, tx := range incomes {
+		c := currencyOf(tx)
+		incomeByCurrency[c] = append(incomeByCurrency[c], tx)
+		currencySet[c] = struct{}{}
+	}
+	for _, tx := range expenses {
+		c := currencyOf(tx)
+		expenseByCurrency[c] = append(expenseByCurrency[c], tx)
+		currencySet[c] = struct{}{}
+	}
+	for _, acc := range accounts {
+		c := normalizeCurrency(acc.Currency)
+		accountsByCurrency[c] = append(accountsByCurrency[c], acc)
+		currencySet[c] = struct{}{}
+	}
+	currencies := sortedKeys(currencySet)
+	if len(currencies) == 0 {
+		currencies = []string{defaultCurrency}
+	}
+
+	sets := make([]chartSet, 0, len(currencies))
+	for _, c := range currencies {
+		set := chartSet{
+			currency: c,
+			slices:   r.buildPieSlices(categories, expenseByCurrency[c]),
+			accounts: accountsByCurrency[c],
+		}
+		if p.singleMonth() {
+			set.buckets = dailyBuckets(monthStart(p.start, p.monthStartDay), incomeByCurrency[c], expenseByCurrency[c])
+		} else {
+			set.buckets = r.monthlyBuckets(p.months(), incomeByCurrency[c], expenseByCurrency[c])
+		}
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+// sectionTitle writes a chart heading at the given position.
+func (r *report) sectionTitle(x, y float64, title string) {
+	r.pdf.SetTextColor(0, 0, 0)
+	r.pdf.SetFont(fontFamily, "B", 12)
+	r.pdf.SetXY(x, y)
+	r.pdf.Cell(0, 8, title)
+}
+
+// buildPieSlices groups expenses by category, largest first, using each
+// category's stored color. Categories beyond maxPieSlices are merged into "Other".
+func (r *report) buildPieSlices(categories []domaincategory.Category, expenses []domaintransaction.Transaction) []pieSlice {
+	byID := make(map[string]domaincategory.Category)
+	for _, cat := range categories {
+		byID[cat.ID] = cat
+	}
+
+	totals := make(map[string]float64)
+	for _, tx := range expenses {
+		totals[tx.CategoryID] += tx.Amount
+	}
+
+	slices := make([]pieSlice, 0, len(totals))
+	for id, amount := range totals {
+		label := byID[id].Name
+		if label == "" {
+			label = r.labels.uncategorized
+		}
+		color, ok := parseHexColor(byID[id].Color)
+		slices = append(slices, pieSlice{label: label, amount: amount, color: color, hasColor: ok})
+	}
+	sort.Slice(slices, func(i, j int) bool {
+		if slices[i].amount != slices[j].amount {
+			return slices[i].amount > slices[j].amount
+		}
+		return slices[i].label < slices[j].label
+	})
+
+	if len(slices) > maxPieSlices {
+		other := pieSlice{label: r.labels.other, color: axisColor, hasColor: true}
+		for _, s := range slices[maxPieSlices-1:] {
+			other.amount += s.amount
+		}
+		slices = append(slices[:maxPieSlices-1], other)
+	}
+
+	palette := fallbackPalette()
+	for i := range slices {
+		if !slices[i].hasColor {
+			slices[i].color = palette[i%len(palette)]
+		}
+	}
+	return slices
+}
+
+// drawPieChart draws slices as filled polygons around (cx, cy) with a legend to
+// the right showing each amount in currency and its percentage.
+func (r *report) drawPieChart(cx, cy, radius float64, slices []pieSlice, currency string) {
+	pdf := r.pdf
+	var total float64
+	for _, s := range slices {
+		total += s.amount
+	}
+	if total <= 0 {
+		r.noData(cx-radius, cy)
+		return
+	}
+
+	start := -math.Pi / 2
+	for _, s := range slices {
+		sweep := 2 * math.Pi * s.amount / total
+		setFill(pdf, s.color)
+		if sweep >= 2*math.Pi-1e-9 {
+			pdf.Circle(cx, cy, radius, "F")
+		} else {
+			pdf.Polygon(arcPoints(cx, cy, radius, start, start+sweep), "F")
+		}
+		start += sweep
+	}
+
+	legendX, legendY := cx+radius+15, cy-radius
+	pdf.SetFont(fontFamily, "", 10)
+	for i, s := range slices {
+		y := legendY + float64(i)*8
+		setFill(pdf, s.color)
+		pdf.Rect(legendX, y+1.5, 4, 4, "F")
+		pdf.SetXY(legendX+6, y)
+		percent := formatPercent(s.amount/total*100, r.locale)
+		pdf.Cell(0, 7, fmt.Sprintf("%s  %s (%s)", s.label, formatMoney(s.amount, currency, r.locale), percent))
+	}
+}
+
+// arcPoints returns the polygon of a pie slice: the center followed by points
+// along the arc from angle a0 to a1 (radians, clockwise on the page).
+func arcPoints(cx, cy, radius, a0, a1 float64) []fpdf.PointType {
+	steps := int(math.Ceil((a1 - a0) / (math.Pi / 90)))
+	if steps < 1 {
+		steps = 1
+	}
+	points := make([]fpdf.PointType, 0, steps+2)
+	points = append(points, fpdf.PointType{X: cx, Y: cy})
+	for i := 0; i <= steps; i++ {
+		a := a0 + (a1-a0)*float64(i)/float64(steps)
+		points = append(points, fpdf.PointType{X: cx + radius*math.Cos(a), Y: cy + radius*math.Sin(a)})
+	}
+	return points
+}
+
+// barBuckets holds the income and expense totals of each bar chart slot.
+// Slots with an empty tick are drawn without an axis label.
+type barBuckets struct {
+	ticks   []string
+	income  []float64
+	expense []float64
+}
+
+// dailyBuckets groups transactions by day of the month starting at start,
+// labelling its first day and every fifth day of the calendar month.
+func dailyBuckets(start time.Time, incomes, expenses []domaintransaction.Transaction) barBuckets {
+	days := int(start.AddDate(0, 1, 0).Sub(start).Hours() / 24)
+	b := barBuckets{ticks: make([]string, days), income: make([]float64, days), expense: make([]float64, days)}
+	for i := range days {
+		if day := start.AddDate(0, 0, i).Day(); i == 0 || day%5 == 0 {
+			b.ticks[i] = strconv.Itoa(day)
+		}
+	}
+	index := func(t time.Time) int {
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return int(day.Sub(start).Hours() / 24)
+	}
+	for _, tx := range incomes {
+		if i := index(tx.Date); i >= 0 && i < days {
+			b.income[i] += tx.Amount
+		}
+	}
+	for _, tx := range expenses {
+		if i := index(tx.Date); i >= 0 && i < days {
+			b.expense[i] += tx.Amount
+		}
+	}
+	return b
+}
+
+// monthlyBuckets groups transactions by month.
+func (r *report) monthlyBuckets(months []time.Time, incomes, expenses []domaintransaction.Transaction) barBuckets {
+	index := make(map[string]int, len(months))
+	b := barBuckets{ticks: make([]string, len(months)), income: make([]float64, len(months)), expense: make([]float64, len(months))}
+	for i, m := range months {
+		index[r.monthKey(m)] = i
+		b.ticks[i] = r.shortMonthLabel(m)
+	}
+	for _, tx := range incomes {
+		if i, ok := index[r.monthKey(tx.Date)]; ok {
+			b.income[i] += tx.Amount
+		}
+	}
+	for _, tx := range expenses {
+		if i, ok := index[r.monthKey(tx.Date)]; ok {
+			b.expense[i] += tx.Amount
+		}
+	}
+	return b
+}
+
+// drawBars draws one income and one expense bar per bucket inside the box
+// (x, y, w, h), scaled to the largest bucket total.
+func (r *report) drawBars(x, y, w, h float64, b barBuckets) {
+	pdf := r.pdf
+	slots := len(b.ticks)
+	income, expense := b.income, b.expense
+
+	var maxValue float64
+	for i := range slots {
+		maxValue = math.Max(maxValue, math.Max(income[i], expense[i]))
+	}
+
+	baseline := y + h
+	setDraw(pdf, axisColor)
+	pdf.Line(x, y, x, baseline)
+	pdf.Line(x, baseline, x+w, baseline)
+
+	pdf.SetFont(fontFamily, "", 7)
+	pdf.SetTextColor(axisColor.r(), axisColor.g(), axisColor.b())
+	pdf.SetXY(x-12, y-2)
+	pdf.CellFormat(11, 4, formatNumber(maxValue, 0, r.locale), "", 0, "R", false, 0, "")
+
+	slot := w / float64(slots)
+	barWidth := slot * 0.4
+	for i := range slots {
+		left := x + float64(i)*slot + slot*0.1
+		if maxValue > 0 {
+			if income[i] > 0 {
+				barHeight := income[i] / maxValue * h
+				setFill(pdf, incomeColor)
+				pdf.Rect(left, baseline-barHeight, barWidth, barHeight, "F")
+			}
+			if expense[i] > 0 {
+				barHeight := expense[i] / maxValue * h
+				setFill(pdf, expenseColor)
+				pdf.Rect(left+barWidth, baseline-barHeight, barWidth, barHeight, "F")
+			}
+		}
+		if b.ticks[i] != "" {
+			pdf.SetXY(left, baseline+1)
+			pdf.Cell(slot, 4, b.ticks[i])
+		}
+	}
+
+	legendY := baseline + 7
+	setFill(pdf, incomeColor)
+	pdf.Rect(x, legendY+1, 3, 3, "F")
+	pdf.SetXY(x+4, legendY)
+	pdf.Cell(20, 5, r.labels.income)
+	setFill(pdf, expenseColor)
+	pdf.Rect(x+25, legendY+1, 3, 3, "F")
+	pdf.SetXY(x+29, legendY)
+	pdf.Cell(20, 5, r.labels.expense)
+	pdf.SetTextColor(0, 0, 0)
+}
+
+// drawAccountBars draws a horizontal bar per account current balance. Negative
+// balances extend left of the zero line; the chart continues on a new page when full.
+func (r *report) drawAccountBars(x, y, w float64, accounts []domainaccount.Account) {
+	pdf := r.pdf
+	if len(accounts) == 0 {
+		r.noData(x, y+5)
+		return
+	}
+
+	var maxPositive, maxNegative float64
+	for _, acc := range accounts {
+		maxPositive = math.Max(maxPositive, acc.CurrentBalance)
+		maxNegative = math.Max(maxNegative, -acc.CurrentBalance)
+	}
+
+	const (
+		labelWidth = 45
+		valueWidth = 30
+		rowHeight  = 8
+	)
+	barArea := w - labelWidth - valueWidth
+	scale := 0.0
+	if span := maxPositive + maxNegative; span > 0 {
+		scale = barArea / span
+	}
+	zero := x + labelWidth + maxNegative*scale
+
+	pdf.SetFont(fontFamily, "", 9)
+	for _, acc := range accounts {
+		if y+rowHeight > pageBottom {
+			pdf.AddPage()
+			y = 20
+		}
+
+		pdf.SetXY(x, y)
+		pdf.Cell(labelWidth, rowHeight, truncate(acc.Name, 24))
+
+		barWidth := math.Abs(acc.CurrentBalance) * scale
+		if acc.CurrentBalance >= 0 {
+			setFill(pdf, incomeColor)
+			pdf.Rect(zero, y+1.5, barWidth, rowHeight-3, "F")
+		} else {
+			setFill(pdf, expenseColor)
+			pdf.Rect(zero-barWidth, y+1.5, barWidth, rowHeight-3, "F")
+		}
+
+		setDraw(pdf, axisColor)
+		pdf.Line(zero, y, zero, y+rowHeight)
+
+		pdf.SetXY(x+w-valueWidth, y)
+		pdf.CellFormat(valueWidth, rowHeight, formatMoney(acc.CurrentBalance, normalizeCurrency(acc.Currency), r.locale), "", 0, "R", false, 0, "")
+		y += rowHeight
+	}
+}
+
+// noData writes a placeholder where a chart has nothing to draw.
+func (r *report) noData(x, y float64) {
+	r.pdf.SetFont(fontFamily, "I", 10)
+	r.pdf.SetXY(x, y)
+	r.pdf.Cell(0, 6, r.labels.noData)
+}
+
+// parseHexColor parses a "#RRGGBB" color as stored in categories.color.
+func parseHexColor(s string) (rgb, bool) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(s) != 6 {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, false
+	}
+	return rgb(v), true
+}
+
+// truncate shortens s to at most n runes, marking the cut with "...".
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-3]) + "..."
+}
+
+func setFill(pdf *fpdf.Fpdf, c rgb) {
+	pdf.SetFillColor(c.r(), c.g(), c.b())
+}
+
+func setDraw(pdf *fpdf.Fpdf, c rgb) {
+	pdf.SetDrawColor(c.r(), c.g(), c.b())
+}
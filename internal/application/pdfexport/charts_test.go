@@ -0,0 +1,78 @@
+package pdfexport_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/pdfexport"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestChartTotalsFor(t *testing.T) {
+	t.Parallel()
+
+	accounts := []domainaccount.Account{
+		{ID: "acc-1", Name: "Checking", Currency: "usd", CurrentBalance: 900},
+		{ID: "acc-2", Name: "Cuenta", Currency: "EUR", CurrentBalance: 400},
+		{ID: "acc-3", Name: "Savings", Currency: "USD", CurrentBalance: 50},
+	}
+	categories := []domaincategory.Category{{ID: "cat-1", Name: "Food"}, {ID: "cat-2", Name: "Rent"}}
+	incomes := []domaintransaction.Transaction{
+		buildIncome("tx-1", 1000),
+		buildIncomeInAccount("tx-2", 500, "acc-2"),
+	}
+	expenses := []domaintransaction.Transaction{
+		buildExpense("tx-3", 40, "cat-1"),
+		buildExpenseInAccount("tx-4", 25, "cat-1", "acc-2"),
+		buildExpenseInAccount("tx-5", 300, "cat-2", "acc-2"),
+		buildExpenseInAccount("tx-6", 10, "cat-1", "acc-3"),
+	}
+
+	tests := []struct {
+		name  string
+		input pdfexport.Input
+	}{
+		{name: "monthly report charts daily buckets", input: pdfexport.Input{Month: "2026-02"}},
+		{name: "yearly report charts monthly buckets", input: pdfexport.Input{Year: 2026}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := pdfexport.ChartTotalsFor(tc.input, categories, incomes, expenses, accounts)
+
+			require.NoError(t, err)
+			assert.Equal(t, []pdfexport.ChartTotals{
+				{
+					Currency: "EUR",
+					Slices:   map[string]float64{"Rent": 300, "Food": 25},
+					Income:   500,
+					Expense:  325,
+					Accounts: []string{"acc-2"},
+				},
+				{
+					Currency: "USD",
+					Slices:   map[string]float64{"Food": 50},
+					Income:   1000,
+					Expense:  50,
+					Accounts: []string{"acc-1", "acc-3"},
+				},
+			}, got)
+		})
+	}
+}
+
+func TestChartTotalsFor_NoData(t *testing.T) {
+	t.Parallel()
+
+	got, err := pdfexport.ChartTotalsFor(pdfexport.Input{Month: "2026-02"}, nil, nil, nil, nil)
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "USD", got[0].Currency)
+	assert.Empty(t, got[0].Slices)
+}
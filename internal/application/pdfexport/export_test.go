@@ -0,0 +1,62 @@
+package pdfexport
+
+import (
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// ChartTotals is the data of one charts page, reduced to the amounts tests compare.
+type ChartTotals struct {
+	Currency string
+	Slices   map[string]float64
+	Income   float64
+	Expense  float64
+	Accounts []string
+}
+
+// ChartTotalsFor returns the chart data of an English report for in, with
+// transactions in the currency of their account.
+func ChartTotalsFor(
+	in Input,
+	categories []domaincategory.Category,
+	incomes, expenses []domaintransaction.Transaction,
+	accounts []domainaccount.Account,
+) ([]ChartTotals, error) {
+	p, err := resolvePeriod(in, 1)
+	if err != nil {
+		return nil, err
+	}
+	l, err := labelsFor(LocaleEnglish)
+	if err != nil {
+		return nil, err
+	}
+	accountCurrency := make(map[string]string)
+	for _, acc := range accounts {
+		accountCurrency[acc.ID] = normalizeCurrency(acc.Currency)
+	}
+	currencyOf := func(tx domaintransaction.Transaction) string {
+		if c, ok := accountCurrency[tx.AccountID]; ok {
+			return c
+		}
+		return defaultCurrency
+	}
+
+	r := &report{locale: LocaleEnglish, labels: l, monthStartDay: 1}
+	sets := r.chartSets(p, categories, incomes, expenses, accounts, currencyOf)
+	totals := make([]ChartTotals, len(sets))
+	for i, set := range sets {
+		totals[i] = ChartTotals{Currency: set.currency, Slices: make(map[string]float64)}
+		for _, s := range set.slices {
+			totals[i].Slices[s.label] = s.amount
+		}
+		for j := range set.buckets.ticks {
+			totals[i].Income += set.buckets.income[j]
+			totals[i].Expense += set.buckets.expense[j]
+		}
+		for _, acc := range set.accounts {
+			totals[i].Accounts = append(totals[i].Accounts, acc.ID)
+		}
+	}
+	return totals, nil
+}
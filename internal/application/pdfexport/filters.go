@@ -0,0 +1,34 @@
+package pdfexport
+
+import (
+	"context"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// listTransactions returns the transactions of type tType between startDate
+// and endDate matching every filter of f but its dates, which the report
+// period replaces. A type filter other than tType matches nothing.
+func (uc *UseCase) listTransactions(
+	ctx context.Context,
+	tType domaintransaction.TransactionType,
+	startDate, endDate string,
+	f domainexport.Filters,
+) ([]domaintransaction.Transaction, error) {
+	transactions := make([]domaintransaction.Transaction, 0)
+	if f.Type != "" && f.Type != string(tType) {
+		return transactions, nil
+	}
+
+	f.Type, f.StartDate, f.EndDate = string(tType), startDate, endDate
+	err := uc.repo.StreamTransactions(ctx, f, func(tx domaintransaction.Transaction) error {
+		transactions = append(transactions, tx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
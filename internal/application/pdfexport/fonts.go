@@ -0,0 +1,31 @@
+package pdfexport
+
+import (
+	_ "embed"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// fontFamily is the UTF-8 font family used by every report.
+const fontFamily = "DejaVu"
+
+// DejaVu Sans Condensed covers Latin accented letters and currency symbols,
+// which the PDF core fonts cannot render from UTF-8 text. Its license is in
+// fonts/LICENSE.
+var (
+	//go:embed fonts/DejaVuSansCondensed.ttf
+	fontRegular []byte
+	//go:embed fonts/DejaVuSansCondensed-Bold.ttf
+	fontBold []byte
+	//go:embed fonts/DejaVuSansCondensed-Oblique.ttf
+	fontItalic []byte
+)
+
+// newDocument creates an A4 portrait document with the embedded fonts registered.
+func newDocument() *fpdf.Fpdf {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(fontFamily, "", fontRegular)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", fontBold)
+	pdf.AddUTF8FontFromBytes(fontFamily, "I", fontItalic)
+	return pdf
+}
@@ -0,0 +1,208 @@
+package pdfexport
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type (
+	// Locale selects the language of the report labels and number formatting.
+	Locale string
+
+	// labels holds the translated text of a report.
+	labels struct {
+		title          string
+		summary        string
+		totalIncome    string
+		totalExpense   string
+		netBalance     string
+		byCategory     string
+		category       string
+		amount         string
+		percentage     string
+		transactions   string
+		date           string
+		txType         string
+		description    string
+		income         string
+		expense        string
+		uncategorized  string
+		accounts       string
+		account        string
+		balance        string
+		charts         string
+		dailyChart     string
+		accountsChart  string
+		other          string
+		noData         string
+		periodSummary  string
+		contents       string
+		page           string
+		comparison     string
+		metric         string
+		current        string
+		previous       string
+		change         string
+		net            string
+		month          string
+		monthly        string
+		trends         string
+		monthlyChart   string
+		notAvailable   string
+		accountTypes   map[string]string
+		months         [12]string
+		monthYearOrder string
+	}
+)
+
+const (
+	// LocaleEnglish renders labels in English with 1,234.56 numbers.
+	LocaleEnglish Locale = "en"
+	// LocaleSpanish renders labels in Spanish with 1.234,56 numbers.
+	LocaleSpanish Locale = "es"
+)
+
+// ErrUnsupportedLocale is returned when a report is requested in an unknown locale.
+var ErrUnsupportedLocale = errors.New("unsupported locale: must be 'en' or 'es'")
+
+// labelsFor returns the labels of locale.
+func labelsFor(locale Locale) (labels, error) {
+	switch locale {
+	case LocaleEnglish:
+		return englishLabels(), nil
+	case LocaleSpanish:
+		return spanishLabels(), nil
+	default:
+		return labels{}, ErrUnsupportedLocale
+	}
+}
+
+// englishLabels returns the labels of LocaleEnglish.
+func englishLabels() labels {
+	return labels{
+		title:         "Financial Report",
+		summary:       "Monthly Summary",
+		totalIncome:   "Total Income",
+		totalExpense:  "Total Expense",
+		netBalance:    "Net Balance",
+		byCategory:    "Expenses by Category",
+		category:      "Category",
+		amount:        "Amount",
+		percentage:    "Percentage",
+		transactions:  "Transactions",
+		date:          "Date",
+		txType:        "Type",
+		description:   "Description",
+		income:        "Income",
+		expense:       "Expense",
+		uncategorized: "Uncategorized",
+		accounts:      "Account Balances",
+		account:       "Account",
+		balance:       "Balance",
+		charts:        "Charts",
+		dailyChart:    "Daily Income vs Expense",
+		accountsChart: "Balance per Account",
+		other:         "Other",
+		noData:        "No data for this period",
+		periodSummary: "Summary",
+		contents:      "Contents",
+		page:          "Page %d",
+		comparison:    "Year-over-Year Comparison",
+		metric:        "Metric",
+		current:       "This period",
+		previous:      "Previous year",
+		change:        "Change",
+		net:           "Net",
+		month:         "Month",
+		monthly:       "Monthly Breakdown",
+		trends:        "Category Trends",
+		monthlyChart:  "Monthly Income vs Expense",
+		notAvailable:  "n/a",
+		accountTypes: map[string]string{
+			"cash": "Cash", "bank": "Bank", "credit_card": "Credit card", "savings": "Savings",
+		},
+		months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		monthYearOrder: "%s %d",
+	}
+}
+
+// spanishLabels returns the labels of LocaleSpanish.
+func spanishLabels() labels {
+	return labels{
+		title:         "Reporte Financiero",
+		summary:       "Resumen del mes",
+		totalIncome:   "Ingresos totales",
+		totalExpense:  "Gastos totales",
+		netBalance:    "Balance neto",
+		byCategory:    "Gastos por categoría",
+		category:      "Categoría",
+		amount:        "Monto",
+		percentage:    "Porcentaje",
+		transactions:  "Transacciones",
+		date:          "Fecha",
+		txType:        "Tipo",
+		description:   "Descripción",
+		income:        "Ingreso",
+		expense:       "Gasto",
+		uncategorized: "Sin categoría",
+		accounts:      "Saldos de cuentas",
+		account:       "Cuenta",
+		balance:       "Saldo",
+		charts:        "Gráficos",
+		dailyChart:    "Ingresos vs gastos por día",
+		accountsChart: "Saldo por cuenta",
+		other:         "Otros",
+		noData:        "Sin datos para este periodo",
+		periodSummary: "Resumen",
+		contents:      "Contenido",
+		page:          "Página %d",
+		comparison:    "Comparación interanual",
+		metric:        "Indicador",
+		current:       "Este periodo",
+		previous:      "Año anterior",
+		change:        "Variación",
+		net:           "Neto",
+		month:         "Mes",
+		monthly:       "Desglose mensual",
+		trends:        "Tendencia por categoría",
+		monthlyChart:  "Ingresos vs gastos por mes",
+		notAvailable:  "n/d",
+		accountTypes: map[string]string{
+			"cash": "Efectivo", "bank": "Banco", "credit_card": "Tarjeta de crédito", "savings": "Ahorros",
+		},
+		months: [12]string{
+			"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+		},
+		monthYearOrder: "%s de %d",
+	}
+}
+
+// monthYear formats t as "February 2026" or "febrero de 2026".
+func (l labels) monthYear(t time.Time) string {
+	return fmt.Sprintf(l.monthYearOrder, l.months[t.Month()-1], t.Year())
+}
+
+// dayRange formats an inclusive range of days as "25 Feb – 24 Mar 2026", or
+// "25 Dec 2025 – 24 Jan 2026" when it spans two years.
+func (l labels) dayRange(from, to time.Time) string {
+	short := func(t time.Time) string {
+		return fmt.Sprintf("%d %s", t.Day(), string([]rune(l.months[t.Month()-1])[:3]))
+	}
+	if from.Year() != to.Year() {
+		return fmt.Sprintf("%s %d – %s %d", short(from), from.Year(), short(to), to.Year())
+	}
+	return fmt.Sprintf("%s – %s %d", short(from), short(to), to.Year())
+}
+
+// accountType translates an account type, falling back to its raw value.
+func (l labels) accountType(t string) string {
+	if name, ok := l.accountTypes[t]; ok {
+		return name
+	}
+	return t
+}
@@ -8,6 +8,7 @@ import (
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -30,9 +31,15 @@ func (m *Repository) ListCategories(ctx context.Context) ([]domaincategory.Categ
 	return categories, args.Error(1)
 }
 
-// ListTransactions mocks Repository.ListTransactions.
-func (m *Repository) ListTransactions(ctx context.Context, tType domaintransaction.TransactionType, startDate, endDate string) ([]domaintransaction.Transaction, error) {
-	args := m.Called(ctx, tType, startDate, endDate)
+// StreamTransactions mocks Repository.StreamTransactions. It calls fn for each
+// transaction in the first return value and then returns the second one.
+func (m *Repository) StreamTransactions(ctx context.Context, filters domainexport.Filters, fn func(domaintransaction.Transaction) error) error {
+	args := m.Called(ctx, filters)
 	transactions, _ := args.Get(0).([]domaintransaction.Transaction)
-	return transactions, args.Error(1)
+	for _, tx := range transactions {
+		if err := fn(tx); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
 }
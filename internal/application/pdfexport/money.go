@@ -0,0 +1,89 @@
+package pdfexport
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// currencyFormat describes how amounts of a currency are written.
+type currencyFormat struct {
+	symbol   string
+	decimals int
+}
+
+// knownCurrencyFormat returns the format of a currency with a known symbol.
+// Currencies that share "$" with the dollar get a distinguishing prefix.
+func knownCurrencyFormat(currency string) (currencyFormat, bool) {
+	switch currency {
+	case "USD":
+		return currencyFormat{symbol: "$", decimals: 2}, true
+	case "EUR":
+		return currencyFormat{symbol: "€", decimals: 2}, true
+	case "GBP":
+		return currencyFormat{symbol: "£", decimals: 2}, true
+	case "JPY":
+		return currencyFormat{symbol: "¥", decimals: 0}, true
+	case "MXN":
+		return currencyFormat{symbol: "MX$", decimals: 2}, true
+	case "COP":
+		return currencyFormat{symbol: "COL$", decimals: 0}, true
+	case "CLP":
+		return currencyFormat{symbol: "CLP$", decimals: 0}, true
+	case "ARS":
+		return currencyFormat{symbol: "AR$", decimals: 2}, true
+	case "BRL":
+		return currencyFormat{symbol: "R$", decimals: 2}, true
+	case "PEN":
+		return currencyFormat{symbol: "S/", decimals: 2}, true
+	default:
+		return currencyFormat{}, false
+	}
+}
+
+// formatMoney formats amount in currency using the locale's separators,
+// e.g. "$1,234.56" in English or "€1.234,56" in Spanish. Unknown currencies
+// are prefixed with their code.
+func formatMoney(amount float64, currency string, locale Locale) string {
+	currency = strings.ToUpper(currency)
+	format, ok := knownCurrencyFormat(currency)
+	if !ok {
+		format = currencyFormat{symbol: currency + " ", decimals: 2}
+	}
+
+	sign := ""
+	if math.Round(amount*math.Pow10(format.decimals)) < 0 {
+		sign = "-"
+	}
+	return sign + format.symbol + formatNumber(math.Abs(amount), format.decimals, locale)
+}
+
+// formatNumber formats a non-negative value with thousands separators and the
+// given number of decimals, using "," and "." as English does or swapped for Spanish.
+func formatNumber(value float64, decimals int, locale Locale) string {
+	thousands, decimal := ",", "."
+	if locale == LocaleSpanish {
+		thousands, decimal = ".", ","
+	}
+
+	fixed := strconv.FormatFloat(value, 'f', decimals, 64)
+	integer, fraction, _ := strings.Cut(fixed, ".")
+
+	var b strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteString(thousands)
+		}
+		b.WriteRune(digit)
+	}
+	if fraction != "" {
+		b.WriteString(decimal)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
+
+// formatPercent formats a percentage with one decimal and the locale's decimal separator.
+func formatPercent(value float64, locale Locale) string {
+	return formatNumber(value, 1, locale) + "%"
+}
@@ -4,52 +4,107 @@ package pdfexport
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"time"
+	"sort"
+	"strings"
 
 	"github.com/go-pdf/fpdf"
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
+// defaultCurrency is used for transactions whose account is unknown and when there are no accounts.
+const defaultCurrency = "USD"
+
 // Repository is the port required by the PDF export use case.
 type Repository interface {
 	ListAccounts(ctx context.Context) ([]domainaccount.Account, error)
 	ListCategories(ctx context.Context) ([]domaincategory.Category, error)
-	ListTransactions(ctx context.Context, tType domaintransaction.TransactionType, startDate, endDate string) ([]domaintransaction.Transaction, error)
+	StreamTransactions(ctx context.Context, filters domainexport.Filters, fn func(domaintransaction.Transaction) error) error
+}
+
+// MonthStart is the port for the day of the month months start on.
+type MonthStart interface {
+	Execute(ctx context.Context) (int, error)
 }
 
 // UseCase implements the PDF export use case.
 type UseCase struct {
-	repo Repository
+	repo       Repository
+	monthStart MonthStart
+	locale     Locale
 }
 
-// Input represents the PDF export request.
+// Input represents the PDF export request. The period is taken from
+// StartDate/EndDate when given, otherwise from Year, otherwise from Month.
 type Input struct {
 	Month         string `json:"month"` // Format: "2026-02"
+	Year          int    `json:"year"`
+	StartDate     string `json:"start_date"` // Format: "2026-01-15"
+	EndDate       string `json:"end_date"`   // Format: "2026-03-31"
 	IncludeCharts bool   `json:"include_charts"`
+	Locale        Locale `json:"locale"` // "en" or "es"; empty uses the configured default
+	// UserPassword, when set, is required to open the report. OwnerPassword
+	// unlocks editing and copying; a random one is used when only
+	// UserPassword is given.
+	UserPassword  string `json:"user_password"`
+	OwnerPassword string `json:"owner_password"`
+	// Filters narrows the reported transactions, in the current period and
+	// in the comparison one; its dates are ignored.
+	Filters domainexport.Filters `json:"-"`
+}
+
+// report carries the document being written together with its language settings.
+type report struct {
+	pdf    *fpdf.Fpdf
+	locale Locale
+	labels labels
+	// contents collects the section headings when the report has a table of contents.
+	contents []contentsEntry
+	withTOC  bool
+	// monthStartDay is the day of the month the monthly breakdowns start on.
+	monthStartDay int
 }
 
-// New creates a new PDF Export UseCase.
-func New(repo Repository) *UseCase {
-	return &UseCase{repo: repo}
+// New creates a new PDF Export UseCase. locale is the default report language.
+func New(repo Repository, monthStart MonthStart, locale Locale) *UseCase {
+	return &UseCase{repo: repo, monthStart: monthStart, locale: locale}
 }
 
-// Execute generates a PDF report for the specified month.
+// Execute generates a PDF report for the requested month, year or date range.
+// Yearly and range reports add a month-by-month breakdown, category trends,
+// a comparison with the same period one year earlier and a table of contents.
 func (uc *UseCase) Execute(ctx context.Context, in Input) ([]byte, error) {
-	// Validate month format
-	monthDate, err := time.Parse("2006-01", in.Month)
+	monthStartDay, err := uc.monthStart.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export pdf: %w", err)
+	}
+
+	p, err := resolvePeriod(in, monthStartDay)
+	if errors.Is(err, ErrInvalidPeriod) {
+		return nil, fmt.Errorf("export pdf: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	locale := in.Locale
+	if locale == "" {
+		locale = uc.locale
+	}
+	if locale == "" {
+		locale = LocaleEnglish
+	}
+	l, err := labelsFor(locale)
 	if err != nil {
-		return nil, fmt.Errorf("invalid month format: %w", err)
+		return nil, fmt.Errorf("export pdf: %w", err)
 	}
 
-	// Calculate month boundaries
-	startOfMonth := time.Date(monthDate.Year(), monthDate.Month(), 1, 0, 0, 0, 0, time.UTC)
-	endOfMonth := startOfMonth.AddDate(0, 1, -1)
-	startDateStr := startOfMonth.Format("2006-01-02")
-	endDateStr := endOfMonth.Format("2006-01-02")
+	startDateStr, endDateStr := p.bounds()
 
 	// Get data
 	accounts, err := uc.repo.ListAccounts(ctx)
@@ -62,25 +117,45 @@ func (uc *UseCase) Execute(ctx context.Context, in Input) ([]byte, error) {
 		return nil, fmt.Errorf("export pdf: %w", err)
 	}
 
-	incomes, err := uc.repo.ListTransactions(ctx, domaintransaction.TransactionTypeIncome, startDateStr, endDateStr)
+	incomes, err := uc.listTransactions(ctx, domaintransaction.TransactionTypeIncome, startDateStr, endDateStr, in.Filters)
 	if err != nil {
 		return nil, fmt.Errorf("export pdf: %w", err)
 	}
 
-	expenses, err := uc.repo.ListTransactions(ctx, domaintransaction.TransactionTypeExpense, startDateStr, endDateStr)
+	expenses, err := uc.listTransactions(ctx, domaintransaction.TransactionTypeExpense, startDateStr, endDateStr, in.Filters)
 	if err != nil {
 		return nil, fmt.Errorf("export pdf: %w", err)
 	}
 
-	// Calculate summary
-	var totalIncome, totalExpense float64
-	for _, tx := range incomes {
-		totalIncome += tx.Amount
+	var previousIncomes, previousExpenses []domaintransaction.Transaction
+	if p.extended {
+		prevStart, prevEnd := p.previousYear().bounds()
+		previousIncomes, err = uc.listTransactions(ctx, domaintransaction.TransactionTypeIncome, prevStart, prevEnd, in.Filters)
+		if err != nil {
+			return nil, fmt.Errorf("export pdf: %w", err)
+		}
+		previousExpenses, err = uc.listTransactions(ctx, domaintransaction.TransactionTypeExpense, prevStart, prevEnd, in.Filters)
+		if err != nil {
+			return nil, fmt.Errorf("export pdf: %w", err)
+		}
 	}
-	for _, tx := range expenses {
-		totalExpense += tx.Amount
+
+	// Resolve the currency of every transaction through its account
+	accountCurrency := make(map[string]string)
+	for _, acc := range accounts {
+		accountCurrency[acc.ID] = normalizeCurrency(acc.Currency)
+	}
+	currencyOf := func(tx domaintransaction.Transaction) string {
+		if c, ok := accountCurrency[tx.AccountID]; ok {
+			return c
+		}
+		return defaultCurrency
 	}
-	netBalance := totalIncome - totalExpense
+
+	// Calculate summary per currency
+	totalIncome := sumByCurrency(incomes, currencyOf)
+	totalExpense := sumByCurrency(expenses, currencyOf)
+	netBalance := net(totalIncome, totalExpense)
 
 	// Calculate expenses by category
 	categoryMap := make(map[string]string)
@@ -88,130 +163,188 @@ func (uc *UseCase) Execute(ctx context.Context, in Input) ([]byte, error) {
 		categoryMap[cat.ID] = cat.Name
 	}
 
-	expenseByCategory := make(map[string]float64)
+	type categoryTotal struct {
+		name     string
+		currency string
+		amount   float64
+	}
+	byCategory := make(map[[2]string]*categoryTotal)
 	for _, tx := range expenses {
-		expenseByCategory[tx.CategoryID] += tx.Amount
+		key := [2]string{tx.CategoryID, currencyOf(tx)}
+		if byCategory[key] == nil {
+			name := categoryMap[tx.CategoryID]
+			if name == "" {
+				name = l.uncategorized
+			}
+			byCategory[key] = &categoryTotal{name: name, currency: key[1]}
+		}
+		byCategory[key].amount += tx.Amount
+	}
+	categoryTotals := make([]*categoryTotal, 0, len(byCategory))
+	for _, ct := range byCategory {
+		categoryTotals = append(categoryTotals, ct)
 	}
+	sort.Slice(categoryTotals, func(i, j int) bool {
+		if categoryTotals[i].currency != categoryTotals[j].currency {
+			return categoryTotals[i].currency < categoryTotals[j].currency
+		}
+		if categoryTotals[i].amount != categoryTotals[j].amount {
+			return categoryTotals[i].amount > categoryTotals[j].amount
+		}
+		return categoryTotals[i].name < categoryTotals[j].name
+	})
 
 	// Create PDF
-	pdf := fpdf.New("P", "mm", "A4", "")
+	r := &report{pdf: newDocument(), locale: locale, labels: l, withTOC: p.extended, monthStartDay: monthStartDay}
+	pdf := r.pdf
+	if in.UserPassword != "" || in.OwnerPassword != "" {
+		// Protected reports may be printed but not modified or copied from.
+		pdf.SetProtection(fpdf.CnProtectPrint, in.UserPassword, in.OwnerPassword)
+	}
+	if p.extended {
+		r.pageNumbers()
+	}
 	pdf.AddPage()
 
 	// Title
-	pdf.SetFont("Arial", "B", 16)
-	pdf.Cell(0, 10, fmt.Sprintf("Financial Report - %s", monthDate.Format("January 2006")))
+	pdf.SetFont(fontFamily, "B", 16)
+	pdf.Cell(0, 10, fmt.Sprintf("%s - %s", l.title, p.label(l)))
 	pdf.Ln(15)
 
+	// Contents are written on the title page once every section knows its page
+	if p.extended {
+		pdf.SetFont(fontFamily, "B", 14)
+		pdf.Cell(0, 10, l.contents)
+		pdf.Ln(12)
+		pdf.AddPage()
+	}
+
 	// Summary Section
-	pdf.SetFont("Arial", "B", 14)
-	pdf.Cell(0, 10, "Monthly Summary")
-	pdf.Ln(10)
+	if p.extended {
+		r.heading(l.periodSummary)
+	} else {
+		r.heading(l.summary)
+	}
 
-	pdf.SetFont("Arial", "", 12)
-	pdf.Cell(0, 8, fmt.Sprintf("Total Income: $%.2f", totalIncome))
+	pdf.SetFont(fontFamily, "", 12)
+	pdf.Cell(0, 8, fmt.Sprintf("%s: %s", l.totalIncome, r.amounts(totalIncome)))
 	pdf.Ln(8)
-	pdf.Cell(0, 8, fmt.Sprintf("Total Expense: $%.2f", totalExpense))
+	pdf.Cell(0, 8, fmt.Sprintf("%s: %s", l.totalExpense, r.amounts(totalExpense)))
 	pdf.Ln(8)
-	pdf.SetFont("Arial", "B", 12)
-	pdf.Cell(0, 8, fmt.Sprintf("Net Balance: $%.2f", netBalance))
+	pdf.SetFont(fontFamily, "B", 12)
+	pdf.Cell(0, 8, fmt.Sprintf("%s: %s", l.netBalance, r.amounts(netBalance)))
 	pdf.Ln(15)
 
-	// Expenses by Category Section
-	if len(expenseByCategory) > 0 {
-		pdf.SetFont("Arial", "B", 14)
-		pdf.Cell(0, 10, "Expenses by Category")
-		pdf.Ln(10)
-
-		pdf.SetFont("Arial", "B", 11)
-		pdf.Cell(80, 8, "Category")
-		pdf.Cell(50, 8, "Amount")
-		pdf.Cell(50, 8, "Percentage")
-		pdf.Ln(8)
+	// Year-over-Year and Monthly Sections
+	if p.extended {
+		previousIncome := sumByCurrency(previousIncomes, currencyOf)
+		previousExpense := sumByCurrency(previousExpenses, currencyOf)
+		r.writeComparison(
+			[3]map[string]float64{totalIncome, totalExpense, netBalance},
+			[3]map[string]float64{previousIncome, previousExpense, net(previousIncome, previousExpense)},
+		)
+		r.writeMonthly(p.months(), incomes, expenses, currencyOf)
+	}
 
-		pdf.SetFont("Arial", "", 11)
-		for catID, amount := range expenseByCategory {
-			catName := categoryMap[catID]
-			if catName == "" {
-				catName = "Uncategorized"
-			}
+	// Expenses by Category Section
+	if len(categoryTotals) > 0 {
+		r.heading(l.byCategory)
+
+		widths := []float64{80, 50, 50}
+		header := func() {
+			pdf.SetFont(fontFamily, "B", 11)
+			r.tableRow(widths, 8, l.category, l.amount, l.percentage)
+			pdf.SetFont(fontFamily, "", 11)
+		}
+		header()
+		for _, ct := range categoryTotals {
 			percentage := 0.0
-			if totalExpense > 0 {
-				percentage = (amount / totalExpense) * 100
+			if total := totalExpense[ct.currency]; total > 0 {
+				percentage = (ct.amount / total) * 100
 			}
-
-			pdf.Cell(80, 8, catName)
-			pdf.Cell(50, 8, fmt.Sprintf("$%.2f", amount))
-			pdf.Cell(50, 8, fmt.Sprintf("%.1f%%", percentage))
-			pdf.Ln(8)
+			r.tableRowWithHeader(widths, 8, header,
+				ct.name,
+				formatMoney(ct.amount, ct.currency, locale),
+				formatPercent(percentage, locale),
+			)
 		}
 		pdf.Ln(10)
 	}
 
+	// Category Trends Section
+	if p.extended && len(expenses) > 0 {
+		r.writeTrends(p.months(), categoryMap, expenses, currencyOf)
+	}
+
+	// Charts Section
+	if in.IncludeCharts {
+		r.drawCharts(p, categories, incomes, expenses, accounts, currencyOf)
+	}
+
 	// Transactions Section
-	allTransactions := append(incomes, expenses...)
+	allTransactions := make([]domaintransaction.Transaction, 0, len(incomes)+len(expenses))
+	allTransactions = append(allTransactions, incomes...)
+	allTransactions = append(allTransactions, expenses...)
 	if len(allTransactions) > 0 {
 		pdf.AddPage()
-		pdf.SetFont("Arial", "B", 14)
-		pdf.Cell(0, 10, "Transactions")
-		pdf.Ln(10)
+		r.heading(l.transactions)
 
-		// Table header
-		pdf.SetFont("Arial", "B", 10)
-		pdf.Cell(30, 8, "Date")
-		pdf.Cell(25, 8, "Type")
-		pdf.Cell(35, 8, "Amount")
-		pdf.Cell(50, 8, "Category")
-		pdf.Cell(50, 8, "Description")
-		pdf.Ln(8)
-
-		// Table rows
-		pdf.SetFont("Arial", "", 9)
+		widths := []float64{25, 22, 33, 45, 65}
+		header := func() {
+			pdf.SetFont(fontFamily, "B", 10)
+			r.tableRow(widths, 8, l.date, l.txType, l.amount, l.category, l.description)
+			pdf.SetFont(fontFamily, "", 9)
+		}
+		header()
 		for _, tx := range allTransactions {
 			catName := categoryMap[tx.CategoryID]
+			txType := l.expense
+			if tx.Type == domaintransaction.TransactionTypeIncome {
+				txType = l.income
+			}
 			if catName == "" {
 				if tx.Type == domaintransaction.TransactionTypeIncome {
-					catName = "Income"
+					catName = l.income
 				} else {
-					catName = "Uncategorized"
+					catName = l.uncategorized
 				}
 			}
 
-			desc := tx.Description
-			if len(desc) > 20 {
-				desc = desc[:17] + "..."
-			}
-
-			pdf.Cell(30, 7, tx.Date.Format("2006-01-02"))
-			pdf.Cell(25, 7, string(tx.Type))
-			pdf.Cell(35, 7, fmt.Sprintf("$%.2f", tx.Amount))
-			pdf.Cell(50, 7, catName)
-			pdf.Cell(50, 7, desc)
-			pdf.Ln(7)
+			r.tableRowWithHeader(widths, 5, header,
+				tx.Date.Format("2006-01-02"),
+				txType,
+				formatMoney(tx.Amount, currencyOf(tx), locale),
+				catName,
+				tx.Description,
+			)
 		}
 	}
 
 	// Accounts Section
 	if len(accounts) > 0 {
 		pdf.AddPage()
-		pdf.SetFont("Arial", "B", 14)
-		pdf.Cell(0, 10, "Account Balances")
-		pdf.Ln(10)
-
-		pdf.SetFont("Arial", "B", 11)
-		pdf.Cell(80, 8, "Account")
-		pdf.Cell(50, 8, "Type")
-		pdf.Cell(50, 8, "Balance")
-		pdf.Ln(8)
+		r.heading(l.accounts)
 
-		pdf.SetFont("Arial", "", 11)
+		widths := []float64{80, 50, 50}
+		header := func() {
+			pdf.SetFont(fontFamily, "B", 11)
+			r.tableRow(widths, 8, l.account, l.txType, l.balance)
+			pdf.SetFont(fontFamily, "", 11)
+		}
+		header()
 		for _, acc := range accounts {
-			pdf.Cell(80, 8, acc.Name)
-			pdf.Cell(50, 8, string(acc.Type))
-			pdf.Cell(50, 8, fmt.Sprintf("$%.2f", acc.CurrentBalance))
-			pdf.Ln(8)
+			r.tableRowWithHeader(widths, 8, header,
+				acc.Name,
+				l.accountType(string(acc.Type)),
+				formatMoney(acc.CurrentBalance, accountCurrency[acc.ID], locale),
+			)
 		}
 	}
 
+	if p.extended {
+		r.writeContents()
+	}
+
 	// Generate PDF bytes
 	var buf bytes.Buffer
 	err = pdf.Output(&buf)
@@ -221,3 +354,68 @@ func (uc *UseCase) Execute(ctx context.Context, in Input) ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// amounts formats one amount per currency, e.g. "$1,200.00 / €80.00".
+// An empty map is rendered as zero in the default currency.
+func (r *report) amounts(byCurrency map[string]float64) string {
+	if len(byCurrency) == 0 {
+		return formatMoney(0, defaultCurrency, r.locale)
+	}
+
+	currencies := make([]string, 0, len(byCurrency))
+	for c := range byCurrency {
+		currencies = append(currencies, c)
+	}
+	sort.Strings(currencies)
+
+	parts := make([]string, len(currencies))
+	for i, c := range currencies {
+		parts[i] = formatMoney(byCurrency[c], c, r.locale)
+	}
+	return strings.Join(parts, " / ")
+}
+
+// tableRowWithHeader writes a table row, first starting a new page and
+// repeating the header when the row would not fit on the current one.
+func (r *report) tableRowWithHeader(widths []float64, lineHeight float64, header func(), cells ...string) {
+	_, pageHeight := r.pdf.GetPageSize()
+	_, bottomMargin := r.pdf.GetAutoPageBreak()
+	if r.pdf.GetY()+r.rowHeight(widths, lineHeight, cells) > pageHeight-bottomMargin {
+		r.addPageLikeCurrent()
+		header()
+	}
+	r.tableRow(widths, lineHeight, cells...)
+}
+
+// tableRow writes a row whose cells wrap within their column widths. The row
+// is as tall as its tallest cell and the cursor ends at the start of the next row.
+func (r *report) tableRow(widths []float64, lineHeight float64, cells ...string) {
+	x, y := r.pdf.GetXY()
+	height := r.rowHeight(widths, lineHeight, cells)
+
+	left := x
+	for i, text := range cells {
+		r.pdf.SetXY(left, y)
+		r.pdf.MultiCell(widths[i], lineHeight, text, "", "L", false)
+		left += widths[i]
+	}
+	r.pdf.SetXY(x, y+height)
+}
+
+// rowHeight returns the height needed to fit every cell of a row once wrapped.
+func (r *report) rowHeight(widths []float64, lineHeight float64, cells []string) float64 {
+	lines := 1
+	for i, text := range cells {
+		lines = max(lines, len(r.pdf.SplitText(text, widths[i])))
+	}
+	return float64(lines) * lineHeight
+}
+
+// normalizeCurrency upper-cases a currency code, defaulting to USD when empty.
+func normalizeCurrency(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return defaultCurrency
+	}
+	return currency
+}
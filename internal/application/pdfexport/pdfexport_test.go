@@ -5,15 +5,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/financial-manager/api/internal/application/pdfexport"
 	"github.com/financial-manager/api/internal/application/pdfexport/mocks"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -21,10 +25,14 @@ func TestUseCase_Execute(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name    string
-		repo    *mocks.Repository
-		input   pdfexport.Input
-		wantErr error
+		name          string
+		repo          *mocks.Repository
+		input         pdfexport.Input
+		monthStartDay int
+		wantErr       error
+		wantPages     int
+		wantLinks     bool
+		wantProtected bool
 	}{
 		{
 			name: "generates PDF report successfully",
@@ -47,7 +55,77 @@ func TestUseCase_Execute(t *testing.T) {
 				Month:         "2026-02",
 				IncludeCharts: false,
 			},
-			wantErr: nil,
+			wantErr:   nil,
+			wantPages: 3,
+		},
+		{
+			name: "include charts adds a charts page",
+			repo: buildMockRepo(
+				[]domainaccount.Account{
+					{ID: "acc-1", Name: "Banco", Type: domainaccount.AccountTypeBank, CurrentBalance: 1200.00},
+					{ID: "acc-2", Name: "Visa", Type: domainaccount.AccountTypeCreditCard, CurrentBalance: -300.00},
+				},
+				[]domaincategory.Category{
+					{ID: "cat-1", Name: "Alimentación", Color: "#FF5733"},
+					{ID: "cat-2", Name: "Transporte", Color: "not-a-color"},
+				},
+				[]domaintransaction.Transaction{
+					buildIncome("tx-1", 1000.00),
+				},
+				[]domaintransaction.Transaction{
+					buildExpense("tx-2", 50.00, "cat-1"),
+					buildExpense("tx-3", 20.00, "cat-2"),
+				},
+				nil,
+			),
+			input: pdfexport.Input{
+				Month:         "2026-02",
+				IncludeCharts: true,
+			},
+			wantPages: 4,
+		},
+		{
+			name: "include charts groups many categories and wraps many accounts",
+			repo: buildMockRepo(
+				buildAccounts(12),
+				buildCategories(12),
+				[]domaintransaction.Transaction{},
+				buildExpensesPerCategory(12),
+				nil,
+			),
+			input: pdfexport.Input{
+				Month:         "2026-02",
+				IncludeCharts: true,
+			},
+			wantPages: 5,
+		},
+		{
+			name: "include charts with no data still renders the charts page",
+			repo: buildMockRepo(
+				[]domainaccount.Account{},
+				[]domaincategory.Category{},
+				[]domaintransaction.Transaction{},
+				[]domaintransaction.Transaction{},
+				nil,
+			),
+			input: pdfexport.Input{
+				Month:         "2026-02",
+				IncludeCharts: true,
+			},
+			wantPages: 2,
+		},
+		{
+			name: "passwords protect the report",
+			repo: buildMockRepo(
+				[]domainaccount.Account{},
+				[]domaincategory.Category{},
+				[]domaintransaction.Transaction{},
+				[]domaintransaction.Transaction{},
+				nil,
+			),
+			input:         pdfexport.Input{Month: "2026-02", UserPassword: "reader", OwnerPassword: "owner"},
+			wantPages:     1,
+			wantProtected: true,
 		},
 		{
 			name:    "invalid month format returns error",
@@ -64,8 +142,9 @@ func TestUseCase_Execute(t *testing.T) {
 				[]domaintransaction.Transaction{},
 				nil,
 			),
-			input:   pdfexport.Input{Month: "2026-01"},
-			wantErr: nil,
+			input:     pdfexport.Input{Month: "2026-01"},
+			wantErr:   nil,
+			wantPages: 1,
 		},
 		{
 			name:    "repository error is propagated",
@@ -73,13 +152,139 @@ func TestUseCase_Execute(t *testing.T) {
 			input:   pdfexport.Input{Month: "2026-02"},
 			wantErr: fmt.Errorf("export pdf: %w", errors.New("db error")),
 		},
+		{
+			name: "renders a Spanish report with accented names and mixed currencies",
+			repo: buildMockRepo(
+				[]domainaccount.Account{
+					{ID: "acc-1", Name: "Cuenta de Ahorros Bogotá", Type: domainaccount.AccountTypeSavings, Currency: "COP", CurrentBalance: 1250000},
+					{ID: "acc-2", Name: "Tarjeta Crédito", Type: domainaccount.AccountTypeCreditCard, Currency: "eur", CurrentBalance: -320.5},
+				},
+				[]domaincategory.Category{
+					{ID: "cat-1", Name: "Alimentación", Color: "#FF5733"},
+					{ID: "cat-2", Name: "Educación", Color: "#3357FF"},
+				},
+				[]domaintransaction.Transaction{
+					buildIncome("tx-1", 1000.00),
+				},
+				[]domaintransaction.Transaction{
+					buildExpense("tx-2", 50.00, "cat-1"),
+					buildExpenseInAccount("tx-3", 20.00, "cat-2", "acc-2"),
+				},
+				nil,
+			),
+			input: pdfexport.Input{Month: "2026-02", Locale: pdfexport.LocaleSpanish, IncludeCharts: true},
+			// One charts page per currency
+			wantPages: 5,
+		},
+		{
+			name: "wraps long descriptions instead of truncating them",
+			repo: buildMockRepo(
+				[]domainaccount.Account{},
+				[]domaincategory.Category{},
+				[]domaintransaction.Transaction{},
+				buildLongExpenses(40),
+				nil,
+			),
+			input:     pdfexport.Input{Month: "2026-02"},
+			wantPages: 6,
+		},
+		{
+			name: "yearly report adds contents, comparison, monthly and trend sections",
+			repo: buildMockRepoForPeriod(
+				[]domainaccount.Account{{ID: "acc-1", Name: "Banco", Currency: "USD", CurrentBalance: 900}},
+				[]domaincategory.Category{{ID: "cat-1", Name: "Alimentación"}},
+				[]domaintransaction.Transaction{buildIncomeOn("tx-1", 1000, "2026-02-15"), buildIncomeOn("tx-2", 1000, "2026-07-15")},
+				[]domaintransaction.Transaction{buildExpenseOn("tx-3", 50, "cat-1", "2026-02-16"), buildExpenseOn("tx-4", 75, "", "2026-11-02")},
+				"2026-01-01", "2026-12-31", "2025-01-01", "2025-12-31",
+				nil,
+			),
+			input:     pdfexport.Input{Year: 2026, IncludeCharts: true},
+			wantPages: 6,
+			wantLinks: true,
+		},
+		{
+			name: "range report spanning more than a year splits trends across pages",
+			repo: buildMockRepoForPeriod(
+				[]domainaccount.Account{},
+				[]domaincategory.Category{},
+				[]domaintransaction.Transaction{},
+				[]domaintransaction.Transaction{buildExpenseOn("tx-1", 50, "", "2025-03-01")},
+				"2024-11-15", "2026-02-28", "2023-11-15", "2025-02-28",
+				nil,
+			),
+			input:     pdfexport.Input{StartDate: "2024-11-15", EndDate: "2026-02-28", Locale: pdfexport.LocaleSpanish},
+			wantPages: 5,
+			wantLinks: true,
+		},
+		{
+			name: "previous period error is propagated",
+			repo: buildMockRepoForPeriod(
+				[]domainaccount.Account{},
+				[]domaincategory.Category{},
+				[]domaintransaction.Transaction{},
+				[]domaintransaction.Transaction{},
+				"2026-01-01", "2026-12-31", "2025-01-01", "2025-12-31",
+				errors.New("db error"),
+			),
+			input:   pdfexport.Input{Year: 2026},
+			wantErr: fmt.Errorf("export pdf: %w", errors.New("db error")),
+		},
+		{
+			name: "month starting on the 25th runs to the 24th of the next month",
+			repo: buildMockRepoForMonth("2026-02-25", "2026-03-24",
+				[]domaintransaction.Transaction{buildIncomeOn("tx-1", 3000, "2026-02-25")},
+				[]domaintransaction.Transaction{buildExpenseOn("tx-2", 80, "", "2026-03-20")},
+			),
+			input:         pdfexport.Input{Month: "2026-02", IncludeCharts: true},
+			monthStartDay: 25,
+			wantPages:     3,
+		},
+		{
+			name: "year starting on the 25th compares with the year before",
+			repo: buildMockRepoForPeriod(
+				[]domainaccount.Account{},
+				[]domaincategory.Category{},
+				[]domaintransaction.Transaction{buildIncomeOn("tx-1", 3000, "2026-01-25"), buildIncomeOn("tx-2", 3000, "2027-01-20")},
+				[]domaintransaction.Transaction{buildExpenseOn("tx-3", 80, "", "2026-03-20")},
+				"2026-01-25", "2027-01-24", "2025-01-25", "2026-01-24",
+				nil,
+			),
+			input:         pdfexport.Input{Year: 2026, IncludeCharts: true},
+			monthStartDay: 25,
+			wantPages:     5,
+			wantLinks:     true,
+		},
+		{
+			name:    "range with end before start returns error",
+			repo:    &mocks.Repository{},
+			input:   pdfexport.Input{StartDate: "2026-03-01", EndDate: "2026-01-01"},
+			wantErr: fmt.Errorf("export pdf: %w", pdfexport.ErrInvalidPeriod),
+		},
+		{
+			name:    "range without end date returns error",
+			repo:    &mocks.Repository{},
+			input:   pdfexport.Input{StartDate: "2026-03-01"},
+			wantErr: fmt.Errorf("export pdf: %w", pdfexport.ErrInvalidPeriod),
+		},
+		{
+			name:    "negative year returns error",
+			repo:    &mocks.Repository{},
+			input:   pdfexport.Input{Year: -1},
+			wantErr: fmt.Errorf("export pdf: %w", pdfexport.ErrInvalidPeriod),
+		},
+		{
+			name:    "unsupported locale returns error",
+			repo:    &mocks.Repository{},
+			input:   pdfexport.Input{Month: "2026-02", Locale: "fr"},
+			wantErr: fmt.Errorf("export pdf: %w", pdfexport.ErrUnsupportedLocale),
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			uc := pdfexport.New(tc.repo)
+			uc := pdfexport.New(tc.repo, buildMonthStart(max(tc.monthStartDay, 1), nil), pdfexport.LocaleEnglish)
 			pdf, err := uc.Execute(context.Background(), tc.input)
 
 			if tc.wantErr != nil {
@@ -91,12 +296,110 @@ func TestUseCase_Execute(t *testing.T) {
 				assert.Greater(t, len(pdf), 0)
 				// PDF files start with %PDF
 				assert.Equal(t, "%PDF", string(pdf[:4]))
+				assert.Equal(t, tc.wantPages, countPages(pdf))
+				// Text is rendered with the embedded UTF-8 font, not a core font
+				assert.Contains(t, string(pdf), "/BaseFont /utf8dejavu")
+				assert.NotContains(t, string(pdf), "/Helvetica")
+				// Only yearly and range reports link their contents to the sections
+				assert.Equal(t, tc.wantLinks, strings.Contains(string(pdf), "/Subtype /Link"))
+				assert.Equal(t, tc.wantProtected, strings.Contains(string(pdf), "/Encrypt"))
 			}
 			tc.repo.AssertExpectations(t)
 		})
 	}
 }
 
+func TestUseCase_Execute_Filters(t *testing.T) {
+	t.Parallel()
+
+	query := domainexport.Filters{AccountIDs: []string{"acc-1"}, Query: "100%", StartDate: "2020-01-01"}
+	withDates := func(tType domaintransaction.TransactionType, start, end string) domainexport.Filters {
+		f := inRange(tType, start, end)
+		f.AccountIDs, f.Query = query.AccountIDs, query.Query
+		return f
+	}
+
+	tests := []struct {
+		name      string
+		input     pdfexport.Input
+		buildRepo func() *mocks.Repository
+		wantPages int
+	}{
+		{
+			name:  "filters are applied by the repository with the report dates",
+			input: pdfexport.Input{Month: "2026-02", Filters: query},
+			buildRepo: func() *mocks.Repository {
+				m := &mocks.Repository{}
+				m.On("ListAccounts", mock.Anything).Return([]domainaccount.Account{}, nil).Once()
+				m.On("ListCategories", mock.Anything).Return([]domaincategory.Category{}, nil).Once()
+				m.On("StreamTransactions", mock.Anything, withDates(domaintransaction.TransactionTypeIncome, "2026-02-01", "2026-02-28")).
+					Return([]domaintransaction.Transaction{}, nil).Once()
+				m.On("StreamTransactions", mock.Anything, withDates(domaintransaction.TransactionTypeExpense, "2026-02-01", "2026-02-28")).
+					Return(buildLongExpenses(40), nil).Once()
+				return m
+			},
+			wantPages: 6,
+		},
+		{
+			name:  "comparison period uses the same filters",
+			input: pdfexport.Input{Year: 2026, Filters: query},
+			buildRepo: func() *mocks.Repository {
+				m := &mocks.Repository{}
+				m.On("ListAccounts", mock.Anything).Return([]domainaccount.Account{}, nil).Once()
+				m.On("ListCategories", mock.Anything).Return([]domaincategory.Category{}, nil).Once()
+				for _, r := range [][2]string{{"2026-01-01", "2026-12-31"}, {"2025-01-01", "2025-12-31"}} {
+					m.On("StreamTransactions", mock.Anything, withDates(domaintransaction.TransactionTypeIncome, r[0], r[1])).
+						Return([]domaintransaction.Transaction{}, nil).Once()
+					m.On("StreamTransactions", mock.Anything, withDates(domaintransaction.TransactionTypeExpense, r[0], r[1])).
+						Return([]domaintransaction.Transaction{}, nil).Once()
+				}
+				return m
+			},
+			wantPages: 2,
+		},
+		{
+			name:  "type filter leaves out the other type without querying it",
+			input: pdfexport.Input{Month: "2026-02", Filters: domainexport.Filters{Type: "expense"}},
+			buildRepo: func() *mocks.Repository {
+				m := &mocks.Repository{}
+				m.On("ListAccounts", mock.Anything).Return([]domainaccount.Account{}, nil).Once()
+				m.On("ListCategories", mock.Anything).Return([]domaincategory.Category{}, nil).Once()
+				m.On("StreamTransactions", mock.Anything, inRange(domaintransaction.TransactionTypeExpense, "2026-02-01", "2026-02-28")).
+					Return([]domaintransaction.Transaction{}, nil).Once()
+				return m
+			},
+			wantPages: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := tc.buildRepo()
+			uc := pdfexport.New(repo, buildMonthStart(1, nil), pdfexport.LocaleEnglish)
+
+			pdf, err := uc.Execute(context.Background(), tc.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantPages, countPages(pdf))
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_MonthStartError(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.Repository{}
+	uc := pdfexport.New(repo, buildMonthStart(0, errors.New("db error")), pdfexport.LocaleEnglish)
+
+	_, err := uc.Execute(context.Background(), pdfexport.Input{Month: "2026-02"})
+
+	assert.Equal(t, fmt.Errorf("export pdf: %w", errors.New("db error")), err)
+	repo.AssertExpectations(t)
+}
+
 // buildIncome creates an income transaction fixture.
 func buildIncome(id string, amount float64) domaintransaction.Transaction {
 	date, _ := time.Parse("2006-01-02", "2026-02-15")
@@ -0,0 +1,120 @@
+package pdfexport
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	reportperiod "github.com/financial-manager/api/internal/application/report/period"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// ErrInvalidPeriod is returned when a year or date range cannot be used as a report period.
+var ErrInvalidPeriod = errors.New("invalid period: year must be positive and start_date/end_date must be YYYY-MM-DD with start_date <= end_date")
+
+// period is the inclusive date range covered by a report.
+type period struct {
+	start time.Time
+	end   time.Time
+	// extended marks yearly and custom-range reports, which add the monthly
+	// breakdown, category trends, year-over-year comparison and contents.
+	extended bool
+	kind     periodKind
+	// monthStartDay is the day of the month months start on; one for
+	// calendar months.
+	monthStartDay int
+}
+
+// periodKind tells how a period was requested, which decides how it is labelled.
+type periodKind int
+
+const (
+	periodMonth periodKind = iota
+	periodYear
+	periodRange
+)
+
+// resolvePeriod picks the report period from the input. A start/end range
+// wins over a year, and a year wins over a month. Months and years start on
+// monthStartDay, so with 25 the month "2026-02" runs from 25 Feb to 24 Mar.
+func resolvePeriod(in Input, monthStartDay int) (period, error) {
+	switch {
+	case in.StartDate != "" || in.EndDate != "":
+		start, err := time.Parse("2006-01-02", in.StartDate)
+		if err != nil {
+			return period{}, ErrInvalidPeriod
+		}
+		end, err := time.Parse("2006-01-02", in.EndDate)
+		if err != nil || end.Before(start) {
+			return period{}, ErrInvalidPeriod
+		}
+		return period{start: start, end: end, extended: true, kind: periodRange, monthStartDay: monthStartDay}, nil
+
+	case in.Year != 0:
+		if in.Year < 1 || in.Year > 9999 {
+			return period{}, ErrInvalidPeriod
+		}
+		start := time.Date(in.Year, time.January, monthStartDay, 0, 0, 0, 0, time.UTC)
+		return period{start: start, end: start.AddDate(1, 0, -1), extended: true, kind: periodYear, monthStartDay: monthStartDay}, nil
+
+	default:
+		monthDate, err := time.Parse("2006-01", in.Month)
+		if err != nil {
+			return period{}, fmt.Errorf("invalid month format: %w", err)
+		}
+		start := time.Date(monthDate.Year(), monthDate.Month(), monthStartDay, 0, 0, 0, 0, time.UTC)
+		return period{start: start, end: start.AddDate(0, 1, -1), kind: periodMonth, monthStartDay: monthStartDay}, nil
+	}
+}
+
+// previousYear returns the same period one year earlier, used for the year-over-year comparison.
+func (p period) previousYear() period {
+	prev := p
+	prev.start = p.start.AddDate(-1, 0, 0)
+	prev.end = p.end.AddDate(-1, 0, 0)
+	if p.kind != periodRange && p.monthStartDay <= 1 {
+		// Keep whole months whole, e.g. February after a leap year.
+		prev.end = time.Date(p.end.Year()-1, p.end.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+	}
+	return prev
+}
+
+// months returns the first day of every month the period touches, in order.
+func (p period) months() []time.Time {
+	var months []time.Time
+	for m := monthStart(p.start, p.monthStartDay); !m.After(p.end); m = m.AddDate(0, 1, 0) {
+		months = append(months, m)
+	}
+	return months
+}
+
+// singleMonth reports whether the period lies within one month.
+func (p period) singleMonth() bool {
+	return len(p.months()) == 1
+}
+
+// label describes the period in the report language. Months and years that
+// do not start on the first are labelled with their day range.
+func (p period) label(l labels) string {
+	switch {
+	case p.kind == periodRange:
+		return p.start.Format("2006-01-02") + " – " + p.end.Format("2006-01-02")
+	case p.monthStartDay > 1:
+		return l.dayRange(p.start, p.end)
+	case p.kind == periodYear:
+		return fmt.Sprintf("%d", p.start.Year())
+	default:
+		return l.monthYear(p.start)
+	}
+}
+
+// monthStart returns the first day of the month t falls in when months start
+// on monthStartDay.
+func monthStart(t time.Time, monthStartDay int) time.Time {
+	return reportperiod.Start(t, domainreport.IntervalMonth, monthStartDay)
+}
+
+// bounds returns the period as YYYY-MM-DD strings for the repository.
+func (p period) bounds() (string, string) {
+	return p.start.Format("2006-01-02"), p.end.Format("2006-01-02")
+}
@@ -0,0 +1,315 @@
+package pdfexport
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// trendColumns is the number of month columns per category trends page.
+const trendColumns = 12
+
+// contentsEntry is a section listed in the table of contents.
+type contentsEntry struct {
+	title string
+	page  int
+	link  int
+}
+
+// minSectionSpace is the room (mm) a heading needs below it; closer to the
+// bottom of the page the section starts on a new page instead.
+const minSectionSpace = 30
+
+// heading writes a section title and, when the report has a table of
+// contents, records the section with a link to its position.
+func (r *report) heading(title string) {
+	_, pageHeight := r.pdf.GetPageSize()
+	_, bottomMargin := r.pdf.GetAutoPageBreak()
+	if r.pdf.GetY()+minSectionSpace > pageHeight-bottomMargin {
+		r.addPageLikeCurrent()
+	}
+	if r.withTOC {
+		link := r.pdf.AddLink()
+		r.pdf.SetLink(link, r.pdf.GetY(), r.pdf.PageNo())
+		r.contents = append(r.contents, contentsEntry{title: title, page: r.pdf.PageNo(), link: link})
+	}
+	r.title(title)
+}
+
+// title writes a section title without listing it in the table of contents.
+func (r *report) title(title string) {
+	r.pdf.SetFont(fontFamily, "B", 14)
+	r.pdf.Cell(0, 10, title)
+	r.pdf.Ln(10)
+}
+
+// pageNumbers prints the page number at the bottom of every page.
+func (r *report) pageNumbers() {
+	r.pdf.SetFooterFunc(func() {
+		r.pdf.SetY(-15)
+		r.pdf.SetFont(fontFamily, "I", 8)
+		r.pdf.CellFormat(0, 10, fmt.Sprintf(r.labels.page, r.pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+}
+
+// writeContents fills the table of contents on the title page, below its
+// heading, with every recorded section and the page it starts on.
+func (r *report) writeContents() {
+	last := r.pdf.PageNo()
+	r.pdf.SetPage(1)
+	r.pdf.SetY(37)
+	r.pdf.SetFont(fontFamily, "", 12)
+	for _, entry := range r.contents {
+		r.pdf.CellFormat(170, 8, entry.title, "", 0, "L", false, entry.link, "")
+		r.pdf.CellFormat(20, 8, strconv.Itoa(entry.page), "", 1, "R", false, entry.link, "")
+	}
+	// Return to the last page so the closing footer lands there.
+	r.pdf.SetPage(last)
+}
+
+// writeComparison writes income, expense and net for the period next to the
+// same period one year earlier, one row per metric and currency.
+func (r *report) writeComparison(current, previous [3]map[string]float64) {
+	l := r.labels
+	r.heading(l.comparison)
+
+	currencySet := make(map[string]struct{})
+	for i := range current {
+		for c := range current[i] {
+			currencySet[c] = struct{}{}
+		}
+		for c := range previous[i] {
+			currencySet[c] = struct{}{}
+		}
+	}
+	currencies := sortedKeys(currencySet)
+	if len(currencies) == 0 {
+		currencies = []string{defaultCurrency}
+	}
+
+	widths := []float64{55, 45, 45, 45}
+	header := func() {
+		r.pdf.SetFont(fontFamily, "B", 11)
+		r.tableRow(widths, 8, l.metric, l.current, l.previous, l.change)
+		r.pdf.SetFont(fontFamily, "", 11)
+	}
+	header()
+
+	metrics := [3]string{l.income, l.expense, l.net}
+	for _, c := range currencies {
+		for i, metric := range metrics {
+			if len(currencies) > 1 {
+				metric += " (" + c + ")"
+			}
+			r.tableRowWithHeader(widths, 8, header,
+				metric,
+				formatMoney(current[i][c], c, r.locale),
+				formatMoney(previous[i][c], c, r.locale),
+				r.change(current[i][c], previous[i][c]),
+			)
+		}
+	}
+	r.pdf.Ln(10)
+}
+
+// writeMonthly writes a month-by-month income, expense and net table.
+func (r *report) writeMonthly(
+	months []time.Time,
+	incomes, expenses []domaintransaction.Transaction,
+	currencyOf func(domaintransaction.Transaction) string,
+) {
+	l := r.labels
+	r.heading(l.monthly)
+
+	incomeByMonth := make(map[string][]domaintransaction.Transaction)
+	for _, tx := range incomes {
+		incomeByMonth[r.monthKey(tx.Date)] = append(incomeByMonth[r.monthKey(tx.Date)], tx)
+	}
+	expenseByMonth := make(map[string][]domaintransaction.Transaction)
+	for _, tx := range expenses {
+		expenseByMonth[r.monthKey(tx.Date)] = append(expenseByMonth[r.monthKey(tx.Date)], tx)
+	}
+
+	widths := []float64{40, 50, 50, 50}
+	header := func() {
+		r.pdf.SetFont(fontFamily, "B", 11)
+		r.tableRow(widths, 8, l.month, l.income, l.expense, l.net)
+		r.pdf.SetFont(fontFamily, "", 10)
+	}
+	header()
+	for _, m := range months {
+		key := r.monthKey(m)
+		income := sumByCurrency(incomeByMonth[key], currencyOf)
+		expense := sumByCurrency(expenseByMonth[key], currencyOf)
+		r.tableRowWithHeader(widths, 7, header,
+			r.monthLabel(m),
+			r.amounts(income),
+			r.amounts(expense),
+			r.amounts(net(income, expense)),
+		)
+	}
+	r.pdf.Ln(10)
+}
+
+// writeTrends writes the expenses of every category across the months of the
+// period on landscape pages, trendColumns months per page.
+func (r *report) writeTrends(
+	months []time.Time,
+	categoryMap map[string]string,
+	expenses []domaintransaction.Transaction,
+	currencyOf func(domaintransaction.Transaction) string,
+) {
+	l := r.labels
+
+	type row struct {
+		name     string
+		currency string
+		byMonth  map[string]float64
+		total    float64
+	}
+	rows := make(map[[2]string]*row)
+	currencySet := make(map[string]struct{})
+	for _, tx := range expenses {
+		key := [2]string{tx.CategoryID, currencyOf(tx)}
+		if rows[key] == nil {
+			name := categoryMap[tx.CategoryID]
+			if name == "" {
+				name = l.uncategorized
+			}
+			rows[key] = &row{name: name, currency: key[1], byMonth: make(map[string]float64)}
+		}
+		rows[key].byMonth[r.monthKey(tx.Date)] += tx.Amount
+		rows[key].total += tx.Amount
+		currencySet[key[1]] = struct{}{}
+	}
+
+	sorted := make([]*row, 0, len(rows))
+	for _, rw := range rows {
+		sorted = append(sorted, rw)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].total != sorted[j].total {
+			return sorted[i].total > sorted[j].total
+		}
+		if sorted[i].name != sorted[j].name {
+			return sorted[i].name < sorted[j].name
+		}
+		return sorted[i].currency < sorted[j].currency
+	})
+
+	for from := 0; from < len(months); from += trendColumns {
+		chunk := months[from:min(from+trendColumns, len(months))]
+
+		r.pdf.AddPageFormat("L", r.pdf.GetPageSizeStr("A4"))
+		if from == 0 {
+			r.heading(l.trends)
+		} else {
+			r.title(l.trends)
+		}
+
+		pageWidth, _ := r.pdf.GetPageSize()
+		left, _, right, _ := r.pdf.GetMargins()
+		widths := []float64{50}
+		titles := []string{l.category}
+		columnWidth := (pageWidth - left - right - 50) / float64(trendColumns)
+		for _, m := range chunk {
+			widths = append(widths, columnWidth)
+			titles = append(titles, r.shortMonthLabel(m))
+		}
+		header := func() {
+			r.pdf.SetFont(fontFamily, "B", 8)
+			r.tableRow(widths, 6, titles...)
+			r.pdf.SetFont(fontFamily, "", 8)
+		}
+		header()
+
+		for _, rw := range sorted {
+			name := rw.name
+			if len(currencySet) > 1 {
+				name += " (" + rw.currency + ")"
+			}
+			cells := []string{name}
+			for _, m := range chunk {
+				cells = append(cells, formatNumber(rw.byMonth[r.monthKey(m)], 0, r.locale))
+			}
+			r.tableRowWithHeader(widths, 6, header, cells...)
+		}
+	}
+}
+
+// addPageLikeCurrent starts a new page with the orientation of the current one.
+func (r *report) addPageLikeCurrent() {
+	if w, h := r.pdf.GetPageSize(); w > h {
+		r.pdf.AddPageFormat("L", r.pdf.GetPageSizeStr("A4"))
+		return
+	}
+	r.pdf.AddPage()
+}
+
+// change formats the relative change from previous to current, e.g. "+12.5%".
+func (r *report) change(current, previous float64) string {
+	if previous == 0 {
+		return r.labels.notAvailable
+	}
+	pct := (current - previous) / math.Abs(previous) * 100
+	sign := "+"
+	if pct < 0 {
+		sign = "-"
+	}
+	return sign + formatPercent(math.Abs(pct), r.locale)
+}
+
+// monthKey identifies the month t falls in by its first day.
+func (r *report) monthKey(t time.Time) string {
+	return monthStart(t, r.monthStartDay).Format("2006-01-02")
+}
+
+// monthLabel names the month starting at m, as "February 2026", or as its
+// day range when months do not start on the first.
+func (r *report) monthLabel(m time.Time) string {
+	if r.monthStartDay > 1 {
+		return r.labels.dayRange(m, m.AddDate(0, 1, -1))
+	}
+	return r.labels.monthYear(m)
+}
+
+// shortMonthLabel formats a month as "Feb 26" for narrow table columns.
+func (r *report) shortMonthLabel(m time.Time) string {
+	name := []rune(r.labels.months[m.Month()-1])
+	return fmt.Sprintf("%s %02d", string(name[:3]), m.Year()%100)
+}
+
+// sumByCurrency totals transaction amounts per currency.
+func sumByCurrency(transactions []domaintransaction.Transaction, currencyOf func(domaintransaction.Transaction) string) map[string]float64 {
+	totals := make(map[string]float64)
+	for _, tx := range transactions {
+		totals[currencyOf(tx)] += tx.Amount
+	}
+	return totals
+}
+
+// net returns income minus expense per currency.
+func net(income, expense map[string]float64) map[string]float64 {
+	result := make(map[string]float64)
+	for c, v := range income {
+		result[c] += v
+	}
+	for c, v := range expense {
+		result[c] -= v
+	}
+	return result
+}
+
+// sortedKeys returns the keys of set in ascending order.
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,11 +1,17 @@
 package pdfexport_test
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
 	"github.com/stretchr/testify/mock"
 
 	"github.com/financial-manager/api/internal/application/pdfexport/mocks"
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -26,8 +32,148 @@ func buildMockRepo(
 
 	m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
 	m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
-	m.On("ListTransactions", mock.Anything, domaintransaction.TransactionTypeIncome, mock.Anything, mock.Anything).Return(incomes, nil).Once()
-	m.On("ListTransactions", mock.Anything, domaintransaction.TransactionTypeExpense, mock.Anything, mock.Anything).Return(expenses, nil).Once()
+	m.On("StreamTransactions", mock.Anything, ofType(domaintransaction.TransactionTypeIncome)).Return(incomes, nil).Once()
+	m.On("StreamTransactions", mock.Anything, ofType(domaintransaction.TransactionTypeExpense)).Return(expenses, nil).Once()
+
+	return m
+}
+
+// ofType matches the filters of the transactions of type tType.
+func ofType(tType domaintransaction.TransactionType) interface{} {
+	return mock.MatchedBy(func(f domainexport.Filters) bool { return f.Type == string(tType) })
+}
+
+// inRange returns the filters of the transactions of type tType between start
+// and end.
+func inRange(tType domaintransaction.TransactionType, start, end string) domainexport.Filters {
+	return domainexport.Filters{Type: string(tType), StartDate: start, EndDate: end}
+}
+
+// buildMockRepoForMonth creates a mocks.Repository for a monthly report that
+// expects the month to run from start to end.
+func buildMockRepoForMonth(start, end string, incomes, expenses []domaintransaction.Transaction) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("ListAccounts", mock.Anything).Return([]domainaccount.Account{}, nil).Once()
+	m.On("ListCategories", mock.Anything).Return([]domaincategory.Category{}, nil).Once()
+	m.On("StreamTransactions", mock.Anything, inRange(domaintransaction.TransactionTypeIncome, start, end)).Return(incomes, nil).Once()
+	m.On("StreamTransactions", mock.Anything, inRange(domaintransaction.TransactionTypeExpense, start, end)).Return(expenses, nil).Once()
+	return m
+}
+
+// buildMonthStart creates a mocks.MonthStart returning day and err.
+func buildMonthStart(day int, err error) *mocks.MonthStart {
+	m := &mocks.MonthStart{}
+	m.On("Execute", mock.Anything).Return(day, err).Once()
+	return m
+}
+
+// pageObject matches a PDF page object, but not the /Pages tree root.
+var pageObject = regexp.MustCompile(`/Type /Page\b[^s]`)
+
+// countPages returns the number of pages in a generated PDF.
+func countPages(pdf []byte) int {
+	return len(pageObject.FindAll(pdf, -1))
+}
+
+// buildAccounts creates n account fixtures alternating positive and negative balances.
+func buildAccounts(n int) []domainaccount.Account {
+	accounts := make([]domainaccount.Account, n)
+	for i := range accounts {
+		balance := float64(100 * (i + 1))
+		if i%2 == 1 {
+			balance = -balance
+		}
+		accounts[i] = domainaccount.Account{
+			ID:             fmt.Sprintf("acc-%d", i),
+			Name:           fmt.Sprintf("Account %d", i),
+			Type:           domainaccount.AccountTypeBank,
+			CurrentBalance: balance,
+		}
+	}
+	return accounts
+}
+
+// buildCategories creates n expense category fixtures without a stored color.
+func buildCategories(n int) []domaincategory.Category {
+	categories := make([]domaincategory.Category, n)
+	for i := range categories {
+		categories[i] = domaincategory.Category{ID: fmt.Sprintf("cat-%d", i), Name: fmt.Sprintf("Category %d", i)}
+	}
+	return categories
+}
+
+// buildExpensesPerCategory creates one expense for each of the n categories built by buildCategories.
+func buildExpensesPerCategory(n int) []domaintransaction.Transaction {
+	expenses := make([]domaintransaction.Transaction, n)
+	for i := range expenses {
+		expenses[i] = buildExpense(fmt.Sprintf("tx-%d", i), float64(10*(i+1)), fmt.Sprintf("cat-%d", i))
+	}
+	return expenses
+}
+
+// buildIncomeInAccount creates an income fixture paid into the given account.
+func buildIncomeInAccount(id string, amount float64, accountID string) domaintransaction.Transaction {
+	tx := buildIncome(id, amount)
+	tx.AccountID = accountID
+	return tx
+}
+
+// buildExpenseInAccount creates an expense fixture charged to the given account.
+func buildExpenseInAccount(id string, amount float64, categoryID, accountID string) domaintransaction.Transaction {
+	tx := buildExpense(id, amount, categoryID)
+	tx.AccountID = accountID
+	return tx
+}
+
+// buildLongExpenses creates n expenses whose descriptions span several lines of the transactions table.
+func buildLongExpenses(n int) []domaintransaction.Transaction {
+	description := strings.Repeat("Pago de matrícula y útiles escolares ", 6)
+	expenses := make([]domaintransaction.Transaction, n)
+	for i := range expenses {
+		expenses[i] = buildExpense(fmt.Sprintf("tx-%d", i), 10, "")
+		expenses[i].Description = description
+	}
+	return expenses
+}
+
+// buildMockRepoForPeriod creates a mocks.Repository for yearly and range reports, which
+// also load the same period one year earlier for the comparison.
+func buildMockRepoForPeriod(
+	accounts []domainaccount.Account,
+	categories []domaincategory.Category,
+	incomes, expenses []domaintransaction.Transaction,
+	start, end, prevStart, prevEnd string,
+	previousErr error,
+) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
+	m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
+	m.On("StreamTransactions", mock.Anything, inRange(domaintransaction.TransactionTypeIncome, start, end)).Return(incomes, nil).Once()
+	m.On("StreamTransactions", mock.Anything, inRange(domaintransaction.TransactionTypeExpense, start, end)).Return(expenses, nil).Once()
+
+	if previousErr != nil {
+		m.On("StreamTransactions", mock.Anything, inRange(domaintransaction.TransactionTypeIncome, prevStart, prevEnd)).
+			Return([]domaintransaction.Transaction(nil), previousErr).Once()
+		return m
+	}
+	m.On("StreamTransactions", mock.Anything, inRange(domaintransaction.TransactionTypeIncome, prevStart, prevEnd)).
+		Return([]domaintransaction.Transaction{buildIncomeOn("tx-prev-1", 800, "2025-02-10")}, nil).Once()
+	m.On("StreamTransactions", mock.Anything, inRange(domaintransaction.TransactionTypeExpense, prevStart, prevEnd)).
+		Return([]domaintransaction.Transaction{buildExpenseOn("tx-prev-2", 40, "cat-1", "2025-02-11")}, nil).Once()
 
 	return m
 }
+
+// buildIncomeOn creates an income fixture dated YYYY-MM-DD.
+func buildIncomeOn(id string, amount float64, date string) domaintransaction.Transaction {
+	tx := buildIncome(id, amount)
+	tx.Date, _ = time.Parse("2006-01-02", date)
+	return tx
+}
+
+// buildExpenseOn creates an expense fixture dated YYYY-MM-DD.
+func buildExpenseOn(id string, amount float64, categoryID, date string) domaintransaction.Transaction {
+	tx := buildExpense(id, amount, categoryID)
+	tx.Date, _ = time.Parse("2006-01-02", date)
+	return tx
+}
@@ -0,0 +1,218 @@
+// Package cashflow implements the cash-flow time series report use case.
+package cashflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/financial-manager/api/internal/application/report/period"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+const (
+	unknownAccount = "Unknown account"
+	uncategorized  = "Uncategorized"
+)
+
+// Input holds the report parameters. Dates are YYYY-MM-DD and inclusive.
+// Empty fields fall back to the last twelve months, monthly, ungrouped.
+type Input struct {
+	From     string
+	To       string
+	Interval string
+	GroupBy  string
+}
+
+// Output is the cash-flow report.
+type Output struct {
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	Interval string   `json:"interval"`
+	GroupBy  string   `json:"group_by,omitempty"`
+	Periods  []Period `json:"periods"`
+}
+
+// Period holds the totals of one interval. Start and End are clamped to the
+// requested range, so the first and last periods may be partial; Label names
+// the whole period.
+type Period struct {
+	Label         string  `json:"label"`
+	Start         string  `json:"start"`
+	End           string  `json:"end"`
+	Income        float64 `json:"income"`
+	Expense       float64 `json:"expense"`
+	Net           float64 `json:"net"`
+	CumulativeNet float64 `json:"cumulative_net"`
+	Groups        []Group `json:"groups,omitempty"`
+}
+
+// Group holds the totals of one account or category within a period. Every
+// period lists the same groups, in name order, so series line up.
+type Group struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	Income        float64 `json:"income"`
+	Expense       float64 `json:"expense"`
+	Net           float64 `json:"net"`
+	CumulativeNet float64 `json:"cumulative_net"`
+}
+
+// UseCase implements the cash-flow report use case.
+type UseCase struct {
+	repo       Repository
+	monthStart MonthStart
+	clock      Clock
+}
+
+// New creates a new cash-flow UseCase.
+func New(repo Repository, monthStart MonthStart, clock Clock) *UseCase {
+	return &UseCase{repo: repo, monthStart: monthStart, clock: clock}
+}
+
+// Execute builds the cash-flow report. Periods without transactions are
+// included with zero totals. Invalid parameters wrap domainreport.ErrInvalidQuery.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
+	q, err := uc.query(ctx, in)
+	if err != nil {
+		return Output{}, fmt.Errorf("cash flow report: %w", err)
+	}
+
+	rows, err := uc.repo.CashFlow(ctx, q)
+	if err != nil {
+		return Output{}, fmt.Errorf("cash flow report: %w", err)
+	}
+
+	var groups []Group
+	if q.GroupBy != domainreport.GroupByNone {
+		groups, err = uc.groups(ctx, q.GroupBy, rows)
+		if err != nil {
+			return Output{}, fmt.Errorf("cash flow report: %w", err)
+		}
+	}
+
+	return Output{
+		From:     q.From.Format(period.DateLayout),
+		To:       q.To.Format(period.DateLayout),
+		Interval: string(q.Interval),
+		GroupBy:  string(q.GroupBy),
+		Periods:  buildPeriods(q, rows, groups),
+	}, nil
+}
+
+// query validates in and applies its defaults.
+func (uc *UseCase) query(ctx context.Context, in Input) (domainreport.CashFlowQuery, error) {
+	monthStartDay, err := uc.monthStart.Execute(ctx)
+	if err != nil {
+		return domainreport.CashFlowQuery{}, err
+	}
+
+	r, err := period.Parse(in.From, in.To, in.Interval, monthStartDay, uc.clock.Now())
+	if err != nil {
+		return domainreport.CashFlowQuery{}, err
+	}
+
+	q := domainreport.CashFlowQuery{Range: r, GroupBy: domainreport.GroupBy(in.GroupBy)}
+	switch q.GroupBy {
+	case domainreport.GroupByNone, domainreport.GroupByAccount, domainreport.GroupByCategory:
+	default:
+		return q, fmt.Errorf("%w: group_by must be 'account' or 'category'", domainreport.ErrInvalidQuery)
+	}
+
+	return q, nil
+}
+
+// groups returns the named groups that appear in rows, sorted by name then ID.
+// Groups whose account or category no longer exists get a placeholder name.
+func (uc *UseCase) groups(ctx context.Context, groupBy domainreport.GroupBy, rows []domainreport.CashFlowRow) ([]Group, error) {
+	names := make(map[string]string)
+	fallback := unknownAccount
+	if groupBy == domainreport.GroupByAccount {
+		accounts, err := uc.repo.ListAccounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range accounts {
+			names[a.ID] = a.Name
+		}
+	} else {
+		categories, err := uc.repo.ListCategories(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range categories {
+			names[c.ID] = c.Name
+		}
+		fallback = uncategorized
+	}
+
+	seen := make(map[string]bool)
+	groups := make([]Group, 0)
+	for _, row := range rows {
+		if seen[row.GroupID] {
+			continue
+		}
+		seen[row.GroupID] = true
+		name, ok := names[row.GroupID]
+		if !ok {
+			name = fallback
+		}
+		groups = append(groups, Group{ID: row.GroupID, Name: name})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Name != groups[j].Name {
+			return groups[i].Name < groups[j].Name
+		}
+		return groups[i].ID < groups[j].ID
+	})
+
+	return groups, nil
+}
+
+// buildPeriods lays rows out over every period between q.From and q.To and
+// accumulates the running net of the report and of each group.
+func buildPeriods(q domainreport.CashFlowQuery, rows []domainreport.CashFlowRow, groups []Group) []Period {
+	type key struct {
+		start string
+		group string
+	}
+	totals := make(map[key]domainreport.CashFlowRow, len(rows))
+	for _, row := range rows {
+		k := key{start: row.PeriodStart.Format(period.DateLayout), group: row.GroupID}
+		t := totals[k]
+		t.Income += row.Income
+		t.Expense += row.Expense
+		totals[k] = t
+	}
+
+	var cumulative float64
+	groupCumulative := make(map[string]float64, len(groups))
+	periods := make([]Period, 0)
+	for _, span := range period.Spans(q.Range) {
+		p := Period{Label: span.Label, Start: span.Start.Format(period.DateLayout), End: span.End.Format(period.DateLayout)}
+
+		periodKey := span.Key.Format(period.DateLayout)
+		if groups == nil {
+			t := totals[key{start: periodKey}]
+			p.Income, p.Expense = t.Income, t.Expense
+		} else {
+			p.Groups = make([]Group, len(groups))
+			for i, g := range groups {
+				t := totals[key{start: periodKey, group: g.ID}]
+				g.Income, g.Expense, g.Net = t.Income, t.Expense, t.Income-t.Expense
+				groupCumulative[g.ID] += g.Net
+				g.CumulativeNet = groupCumulative[g.ID]
+				p.Groups[i] = g
+				p.Income += t.Income
+				p.Expense += t.Expense
+			}
+		}
+		p.Net = p.Income - p.Expense
+		cumulative += p.Net
+		p.CumulativeNet = cumulative
+
+		periods = append(periods, p)
+	}
+
+	return periods
+}
@@ -0,0 +1,256 @@
+package cashflow_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/report/cashflow"
+	"github.com/financial-manager/api/internal/application/report/cashflow/mocks"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		repo    func() *mocks.Repository
+		input   cashflow.Input
+		wantOut cashflow.Output
+	}{
+		{
+			name: "defaults to the last twelve months and zero-fills empty periods",
+			repo: func() *mocks.Repository {
+				return buildMockRepo(domainreport.CashFlowQuery{
+					Range: domainreport.Range{From: date("2025-04-01"), To: date("2026-03-18"), Interval: domainreport.IntervalMonth, MonthStartDay: 1},
+				}, []domainreport.CashFlowRow{
+					{PeriodStart: date("2026-01-01"), Income: 1000, Expense: 400},
+					{PeriodStart: date("2026-03-01"), Expense: 100},
+				}, nil)
+			},
+			input: cashflow.Input{},
+			wantOut: cashflow.Output{
+				From: "2025-04-01", To: "2026-03-18", Interval: "month",
+				Periods: func() []cashflow.Period {
+					periods := make([]cashflow.Period, 0, 12)
+					for _, m := range []string{"2025-04", "2025-05", "2025-06", "2025-07", "2025-08", "2025-09", "2025-10", "2025-11", "2025-12"} {
+						start := date(m + "-01")
+						periods = append(periods, cashflow.Period{Label: start.Format("Jan 2006"), Start: m + "-01", End: start.AddDate(0, 1, -1).Format("2006-01-02")})
+					}
+					return append(periods,
+						cashflow.Period{Label: "Jan 2026", Start: "2026-01-01", End: "2026-01-31", Income: 1000, Expense: 400, Net: 600, CumulativeNet: 600},
+						cashflow.Period{Label: "Feb 2026", Start: "2026-02-01", End: "2026-02-28", CumulativeNet: 600},
+						cashflow.Period{Label: "Mar 2026", Start: "2026-03-01", End: "2026-03-18", Expense: 100, Net: -100, CumulativeNet: 500},
+					)
+				}(),
+			},
+		},
+		{
+			name: "clamps partial weeks to the requested range",
+			repo: func() *mocks.Repository {
+				return buildMockRepo(domainreport.CashFlowQuery{
+					Range: domainreport.Range{From: date("2026-03-04"), To: date("2026-03-10"), Interval: domainreport.IntervalWeek, MonthStartDay: 1},
+				}, []domainreport.CashFlowRow{
+					{PeriodStart: date("2026-03-02"), Income: 50},
+					{PeriodStart: date("2026-03-09"), Expense: 20},
+				}, nil)
+			},
+			input: cashflow.Input{From: "2026-03-04", To: "2026-03-10", Interval: "week"},
+			wantOut: cashflow.Output{
+				From: "2026-03-04", To: "2026-03-10", Interval: "week",
+				Periods: []cashflow.Period{
+					{Label: "2 Mar – 8 Mar", Start: "2026-03-04", End: "2026-03-08", Income: 50, Net: 50, CumulativeNet: 50},
+					{Label: "9 Mar – 15 Mar", Start: "2026-03-09", End: "2026-03-10", Expense: 20, Net: -20, CumulativeNet: 30},
+				},
+			},
+		},
+		{
+			name: "groups by account with every group in every period",
+			repo: func() *mocks.Repository {
+				m := buildMockRepo(domainreport.CashFlowQuery{
+					Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-06-30"), Interval: domainreport.IntervalQuarter, MonthStartDay: 1}, GroupBy: domainreport.GroupByAccount,
+				}, []domainreport.CashFlowRow{
+					{PeriodStart: date("2026-01-01"), GroupID: "acc-1", Income: 300},
+					{PeriodStart: date("2026-01-01"), GroupID: "acc-2", Expense: 100},
+					{PeriodStart: date("2026-04-01"), GroupID: "acc-gone", Expense: 10},
+				}, nil)
+				m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
+				return m
+			},
+			input: cashflow.Input{From: "2026-01-01", To: "2026-06-30", Interval: "quarter", GroupBy: "account"},
+			wantOut: cashflow.Output{
+				From: "2026-01-01", To: "2026-06-30", Interval: "quarter", GroupBy: "account",
+				Periods: []cashflow.Period{
+					{
+						Label: "Q1 2026", Start: "2026-01-01", End: "2026-03-31", Income: 300, Expense: 100, Net: 200, CumulativeNet: 200,
+						Groups: []cashflow.Group{
+							{ID: "acc-2", Name: "Bank", Expense: 100, Net: -100, CumulativeNet: -100},
+							{ID: "acc-gone", Name: "Unknown account"},
+							{ID: "acc-1", Name: "Wallet", Income: 300, Net: 300, CumulativeNet: 300},
+						},
+					},
+					{
+						Label: "Q2 2026", Start: "2026-04-01", End: "2026-06-30", Expense: 10, Net: -10, CumulativeNet: 190,
+						Groups: []cashflow.Group{
+							{ID: "acc-2", Name: "Bank", CumulativeNet: -100},
+							{ID: "acc-gone", Name: "Unknown account", Expense: 10, Net: -10, CumulativeNet: -10},
+							{ID: "acc-1", Name: "Wallet", CumulativeNet: 300},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "groups by category and names uncategorized transactions",
+			repo: func() *mocks.Repository {
+				m := buildMockRepo(domainreport.CashFlowQuery{
+					Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-12-31"), Interval: domainreport.IntervalYear, MonthStartDay: 1}, GroupBy: domainreport.GroupByCategory,
+				}, []domainreport.CashFlowRow{
+					{PeriodStart: date("2026-01-01"), GroupID: "", Expense: 5},
+					{PeriodStart: date("2026-01-01"), GroupID: "cat-1", Income: 900},
+				}, nil)
+				m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
+				return m
+			},
+			input: cashflow.Input{From: "2026-01-01", To: "2026-12-31", Interval: "year", GroupBy: "category"},
+			wantOut: cashflow.Output{
+				From: "2026-01-01", To: "2026-12-31", Interval: "year", GroupBy: "category",
+				Periods: []cashflow.Period{
+					{
+						Label: "2026", Start: "2026-01-01", End: "2026-12-31", Income: 900, Expense: 5, Net: 895, CumulativeNet: 895,
+						Groups: []cashflow.Group{
+							{ID: "cat-1", Name: "Salary", Income: 900, Net: 900, CumulativeNet: 900},
+							{ID: "", Name: "Uncategorized", Expense: 5, Net: -5, CumulativeNet: -5},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := tc.repo()
+			uc := cashflow.New(repo, buildMonthStart(1, nil), buildClock())
+
+			out, err := uc.Execute(context.Background(), tc.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_MonthStartDay(t *testing.T) {
+	t.Parallel()
+
+	repo := buildMockRepo(domainreport.CashFlowQuery{
+		Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-03-01"), Interval: domainreport.IntervalMonth, MonthStartDay: 25},
+	}, []domainreport.CashFlowRow{
+		{PeriodStart: date("2026-01-25"), Income: 3000},
+	}, nil)
+	uc := cashflow.New(repo, buildMonthStart(25, nil), buildClock())
+
+	out, err := uc.Execute(context.Background(), cashflow.Input{From: "2026-01-01", To: "2026-03-01"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []cashflow.Period{
+		{Label: "25 Dec – 24 Jan", Start: "2026-01-01", End: "2026-01-24"},
+		{Label: "25 Jan – 24 Feb", Start: "2026-01-25", End: "2026-02-24", Income: 3000, Net: 3000, CumulativeNet: 3000},
+		{Label: "25 Feb – 24 Mar", Start: "2026-02-25", End: "2026-03-01", CumulativeNet: 3000},
+	}, out.Periods)
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input cashflow.Input
+	}{
+		{name: "malformed from", input: cashflow.Input{From: "2026/01/01"}},
+		{name: "malformed to", input: cashflow.Input{To: "yesterday"}},
+		{name: "from after to", input: cashflow.Input{From: "2026-02-01", To: "2026-01-01"}},
+		{name: "unknown interval", input: cashflow.Input{Interval: "day"}},
+		{name: "unknown grouping", input: cashflow.Input{GroupBy: "payee"}},
+		{name: "too many periods", input: cashflow.Input{From: "1990-01-01", To: "2026-01-01", Interval: "week"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.Repository{}
+			uc := cashflow.New(repo, buildMonthStart(1, nil), buildClock())
+
+			_, err := uc.Execute(context.Background(), tc.input)
+
+			assert.ErrorIs(t, err, domainreport.ErrInvalidQuery)
+			repo.AssertNotCalled(t, "CashFlow", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUseCase_Execute_RepositoryErrors(t *testing.T) {
+	t.Parallel()
+
+	dbErr := errors.New("db error")
+	q := domainreport.CashFlowQuery{Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-01-31"), Interval: domainreport.IntervalMonth, MonthStartDay: 1}, GroupBy: domainreport.GroupByCategory}
+	input := cashflow.Input{From: "2026-01-01", To: "2026-01-31", GroupBy: "category"}
+
+	tests := []struct {
+		name string
+		repo func() *mocks.Repository
+	}{
+		{
+			name: "cash flow error is propagated",
+			repo: func() *mocks.Repository { return buildMockRepo(q, nil, dbErr) },
+		},
+		{
+			name: "list categories error is propagated",
+			repo: func() *mocks.Repository {
+				m := buildMockRepo(q, []domainreport.CashFlowRow{{PeriodStart: date("2026-01-01"), GroupID: "cat-1", Income: 1}}, nil)
+				m.On("ListCategories", mock.Anything).Return(nil, dbErr).Once()
+				return m
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := tc.repo()
+			uc := cashflow.New(repo, buildMonthStart(1, nil), buildClock())
+
+			out, err := uc.Execute(context.Background(), input)
+
+			assert.Equal(t, fmt.Errorf("cash flow report: %w", dbErr), err)
+			assert.Empty(t, out)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_MonthStartError(t *testing.T) {
+	t.Parallel()
+
+	settingErr := errors.New("settings unavailable")
+	repo := &mocks.Repository{}
+	uc := cashflow.New(repo, buildMonthStart(0, settingErr), buildClock())
+
+	_, err := uc.Execute(context.Background(), cashflow.Input{})
+
+	assert.Equal(t, fmt.Errorf("cash flow report: %w", settingErr), err)
+	repo.AssertNotCalled(t, "CashFlow", mock.Anything, mock.Anything)
+}
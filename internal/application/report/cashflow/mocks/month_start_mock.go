@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MonthStart is a testify mock for the cashflow.MonthStart interface.
+type MonthStart struct {
+	mock.Mock
+}
+
+// Execute mocks MonthStart.Execute.
+func (m *MonthStart) Execute(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,27 @@
+package cashflow
+
+import (
+	"context"
+	"time"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// Repository is the port required by the cash-flow use case.
+type Repository interface {
+	CashFlow(ctx context.Context, q domainreport.CashFlowQuery) ([]domainreport.CashFlowRow, error)
+	ListAccounts(ctx context.Context) ([]domainaccount.Account, error)
+	ListCategories(ctx context.Context) ([]domaincategory.Category, error)
+}
+
+// MonthStart is the port returning the day of the month months start on.
+type MonthStart interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
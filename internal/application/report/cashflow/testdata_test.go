@@ -0,0 +1,53 @@
+package cashflow_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/report/cashflow/mocks"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// now is the fixed clock reading used by every test.
+var now = time.Date(2026, time.March, 18, 15, 4, 5, 0, time.UTC)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+// buildClock returns a mocks.Clock that always reports now.
+func buildClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(now)
+	return m
+}
+
+// buildMonthStart returns a mocks.MonthStart reporting day, or err when set.
+func buildMonthStart(day int, err error) *mocks.MonthStart {
+	m := &mocks.MonthStart{}
+	m.On("Execute", mock.Anything).Return(day, err)
+	return m
+}
+
+// buildMockRepo creates a mocks.Repository that expects q and returns rows or err.
+func buildMockRepo(q domainreport.CashFlowQuery, rows []domainreport.CashFlowRow, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("CashFlow", mock.Anything, q).Return(rows, err).Once()
+	return m
+}
+
+var (
+	accounts = []domainaccount.Account{
+		{ID: "acc-1", Name: "Wallet", IsActive: true},
+		{ID: "acc-2", Name: "Bank", IsActive: false},
+	}
+
+	categories = []domaincategory.Category{
+		{ID: "cat-1", Name: "Salary", Type: domaincategory.TypeIncome, IsActive: true},
+		{ID: "cat-2", Name: "Food", Type: domaincategory.TypeExpense, IsActive: true},
+	}
+)
@@ -0,0 +1,378 @@
+// Package categorytrend implements the category trend and period-over-period
+// comparison report use case.
+package categorytrend
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/financial-manager/api/internal/application/report/period"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+const (
+	// CompareMonth compares against the same dates one month earlier.
+	CompareMonth = "month"
+	// CompareYear compares against the same dates one year earlier.
+	CompareYear = "year"
+
+	uncategorized = "Uncategorized"
+)
+
+// averageWindows are the rolling average lengths, in months, reported per category.
+var averageWindows = []int{3, 6, 12}
+
+// Input holds the report parameters. Dates are YYYY-MM-DD and inclusive.
+// From and To default to the current month to date, where months start on
+// the configured month start day. The comparison period is
+// CompareFrom to CompareTo when both are set, otherwise the current period
+// shifted by Compare, which defaults to CompareMonth. Type is "expense"
+// (default) or "income"; Threshold is a percentage and defaults to the one
+// the use case was created with.
+type Input struct {
+	From        string
+	To          string
+	Compare     string
+	CompareFrom string
+	CompareTo   string
+	Type        string
+	Threshold   string
+}
+
+// Output is the category trend report.
+type Output struct {
+	Type      string  `json:"type"`
+	Threshold float64 `json:"threshold"`
+	Current   Span    `json:"current"`
+	Previous  Span    `json:"previous"`
+	Totals    Change  `json:"totals"`
+	Items     []Item  `json:"categories"`
+}
+
+// Span is an inclusive date range.
+type Span struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Change compares a current and a previous total. ChangePercent is nil when
+// the previous total is zero.
+type Change struct {
+	Current       float64  `json:"current"`
+	Previous      float64  `json:"previous"`
+	Change        float64  `json:"change"`
+	ChangePercent *float64 `json:"change_percent"`
+}
+
+// Item is the comparison and trend of one category. Averages holds the mean
+// monthly total over the 3, 6 and 12 full months before the current period;
+// AboveAverage lists the windows whose average the current total exceeds by
+// more than the threshold.
+type Item struct {
+	CategoryID   string `json:"category_id"`
+	CategoryName string `json:"category_name"`
+	Change
+	Averages     Averages `json:"averages"`
+	AboveAverage []int    `json:"above_average"`
+}
+
+// Averages holds the rolling monthly averages of a category.
+type Averages struct {
+	ThreeMonths  float64 `json:"three_months"`
+	SixMonths    float64 `json:"six_months"`
+	TwelveMonths float64 `json:"twelve_months"`
+}
+
+// UseCase implements the category trend use case.
+type UseCase struct {
+	repo       Repository
+	monthStart MonthStart
+	clock      Clock
+	threshold  float64
+}
+
+// New creates a new category trend UseCase that flags categories more than
+// threshold percent above their average unless the input sets another value.
+func New(repo Repository, monthStart MonthStart, clock Clock, threshold float64) *UseCase {
+	return &UseCase{repo: repo, monthStart: monthStart, clock: clock, threshold: threshold}
+}
+
+// params is the validated form of Input.
+type params struct {
+	current   domainreport.Range
+	previous  domainreport.Range
+	history   domainreport.Range
+	income    bool
+	threshold float64
+}
+
+// Execute builds the report. Categories are ordered by current total,
+// largest first, and include every category with activity in the current or
+// previous period or the last twelve months. Invalid parameters wrap
+// domainreport.ErrInvalidQuery.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
+	monthStartDay, err := uc.monthStart.Execute(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("category trend report: %w", err)
+	}
+	p, err := uc.params(in, monthStartDay)
+	if err != nil {
+		return Output{}, fmt.Errorf("category trend report: %w", err)
+	}
+
+	current, err := uc.totals(ctx, p.current, p.income)
+	if err != nil {
+		return Output{}, fmt.Errorf("category trend report: %w", err)
+	}
+	previous, err := uc.totals(ctx, p.previous, p.income)
+	if err != nil {
+		return Output{}, fmt.Errorf("category trend report: %w", err)
+	}
+	history, err := uc.monthly(ctx, p.history, p.income)
+	if err != nil {
+		return Output{}, fmt.Errorf("category trend report: %w", err)
+	}
+	categories, err := uc.repo.ListCategories(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("category trend report: %w", err)
+	}
+	names := make(map[string]string, len(categories))
+	for _, c := range categories {
+		names[c.ID] = c.Name
+	}
+
+	ids := make(map[string]bool)
+	for _, m := range []map[string]float64{current, previous} {
+		for id := range m {
+			ids[id] = true
+		}
+	}
+	for id := range history {
+		ids[id] = true
+	}
+
+	out := Output{
+		Type:      "expense",
+		Threshold: p.threshold,
+		Current:   span(p.current),
+		Previous:  span(p.previous),
+		Items:     make([]Item, 0, len(ids)),
+	}
+	if p.income {
+		out.Type = "income"
+	}
+	var totalCurrent, totalPrevious float64
+	for id := range ids {
+		name, ok := names[id]
+		if !ok {
+			name = uncategorized
+		}
+		item := Item{
+			CategoryID:   id,
+			CategoryName: name,
+			Change:       change(current[id], previous[id]),
+			AboveAverage: make([]int, 0, len(averageWindows)),
+		}
+		averages := make([]float64, len(averageWindows))
+		for i, months := range averageWindows {
+			averages[i] = average(history[id], months)
+			if averages[i] > 0 && current[id] > averages[i]*(1+p.threshold/100) {
+				item.AboveAverage = append(item.AboveAverage, months)
+			}
+		}
+		item.Averages = Averages{ThreeMonths: averages[0], SixMonths: averages[1], TwelveMonths: averages[2]}
+		out.Items = append(out.Items, item)
+		totalCurrent += current[id]
+		totalPrevious += previous[id]
+	}
+	out.Totals = change(totalCurrent, totalPrevious)
+	sort.Slice(out.Items, func(i, j int) bool {
+		a, b := out.Items[i], out.Items[j]
+		if a.Current != b.Current {
+			return a.Current > b.Current
+		}
+		if a.CategoryName != b.CategoryName {
+			return a.CategoryName < b.CategoryName
+		}
+		return a.CategoryID < b.CategoryID
+	})
+
+	return out, nil
+}
+
+// params validates in and applies its defaults, with months starting on
+// monthStartDay.
+func (uc *UseCase) params(in Input, monthStartDay int) (params, error) {
+	now := uc.clock.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	p := params{
+		current:   domainreport.Range{From: period.Start(today, domainreport.IntervalMonth, monthStartDay), To: today},
+		threshold: uc.threshold,
+	}
+
+	var err error
+	if p.current, err = parseRange(in.From, in.To, p.current); err != nil {
+		return p, err
+	}
+
+	switch {
+	case in.CompareFrom != "" || in.CompareTo != "":
+		if in.CompareFrom == "" || in.CompareTo == "" {
+			return p, fmt.Errorf("%w: compare_from and compare_to must be set together", domainreport.ErrInvalidQuery)
+		}
+		if p.previous, err = parseRange(in.CompareFrom, in.CompareTo, domainreport.Range{}); err != nil {
+			return p, err
+		}
+	case in.Compare == "" || in.Compare == CompareMonth:
+		p.previous = shift(p.current, 1)
+	case in.Compare == CompareYear:
+		p.previous = shift(p.current, 12)
+	default:
+		return p, fmt.Errorf("%w: compare must be 'month' or 'year'", domainreport.ErrInvalidQuery)
+	}
+
+	switch in.Type {
+	case "", "expense":
+	case "income":
+		p.income = true
+	default:
+		return p, fmt.Errorf("%w: type must be 'expense' or 'income'", domainreport.ErrInvalidQuery)
+	}
+
+	if in.Threshold != "" {
+		p.threshold, err = strconv.ParseFloat(in.Threshold, 64)
+		if err != nil || p.threshold < 0 || math.IsInf(p.threshold, 0) {
+			return p, fmt.Errorf("%w: threshold must be a non-negative percentage", domainreport.ErrInvalidQuery)
+		}
+	}
+
+	monthStart := period.Start(p.current.From, domainreport.IntervalMonth, monthStartDay)
+	p.history = domainreport.Range{
+		From:          monthStart.AddDate(0, -averageWindows[len(averageWindows)-1], 0),
+		To:            monthStart.AddDate(0, 0, -1),
+		Interval:      domainreport.IntervalMonth,
+		MonthStartDay: monthStartDay,
+	}
+
+	return p, nil
+}
+
+// totals returns the income or expense total of every category over r.
+func (uc *UseCase) totals(ctx context.Context, r domainreport.Range, income bool) (map[string]float64, error) {
+	r.Interval = domainreport.IntervalYear
+	rows, err := uc.repo.CashFlow(ctx, domainreport.CashFlowQuery{Range: r, GroupBy: domainreport.GroupByCategory})
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]float64)
+	for _, row := range rows {
+		if amount := pick(row, income); amount != 0 {
+			totals[row.GroupID] += amount
+		}
+	}
+	return totals, nil
+}
+
+// monthly returns, per category, the income or expense total of each month
+// of r, most recent month first.
+func (uc *UseCase) monthly(ctx context.Context, r domainreport.Range, income bool) (map[string][]float64, error) {
+	rows, err := uc.repo.CashFlow(ctx, domainreport.CashFlowQuery{Range: r, GroupBy: domainreport.GroupByCategory})
+	if err != nil {
+		return nil, err
+	}
+
+	spans := period.Spans(r)
+	months := len(spans)
+	last := spans[months-1].Key
+	history := make(map[string][]float64)
+	for _, row := range rows {
+		amount := pick(row, income)
+		if amount == 0 {
+			continue
+		}
+		if history[row.GroupID] == nil {
+			history[row.GroupID] = make([]float64, months)
+		}
+		i := (last.Year()-row.PeriodStart.Year())*12 + int(last.Month()-row.PeriodStart.Month())
+		if i >= 0 && i < months {
+			history[row.GroupID][i] += amount
+		}
+	}
+	return history, nil
+}
+
+// parseRange parses from and to, keeping the bounds of def that are empty.
+func parseRange(from, to string, def domainreport.Range) (domainreport.Range, error) {
+	r := def
+	if from != "" {
+		t, err := time.Parse(period.DateLayout, from)
+		if err != nil {
+			return r, fmt.Errorf("%w: dates must be YYYY-MM-DD", domainreport.ErrInvalidQuery)
+		}
+		r.From = t
+	}
+	if to != "" {
+		t, err := time.Parse(period.DateLayout, to)
+		if err != nil {
+			return r, fmt.Errorf("%w: dates must be YYYY-MM-DD", domainreport.ErrInvalidQuery)
+		}
+		r.To = t
+	}
+	if r.From.After(r.To) {
+		return r, fmt.Errorf("%w: from must not be after to", domainreport.ErrInvalidQuery)
+	}
+	return r, nil
+}
+
+// shift moves r the given number of months back. A bound on the last day of
+// its month stays on the last day, so a full month maps to a full month.
+func shift(r domainreport.Range, months int) domainreport.Range {
+	return domainreport.Range{From: shiftDate(r.From, months), To: shiftDate(r.To, months)}
+}
+
+func shiftDate(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()-time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1)
+	if t.AddDate(0, 0, 1).Day() == 1 || t.Day() > last.Day() {
+		return last
+	}
+	return first.AddDate(0, 0, t.Day()-1)
+}
+
+// pick returns the income or the expense of row.
+func pick(row domainreport.CashFlowRow, income bool) float64 {
+	if income {
+		return row.Income
+	}
+	return row.Expense
+}
+
+// average returns the mean of the first n values of monthly, counting
+// missing months as zero.
+func average(monthly []float64, n int) float64 {
+	var sum float64
+	for i := 0; i < n && i < len(monthly); i++ {
+		sum += monthly[i]
+	}
+	return sum / float64(n)
+}
+
+// change compares current with previous.
+func change(current, previous float64) Change {
+	c := Change{Current: current, Previous: previous, Change: current - previous}
+	if previous != 0 {
+		pct := (current - previous) / previous * 100
+		c.ChangePercent = &pct
+	}
+	return c
+}
+
+// span formats r for the response.
+func span(r domainreport.Range) Span {
+	return Span{From: r.From.Format(period.DateLayout), To: r.To.Format(period.DateLayout)}
+}
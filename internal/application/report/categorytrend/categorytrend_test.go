@@ -0,0 +1,237 @@
+package categorytrend_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/report/categorytrend"
+	"github.com/financial-manager/api/internal/application/report/categorytrend/mocks"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	repo := buildMockRepo(
+		totalsQuery("2026-03-01", "2026-03-18"),
+		totalsQuery("2026-02-01", "2026-02-18"),
+		historyQuery("2025-03-01", "2026-02-28"),
+		[]domainreport.CashFlowRow{
+			{PeriodStart: date("2026-01-01"), GroupID: "cat-transport", Income: 5, Expense: 150},
+			{PeriodStart: date("2026-01-01"), GroupID: "cat-food", Expense: 90},
+			{PeriodStart: date("2026-01-01"), GroupID: "cat-salary", Income: 3000},
+		},
+		[]domainreport.CashFlowRow{
+			{PeriodStart: date("2026-01-01"), GroupID: "cat-transport", Expense: 100},
+			{PeriodStart: date("2026-01-01"), GroupID: "", Expense: 40},
+		},
+		[]domainreport.CashFlowRow{
+			monthRow("2025-03-01", "cat-food", 1200),
+			monthRow("2025-12-01", "cat-transport", 60),
+			monthRow("2026-01-01", "cat-transport", 120),
+			monthRow("2026-02-01", "cat-transport", 120),
+			monthRow("2026-02-01", "cat-food", 120),
+		},
+	)
+	uc := categorytrend.New(repo, buildMonthStart(1), buildClock(), 20)
+
+	out, err := uc.Execute(context.Background(), categorytrend.Input{})
+
+	require.NoError(t, err)
+	assert.Equal(t, categorytrend.Output{
+		Type:      "expense",
+		Threshold: 20,
+		Current:   categorytrend.Span{From: "2026-03-01", To: "2026-03-18"},
+		Previous:  categorytrend.Span{From: "2026-02-01", To: "2026-02-18"},
+		Totals:    categorytrend.Change{Current: 240, Previous: 140, Change: 100, ChangePercent: ptr(100.0 / 140 * 100)},
+		Items: []categorytrend.Item{
+			{
+				CategoryID: "cat-transport", CategoryName: "Transporte",
+				Change:       categorytrend.Change{Current: 150, Previous: 100, Change: 50, ChangePercent: ptr(50)},
+				Averages:     categorytrend.Averages{ThreeMonths: 100, SixMonths: 50, TwelveMonths: 25},
+				AboveAverage: []int{3, 6, 12},
+			},
+			{
+				CategoryID: "cat-food", CategoryName: "Alimentación",
+				Change:       categorytrend.Change{Current: 90, Previous: 0, Change: 90},
+				Averages:     categorytrend.Averages{ThreeMonths: 40, SixMonths: 20, TwelveMonths: 110},
+				AboveAverage: []int{3, 6},
+			},
+			{
+				CategoryID: "", CategoryName: "Uncategorized",
+				Change:       categorytrend.Change{Current: 0, Previous: 40, Change: -40, ChangePercent: ptr(-100)},
+				AboveAverage: []int{},
+			},
+		},
+	}, out)
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_ComparisonPeriods(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    categorytrend.Input
+		current  domainreport.CashFlowQuery
+		previous domainreport.CashFlowQuery
+		history  domainreport.CashFlowQuery
+	}{
+		{
+			name:     "full month compares with the full previous month",
+			input:    categorytrend.Input{From: "2026-03-01", To: "2026-03-31"},
+			current:  totalsQuery("2026-03-01", "2026-03-31"),
+			previous: totalsQuery("2026-02-01", "2026-02-28"),
+			history:  historyQuery("2025-03-01", "2026-02-28"),
+		},
+		{
+			name:     "same month last year",
+			input:    categorytrend.Input{From: "2026-02-01", To: "2026-02-28", Compare: "year"},
+			current:  totalsQuery("2026-02-01", "2026-02-28"),
+			previous: totalsQuery("2025-02-01", "2025-02-28"),
+			history:  historyQuery("2025-02-01", "2026-01-31"),
+		},
+		{
+			name:     "explicit comparison range",
+			input:    categorytrend.Input{From: "2026-03-01", To: "2026-03-18", CompareFrom: "2025-12-01", CompareTo: "2025-12-31"},
+			current:  totalsQuery("2026-03-01", "2026-03-18"),
+			previous: totalsQuery("2025-12-01", "2025-12-31"),
+			history:  historyQuery("2025-03-01", "2026-02-28"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := buildMockRepo(tc.current, tc.previous, tc.history, nil, nil, nil)
+			uc := categorytrend.New(repo, buildMonthStart(1), buildClock(), 20)
+
+			out, err := uc.Execute(context.Background(), tc.input)
+
+			require.NoError(t, err)
+			assert.Empty(t, out.Items)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_MonthStartDay(t *testing.T) {
+	t.Parallel()
+
+	history := domainreport.CashFlowQuery{
+		Range:   domainreport.Range{From: date("2025-02-25"), To: date("2026-02-24"), Interval: domainreport.IntervalMonth, MonthStartDay: 25},
+		GroupBy: domainreport.GroupByCategory,
+	}
+	repo := buildMockRepo(
+		totalsQuery("2026-02-25", "2026-03-18"),
+		totalsQuery("2026-01-25", "2026-02-18"),
+		history,
+		[]domainreport.CashFlowRow{{PeriodStart: date("2026-02-25"), GroupID: "cat-food", Expense: 90}},
+		nil,
+		[]domainreport.CashFlowRow{monthRow("2025-11-25", "cat-food", 120)},
+	)
+	uc := categorytrend.New(repo, buildMonthStart(25), buildClock(), 20)
+
+	out, err := uc.Execute(context.Background(), categorytrend.Input{})
+
+	require.NoError(t, err)
+	assert.Equal(t, categorytrend.Span{From: "2026-02-25", To: "2026-03-18"}, out.Current)
+	require.Len(t, out.Items, 1)
+	assert.Equal(t, categorytrend.Averages{ThreeMonths: 40, SixMonths: 20, TwelveMonths: 10}, out.Items[0].Averages, "months are counted back from the last full one")
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_IncomeWithThreshold(t *testing.T) {
+	t.Parallel()
+
+	repo := buildMockRepo(
+		totalsQuery("2026-03-01", "2026-03-31"),
+		totalsQuery("2026-02-01", "2026-02-28"),
+		historyQuery("2025-03-01", "2026-02-28"),
+		[]domainreport.CashFlowRow{{PeriodStart: date("2026-01-01"), GroupID: "cat-salary", Income: 3300, Expense: 10}},
+		nil,
+		[]domainreport.CashFlowRow{
+			{PeriodStart: date("2025-12-01"), GroupID: "cat-salary", Income: 3000},
+			{PeriodStart: date("2026-01-01"), GroupID: "cat-salary", Income: 3000},
+			{PeriodStart: date("2026-02-01"), GroupID: "cat-salary", Income: 3000},
+		},
+	)
+	uc := categorytrend.New(repo, buildMonthStart(1), buildClock(), 20)
+
+	out, err := uc.Execute(context.Background(), categorytrend.Input{From: "2026-03-01", To: "2026-03-31", Type: "income", Threshold: "5"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "income", out.Type)
+	require.Len(t, out.Items, 1)
+	assert.Equal(t, 3300.0, out.Items[0].Current)
+	assert.Equal(t, []int{3, 6, 12}, out.Items[0].AboveAverage)
+	assert.Nil(t, out.Items[0].ChangePercent)
+}
+
+func TestUseCase_Execute_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input categorytrend.Input
+	}{
+		{name: "malformed from", input: categorytrend.Input{From: "March"}},
+		{name: "from after to", input: categorytrend.Input{From: "2026-03-10", To: "2026-03-01"}},
+		{name: "half an explicit comparison", input: categorytrend.Input{CompareFrom: "2026-01-01"}},
+		{name: "unknown comparison", input: categorytrend.Input{Compare: "week"}},
+		{name: "unknown type", input: categorytrend.Input{Type: "transfer"}},
+		{name: "negative threshold", input: categorytrend.Input{Threshold: "-5"}},
+		{name: "malformed threshold", input: categorytrend.Input{Threshold: "lots"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.Repository{}
+			uc := categorytrend.New(repo, buildMonthStart(1), buildClock(), 20)
+
+			_, err := uc.Execute(context.Background(), tc.input)
+
+			assert.ErrorIs(t, err, domainreport.ErrInvalidQuery)
+			repo.AssertNotCalled(t, "CashFlow", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUseCase_Execute_MonthStartError(t *testing.T) {
+	t.Parallel()
+
+	settingsErr := errors.New("settings unavailable")
+	monthStart := &mocks.MonthStart{}
+	monthStart.On("Execute", mock.Anything).Return(0, settingsErr).Once()
+	repo := &mocks.Repository{}
+	uc := categorytrend.New(repo, monthStart, buildClock(), 20)
+
+	_, err := uc.Execute(context.Background(), categorytrend.Input{})
+
+	assert.ErrorIs(t, err, settingsErr)
+	repo.AssertNotCalled(t, "CashFlow", mock.Anything, mock.Anything)
+}
+
+func TestUseCase_Execute_RepositoryError(t *testing.T) {
+	t.Parallel()
+
+	dbErr := errors.New("db error")
+	repo := &mocks.Repository{}
+	repo.On("CashFlow", mock.Anything, mock.Anything).Return(nil, dbErr).Once()
+	uc := categorytrend.New(repo, buildMonthStart(1), buildClock(), 20)
+
+	out, err := uc.Execute(context.Background(), categorytrend.Input{})
+
+	assert.Equal(t, fmt.Errorf("category trend report: %w", dbErr), err)
+	assert.Empty(t, out)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,25 @@
+package categorytrend
+
+import (
+	"context"
+	"time"
+
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// Repository is the port required by the category trend use case.
+type Repository interface {
+	CashFlow(ctx context.Context, q domainreport.CashFlowQuery) ([]domainreport.CashFlowRow, error)
+	ListCategories(ctx context.Context) ([]domaincategory.Category, error)
+}
+
+// MonthStart is the port for the day of the month months start on.
+type MonthStart interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
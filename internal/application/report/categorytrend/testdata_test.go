@@ -0,0 +1,73 @@
+package categorytrend_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/report/categorytrend/mocks"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// now is the fixed clock reading used by every test.
+var now = time.Date(2026, time.March, 18, 15, 4, 5, 0, time.UTC)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func ptr(f float64) *float64 { return &f }
+
+// buildClock returns a mocks.Clock that always reports now.
+func buildClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(now)
+	return m
+}
+
+// buildMonthStart returns a mocks.MonthStart that always reports day.
+func buildMonthStart(day int) *mocks.MonthStart {
+	m := &mocks.MonthStart{}
+	m.On("Execute", mock.Anything).Return(day, nil)
+	return m
+}
+
+// totalsQuery is the repository query for the category totals of from..to.
+func totalsQuery(from, to string) domainreport.CashFlowQuery {
+	return domainreport.CashFlowQuery{
+		Range:   domainreport.Range{From: date(from), To: date(to), Interval: domainreport.IntervalYear},
+		GroupBy: domainreport.GroupByCategory,
+	}
+}
+
+// historyQuery is the repository query for the twelve calendar months before from..to.
+func historyQuery(from, to string) domainreport.CashFlowQuery {
+	return domainreport.CashFlowQuery{
+		Range:   domainreport.Range{From: date(from), To: date(to), Interval: domainreport.IntervalMonth, MonthStartDay: 1},
+		GroupBy: domainreport.GroupByCategory,
+	}
+}
+
+// buildMockRepo expects the current, previous and history queries in order
+// and returns the matching rows.
+func buildMockRepo(current, previous, history domainreport.CashFlowQuery, currentRows, previousRows, historyRows []domainreport.CashFlowRow) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("CashFlow", mock.Anything, current).Return(currentRows, nil).Once()
+	m.On("CashFlow", mock.Anything, previous).Return(previousRows, nil).Once()
+	m.On("CashFlow", mock.Anything, history).Return(historyRows, nil).Once()
+	m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
+	return m
+}
+
+var categories = []domaincategory.Category{
+	{ID: "cat-food", Name: "Alimentación", Type: domaincategory.TypeExpense, IsActive: true},
+	{ID: "cat-transport", Name: "Transporte", Type: domaincategory.TypeExpense, IsActive: true},
+	{ID: "cat-salary", Name: "Salario", Type: domaincategory.TypeIncome, IsActive: true},
+}
+
+// monthRow returns a history row of category id for the month starting on start.
+func monthRow(start, id string, expense float64) domainreport.CashFlowRow {
+	return domainreport.CashFlowRow{PeriodStart: date(start), GroupID: id, Expense: expense}
+}
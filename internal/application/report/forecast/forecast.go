@@ -0,0 +1,377 @@
+// Package forecast implements the spending and balance forecast use case.
+package forecast
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/financial-manager/api/internal/application/report/period"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+const (
+	// DefaultMonths is the number of months forecast after the current one
+	// when the input does not set it; MaxMonths is the most allowed.
+	DefaultMonths = 3
+	MaxMonths     = 6
+
+	// historyMonths is the number of full months before the current one the
+	// forecast learns from.
+	historyMonths = 24
+
+	uncategorized = "Uncategorized"
+)
+
+// Input holds the forecast parameters. Months is the number of months to
+// forecast after the current one.
+type Input struct {
+	Months string
+}
+
+// Output is the forecast. Every projection carries an 80% confidence band,
+// Low to High.
+type Output struct {
+	AsOf       string               `json:"as_of"`
+	Month      Month                `json:"month"`
+	Total      AccountProjection    `json:"total"`
+	Accounts   []AccountProjection  `json:"accounts"`
+	Categories []CategoryProjection `json:"categories"`
+	Months     []MonthProjection    `json:"months"`
+}
+
+// Month describes the current month and how much of it has passed.
+type Month struct {
+	Start       string `json:"start"`
+	End         string `json:"end"`
+	DaysElapsed int    `json:"days_elapsed"`
+	DaysInMonth int    `json:"days_in_month"`
+}
+
+// AccountProjection is the projected month-end balance of an account, or of
+// all of them for the total.
+type AccountProjection struct {
+	ID               string  `json:"id,omitempty"`
+	Name             string  `json:"name,omitempty"`
+	Currency         string  `json:"currency,omitempty"`
+	CurrentBalance   float64 `json:"current_balance"`
+	ProjectedBalance float64 `json:"projected_balance"`
+	Low              float64 `json:"low"`
+	High             float64 `json:"high"`
+}
+
+// CategoryProjection is the projected expense total of a category for the
+// current month. RunRate extrapolates the spending so far to the whole
+// month; Average is the seasonal or trailing average the run rate is
+// blended with.
+type CategoryProjection struct {
+	CategoryID   string  `json:"category_id"`
+	CategoryName string  `json:"category_name"`
+	Actual       float64 `json:"actual"`
+	RunRate      float64 `json:"run_rate"`
+	Average      float64 `json:"average"`
+	Projected    float64 `json:"projected"`
+	Low          float64 `json:"low"`
+	High         float64 `json:"high"`
+}
+
+// MonthProjection is the projected expense total of a future month, per
+// category and overall.
+type MonthProjection struct {
+	Month      string                    `json:"month"`
+	Total      Projection                `json:"total"`
+	Categories []CategoryMonthProjection `json:"categories"`
+}
+
+// CategoryMonthProjection is the projected expense total of a category in a
+// future month.
+type CategoryMonthProjection struct {
+	CategoryID   string `json:"category_id"`
+	CategoryName string `json:"category_name"`
+	Projection
+}
+
+// Projection is a projected amount with its confidence band.
+type Projection struct {
+	Projected float64 `json:"projected"`
+	Low       float64 `json:"low"`
+	High      float64 `json:"high"`
+}
+
+// UseCase implements the forecast use case.
+type UseCase struct {
+	repo       Repository
+	timezone   Timezone
+	monthStart MonthStart
+	clock      Clock
+}
+
+// New creates a new forecast UseCase.
+func New(repo Repository, timezone Timezone, monthStart MonthStart, clock Clock) *UseCase {
+	return &UseCase{repo: repo, timezone: timezone, monthStart: monthStart, clock: clock}
+}
+
+// history is the monthly activity the forecast is built from.
+type history struct {
+	monthStart time.Time
+	available  int
+	categories map[string]*series
+	income     map[string]*series
+	expense    map[string]*series
+	net        map[string]*series
+	totalNet   *series
+}
+
+// Execute forecasts the end-of-month balance of every active account and
+// the expense total of every category for the current month and the next
+// Input.Months months, as of today in the user's timezone and with months
+// starting on the configured month start day. Expenses blend the month's run rate with the average
+// of past months; income is expected to match its average. Projections use
+// only past transactions; recurring items are not modelled separately.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
+	months := DefaultMonths
+	if in.Months != "" {
+		n, err := strconv.Atoi(in.Months)
+		if err != nil || n < 0 || n > MaxMonths {
+			return Output{}, fmt.Errorf("forecast: %w: months must be between 0 and %d", domainreport.ErrInvalidQuery, MaxMonths)
+		}
+		months = n
+	}
+
+	today, err := uc.today(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("forecast: %w", err)
+	}
+	monthStartDay, err := uc.monthStart.Execute(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("forecast: %w", err)
+	}
+	h, err := uc.history(ctx, today, monthStartDay)
+	if err != nil {
+		return Output{}, fmt.Errorf("forecast: %w", err)
+	}
+	accounts, err := uc.repo.ListAccounts(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("forecast: %w", err)
+	}
+	categories, err := uc.repo.ListCategories(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("forecast: %w", err)
+	}
+	names := make(map[string]string, len(categories))
+	for _, c := range categories {
+		names[c.ID] = c.Name
+	}
+
+	monthEnd := period.Next(h.monthStart, domainreport.IntervalMonth).AddDate(0, 0, -1)
+	daysElapsed := days(h.monthStart, today)
+	daysInMonth := days(h.monthStart, monthEnd)
+	elapsed := float64(daysElapsed) / float64(daysInMonth)
+	out := Output{
+		AsOf: today.Format(period.DateLayout),
+		Month: Month{
+			Start:       h.monthStart.Format(period.DateLayout),
+			End:         monthEnd.Format(period.DateLayout),
+			DaysElapsed: daysElapsed,
+			DaysInMonth: daysInMonth,
+		},
+		Accounts:   make([]AccountProjection, 0, len(accounts)),
+		Categories: make([]CategoryProjection, 0, len(h.categories)),
+		Months:     make([]MonthProjection, 0, months),
+	}
+
+	var total float64
+	for _, a := range accounts {
+		if !a.IsActive {
+			continue
+		}
+		p := AccountProjection{ID: a.ID, Name: a.Name, Currency: a.Currency, CurrentBalance: a.CurrentBalance}
+		income, expense := h.get(h.income, a.ID), h.get(h.expense, a.ID)
+		projectedIncome, _ := income.expectedMonth(h.available, elapsed)
+		projectedExpense, _ := expense.currentMonth(h.available, elapsed)
+		change := (projectedIncome - income.actual) - (projectedExpense - expense.actual)
+		band := bandZ * h.get(h.net, a.ID).spread(h.available, change) * (1 - elapsed)
+		p.ProjectedBalance = round(a.CurrentBalance + change)
+		p.Low, p.High = round(a.CurrentBalance+change-band), round(a.CurrentBalance+change+band)
+		out.Accounts = append(out.Accounts, p)
+		out.Total.CurrentBalance += a.CurrentBalance
+		total += p.ProjectedBalance
+	}
+	band := bandZ * h.totalNet.spread(h.available, total-out.Total.CurrentBalance) * (1 - elapsed)
+	out.Total.ProjectedBalance = round(total)
+	out.Total.Low, out.Total.High = round(total-band), round(total+band)
+	out.Total.CurrentBalance = round(out.Total.CurrentBalance)
+
+	ids := make([]string, 0, len(h.categories))
+	for id := range h.categories {
+		ids = append(ids, id)
+	}
+	name := func(id string) string {
+		if n, ok := names[id]; ok {
+			return n
+		}
+		return uncategorized
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if name(ids[i]) != name(ids[j]) {
+			return name(ids[i]) < name(ids[j])
+		}
+		return ids[i] < ids[j]
+	})
+
+	for _, id := range ids {
+		s := h.categories[id]
+		projected, band := s.currentMonth(h.available, elapsed)
+		avg, ok := s.baseline(h.available, 0)
+		if !ok {
+			avg = s.actual / elapsed
+		}
+		out.Categories = append(out.Categories, CategoryProjection{
+			CategoryID:   id,
+			CategoryName: name(id),
+			Actual:       round(s.actual),
+			RunRate:      round(s.actual / elapsed),
+			Average:      round(avg),
+			Projected:    round(projected),
+			Low:          round(math.Max(s.actual, projected-band)),
+			High:         round(projected + band),
+		})
+	}
+
+	for ahead := 1; ahead <= months; ahead++ {
+		m := MonthProjection{
+			Month:      h.monthStart.AddDate(0, ahead, 0).Format("2006-01"),
+			Categories: make([]CategoryMonthProjection, 0, len(ids)),
+		}
+		var variance float64
+		for _, id := range ids {
+			s := h.categories[id]
+			projected, ok := s.baseline(h.available, ahead)
+			if !ok {
+				projected, _ = s.currentMonth(h.available, elapsed)
+			}
+			spread := s.spread(h.available, projected)
+			variance += spread * spread
+			band := bandZ * spread
+			m.Categories = append(m.Categories, CategoryMonthProjection{
+				CategoryID:   id,
+				CategoryName: name(id),
+				Projection:   Projection{Projected: round(projected), Low: round(math.Max(0, projected-band)), High: round(projected + band)},
+			})
+			m.Total.Projected += projected
+		}
+		band := bandZ * math.Sqrt(variance)
+		m.Total = Projection{Projected: round(m.Total.Projected), Low: round(math.Max(0, m.Total.Projected-band)), High: round(m.Total.Projected + band)}
+		out.Months = append(out.Months, m)
+	}
+
+	return out, nil
+}
+
+// ProjectedMonthEndBalance returns the projected total balance of the active
+// accounts at the end of the current month.
+func (uc *UseCase) ProjectedMonthEndBalance(ctx context.Context) (float64, error) {
+	out, err := uc.Execute(ctx, Input{Months: "0"})
+	if err != nil {
+		return 0, err
+	}
+	return out.Total.ProjectedBalance, nil
+}
+
+// today returns the current date in the user's timezone, at midnight UTC.
+func (uc *UseCase) today(ctx context.Context) (time.Time, error) {
+	loc, err := uc.timezone.Execute(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	now := uc.clock.Now().In(loc)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), nil
+}
+
+// days returns the number of days from start to end, both included.
+func days(start, end time.Time) int {
+	return int(end.Sub(start).Hours()/24) + 1
+}
+
+// history loads the monthly income and expense totals per category and per
+// account, from historyMonths months before the current one up to today,
+// with months starting on monthStartDay.
+func (uc *UseCase) history(ctx context.Context, today time.Time, monthStartDay int) (*history, error) {
+	h := &history{
+		monthStart: period.Start(today, domainreport.IntervalMonth, monthStartDay),
+		categories: make(map[string]*series),
+		income:     make(map[string]*series),
+		expense:    make(map[string]*series),
+		net:        make(map[string]*series),
+		totalNet:   &series{history: make([]float64, historyMonths)},
+	}
+	r := domainreport.Range{
+		From:          h.monthStart.AddDate(0, -historyMonths, 0),
+		To:            today,
+		Interval:      domainreport.IntervalMonth,
+		MonthStartDay: monthStartDay,
+	}
+
+	byCategory, err := uc.repo.CashFlow(ctx, domainreport.CashFlowQuery{Range: r, GroupBy: domainreport.GroupByCategory})
+	if err != nil {
+		return nil, err
+	}
+	byAccount, err := uc.repo.CashFlow(ctx, domainreport.CashFlowQuery{Range: r, GroupBy: domainreport.GroupByAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	earliest := h.monthStart
+	for _, rows := range [][]domainreport.CashFlowRow{byCategory, byAccount} {
+		for _, row := range rows {
+			if row.PeriodStart.Before(earliest) {
+				earliest = row.PeriodStart
+			}
+		}
+	}
+	for _, row := range byCategory {
+		if row.Expense != 0 {
+			h.add(h.categories, row.GroupID, row.PeriodStart, row.Expense)
+		}
+	}
+	for _, row := range byAccount {
+		h.add(h.income, row.GroupID, row.PeriodStart, row.Income)
+		h.add(h.expense, row.GroupID, row.PeriodStart, row.Expense)
+		h.add(h.net, row.GroupID, row.PeriodStart, row.Income-row.Expense)
+		h.addTo(h.totalNet, row.PeriodStart, row.Income-row.Expense)
+	}
+	h.available = (h.monthStart.Year()-earliest.Year())*12 + int(h.monthStart.Month()-earliest.Month())
+
+	return h, nil
+}
+
+// add adds amount to the month starting at start of the series id in m.
+func (h *history) add(m map[string]*series, id string, start time.Time, amount float64) {
+	s, ok := m[id]
+	if !ok {
+		s = &series{history: make([]float64, historyMonths)}
+		m[id] = s
+	}
+	h.addTo(s, start, amount)
+}
+
+func (h *history) addTo(s *series, start time.Time, amount float64) {
+	back := (h.monthStart.Year()-start.Year())*12 + int(h.monthStart.Month()-start.Month())
+	switch {
+	case back == 0:
+		s.actual += amount
+	case back > 0 && back <= historyMonths:
+		s.history[back-1] += amount
+	}
+}
+
+// get returns the series id in m, or an empty one.
+func (h *history) get(m map[string]*series, id string) series {
+	if s, ok := m[id]; ok {
+		return *s
+	}
+	return series{history: make([]float64, historyMonths)}
+}
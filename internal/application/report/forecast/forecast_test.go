@@ -0,0 +1,246 @@
+package forecast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/report/forecast"
+	"github.com/financial-manager/api/internal/application/report/forecast/mocks"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+func TestUseCase_Execute_TrailingAverage(t *testing.T) {
+	t.Parallel()
+
+	repo := buildMockRepo(
+		[]domainreport.CashFlowRow{
+			row("2026-01", "cat-food", 0, 200),
+			row("2026-02", "cat-food", 0, 200),
+			row("2026-03", "cat-food", 0, 200),
+			row("2026-04", "cat-food", 0, 150),
+		},
+		[]domainreport.CashFlowRow{
+			row("2026-01", "acc-1", 1000, 200),
+			row("2026-02", "acc-1", 1000, 200),
+			row("2026-03", "acc-1", 1000, 200),
+			row("2026-04", "acc-1", 1000, 150),
+		},
+	)
+	uc := forecast.New(repo, buildTimezone(time.UTC), buildMonthStart(1), buildClock())
+
+	out, err := uc.Execute(context.Background(), forecast.Input{Months: "2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, forecast.Output{
+		AsOf:  "2026-04-15",
+		Month: forecast.Month{Start: "2026-04-01", End: "2026-04-30", DaysElapsed: 15, DaysInMonth: 30},
+		Total: forecast.AccountProjection{CurrentBalance: 5000, ProjectedBalance: 4900, Low: 4900, High: 4900},
+		Accounts: []forecast.AccountProjection{
+			{ID: "acc-1", Name: "Bank", Currency: "USD", CurrentBalance: 5000, ProjectedBalance: 4900, Low: 4900, High: 4900},
+		},
+		Categories: []forecast.CategoryProjection{
+			{CategoryID: "cat-food", CategoryName: "Alimentación", Actual: 150, RunRate: 300, Average: 200, Projected: 250, Low: 250, High: 250},
+		},
+		Months: []forecast.MonthProjection{
+			{
+				Month: "2026-05", Total: forecast.Projection{Projected: 200, Low: 200, High: 200},
+				Categories: []forecast.CategoryMonthProjection{
+					{CategoryID: "cat-food", CategoryName: "Alimentación", Projection: forecast.Projection{Projected: 200, Low: 200, High: 200}},
+				},
+			},
+			{
+				Month: "2026-06", Total: forecast.Projection{Projected: 200, Low: 200, High: 200},
+				Categories: []forecast.CategoryMonthProjection{
+					{CategoryID: "cat-food", CategoryName: "Alimentación", Projection: forecast.Projection{Projected: 200, Low: 200, High: 200}},
+				},
+			},
+		},
+	}, out)
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_SeasonalAverage(t *testing.T) {
+	t.Parallel()
+
+	repo := buildMockRepo(
+		[]domainreport.CashFlowRow{
+			row("2025-04", "cat-food", 0, 400),
+			row("2025-05", "cat-food", 0, 500),
+			row("2026-03", "cat-food", 0, 100),
+			row("2026-04", "cat-food", 0, 150),
+			row("2026-04", "cat-rent", 0, 900),
+		},
+		nil,
+	)
+	uc := forecast.New(repo, buildTimezone(time.UTC), buildMonthStart(1), buildClock())
+
+	out, err := uc.Execute(context.Background(), forecast.Input{Months: "1"})
+
+	require.NoError(t, err)
+	require.Len(t, out.Categories, 2)
+	food := out.Categories[0]
+	assert.Equal(t, 400.0, food.Average, "same month last year")
+	assert.Equal(t, 350.0, food.Projected, "half run rate, half seasonal average")
+	assert.Greater(t, food.High, food.Projected)
+	assert.GreaterOrEqual(t, food.Low, food.Actual)
+	rent := out.Categories[1]
+	assert.Equal(t, 900.0, rent.Projected, "never below the actual total")
+	require.Len(t, out.Months, 1)
+	assert.Equal(t, 500.0, out.Months[0].Categories[0].Projected, "same month last year")
+	assert.Less(t, out.Months[0].Categories[0].Low, 500.0)
+}
+
+func TestUseCase_Execute_NoHistory(t *testing.T) {
+	t.Parallel()
+
+	repo := buildMockRepo(
+		[]domainreport.CashFlowRow{row("2026-04", "", 0, 100)},
+		[]domainreport.CashFlowRow{row("2026-04", "acc-1", 0, 100)},
+	)
+	uc := forecast.New(repo, buildTimezone(time.UTC), buildMonthStart(1), buildClock())
+
+	out, err := uc.Execute(context.Background(), forecast.Input{Months: "0"})
+
+	require.NoError(t, err)
+	require.Len(t, out.Categories, 1)
+	c := out.Categories[0]
+	assert.Equal(t, "Uncategorized", c.CategoryName)
+	assert.Equal(t, 200.0, c.Projected, "the run rate alone")
+	assert.Equal(t, 4900.0, out.Total.ProjectedBalance)
+	assert.Less(t, out.Total.Low, out.Total.ProjectedBalance)
+	assert.Empty(t, out.Months)
+}
+
+func TestUseCase_ProjectedMonthEndBalance(t *testing.T) {
+	t.Parallel()
+
+	repo := buildMockRepo(nil, []domainreport.CashFlowRow{
+		row("2026-03", "acc-1", 1000, 400),
+		row("2026-04", "acc-1", 1000, 100),
+	})
+	uc := forecast.New(repo, buildTimezone(time.UTC), buildMonthStart(1), buildClock())
+
+	got, err := uc.ProjectedMonthEndBalance(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 4800.0, got, "salary as usual, half run rate and half average spending")
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_UsesTheUserTimezone(t *testing.T) {
+	t.Parallel()
+
+	// At now, it is already the 16th in UTC+9.
+	tokyo := time.FixedZone("UTC+9", 9*60*60)
+	r := domainreport.Range{From: date("2024-04-01"), To: date("2026-04-16"), Interval: domainreport.IntervalMonth, MonthStartDay: 1}
+	repo := &mocks.Repository{}
+	repo.On("CashFlow", mock.Anything, domainreport.CashFlowQuery{Range: r, GroupBy: domainreport.GroupByCategory}).Return(nil, nil).Once()
+	repo.On("CashFlow", mock.Anything, domainreport.CashFlowQuery{Range: r, GroupBy: domainreport.GroupByAccount}).Return(nil, nil).Once()
+	repo.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
+	repo.On("ListCategories", mock.Anything).Return(categories, nil).Once()
+	uc := forecast.New(repo, buildTimezone(tokyo), buildMonthStart(1), buildClock())
+
+	out, err := uc.Execute(context.Background(), forecast.Input{Months: "0"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2026-04-16", out.AsOf)
+	assert.Equal(t, 16, out.Month.DaysElapsed)
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_MonthStartDay(t *testing.T) {
+	t.Parallel()
+
+	// With months starting on the 10th, now falls in the month of 10 April.
+	r := domainreport.Range{From: date("2024-04-10"), To: date("2026-04-15"), Interval: domainreport.IntervalMonth, MonthStartDay: 10}
+	byAccount := []domainreport.CashFlowRow{
+		{PeriodStart: date("2026-03-10"), GroupID: "acc-1", Income: 1000, Expense: 300},
+		{PeriodStart: date("2026-04-10"), GroupID: "acc-1", Expense: 60},
+	}
+	repo := &mocks.Repository{}
+	repo.On("CashFlow", mock.Anything, domainreport.CashFlowQuery{Range: r, GroupBy: domainreport.GroupByCategory}).Return(nil, nil).Once()
+	repo.On("CashFlow", mock.Anything, domainreport.CashFlowQuery{Range: r, GroupBy: domainreport.GroupByAccount}).Return(byAccount, nil).Once()
+	repo.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
+	repo.On("ListCategories", mock.Anything).Return(categories, nil).Once()
+	uc := forecast.New(repo, buildTimezone(time.UTC), buildMonthStart(10), buildClock())
+
+	out, err := uc.Execute(context.Background(), forecast.Input{Months: "1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, forecast.Month{Start: "2026-04-10", End: "2026-05-09", DaysElapsed: 6, DaysInMonth: 30}, out.Month)
+	require.Len(t, out.Months, 1)
+	assert.Equal(t, "2026-05", out.Months[0].Month)
+	assert.Greater(t, out.Total.ProjectedBalance, out.Total.CurrentBalance, "the usual salary is still to come")
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_TimezoneError(t *testing.T) {
+	t.Parallel()
+
+	tzErr := errors.New("settings unavailable")
+	timezone := &mocks.Timezone{}
+	timezone.On("Execute", mock.Anything).Return(nil, tzErr).Once()
+	repo := &mocks.Repository{}
+	uc := forecast.New(repo, timezone, buildMonthStart(1), buildClock())
+
+	_, err := uc.Execute(context.Background(), forecast.Input{})
+
+	assert.ErrorIs(t, err, tzErr)
+	repo.AssertNotCalled(t, "CashFlow", mock.Anything, mock.Anything)
+}
+
+func TestUseCase_Execute_Errors(t *testing.T) {
+	t.Parallel()
+
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name    string
+		input   forecast.Input
+		repo    func() *mocks.Repository
+		wantErr error
+	}{
+		{
+			name:    "months out of range",
+			input:   forecast.Input{Months: "13"},
+			repo:    func() *mocks.Repository { return &mocks.Repository{} },
+			wantErr: domainreport.ErrInvalidQuery,
+		},
+		{
+			name:    "malformed months",
+			input:   forecast.Input{Months: "few"},
+			repo:    func() *mocks.Repository { return &mocks.Repository{} },
+			wantErr: domainreport.ErrInvalidQuery,
+		},
+		{
+			name: "repository error is propagated",
+			repo: func() *mocks.Repository {
+				m := &mocks.Repository{}
+				m.On("CashFlow", mock.Anything, mock.Anything).Return(nil, dbErr).Once()
+				return m
+			},
+			wantErr: dbErr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := tc.repo()
+			uc := forecast.New(repo, buildTimezone(time.UTC), buildMonthStart(1), buildClock())
+
+			out, err := uc.Execute(context.Background(), tc.input)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Empty(t, out)
+			repo.AssertExpectations(t)
+		})
+	}
+}
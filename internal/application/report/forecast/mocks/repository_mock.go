@@ -0,0 +1,38 @@
+// Package mocks contains testify mock implementations for the forecast use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// Repository is a testify mock for the forecast.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// CashFlow mocks Repository.CashFlow.
+func (m *Repository) CashFlow(ctx context.Context, q domainreport.CashFlowQuery) ([]domainreport.CashFlowRow, error) {
+	args := m.Called(ctx, q)
+	rows, _ := args.Get(0).([]domainreport.CashFlowRow)
+	return rows, args.Error(1)
+}
+
+// ListAccounts mocks Repository.ListAccounts.
+func (m *Repository) ListAccounts(ctx context.Context) ([]domainaccount.Account, error) {
+	args := m.Called(ctx)
+	accounts, _ := args.Get(0).([]domainaccount.Account)
+	return accounts, args.Error(1)
+}
+
+// ListCategories mocks Repository.ListCategories.
+func (m *Repository) ListCategories(ctx context.Context) ([]domaincategory.Category, error) {
+	args := m.Called(ctx)
+	categories, _ := args.Get(0).([]domaincategory.Category)
+	return categories, args.Error(1)
+}
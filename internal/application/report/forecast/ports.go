@@ -0,0 +1,32 @@
+package forecast
+
+import (
+	"context"
+	"time"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// Repository is the port required by the forecast use case.
+type Repository interface {
+	CashFlow(ctx context.Context, q domainreport.CashFlowQuery) ([]domainreport.CashFlowRow, error)
+	ListAccounts(ctx context.Context) ([]domainaccount.Account, error)
+	ListCategories(ctx context.Context) ([]domaincategory.Category, error)
+}
+
+// Timezone is the port for the user's timezone, which decides what "today" is.
+type Timezone interface {
+	Execute(ctx context.Context) (*time.Location, error)
+}
+
+// MonthStart is the port for the day of the month months start on.
+type MonthStart interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,111 @@
+package forecast
+
+import "math"
+
+const (
+	// bandZ is the z-score of the two-sided 80% confidence band, assuming
+	// monthly totals are roughly normally distributed.
+	bandZ = 1.2816
+
+	// trailingMonths is the number of recent months averaged when there is
+	// not a full year of history to take a seasonal average from.
+	trailingMonths = 6
+
+	// spreadMonths is the number of recent months the spread of monthly
+	// totals is measured over.
+	spreadMonths = 12
+
+	// minSpreadMonths is the fewest months of history the spread is measured
+	// on; with less, the band is fallbackSpread of the projection.
+	minSpreadMonths = 3
+	fallbackSpread  = 0.5
+)
+
+// series holds the monthly totals of one category, account or flow.
+// history[i] is the total of the month i+1 months before the current one.
+type series struct {
+	actual  float64
+	history []float64
+}
+
+// baseline returns the expected total of the month ahead months after the
+// current one: the mean of the same calendar month in previous years when
+// there is at least a year of history, otherwise the mean of the last
+// trailingMonths months. ok is false without any history.
+func (s series) baseline(available, ahead int) (avg float64, ok bool) {
+	if available >= 12 {
+		var values []float64
+		for back := 12 - ahead; back <= available; back += 12 {
+			values = append(values, s.history[back-1])
+		}
+		return mean(values), true
+	}
+	if available == 0 {
+		return 0, false
+	}
+	return mean(s.history[:min(trailingMonths, available)]), true
+}
+
+// spread returns the standard deviation of the recent monthly totals, or
+// fallbackSpread of projected when there are too few months to measure it.
+func (s series) spread(available int, projected float64) float64 {
+	if available < minSpreadMonths {
+		return fallbackSpread * math.Abs(projected)
+	}
+	return stddev(s.history[:min(spreadMonths, available)])
+}
+
+// currentMonth projects the total of the current month, of which elapsed
+// share has passed. The run rate and the baseline are blended by that share,
+// so early in the month the projection leans on history and late in the
+// month on what has actually happened. The projection never falls below the
+// actual total.
+func (s series) currentMonth(available int, elapsed float64) (projected, band float64) {
+	runRate := s.actual / elapsed
+	avg, ok := s.baseline(available, 0)
+	if !ok {
+		avg = runRate
+	}
+	projected = math.Max(s.actual, elapsed*runRate+(1-elapsed)*avg)
+	return projected, bandZ * s.spread(available, projected) * (1 - elapsed)
+}
+
+// expectedMonth projects the total of the current month as its baseline, or
+// the actual total once that is higher. It suits income, which arrives in a
+// few large amounts that a run rate would extrapolate wildly.
+func (s series) expectedMonth(available int, elapsed float64) (projected, band float64) {
+	avg, ok := s.baseline(available, 0)
+	if !ok {
+		avg = s.actual
+	}
+	projected = math.Max(s.actual, avg)
+	return projected, bandZ * s.spread(available, projected) * (1 - elapsed)
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func stddev(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	m := mean(values)
+	var sum float64
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+	return math.Sqrt(sum / float64(len(values)-1))
+}
+
+// round rounds to cents.
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
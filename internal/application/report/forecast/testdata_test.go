@@ -0,0 +1,71 @@
+package forecast_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/report/forecast/mocks"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// now is the fixed clock reading used by every test: halfway through April.
+var now = time.Date(2026, time.April, 15, 20, 0, 0, 0, time.UTC)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+// buildClock returns a mocks.Clock that always reports now.
+func buildClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(now)
+	return m
+}
+
+// buildTimezone returns a mocks.Timezone that always reports loc.
+func buildTimezone(loc *time.Location) *mocks.Timezone {
+	m := &mocks.Timezone{}
+	m.On("Execute", mock.Anything).Return(loc, nil)
+	return m
+}
+
+// buildMonthStart returns a mocks.MonthStart that always reports day.
+func buildMonthStart(day int) *mocks.MonthStart {
+	m := &mocks.MonthStart{}
+	m.On("Execute", mock.Anything).Return(day, nil)
+	return m
+}
+
+// historyRange is the range the forecast loads for now.
+var historyRange = domainreport.Range{From: date("2024-04-01"), To: date("2026-04-15"), Interval: domainreport.IntervalMonth, MonthStartDay: 1}
+
+// buildMockRepo creates a mocks.Repository returning the given monthly rows.
+func buildMockRepo(byCategory, byAccount []domainreport.CashFlowRow) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("CashFlow", mock.Anything, domainreport.CashFlowQuery{Range: historyRange, GroupBy: domainreport.GroupByCategory}).Return(byCategory, nil).Once()
+	m.On("CashFlow", mock.Anything, domainreport.CashFlowQuery{Range: historyRange, GroupBy: domainreport.GroupByAccount}).Return(byAccount, nil).Once()
+	m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
+	m.On("ListCategories", mock.Anything).Return(categories, nil).Once()
+	return m
+}
+
+// row returns a monthly row of group id.
+func row(month, id string, income, expense float64) domainreport.CashFlowRow {
+	return domainreport.CashFlowRow{PeriodStart: date(month + "-01"), GroupID: id, Income: income, Expense: expense}
+}
+
+var (
+	accounts = []domainaccount.Account{
+		{ID: "acc-1", Name: "Bank", Type: domainaccount.AccountTypeBank, CurrentBalance: 5000, Currency: "USD", IsActive: true},
+		{ID: "acc-2", Name: "Closed", Type: domainaccount.AccountTypeCash, CurrentBalance: 70, Currency: "USD", IsActive: false},
+	}
+
+	categories = []domaincategory.Category{
+		{ID: "cat-food", Name: "Alimentación", Type: domaincategory.TypeExpense, IsActive: true},
+		{ID: "cat-rent", Name: "Vivienda", Type: domaincategory.TypeExpense, IsActive: true},
+	}
+)
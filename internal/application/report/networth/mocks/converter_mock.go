@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Converter is a testify mock for the networth.Converter interface.
+type Converter struct {
+	mock.Mock
+}
+
+// Convert mocks Converter.Convert.
+func (m *Converter) Convert(ctx context.Context, amount float64, from, to string, on time.Time) (float64, error) {
+	args := m.Called(ctx, amount, from, to, on)
+	if fn, ok := args.Get(0).(func(float64) float64); ok {
+		return fn(amount), args.Error(1)
+	}
+	converted, _ := args.Get(0).(float64)
+	return converted, args.Error(1)
+}
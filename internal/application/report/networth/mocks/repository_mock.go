@@ -0,0 +1,30 @@
+// Package mocks contains testify mock implementations for the net worth use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// Repository is a testify mock for the networth.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// BalanceChanges mocks Repository.BalanceChanges.
+func (m *Repository) BalanceChanges(ctx context.Context, r domainreport.Range) ([]domainreport.BalanceChange, error) {
+	args := m.Called(ctx, r)
+	changes, _ := args.Get(0).([]domainreport.BalanceChange)
+	return changes, args.Error(1)
+}
+
+// ListAccounts mocks Repository.ListAccounts.
+func (m *Repository) ListAccounts(ctx context.Context) ([]domainaccount.Account, error) {
+	args := m.Called(ctx)
+	accounts, _ := args.Get(0).([]domainaccount.Account)
+	return accounts, args.Error(1)
+}
@@ -0,0 +1,160 @@
+// Package networth implements the net worth history report use case.
+package networth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/financial-manager/api/internal/application/report/period"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+)
+
+// Input holds the report parameters. Dates are YYYY-MM-DD and inclusive.
+// Empty fields fall back to the last twelve months, monthly, in the base
+// currency.
+type Input struct {
+	From     string
+	To       string
+	Interval string
+	Currency string
+}
+
+// Output is the net worth history report.
+type Output struct {
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	Interval string   `json:"interval"`
+	Currency string   `json:"currency"`
+	Periods  []Period `json:"periods"`
+}
+
+// Period holds the balances at the end of one interval. Assets is the sum of
+// every non credit card account and Liabilities the amount owed on credit
+// cards, both in the report currency. Label names the whole period.
+type Period struct {
+	Label       string           `json:"label"`
+	Start       string           `json:"start"`
+	End         string           `json:"end"`
+	Assets      float64          `json:"assets"`
+	Liabilities float64          `json:"liabilities"`
+	NetWorth    float64          `json:"net_worth"`
+	Accounts    []AccountBalance `json:"accounts"`
+}
+
+// AccountBalance is the balance of one account at the end of a period, in the
+// account currency and converted to the report currency.
+type AccountBalance struct {
+	ID               string  `json:"id"`
+	Name             string  `json:"name"`
+	Type             string  `json:"type"`
+	Currency         string  `json:"currency"`
+	Balance          float64 `json:"balance"`
+	ConvertedBalance float64 `json:"converted_balance"`
+}
+
+// UseCase implements the net worth history use case.
+type UseCase struct {
+	repo         Repository
+	converter    Converter
+	monthStart   MonthStart
+	clock        Clock
+	baseCurrency string
+}
+
+// New creates a new net worth UseCase that reports in baseCurrency unless
+// the input asks for another one.
+func New(repo Repository, converter Converter, monthStart MonthStart, clock Clock, baseCurrency string) *UseCase {
+	return &UseCase{repo: repo, converter: converter, monthStart: monthStart, clock: clock, baseCurrency: baseCurrency}
+}
+
+// Execute reconstructs the balance of every active account at the end of each
+// period from its initial balance plus its dated active transactions, so the
+// last period of a range ending today matches the current balances.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
+	monthStartDay, err := uc.monthStart.Execute(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("net worth report: %w", err)
+	}
+	r, err := period.Parse(in.From, in.To, in.Interval, monthStartDay, uc.clock.Now())
+	if err != nil {
+		return Output{}, fmt.Errorf("net worth report: %w", err)
+	}
+	currency := strings.ToUpper(in.Currency)
+	if currency == "" {
+		currency = uc.baseCurrency
+	}
+
+	accounts, err := uc.repo.ListAccounts(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("net worth report: %w", err)
+	}
+	changes, err := uc.repo.BalanceChanges(ctx, r)
+	if err != nil {
+		return Output{}, fmt.Errorf("net worth report: %w", err)
+	}
+
+	type key struct {
+		account string
+		period  string
+	}
+	amounts := make(map[key]float64, len(changes))
+	for _, c := range changes {
+		k := key{account: c.AccountID}
+		if !c.PeriodStart.IsZero() {
+			k.period = c.PeriodStart.Format(period.DateLayout)
+		}
+		amounts[k] += c.Amount
+	}
+
+	active := make([]domainaccount.Account, 0, len(accounts))
+	balances := make(map[string]float64, len(accounts))
+	for _, a := range accounts {
+		if !a.IsActive {
+			continue
+		}
+		active = append(active, a)
+		balances[a.ID] = a.InitialBalance + amounts[key{account: a.ID}]
+	}
+
+	periods := make([]Period, 0)
+	for _, span := range period.Spans(r) {
+		p := Period{
+			Label:    span.Label,
+			Start:    span.Start.Format(period.DateLayout),
+			End:      span.End.Format(period.DateLayout),
+			Accounts: make([]AccountBalance, len(active)),
+		}
+		periodKey := span.Key.Format(period.DateLayout)
+		for i, a := range active {
+			balances[a.ID] += amounts[key{account: a.ID, period: periodKey}]
+			converted, err := uc.converter.Convert(ctx, balances[a.ID], a.Currency, currency, span.End)
+			if err != nil {
+				return Output{}, fmt.Errorf("net worth report: convert %s to %s: %w", a.Currency, currency, err)
+			}
+			if a.Type == domainaccount.AccountTypeCreditCard {
+				p.Liabilities -= converted
+			} else {
+				p.Assets += converted
+			}
+			p.Accounts[i] = AccountBalance{
+				ID:               a.ID,
+				Name:             a.Name,
+				Type:             string(a.Type),
+				Currency:         a.Currency,
+				Balance:          balances[a.ID],
+				ConvertedBalance: converted,
+			}
+		}
+		p.NetWorth = p.Assets - p.Liabilities
+		periods = append(periods, p)
+	}
+
+	return Output{
+		From:     r.From.Format(period.DateLayout),
+		To:       r.To.Format(period.DateLayout),
+		Interval: string(r.Interval),
+		Currency: currency,
+		Periods:  periods,
+	}, nil
+}
@@ -0,0 +1,164 @@
+package networth_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/report/networth"
+	"github.com/financial-manager/api/internal/application/report/networth/mocks"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.Repository{}
+	repo.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
+	repo.On("BalanceChanges", mock.Anything, firstQuarter).Return(changes, nil).Once()
+	uc := networth.New(repo, buildConverter(), buildMonthStart(1), buildClock(), "USD")
+
+	out, err := uc.Execute(context.Background(), networth.Input{From: "2026-01-01", To: "2026-03-31"})
+
+	require.NoError(t, err)
+	balances := func(card, eur, wallet float64) []networth.AccountBalance {
+		return []networth.AccountBalance{
+			{ID: "acc-card", Name: "Card", Type: "credit_card", Currency: "USD", Balance: card, ConvertedBalance: card},
+			{ID: "acc-eur", Name: "Savings", Type: "savings", Currency: "EUR", Balance: eur, ConvertedBalance: eur * 2},
+			{ID: "acc-wallet", Name: "Wallet", Type: "cash", Currency: "USD", Balance: wallet, ConvertedBalance: wallet},
+		}
+	}
+	assert.Equal(t, networth.Output{
+		From: "2026-01-01", To: "2026-03-31", Interval: "month", Currency: "USD",
+		Periods: []networth.Period{
+			{Label: "Jan 2026", Start: "2026-01-01", End: "2026-01-31", Assets: 2350, NetWorth: 2350, Accounts: balances(0, 1000, 350)},
+			{Label: "Feb 2026", Start: "2026-02-01", End: "2026-02-28", Assets: 2350, Liabilities: 300, NetWorth: 2050, Accounts: balances(-300, 1000, 350)},
+			{Label: "Mar 2026", Start: "2026-03-01", End: "2026-03-31", Assets: 2550, Liabilities: 300, NetWorth: 2250, Accounts: balances(-300, 1100, 350)},
+		},
+	}, out)
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_MonthStartDay(t *testing.T) {
+	t.Parallel()
+
+	rng := domainreport.Range{From: date("2026-01-25"), To: date("2026-03-24"), Interval: domainreport.IntervalMonth, MonthStartDay: 25}
+	repo := &mocks.Repository{}
+	repo.On("ListAccounts", mock.Anything).Return(accounts[3:], nil).Once()
+	repo.On("BalanceChanges", mock.Anything, rng).Return([]domainreport.BalanceChange{
+		{AccountID: "acc-wallet", PeriodStart: date("2026-02-25"), Amount: 40},
+	}, nil).Once()
+	uc := networth.New(repo, buildConverter(), buildMonthStart(25), buildClock(), "USD")
+
+	out, err := uc.Execute(context.Background(), networth.Input{From: "2026-01-25", To: "2026-03-24"})
+
+	require.NoError(t, err)
+	wallet := func(balance float64) []networth.AccountBalance {
+		return []networth.AccountBalance{{ID: "acc-wallet", Name: "Wallet", Type: "cash", Currency: "USD", Balance: balance, ConvertedBalance: balance}}
+	}
+	assert.Equal(t, []networth.Period{
+		{Label: "25 Jan – 24 Feb", Start: "2026-01-25", End: "2026-02-24", Assets: 100, NetWorth: 100, Accounts: wallet(100)},
+		{Label: "25 Feb – 24 Mar", Start: "2026-02-25", End: "2026-03-24", Assets: 140, NetWorth: 140, Accounts: wallet(140)},
+	}, out.Periods)
+	repo.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_ConvertsAtPeriodEnd(t *testing.T) {
+	t.Parallel()
+
+	repo := &mocks.Repository{}
+	repo.On("ListAccounts", mock.Anything).Return(accounts[2:3], nil).Once()
+	repo.On("BalanceChanges", mock.Anything, firstQuarter).Return(nil, nil).Once()
+	converter := &mocks.Converter{}
+	for _, end := range []string{"2026-01-31", "2026-02-28", "2026-03-31"} {
+		converter.On("Convert", mock.Anything, 1000.0, "EUR", "EUR", date(end)).Return(1000.0, nil).Once()
+	}
+	uc := networth.New(repo, converter, buildMonthStart(1), buildClock(), "USD")
+
+	out, err := uc.Execute(context.Background(), networth.Input{From: "2026-01-01", To: "2026-03-31", Currency: "eur"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", out.Currency)
+	converter.AssertExpectations(t)
+}
+
+func TestUseCase_Execute_Errors(t *testing.T) {
+	t.Parallel()
+
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		input     networth.Input
+		repo      func() *mocks.Repository
+		converter *mocks.Converter
+		wantErr   error
+	}{
+		{
+			name:      "invalid range",
+			input:     networth.Input{From: "2026-04-01", To: "2026-03-31"},
+			repo:      func() *mocks.Repository { return &mocks.Repository{} },
+			converter: &mocks.Converter{},
+			wantErr:   domainreport.ErrInvalidQuery,
+		},
+		{
+			name:  "list accounts error is propagated",
+			input: networth.Input{From: "2026-01-01", To: "2026-03-31"},
+			repo: func() *mocks.Repository {
+				m := &mocks.Repository{}
+				m.On("ListAccounts", mock.Anything).Return(nil, dbErr).Once()
+				return m
+			},
+			converter: &mocks.Converter{},
+			wantErr:   dbErr,
+		},
+		{
+			name:  "balance changes error is propagated",
+			input: networth.Input{From: "2026-01-01", To: "2026-03-31"},
+			repo: func() *mocks.Repository {
+				m := &mocks.Repository{}
+				m.On("ListAccounts", mock.Anything).Return(accounts, nil).Once()
+				m.On("BalanceChanges", mock.Anything, firstQuarter).Return(nil, dbErr).Once()
+				return m
+			},
+			converter: &mocks.Converter{},
+			wantErr:   dbErr,
+		},
+		{
+			name:  "missing exchange rate is propagated",
+			input: networth.Input{From: "2026-01-01", To: "2026-03-31"},
+			repo: func() *mocks.Repository {
+				m := &mocks.Repository{}
+				m.On("ListAccounts", mock.Anything).Return(accounts[2:3], nil).Once()
+				m.On("BalanceChanges", mock.Anything, firstQuarter).Return(nil, nil).Once()
+				return m
+			},
+			converter: func() *mocks.Converter {
+				m := &mocks.Converter{}
+				m.On("Convert", mock.Anything, mock.Anything, "EUR", "USD", mock.Anything).Return(0.0, fmt.Errorf("EUR: %w", domainreport.ErrNoExchangeRate)).Once()
+				return m
+			}(),
+			wantErr: domainreport.ErrNoExchangeRate,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := tc.repo()
+			uc := networth.New(repo, tc.converter, buildMonthStart(1), buildClock(), "USD")
+
+			out, err := uc.Execute(context.Background(), tc.input)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Empty(t, out)
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package networth
+
+import (
+	"context"
+	"time"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// Repository is the port required by the net worth use case.
+type Repository interface {
+	BalanceChanges(ctx context.Context, r domainreport.Range) ([]domainreport.BalanceChange, error)
+	ListAccounts(ctx context.Context) ([]domainaccount.Account, error)
+}
+
+// Converter is the port for converting an amount between currencies at the
+// rate of a given day. It returns an error wrapping
+// domainreport.ErrNoExchangeRate when the pair is unknown.
+type Converter interface {
+	Convert(ctx context.Context, amount float64, from, to string, on time.Time) (float64, error)
+}
+
+// MonthStart is the port returning the day of the month months start on.
+type MonthStart interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,62 @@
+package networth_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/report/networth/mocks"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// now is the fixed clock reading used by every test.
+var now = time.Date(2026, time.March, 18, 15, 4, 5, 0, time.UTC)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+// buildClock returns a mocks.Clock that always reports now.
+func buildClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(now)
+	return m
+}
+
+// buildMonthStart returns a mocks.MonthStart reporting day.
+func buildMonthStart(day int) *mocks.MonthStart {
+	m := &mocks.MonthStart{}
+	m.On("Execute", mock.Anything).Return(day, nil)
+	return m
+}
+
+// buildConverter returns a mocks.Converter that keeps USD amounts and
+// doubles EUR amounts when converting to USD.
+func buildConverter() *mocks.Converter {
+	m := &mocks.Converter{}
+	m.On("Convert", mock.Anything, mock.Anything, "USD", "USD", mock.Anything).Return(func(a float64) float64 { return a }, nil)
+	m.On("Convert", mock.Anything, mock.Anything, "EUR", "USD", mock.Anything).Return(func(a float64) float64 { return a * 2 }, nil)
+	return m
+}
+
+// firstQuarter is the range covering January to March 2026, monthly.
+var firstQuarter = domainreport.Range{From: date("2026-01-01"), To: date("2026-03-31"), Interval: domainreport.IntervalMonth, MonthStartDay: 1}
+
+var (
+	accounts = []domainaccount.Account{
+		{ID: "acc-card", Name: "Card", Type: domainaccount.AccountTypeCreditCard, Currency: "USD", IsActive: true},
+		{ID: "acc-old", Name: "Closed", Type: domainaccount.AccountTypeBank, InitialBalance: 999, Currency: "USD", IsActive: false},
+		{ID: "acc-eur", Name: "Savings", Type: domainaccount.AccountTypeSavings, InitialBalance: 1000, Currency: "EUR", IsActive: true},
+		{ID: "acc-wallet", Name: "Wallet", Type: domainaccount.AccountTypeCash, InitialBalance: 100, Currency: "USD", IsActive: true},
+	}
+
+	changes = []domainreport.BalanceChange{
+		{AccountID: "acc-card", PeriodStart: date("2026-02-01"), Amount: -300},
+		{AccountID: "acc-eur", PeriodStart: date("2026-03-01"), Amount: 100},
+		{AccountID: "acc-old", PeriodStart: date("2026-01-01"), Amount: 1},
+		{AccountID: "acc-wallet", Amount: 50},
+		{AccountID: "acc-wallet", PeriodStart: date("2026-01-01"), Amount: 200},
+	}
+)
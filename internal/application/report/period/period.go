@@ -0,0 +1,165 @@
+// Package period resolves report date ranges and splits them into periods.
+// It is shared by the report use cases so they agree with each other, and
+// with the repository, on where every period starts.
+package period
+
+import (
+	"fmt"
+	"time"
+
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+const (
+	// DateLayout is the layout of report dates.
+	DateLayout = "2006-01-02"
+
+	// MaxPeriods bounds the size of a report so a wide range with a short
+	// interval cannot produce an unbounded response.
+	MaxPeriods = 1000
+
+	// defaultMonths is the number of months covered when from is omitted,
+	// including the current one.
+	defaultMonths = 12
+)
+
+// Span is one period of a range. Key is the first day of the period, as the
+// repository groups by it; Start and End are clamped to the range, so the
+// first and last spans may be partial. Label names the whole period.
+type Span struct {
+	Key   time.Time
+	Start time.Time
+	End   time.Time
+	Label string
+}
+
+// Parse validates the from, to and interval report parameters and applies
+// their defaults: to is today, from the first day of the month eleven months
+// before to, and the interval is monthly. Months, quarters and years start on
+// monthStartDay. Errors wrap domainreport.ErrInvalidQuery.
+func Parse(from, to, interval string, monthStartDay int, today time.Time) (domainreport.Range, error) {
+	r := domainreport.Range{
+		To:            time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC),
+		Interval:      domainreport.IntervalMonth,
+		MonthStartDay: monthStartDay,
+	}
+
+	if to != "" {
+		t, err := time.Parse(DateLayout, to)
+		if err != nil {
+			return r, fmt.Errorf("%w: to must be YYYY-MM-DD", domainreport.ErrInvalidQuery)
+		}
+		r.To = t
+	}
+	r.From = Start(r.To, domainreport.IntervalMonth, monthStartDay).AddDate(0, -defaultMonths+1, 0)
+	if from != "" {
+		f, err := time.Parse(DateLayout, from)
+		if err != nil {
+			return r, fmt.Errorf("%w: from must be YYYY-MM-DD", domainreport.ErrInvalidQuery)
+		}
+		r.From = f
+	}
+	if r.From.After(r.To) {
+		return r, fmt.Errorf("%w: from must not be after to", domainreport.ErrInvalidQuery)
+	}
+
+	if interval != "" {
+		r.Interval = domainreport.Interval(interval)
+	}
+	switch r.Interval {
+	case domainreport.IntervalWeek, domainreport.IntervalMonth, domainreport.IntervalQuarter, domainreport.IntervalYear:
+	default:
+		return r, fmt.Errorf("%w: interval must be 'week', 'month', 'quarter' or 'year'", domainreport.ErrInvalidQuery)
+	}
+
+	n := 0
+	for start := Start(r.From, r.Interval, r.MonthStartDay); !start.After(r.To); start = Next(start, r.Interval) {
+		if n++; n > MaxPeriods {
+			return r, fmt.Errorf("%w: range spans more than %d periods", domainreport.ErrInvalidQuery, MaxPeriods)
+		}
+	}
+
+	return r, nil
+}
+
+// Spans returns every period of r in order.
+func Spans(r domainreport.Range) []Span {
+	spans := make([]Span, 0)
+	for start := Start(r.From, r.Interval, r.MonthStartDay); !start.After(r.To); start = Next(start, r.Interval) {
+		s := Span{
+			Key:   start,
+			Start: start,
+			End:   Next(start, r.Interval).AddDate(0, 0, -1),
+			Label: Label(start, r.Interval, r.MonthStartDay),
+		}
+		if s.Start.Before(r.From) {
+			s.Start = r.From
+		}
+		if s.End.After(r.To) {
+			s.End = r.To
+		}
+		spans = append(spans, s)
+	}
+
+	return spans
+}
+
+// Start returns the first day of the interval period t falls in. Weeks start
+// on Monday; months, quarters and years start on monthStartDay of their first
+// month, so with 25 the month of February runs from 25 Feb to 24 Mar. A
+// monthStartDay of zero or one keeps calendar periods.
+func Start(t time.Time, interval domainreport.Interval, monthStartDay int) time.Time {
+	if shift := monthStartDay - 1; shift > 0 && interval != domainreport.IntervalWeek {
+		return Start(t.AddDate(0, 0, -shift), interval, 1).AddDate(0, 0, shift)
+	}
+
+	y, m, d := t.Date()
+	switch interval {
+	case domainreport.IntervalWeek:
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, time.UTC)
+	case domainreport.IntervalQuarter:
+		return time.Date(y, m-(m-1)%3, 1, 0, 0, 0, 0, time.UTC)
+	case domainreport.IntervalYear:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Label names the interval period starting at start: "Mar 2026", "Q1 2026"
+// and "2026" for calendar periods, and the day range otherwise, for example
+// "2 Mar – 8 Mar" for a week or "25 Jan – 24 Feb" for a month starting on the
+// 25th. Years starting on a later day carry both years.
+func Label(start time.Time, interval domainreport.Interval, monthStartDay int) string {
+	end := Next(start, interval).AddDate(0, 0, -1)
+	if interval != domainreport.IntervalWeek && monthStartDay <= 1 {
+		switch interval {
+		case domainreport.IntervalQuarter:
+			return fmt.Sprintf("Q%d %d", (int(start.Month())-1)/3+1, start.Year())
+		case domainreport.IntervalYear:
+			return start.Format("2006")
+		default:
+			return start.Format("Jan 2006")
+		}
+	}
+	if interval == domainreport.IntervalYear {
+		return start.Format("2 Jan 2006") + " – " + end.Format("2 Jan 2006")
+	}
+
+	return start.Format("2 Jan") + " – " + end.Format("2 Jan")
+}
+
+// Next returns the first day of the period following the one starting at start.
+func Next(start time.Time, interval domainreport.Interval) time.Time {
+	switch interval {
+	case domainreport.IntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case domainreport.IntervalQuarter:
+		return start.AddDate(0, 3, 0)
+	case domainreport.IntervalYear:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
@@ -0,0 +1,180 @@
+package period_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/report/period"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	today := time.Date(2026, time.March, 18, 23, 59, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		from, to, interval string
+		monthStartDay      int
+		want               domainreport.Range
+		wantErr            bool
+	}{
+		{
+			name: "defaults to the last twelve months, monthly",
+			want: domainreport.Range{From: date("2025-04-01"), To: date("2026-03-18"), Interval: domainreport.IntervalMonth},
+		},
+		{
+			name: "default from follows an explicit to",
+			to:   "2025-12-31",
+			want: domainreport.Range{From: date("2025-01-01"), To: date("2025-12-31"), Interval: domainreport.IntervalMonth},
+		},
+		{
+			name: "explicit range and interval", from: "2026-01-01", to: "2026-01-31", interval: "week",
+			want: domainreport.Range{From: date("2026-01-01"), To: date("2026-01-31"), Interval: domainreport.IntervalWeek},
+		},
+		{
+			name: "default from starts on the month start day", monthStartDay: 25,
+			want: domainreport.Range{From: date("2025-03-25"), To: date("2026-03-18"), Interval: domainreport.IntervalMonth, MonthStartDay: 25},
+		},
+		{name: "malformed from", from: "01/01/2026", wantErr: true},
+		{name: "malformed to", to: "today", wantErr: true},
+		{name: "from after to", from: "2026-02-01", to: "2026-01-01", wantErr: true},
+		{name: "unknown interval", interval: "day", wantErr: true},
+		{name: "too many periods", from: "1900-01-01", to: "2026-01-01", interval: "month", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := period.Parse(tc.from, tc.to, tc.interval, tc.monthStartDay, today)
+
+			if tc.wantErr {
+				assert.ErrorIs(t, err, domainreport.ErrInvalidQuery)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSpans(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		r    domainreport.Range
+		want []period.Span
+	}{
+		{
+			name: "weeks start on monday and are clamped to the range",
+			r:    domainreport.Range{From: date("2026-03-04"), To: date("2026-03-10"), Interval: domainreport.IntervalWeek},
+			want: []period.Span{
+				{Key: date("2026-03-02"), Start: date("2026-03-04"), End: date("2026-03-08"), Label: "2 Mar – 8 Mar"},
+				{Key: date("2026-03-09"), Start: date("2026-03-09"), End: date("2026-03-10"), Label: "9 Mar – 15 Mar"},
+			},
+		},
+		{
+			name: "sunday belongs to the previous week",
+			r:    domainreport.Range{From: date("2026-03-08"), To: date("2026-03-08"), Interval: domainreport.IntervalWeek},
+			want: []period.Span{{Key: date("2026-03-02"), Start: date("2026-03-08"), End: date("2026-03-08"), Label: "2 Mar – 8 Mar"}},
+		},
+		{
+			name: "quarters",
+			r:    domainreport.Range{From: date("2026-02-10"), To: date("2026-07-01"), Interval: domainreport.IntervalQuarter},
+			want: []period.Span{
+				{Key: date("2026-01-01"), Start: date("2026-02-10"), End: date("2026-03-31"), Label: "Q1 2026"},
+				{Key: date("2026-04-01"), Start: date("2026-04-01"), End: date("2026-06-30"), Label: "Q2 2026"},
+				{Key: date("2026-07-01"), Start: date("2026-07-01"), End: date("2026-07-01"), Label: "Q3 2026"},
+			},
+		},
+		{
+			name: "years",
+			r:    domainreport.Range{From: date("2025-06-01"), To: date("2026-06-01"), Interval: domainreport.IntervalYear},
+			want: []period.Span{
+				{Key: date("2025-01-01"), Start: date("2025-06-01"), End: date("2025-12-31"), Label: "2025"},
+				{Key: date("2026-01-01"), Start: date("2026-01-01"), End: date("2026-06-01"), Label: "2026"},
+			},
+		},
+		{
+			name: "months starting on the 25th",
+			r:    domainreport.Range{From: date("2026-01-10"), To: date("2026-03-01"), Interval: domainreport.IntervalMonth, MonthStartDay: 25},
+			want: []period.Span{
+				{Key: date("2025-12-25"), Start: date("2026-01-10"), End: date("2026-01-24"), Label: "25 Dec – 24 Jan"},
+				{Key: date("2026-01-25"), Start: date("2026-01-25"), End: date("2026-02-24"), Label: "25 Jan – 24 Feb"},
+				{Key: date("2026-02-25"), Start: date("2026-02-25"), End: date("2026-03-01"), Label: "25 Feb – 24 Mar"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, period.Spans(tc.r))
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		t             string
+		interval      domainreport.Interval
+		monthStartDay int
+		want          string
+	}{
+		{name: "calendar month", t: "2026-02-10", interval: domainreport.IntervalMonth, want: "2026-02-01"},
+		{name: "before the start day belongs to the previous month", t: "2026-02-10", interval: domainreport.IntervalMonth, monthStartDay: 25, want: "2026-01-25"},
+		{name: "the start day opens the month", t: "2026-02-25", interval: domainreport.IntervalMonth, monthStartDay: 25, want: "2026-02-25"},
+		{name: "early january belongs to the previous year", t: "2026-01-05", interval: domainreport.IntervalYear, monthStartDay: 25, want: "2025-01-25"},
+		{name: "quarters shift with the start day", t: "2026-04-20", interval: domainreport.IntervalQuarter, monthStartDay: 25, want: "2026-01-25"},
+		{name: "weeks ignore the start day", t: "2026-03-08", interval: domainreport.IntervalWeek, monthStartDay: 25, want: "2026-03-02"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, date(tc.want), period.Start(date(tc.t), tc.interval, tc.monthStartDay))
+		})
+	}
+}
+
+func TestLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		start         string
+		interval      domainreport.Interval
+		monthStartDay int
+		want          string
+	}{
+		{name: "calendar month", start: "2026-03-01", interval: domainreport.IntervalMonth, want: "Mar 2026"},
+		{name: "custom month", start: "2026-01-25", interval: domainreport.IntervalMonth, monthStartDay: 25, want: "25 Jan – 24 Feb"},
+		{name: "custom quarter", start: "2026-01-25", interval: domainreport.IntervalQuarter, monthStartDay: 25, want: "25 Jan – 24 Apr"},
+		{name: "custom year", start: "2026-01-25", interval: domainreport.IntervalYear, monthStartDay: 25, want: "25 Jan 2026 – 24 Jan 2027"},
+		{name: "calendar quarter", start: "2026-04-01", interval: domainreport.IntervalQuarter, monthStartDay: 1, want: "Q2 2026"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, period.Label(date(tc.start), tc.interval, tc.monthStartDay))
+		})
+	}
+}
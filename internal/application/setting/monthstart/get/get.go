@@ -0,0 +1,48 @@
+// Package get implements the use case that returns the configured month start day.
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+// Repository is the narrow read port for the settings.
+type Repository interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+// UseCase returns the month start day stored in the settings.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute returns the stored month start day, or domainsetting.DefaultMonthStartDay
+// when none is stored or the stored value is not a valid day.
+func (uc *UseCase) Execute(ctx context.Context) (int, error) {
+	value, err := uc.repo.Get(ctx, domainsetting.KeyMonthStartDay)
+	if errors.Is(err, domainshared.ErrNotFound) {
+		return domainsetting.DefaultMonthStartDay, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("get month start day: %w", err)
+	}
+
+	day, err := strconv.Atoi(value)
+	if err != nil || day < 1 || day > domainsetting.MaxMonthStartDay {
+		log.Printf("get month start day: ignoring stored value %q", value)
+		return domainsetting.DefaultMonthStartDay, nil
+	}
+
+	return day, nil
+}
@@ -0,0 +1,64 @@
+package get_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/setting/monthstart/get"
+	"github.com/financial-manager/api/internal/application/setting/monthstart/get/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		repo    *mocks.Repository
+		want    int
+		wantErr error
+	}{
+		{
+			name: "returns the stored day",
+			repo: buildMockRepo("25", nil),
+			want: 25,
+		},
+		{
+			name: "unset day returns calendar months",
+			repo: buildMockRepo("", domainshared.ErrNotFound),
+			want: 1,
+		},
+		{
+			name: "out of range stored day returns calendar months",
+			repo: buildMockRepo("31", nil),
+			want: 1,
+		},
+		{
+			name: "malformed stored day returns calendar months",
+			repo: buildMockRepo("twenty", nil),
+			want: 1,
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			repo:    buildMockRepo("", errors.New("db error")),
+			wantErr: fmt.Errorf("get month start day: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := get.New(tc.repo)
+			got, err := uc.Execute(context.Background())
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
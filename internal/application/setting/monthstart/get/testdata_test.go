@@ -0,0 +1,14 @@
+package get_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/setting/monthstart/get/mocks"
+)
+
+// buildMockRepo creates a mocks.Repository returning value and err for the month start day key.
+func buildMockRepo(value string, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("Get", mock.Anything, "month_start_day").Return(value, err).Once()
+	return m
+}
@@ -0,0 +1,44 @@
+// Package update implements the use case that changes the configured month start day.
+package update
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+)
+
+// Repository is the narrow write port for the settings.
+type Repository interface {
+	Set(ctx context.Context, key, value string) error
+}
+
+// Input carries the new month start day.
+type Input struct {
+	Day int
+}
+
+// UseCase stores the month start day in the settings.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute validates and stores the month start day and returns it.
+// Returns domainsetting.ErrInvalidMonthStartDay for days outside 1..28.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (int, error) {
+	if in.Day < 1 || in.Day > domainsetting.MaxMonthStartDay {
+		return 0, domainsetting.ErrInvalidMonthStartDay
+	}
+
+	if err := uc.repo.Set(ctx, domainsetting.KeyMonthStartDay, strconv.Itoa(in.Day)); err != nil {
+		return 0, fmt.Errorf("update month start day: %w", err)
+	}
+
+	return in.Day, nil
+}
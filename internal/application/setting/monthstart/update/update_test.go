@@ -0,0 +1,63 @@
+package update_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/setting/monthstart/update"
+	"github.com/financial-manager/api/internal/application/setting/monthstart/update/mocks"
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   update.Input
+		repo    *mocks.Repository
+		want    int
+		wantErr error
+	}{
+		{
+			name:  "stores a valid day",
+			input: update.Input{Day: 25},
+			repo:  buildMockRepo("25", nil),
+			want:  25,
+		},
+		{
+			name:    "day zero is rejected",
+			repo:    buildMockRepo("", nil),
+			wantErr: domainsetting.ErrInvalidMonthStartDay,
+		},
+		{
+			name:    "day past the 28th is rejected",
+			input:   update.Input{Day: 29},
+			repo:    buildMockRepo("", nil),
+			wantErr: domainsetting.ErrInvalidMonthStartDay,
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			input:   update.Input{Day: 1},
+			repo:    buildMockRepo("1", errors.New("db error")),
+			wantErr: fmt.Errorf("update month start day: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := update.New(tc.repo)
+			got, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
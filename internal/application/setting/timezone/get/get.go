@@ -0,0 +1,49 @@
+// Package get implements the use case that returns the configured timezone.
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+// Repository is the narrow read port for the settings.
+type Repository interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+// UseCase returns the timezone stored in the settings.
+type UseCase struct {
+	repo     Repository
+	fallback *time.Location
+}
+
+// New creates a new UseCase. fallback is returned while no timezone is stored.
+func New(repo Repository, fallback *time.Location) *UseCase {
+	return &UseCase{repo: repo, fallback: fallback}
+}
+
+// Execute returns the stored timezone, or the fallback when none is stored or
+// the stored name is no longer known to the timezone database.
+func (uc *UseCase) Execute(ctx context.Context) (*time.Location, error) {
+	name, err := uc.repo.Get(ctx, domainsetting.KeyTimezone)
+	if errors.Is(err, domainshared.ErrNotFound) {
+		return uc.fallback, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get timezone: %w", err)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		log.Printf("get timezone: %s: %v", name, err)
+		return uc.fallback, nil
+	}
+
+	return loc, nil
+}
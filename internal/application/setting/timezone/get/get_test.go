@@ -0,0 +1,64 @@
+package get_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/setting/timezone/get"
+	"github.com/financial-manager/api/internal/application/setting/timezone/get/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	fallback := time.FixedZone("fallback", 3600)
+
+	tests := []struct {
+		name     string
+		repo     *mocks.Repository
+		wantName string
+		wantErr  error
+	}{
+		{
+			name:     "returns the stored timezone",
+			repo:     buildMockRepo("America/Bogota", nil),
+			wantName: "America/Bogota",
+		},
+		{
+			name:     "unset timezone returns the fallback",
+			repo:     buildMockRepo("", domainshared.ErrNotFound),
+			wantName: "fallback",
+		},
+		{
+			name:     "unknown stored timezone returns the fallback",
+			repo:     buildMockRepo("Mars/Olympus", nil),
+			wantName: "fallback",
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			repo:    buildMockRepo("", errors.New("db error")),
+			wantErr: fmt.Errorf("get timezone: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := get.New(tc.repo, fallback)
+			loc, err := uc.Execute(context.Background())
+
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.wantName, loc.String())
+			}
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Package mocks contains testify mock implementations for the get timezone use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Repository is a testify mock for the get.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// Get mocks Repository.Get.
+func (m *Repository) Get(ctx context.Context, key string) (string, error) {
+	args := m.Called(ctx, key)
+	return args.String(0), args.Error(1)
+}
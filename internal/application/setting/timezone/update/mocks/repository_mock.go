@@ -0,0 +1,19 @@
+// Package mocks contains testify mock implementations for the update timezone use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Repository is a testify mock for the update.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// Set mocks Repository.Set.
+func (m *Repository) Set(ctx context.Context, key, value string) error {
+	args := m.Called(ctx, key, value)
+	return args.Error(0)
+}
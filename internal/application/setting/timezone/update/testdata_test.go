@@ -0,0 +1,17 @@
+package update_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/setting/timezone/update/mocks"
+)
+
+// buildMockRepo creates a mocks.Repository expecting value to be stored under the
+// timezone key, or no call at all when value is empty.
+func buildMockRepo(value string, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	if value != "" {
+		m.On("Set", mock.Anything, "timezone", value).Return(err).Once()
+	}
+	return m
+}
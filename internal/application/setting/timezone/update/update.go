@@ -0,0 +1,51 @@
+// Package update implements the use case that changes the configured timezone.
+package update
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+)
+
+// Repository is the narrow write port for the settings.
+type Repository interface {
+	Set(ctx context.Context, key, value string) error
+}
+
+// Input carries the IANA name of the new timezone.
+type Input struct {
+	Timezone string
+}
+
+// UseCase stores the timezone in the settings.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute validates and stores the timezone and returns it.
+// Returns domainsetting.ErrInvalidTimezone for names unknown to the timezone database.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (*time.Location, error) {
+	name := strings.TrimSpace(in.Timezone)
+	if name == "" {
+		return nil, fmt.Errorf("%w: timezone is required", domainsetting.ErrInvalidTimezone)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domainsetting.ErrInvalidTimezone, name)
+	}
+
+	if err := uc.repo.Set(ctx, domainsetting.KeyTimezone, loc.String()); err != nil {
+		return nil, fmt.Errorf("update timezone: %w", err)
+	}
+
+	return loc, nil
+}
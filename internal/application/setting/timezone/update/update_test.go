@@ -0,0 +1,65 @@
+package update_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/setting/timezone/update"
+	"github.com/financial-manager/api/internal/application/setting/timezone/update/mocks"
+	domainsetting "github.com/financial-manager/api/internal/domain/setting"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    update.Input
+		repo     *mocks.Repository
+		wantName string
+		wantErr  error
+	}{
+		{
+			name:     "stores a valid timezone",
+			input:    update.Input{Timezone: " America/Bogota "},
+			repo:     buildMockRepo("America/Bogota", nil),
+			wantName: "America/Bogota",
+		},
+		{
+			name:    "empty timezone is rejected",
+			repo:    buildMockRepo("", nil),
+			wantErr: fmt.Errorf("%w: timezone is required", domainsetting.ErrInvalidTimezone),
+		},
+		{
+			name:    "unknown timezone is rejected",
+			input:   update.Input{Timezone: "Mars/Olympus"},
+			repo:    buildMockRepo("", nil),
+			wantErr: fmt.Errorf("%w: Mars/Olympus", domainsetting.ErrInvalidTimezone),
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			input:   update.Input{Timezone: "UTC"},
+			repo:    buildMockRepo("UTC", errors.New("db error")),
+			wantErr: fmt.Errorf("update timezone: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := update.New(tc.repo)
+			loc, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.wantName, loc.String())
+			}
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
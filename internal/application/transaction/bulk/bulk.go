@@ -0,0 +1,258 @@
+// Package bulk implements the bulk transaction operations use case.
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// MaxOperations bounds the size of a bulk request.
+const MaxOperations = 1000
+
+// Repository is the port that applies a bulk request atomically.
+type Repository interface {
+	ApplyBulk(ctx context.Context, ops []domaintransaction.BulkOperation) (domaintransaction.BulkResult, error)
+}
+
+// AccountRepository is the port to the accounts, which live in the accounts
+// database. GetByID returns domainshared.ErrNotFound for a missing account.
+type AccountRepository interface {
+	GetByID(ctx context.Context, id string) (domainaccount.Account, error)
+	AdjustBalances(ctx context.Context, deltas map[string]float64) error
+}
+
+// IDGenerator is the port for unique identifier generation.
+type IDGenerator interface {
+	NewID() string
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
+
+// AnomalyScorer scores a new expense against its history and stores it when
+// unusual, reporting whether it was.
+type AnomalyScorer interface {
+	Execute(ctx context.Context, t domaintransaction.Transaction) (domaininsight.Anomaly, bool, error)
+}
+
+// UseCase implements the bulk transaction operations use case.
+type UseCase struct {
+	repo      Repository
+	accounts  AccountRepository
+	idGen     IDGenerator
+	clock     Clock
+	anomalies AnomalyScorer
+}
+
+// New creates a new UseCase.
+func New(repo Repository, accounts AccountRepository, idGen IDGenerator, clock Clock, anomalies AnomalyScorer) *UseCase {
+	return &UseCase{repo: repo, accounts: accounts, idGen: idGen, clock: clock, anomalies: anomalies}
+}
+
+// Operation is one change of a bulk request. Action is "create", "update",
+// "delete" or "recategorize".
+//   - create needs Type, AccountID, a positive Amount and Date; CategoryID
+//     and Description are optional.
+//   - update needs ID; its non-empty CategoryID, Description and Date and
+//     its non-zero Amount replace the current ones.
+//   - delete needs ID.
+//   - recategorize needs ID and CategoryID.
+type Operation struct {
+	Action      string  `json:"action"`
+	ID          string  `json:"id"`
+	Type        string  `json:"type"`
+	AccountID   string  `json:"account_id"`
+	CategoryID  string  `json:"category_id"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description"`
+	Date        string  `json:"date"` // YYYY-MM-DD
+}
+
+// Input is the list of operations of a bulk request, applied in order.
+type Input struct {
+	Operations []Operation `json:"operations"`
+}
+
+// Result is the transaction one operation produced, in its state after the
+// request; deleted transactions are inactive. Anomaly is set when a created
+// expense was flagged as unusual.
+type Result struct {
+	Action      string
+	Transaction domaintransaction.Transaction
+	Anomaly     *domaininsight.Anomaly
+}
+
+// Execute validates every operation and applies them all in a single
+// database transaction, or none of them. Invalid operations, creates in a
+// missing account and operations on missing transactions are reported
+// together in a *domaintransaction.BulkError wrapping
+// domaintransaction.ErrInvalidBulk. The net balance change of each account is
+// then applied once to the accounts database.
+// Created expenses are then scored for anomalies; the request is already
+// applied by then, so a failed score is logged instead of failing it.
+func (uc *UseCase) Execute(ctx context.Context, in Input) ([]Result, error) {
+	if len(in.Operations) == 0 {
+		return nil, fmt.Errorf("bulk transactions: %w: operations are required", domaintransaction.ErrInvalidBulk)
+	}
+	if len(in.Operations) > MaxOperations {
+		return nil, fmt.Errorf("bulk transactions: %w: at most %d operations are allowed", domaintransaction.ErrInvalidBulk, MaxOperations)
+	}
+
+	now := uc.clock.Now().UTC()
+	ops := make([]domaintransaction.BulkOperation, len(in.Operations))
+	accounts := make(map[string]bool)
+	var failed []domaintransaction.BulkItemError
+	for i, op := range in.Operations {
+		bulkOp, err := uc.toBulkOperation(op, now)
+		if err == nil && bulkOp.Action == domaintransaction.BulkCreate {
+			found, lookupErr := uc.accountExists(ctx, accounts, bulkOp.Transaction.AccountID)
+			if lookupErr != nil {
+				return nil, fmt.Errorf("bulk transactions: %w", lookupErr)
+			}
+			if !found {
+				err = domaintransaction.ErrAccountNotFound
+			}
+		}
+		if err != nil {
+			failed = append(failed, domaintransaction.BulkItemError{Index: i, Err: err})
+			continue
+		}
+		ops[i] = bulkOp
+	}
+	if len(failed) > 0 {
+		return nil, fmt.Errorf("bulk transactions: %w", &domaintransaction.BulkError{Items: failed})
+	}
+
+	res, err := uc.repo.ApplyBulk(ctx, ops)
+	if err != nil {
+		return nil, fmt.Errorf("bulk transactions: %w", err)
+	}
+	if err := uc.accounts.AdjustBalances(ctx, res.Balances); err != nil {
+		return nil, fmt.Errorf("bulk transactions: %w", err)
+	}
+
+	results := make([]Result, len(res.Transactions))
+	for i, tx := range res.Transactions {
+		results[i] = Result{Action: string(ops[i].Action), Transaction: tx}
+		if ops[i].Action != domaintransaction.BulkCreate || tx.Type != domaintransaction.TransactionTypeExpense {
+			continue
+		}
+		anomaly, unusual, err := uc.anomalies.Execute(ctx, tx)
+		if err != nil {
+			log.Printf("bulk transactions: anomaly score: %v", err)
+		} else if unusual {
+			results[i].Anomaly = &anomaly
+		}
+	}
+
+	return results, nil
+}
+
+// toBulkOperation validates op and converts it into a domain operation
+// stamped with now.
+func (uc *UseCase) toBulkOperation(op Operation, now time.Time) (domaintransaction.BulkOperation, error) {
+	action := domaintransaction.BulkAction(op.Action)
+	tx := domaintransaction.Transaction{
+		ID:          op.ID,
+		CategoryID:  op.CategoryID,
+		Amount:      op.Amount,
+		Description: op.Description,
+		UpdatedAt:   now,
+	}
+
+	var err error
+	if op.Date != "" {
+		tx.Date, err = time.Parse("2006-01-02", op.Date)
+		if err != nil {
+			return domaintransaction.BulkOperation{}, errors.New("invalid date format, use YYYY-MM-DD")
+		}
+	}
+
+	switch action {
+	case domaintransaction.BulkCreate:
+		if err = validateCreate(op); err == nil {
+			tx.ID = uc.idGen.NewID()
+			tx.AccountID = op.AccountID
+			tx.Type = domaintransaction.TransactionType(op.Type)
+			tx.IsActive = true
+			tx.CreatedAt = now
+		}
+	case domaintransaction.BulkUpdate:
+		err = validateUpdate(op)
+	case domaintransaction.BulkDelete:
+		err = requireID(op)
+	case domaintransaction.BulkRecategorize:
+		err = requireID(op)
+		if err == nil && op.CategoryID == "" {
+			err = errors.New("category_id is required")
+		}
+	default:
+		err = errors.New("action must be 'create', 'update', 'delete' or 'recategorize'")
+	}
+	if err != nil {
+		return domaintransaction.BulkOperation{}, err
+	}
+
+	return domaintransaction.BulkOperation{Action: action, Transaction: tx}, nil
+}
+
+// accountExists reports whether the account with the given id exists,
+// remembering the answer in known so each account is looked up once.
+func (uc *UseCase) accountExists(ctx context.Context, known map[string]bool, id string) (bool, error) {
+	if found, ok := known[id]; ok {
+		return found, nil
+	}
+
+	_, err := uc.accounts.GetByID(ctx, id)
+	if err != nil && !errors.Is(err, domainshared.ErrNotFound) {
+		return false, err
+	}
+
+	known[id] = err == nil
+	return known[id], nil
+}
+
+func validateCreate(op Operation) error {
+	switch domaintransaction.TransactionType(op.Type) {
+	case domaintransaction.TransactionTypeIncome, domaintransaction.TransactionTypeExpense:
+	default:
+		return errors.New("type must be 'income' or 'expense'")
+	}
+	if op.AccountID == "" {
+		return errors.New("account_id is required")
+	}
+	if op.Amount <= 0 {
+		return domaintransaction.ErrInvalidAmount
+	}
+	if op.Date == "" {
+		return errors.New("date is required")
+	}
+	return nil
+}
+
+func validateUpdate(op Operation) error {
+	if err := requireID(op); err != nil {
+		return err
+	}
+	if op.Amount < 0 {
+		return domaintransaction.ErrInvalidAmount
+	}
+	return nil
+}
+
+func requireID(op Operation) error {
+	if op.ID == "" {
+		return errors.New("id is required")
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package bulk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/transaction/bulk"
+	"github.com/financial-manager/api/internal/application/transaction/bulk/mocks"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	accountsqlite "github.com/financial-manager/api/internal/platform/account/sqlite"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	in := bulk.Input{Operations: []bulk.Operation{
+		{Action: "create", Type: "expense", AccountID: "acc-001", CategoryID: "cat-001", Amount: 25, Description: "Lunch", Date: "2026-02-27"},
+		{Action: "update", ID: "tx-1", Amount: 80, Date: "2026-02-20"},
+		{Action: "delete", ID: "tx-2"},
+		{Action: "recategorize", ID: "tx-3", CategoryID: "cat-002"},
+	}}
+	created := domaintransaction.Transaction{
+		ID:          fixedID,
+		AccountID:   "acc-001",
+		CategoryID:  "cat-001",
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      25,
+		Description: "Lunch",
+		Date:        date("2026-02-27"),
+		IsActive:    true,
+		CreatedAt:   fixedTime(),
+		UpdatedAt:   fixedTime(),
+	}
+	ops := []domaintransaction.BulkOperation{
+		{Action: domaintransaction.BulkCreate, Transaction: created},
+		{Action: domaintransaction.BulkUpdate, Transaction: domaintransaction.Transaction{ID: "tx-1", Amount: 80, Date: date("2026-02-20"), UpdatedAt: fixedTime()}},
+		{Action: domaintransaction.BulkDelete, Transaction: domaintransaction.Transaction{ID: "tx-2", UpdatedAt: fixedTime()}},
+		{Action: domaintransaction.BulkRecategorize, Transaction: domaintransaction.Transaction{ID: "tx-3", CategoryID: "cat-002", UpdatedAt: fixedTime()}},
+	}
+	applied := domaintransaction.BulkResult{
+		Transactions: []domaintransaction.Transaction{created, {ID: "tx-1"}, {ID: "tx-2"}, {ID: "tx-3"}},
+		Balances:     map[string]float64{"acc-001": -45},
+	}
+	unusual := domaininsight.Anomaly{TransactionID: fixedID, Amount: 25, Score: 1}
+
+	t.Run("applies every operation in one request", func(t *testing.T) {
+		t.Parallel()
+
+		repo := buildMockRepo(ops, applied, nil)
+		accounts := buildMockAccounts([]string{"acc-001"}, applied.Balances, nil)
+		idGen := buildMockIDGenerator()
+		anomalies := buildMockAnomalyScorer(created, domaininsight.Anomaly{Score: 0.2}, false, nil)
+
+		got, err := bulk.New(repo, accounts, idGen, buildMockClock(), anomalies).Execute(context.Background(), in)
+
+		require.NoError(t, err)
+		assert.Equal(t, []bulk.Result{
+			{Action: "create", Transaction: created},
+			{Action: "update", Transaction: domaintransaction.Transaction{ID: "tx-1"}},
+			{Action: "delete", Transaction: domaintransaction.Transaction{ID: "tx-2"}},
+			{Action: "recategorize", Transaction: domaintransaction.Transaction{ID: "tx-3"}},
+		}, got)
+		repo.AssertExpectations(t)
+		accounts.AssertExpectations(t)
+		idGen.AssertExpectations(t)
+		anomalies.AssertExpectations(t)
+	})
+
+	t.Run("created expenses flagged as unusual carry their anomaly", func(t *testing.T) {
+		t.Parallel()
+
+		repo := buildMockRepo(ops, applied, nil)
+		accounts := buildMockAccounts([]string{"acc-001"}, applied.Balances, nil)
+		anomalies := buildMockAnomalyScorer(created, unusual, true, nil)
+
+		got, err := bulk.New(repo, accounts, buildMockIDGenerator(), buildMockClock(), anomalies).Execute(context.Background(), in)
+
+		require.NoError(t, err)
+		require.Len(t, got, 4)
+		assert.Equal(t, &unusual, got[0].Anomaly)
+		assert.Nil(t, got[1].Anomaly)
+		anomalies.AssertExpectations(t)
+	})
+
+	t.Run("a failed anomaly score does not fail the request", func(t *testing.T) {
+		t.Parallel()
+
+		repo := buildMockRepo(ops, applied, nil)
+		accounts := buildMockAccounts([]string{"acc-001"}, applied.Balances, nil)
+		anomalies := buildMockAnomalyScorer(created, domaininsight.Anomaly{}, false, errors.New("db unavailable"))
+
+		got, err := bulk.New(repo, accounts, buildMockIDGenerator(), buildMockClock(), anomalies).Execute(context.Background(), in)
+
+		require.NoError(t, err)
+		require.Len(t, got, 4)
+		assert.Nil(t, got[0].Anomaly)
+	})
+
+	t.Run("repository errors are wrapped and propagated", func(t *testing.T) {
+		t.Parallel()
+
+		repo := buildMockRepo(ops, domaintransaction.BulkResult{}, errors.New("db unavailable"))
+		accounts := buildMockAccounts([]string{"acc-001"}, nil, nil)
+		anomalies := &mocks.AnomalyScorer{}
+
+		_, err := bulk.New(repo, accounts, buildMockIDGenerator(), buildMockClock(), anomalies).Execute(context.Background(), in)
+
+		assert.EqualError(t, err, "bulk transactions: db unavailable")
+		accounts.AssertNotCalled(t, "AdjustBalances", mock.Anything, mock.Anything)
+		anomalies.AssertNotCalled(t, "Execute")
+	})
+
+	t.Run("balance errors are wrapped and propagated", func(t *testing.T) {
+		t.Parallel()
+
+		repo := buildMockRepo(ops, applied, nil)
+		accounts := buildMockAccounts([]string{"acc-001"}, applied.Balances, errors.New("db unavailable"))
+		anomalies := &mocks.AnomalyScorer{}
+
+		_, err := bulk.New(repo, accounts, buildMockIDGenerator(), buildMockClock(), anomalies).Execute(context.Background(), in)
+
+		assert.EqualError(t, err, "bulk transactions: db unavailable")
+		anomalies.AssertNotCalled(t, "Execute")
+	})
+
+	t.Run("account lookup errors are wrapped and propagated", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.Repository{}
+		accounts := &mocks.AccountRepository{}
+		accounts.On("GetByID", mock.Anything, "acc-001").Return(domainaccount.Account{}, errors.New("db unavailable")).Once()
+
+		_, err := bulk.New(repo, accounts, buildMockIDGenerator(), buildMockClock(), &mocks.AnomalyScorer{}).Execute(context.Background(), in)
+
+		assert.EqualError(t, err, "bulk transactions: db unavailable")
+		repo.AssertNotCalled(t, "ApplyBulk", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUseCase_Execute_ReportsMissingAccounts(t *testing.T) {
+	t.Parallel()
+
+	in := bulk.Input{Operations: []bulk.Operation{
+		{Action: "create", Type: "income", AccountID: "acc-001", Amount: 10, Date: "2026-02-27"},
+		{Action: "create", Type: "expense", AccountID: "missing", Amount: 5, Date: "2026-02-27"},
+		{Action: "create", Type: "expense", AccountID: "missing", Amount: 7, Date: "2026-02-28"},
+		{Action: "delete"},
+	}}
+	repo := &mocks.Repository{}
+	accounts := buildMockAccounts([]string{"acc-001"}, nil, nil)
+	idGen := &mocks.IDGenerator{}
+	idGen.On("NewID").Return(fixedID)
+
+	_, err := bulk.New(repo, accounts, idGen, buildMockClock(), &mocks.AnomalyScorer{}).Execute(context.Background(), in)
+
+	var bulkErr *domaintransaction.BulkError
+	require.ErrorAs(t, err, &bulkErr)
+	assert.Equal(t, []domaintransaction.BulkItemError{
+		{Index: 1, Err: domaintransaction.ErrAccountNotFound},
+		{Index: 2, Err: domaintransaction.ErrAccountNotFound},
+		{Index: 3, Err: errors.New("id is required")},
+	}, bulkErr.Items)
+	// Each account is looked up once.
+	accounts.AssertNumberOfCalls(t, "GetByID", 2)
+	repo.AssertNotCalled(t, "ApplyBulk", mock.Anything, mock.Anything)
+}
+
+func TestUseCase_Execute_ReportsEveryInvalidOperation(t *testing.T) {
+	t.Parallel()
+
+	in := bulk.Input{Operations: []bulk.Operation{
+		{Action: "recategorize", ID: "tx-1", CategoryID: "cat-002"},
+		{Action: "create", Type: "transfer", AccountID: "acc-001", Amount: 10, Date: "2026-02-27"},
+		{Action: "create", Type: "income", AccountID: "acc-001", Amount: 0, Date: "2026-02-27"},
+		{Action: "create", Type: "income", Amount: 10, Date: "2026-02-27"},
+		{Action: "create", Type: "income", AccountID: "acc-001", Amount: 10},
+		{Action: "update", ID: "tx-2", Date: "27/02/2026"},
+		{Action: "update", ID: "tx-2", Amount: -5},
+		{Action: "update"},
+		{Action: "delete"},
+		{Action: "recategorize", ID: "tx-3"},
+		{Action: "merge", ID: "tx-4"},
+	}}
+	repo := &mocks.Repository{}
+	idGen := &mocks.IDGenerator{}
+
+	_, err := bulk.New(repo, &mocks.AccountRepository{}, idGen, buildMockClock(), &mocks.AnomalyScorer{}).Execute(context.Background(), in)
+
+	var bulkErr *domaintransaction.BulkError
+	require.ErrorAs(t, err, &bulkErr)
+	assert.ErrorIs(t, err, domaintransaction.ErrInvalidBulk)
+
+	msgs := make(map[int]string)
+	for _, item := range bulkErr.Items {
+		msgs[item.Index] = item.Err.Error()
+	}
+	assert.Equal(t, map[int]string{
+		1:  "type must be 'income' or 'expense'",
+		2:  "amount must be positive",
+		3:  "account_id is required",
+		4:  "date is required",
+		5:  "invalid date format, use YYYY-MM-DD",
+		6:  "amount must be positive",
+		7:  "id is required",
+		8:  "id is required",
+		9:  "category_id is required",
+		10: "action must be 'create', 'update', 'delete' or 'recategorize'",
+	}, msgs)
+	repo.AssertNotCalled(t, "ApplyBulk")
+	idGen.AssertNotCalled(t, "NewID")
+}
+
+func TestUseCase_Execute_RequestSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ops  []bulk.Operation
+	}{
+		{name: "empty request"},
+		{name: "too many operations", ops: make([]bulk.Operation, bulk.MaxOperations+1)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.Repository{}
+
+			_, err := bulk.New(repo, &mocks.AccountRepository{}, &mocks.IDGenerator{}, &mocks.Clock{}, &mocks.AnomalyScorer{}).Execute(context.Background(), bulk.Input{Operations: tc.ops})
+
+			assert.ErrorIs(t, err, domaintransaction.ErrInvalidBulk)
+			repo.AssertNotCalled(t, "ApplyBulk")
+		})
+	}
+}
+
+func TestUseCase_Execute_FromSQLite(t *testing.T) {
+	t.Parallel()
+
+	dbs := openTestDatabases(t)
+	_, err := dbs.Accounts.Exec(`INSERT INTO accounts (id, name, type, initial_balance, current_balance, currency, is_active, created_at, updated_at)
+		VALUES ('acc-001', 'Banco', 'bank', 1000, 900, 'USD', 1, '2026-01-01T00:00:00Z', '2026-01-01T00:00:00Z')`)
+	require.NoError(t, err)
+	for _, id := range []string{"tx-1", "tx-2"} {
+		_, err = dbs.Transactions.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at)
+			VALUES (?, 'acc-001', 'cat-001', 'expense', 50, 'Groceries', '2026-02-20', 1, '2026-02-20T00:00:00Z', '2026-02-20T00:00:00Z')`, id)
+		require.NoError(t, err)
+	}
+
+	repo := transactionsqlite.NewTransactionRepository(dbs.Transactions)
+	accounts := accountsqlite.NewAccountRepository(dbs.Accounts)
+	uc := bulk.New(repo, accounts, buildMockIDGenerator(), buildMockClock(), &mocks.AnomalyScorer{})
+	ctx := context.Background()
+
+	got, err := uc.Execute(ctx, bulk.Input{Operations: []bulk.Operation{
+		{Action: "create", Type: "income", AccountID: "acc-001", Amount: 300, Date: "2026-02-27"},
+		{Action: "update", ID: "tx-1", Amount: 80},
+		{Action: "delete", ID: "tx-2"},
+	}})
+
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	// +300 created, -30 updated, +50 deleted.
+	account, err := accounts.GetByID(ctx, "acc-001")
+	require.NoError(t, err)
+	assert.InDelta(t, 1220.0, account.CurrentBalance, 0.001)
+
+	uc = bulk.New(repo, accounts, buildMockIDGenerator(), buildMockClock(), &mocks.AnomalyScorer{})
+	_, err = uc.Execute(ctx, bulk.Input{Operations: []bulk.Operation{
+		{Action: "create", Type: "income", AccountID: "missing", Amount: 10, Date: "2026-02-27"},
+	}})
+	var bulkErr *domaintransaction.BulkError
+	require.ErrorAs(t, err, &bulkErr)
+	assert.Equal(t, []domaintransaction.BulkItemError{{Index: 0, Err: domaintransaction.ErrAccountNotFound}}, bulkErr.Items)
+}
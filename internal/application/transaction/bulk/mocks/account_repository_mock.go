@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+)
+
+// AccountRepository is a testify mock for the bulk.AccountRepository interface.
+type AccountRepository struct {
+	mock.Mock
+}
+
+// GetByID mocks AccountRepository.GetByID.
+func (m *AccountRepository) GetByID(ctx context.Context, id string) (domainaccount.Account, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domainaccount.Account), args.Error(1)
+}
+
+// AdjustBalances mocks AccountRepository.AdjustBalances.
+func (m *AccountRepository) AdjustBalances(ctx context.Context, deltas map[string]float64) error {
+	return m.Called(ctx, deltas).Error(0)
+}
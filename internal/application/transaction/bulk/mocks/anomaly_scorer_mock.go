@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// AnomalyScorer is a testify mock for the bulk.AnomalyScorer interface.
+type AnomalyScorer struct {
+	mock.Mock
+}
+
+// Execute mocks AnomalyScorer.Execute.
+func (m *AnomalyScorer) Execute(ctx context.Context, t domaintransaction.Transaction) (domaininsight.Anomaly, bool, error) {
+	args := m.Called(ctx, t)
+	return args.Get(0).(domaininsight.Anomaly), args.Bool(1), args.Error(2)
+}
@@ -0,0 +1,13 @@
+package mocks
+
+import "github.com/stretchr/testify/mock"
+
+// IDGenerator is a testify mock for the bulk.IDGenerator interface.
+type IDGenerator struct {
+	mock.Mock
+}
+
+// NewID mocks IDGenerator.NewID.
+func (m *IDGenerator) NewID() string {
+	return m.Called().String(0)
+}
@@ -0,0 +1,21 @@
+// Package mocks contains testify mock implementations for the bulk use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Repository is a testify mock for the bulk.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// ApplyBulk mocks Repository.ApplyBulk.
+func (m *Repository) ApplyBulk(ctx context.Context, ops []domaintransaction.BulkOperation) (domaintransaction.BulkResult, error) {
+	args := m.Called(ctx, ops)
+	return args.Get(0).(domaintransaction.BulkResult), args.Error(1)
+}
@@ -0,0 +1,85 @@
+package bulk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/transaction/bulk/mocks"
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	"github.com/financial-manager/api/internal/platform/database"
+	"github.com/financial-manager/api/internal/platform/database/migrator"
+	"github.com/financial-manager/api/internal/platform/database/sqlite"
+)
+
+const fixedID = "fixed-uuid-tx000001"
+
+// buildMockRepo creates a mocks.Repository pre-configured for one ApplyBulk
+// call with ops.
+func buildMockRepo(ops []domaintransaction.BulkOperation, res domaintransaction.BulkResult, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("ApplyBulk", mock.Anything, ops).Return(res, err).Once()
+	return m
+}
+
+// buildMockAccounts creates a mocks.AccountRepository where only the accounts
+// in existing are found. When deltas is not nil it also expects one
+// AdjustBalances call with deltas returning adjustErr.
+func buildMockAccounts(existing []string, deltas map[string]float64, adjustErr error) *mocks.AccountRepository {
+	m := &mocks.AccountRepository{}
+	for _, id := range existing {
+		m.On("GetByID", mock.Anything, id).Return(domainaccount.Account{ID: id}, nil).Once()
+	}
+	m.On("GetByID", mock.Anything, mock.Anything).Return(domainaccount.Account{}, domainshared.ErrNotFound).Maybe()
+	if deltas != nil {
+		m.On("AdjustBalances", mock.Anything, deltas).Return(adjustErr).Once()
+	}
+	return m
+}
+
+// buildMockAnomalyScorer creates a mocks.AnomalyScorer expecting one score of t.
+func buildMockAnomalyScorer(t domaintransaction.Transaction, a domaininsight.Anomaly, unusual bool, err error) *mocks.AnomalyScorer {
+	m := &mocks.AnomalyScorer{}
+	m.On("Execute", mock.Anything, t).Return(a, unusual, err).Once()
+	return m
+}
+
+// buildMockIDGenerator creates a mocks.IDGenerator pre-configured to return fixedID once.
+func buildMockIDGenerator() *mocks.IDGenerator {
+	m := &mocks.IDGenerator{}
+	m.On("NewID").Return(fixedID).Once()
+	return m
+}
+
+// buildMockClock creates a mocks.Clock pre-configured to return fixedTime once.
+func buildMockClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(fixedTime()).Once()
+	return m
+}
+
+func fixedTime() time.Time {
+	return time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+}
+
+func date(s string) time.Time {
+	d, _ := time.Parse("2006-01-02", s)
+	return d
+}
+
+// openTestDatabases opens the four migrated databases in a temporary directory.
+func openTestDatabases(t *testing.T) *database.Databases {
+	t.Helper()
+
+	dbs := database.New(sqlite.NewConnector(), migrator.New())
+	require.NoError(t, dbs.Open(context.Background(), t.TempDir()))
+	t.Cleanup(func() { _ = dbs.Close() })
+
+	return dbs
+}
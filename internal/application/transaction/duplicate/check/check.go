@@ -0,0 +1,45 @@
+// Package check implements the use case that finds likely duplicates of a transaction.
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Repository is the narrow read port required by this use case.
+type Repository interface {
+	ListActiveBetween(ctx context.Context, startDate, endDate string) ([]domaintransaction.Transaction, error)
+}
+
+// UseCase implements the duplicate check run when a transaction is created.
+type UseCase struct {
+	repo    Repository
+	matcher duplicate.Matcher
+}
+
+// New creates a new UseCase using the given matcher.
+func New(repo Repository, matcher duplicate.Matcher) *UseCase {
+	return &UseCase{repo: repo, matcher: matcher}
+}
+
+// Execute returns the active transactions that are likely duplicates of t.
+func (uc *UseCase) Execute(ctx context.Context, t domaintransaction.Transaction) ([]domaintransaction.Transaction, error) {
+	startDate, endDate := uc.matcher.Window(t.Date)
+
+	txs, err := uc.repo.ListActiveBetween(ctx, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("check duplicates: %w", err)
+	}
+
+	candidates := make([]domaintransaction.Transaction, 0)
+	for _, other := range txs {
+		if uc.matcher.Matches(t, other) {
+			candidates = append(candidates, other)
+		}
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,62 @@
+package check_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate"
+	"github.com/financial-manager/api/internal/application/transaction/duplicate/check"
+	"github.com/financial-manager/api/internal/application/transaction/duplicate/check/mocks"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	match := buildTransaction("tx-1", "acc-1", 80.0, "NETFLIX Subscription", "2026-02-14")
+
+	tests := []struct {
+		name    string
+		repo    *mocks.Repository
+		want    []domaintransaction.Transaction
+		wantErr error
+	}{
+		{
+			name: "returns only the likely duplicates",
+			repo: buildMockRepo([]domaintransaction.Transaction{
+				created,
+				match,
+				buildTransaction("tx-2", "acc-2", 80.0, "Netflix subscription", "2026-02-15"),
+				buildTransaction("tx-3", "acc-1", 12.0, "Netflix subscription", "2026-02-15"),
+			}, nil),
+			want: []domaintransaction.Transaction{match},
+		},
+		{
+			name: "no candidates returns empty slice",
+			repo: buildMockRepo(nil, nil),
+			want: []domaintransaction.Transaction{},
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			repo:    buildMockRepo(nil, errors.New("db error")),
+			wantErr: fmt.Errorf("check duplicates: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := check.New(tc.repo, duplicate.NewMatcher(0, 0))
+			got, err := uc.Execute(context.Background(), created)
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
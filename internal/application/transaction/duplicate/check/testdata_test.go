@@ -0,0 +1,35 @@
+package check_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate/check/mocks"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// created is the freshly created transaction checked against existing ones.
+var created = buildTransaction("tx-new", "acc-1", 80.0, "Netflix subscription", "2026-02-15")
+
+// buildTransaction returns an active expense fixture dated on the given YYYY-MM-DD day.
+func buildTransaction(id, accountID string, amount float64, desc, date string) domaintransaction.Transaction {
+	d, _ := time.Parse("2006-01-02", date)
+	return domaintransaction.Transaction{
+		ID:          id,
+		AccountID:   accountID,
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      amount,
+		Description: desc,
+		Date:        d,
+		IsActive:    true,
+	}
+}
+
+// buildMockRepo creates a mocks.Repository pre-configured for one ListActiveBetween call
+// covering the default three-day window around created.
+func buildMockRepo(txs []domaintransaction.Transaction, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("ListActiveBetween", mock.Anything, "2026-02-12", "2026-02-18").Return(txs, err).Once()
+	return m
+}
@@ -0,0 +1,99 @@
+// Package duplicate contains the matcher shared by the duplicate detection use cases.
+package duplicate
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const (
+	// DefaultWindowDays is the maximum distance in days between two likely duplicates.
+	DefaultWindowDays = 3
+	// DefaultMinSimilarity is the minimum description similarity (0..1) for two likely duplicates.
+	DefaultMinSimilarity = 0.5
+	// amountTolerance absorbs floating point noise when comparing amounts.
+	amountTolerance = 0.005
+)
+
+// Matcher decides whether two transactions are likely duplicates of each other.
+// Two transactions match when they share account and type, have the same amount,
+// are dated within WindowDays of each other and their descriptions are similar.
+type Matcher struct {
+	WindowDays    int
+	MinSimilarity float64
+}
+
+// NewMatcher creates a Matcher, falling back to the defaults for non-positive values.
+func NewMatcher(windowDays int, minSimilarity float64) Matcher {
+	if windowDays <= 0 {
+		windowDays = DefaultWindowDays
+	}
+	if minSimilarity <= 0 {
+		minSimilarity = DefaultMinSimilarity
+	}
+	return Matcher{WindowDays: windowDays, MinSimilarity: minSimilarity}
+}
+
+// Window returns the date range [date-WindowDays, date+WindowDays] formatted as YYYY-MM-DD.
+func (m Matcher) Window(date time.Time) (string, string) {
+	return date.AddDate(0, 0, -m.WindowDays).Format("2006-01-02"),
+		date.AddDate(0, 0, m.WindowDays).Format("2006-01-02")
+}
+
+// Matches reports whether a and b are likely duplicates. A transaction never matches itself.
+func (m Matcher) Matches(a, b domaintransaction.Transaction) bool {
+	if a.ID == b.ID || a.AccountID != b.AccountID || a.Type != b.Type {
+		return false
+	}
+	if math.Abs(a.Amount-b.Amount) > amountTolerance {
+		return false
+	}
+	if daysApart(a.Date, b.Date) > m.WindowDays {
+		return false
+	}
+	return Similarity(a.Description, b.Description) >= m.MinSimilarity
+}
+
+// Similarity returns the Dice coefficient of the word sets of a and b, in the range 0..1.
+// Comparison ignores case, punctuation and accents; two empty descriptions are identical.
+func Similarity(a, b string) float64 {
+	ta, tb := tokens(a), tokens(b)
+	if len(ta) == 0 && len(tb) == 0 {
+		return 1
+	}
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	common := 0
+	for tok := range ta {
+		if _, ok := tb[tok]; ok {
+			common++
+		}
+	}
+
+	return 2 * float64(common) / float64(len(ta)+len(tb))
+}
+
+// tokens splits s into a set of lowercase, accent-free alphanumeric words.
+func tokens(s string) map[string]struct{} {
+	set := make(map[string]struct{})
+	words := strings.FieldsFunc(strings.ToLower(domainshared.FoldAccents(s)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, w := range words {
+		set[w] = struct{}{}
+	}
+	return set
+}
+
+// daysApart returns the absolute number of whole days between two dates.
+func daysApart(a, b time.Time) int {
+	d := a.Sub(b).Hours() / 24
+	return int(math.Abs(math.Round(d)))
+}
@@ -0,0 +1,104 @@
+package duplicate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestMatcher_Matches(t *testing.T) {
+	t.Parallel()
+
+	base := buildTransaction("tx-1", "acc-1", 50.0, "Supermercado Éxito", "2026-02-10")
+
+	tests := []struct {
+		name  string
+		other domaintransaction.Transaction
+		want  bool
+	}{
+		{
+			name:  "same account, amount and similar description within window matches",
+			other: buildTransaction("tx-2", "acc-1", 50.0, "SUPERMERCADO EXITO", "2026-02-12"),
+			want:  true,
+		},
+		{
+			name:  "same transaction never matches itself",
+			other: base,
+			want:  false,
+		},
+		{
+			name:  "different account does not match",
+			other: buildTransaction("tx-2", "acc-2", 50.0, "Supermercado Éxito", "2026-02-10"),
+			want:  false,
+		},
+		{
+			name:  "different amount does not match",
+			other: buildTransaction("tx-2", "acc-1", 50.5, "Supermercado Éxito", "2026-02-10"),
+			want:  false,
+		},
+		{
+			name:  "date outside window does not match",
+			other: buildTransaction("tx-2", "acc-1", 50.0, "Supermercado Éxito", "2026-02-14"),
+			want:  false,
+		},
+		{
+			name:  "unrelated description does not match",
+			other: buildTransaction("tx-2", "acc-1", 50.0, "Farmacia", "2026-02-10"),
+			want:  false,
+		},
+	}
+
+	m := duplicate.NewMatcher(3, 0.5)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, m.Matches(base, tc.other))
+		})
+	}
+}
+
+func TestNewMatcher_AppliesDefaults(t *testing.T) {
+	t.Parallel()
+
+	m := duplicate.NewMatcher(0, 0)
+
+	assert.Equal(t, duplicate.DefaultWindowDays, m.WindowDays)
+	assert.Equal(t, duplicate.DefaultMinSimilarity, m.MinSimilarity)
+}
+
+func TestMatcher_Window(t *testing.T) {
+	t.Parallel()
+
+	date, _ := time.Parse("2006-01-02", "2026-03-01")
+	start, end := duplicate.NewMatcher(2, 0.5).Window(date)
+
+	assert.Equal(t, "2026-02-27", start)
+	assert.Equal(t, "2026-03-03", end)
+}
+
+func TestSimilarity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{name: "both empty are identical", a: "", b: "", want: 1},
+		{name: "one empty is unrelated", a: "Taxi", b: "", want: 0},
+		{name: "case and accents are ignored", a: "Educación", b: "EDUCACION", want: 1},
+		{name: "punctuation is ignored", a: "Uber*Trip", b: "uber trip", want: 1},
+		{name: "partial overlap", a: "Pago tarjeta", b: "Pago arriendo", want: 0.5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.InDelta(t, tc.want, duplicate.Similarity(tc.a, tc.b), 0.001)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Package report implements the use case that lists groups of suspected duplicate transactions.
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Repository is the narrow read port required by this use case.
+type Repository interface {
+	ListActiveBetween(ctx context.Context, startDate, endDate string) ([]domaintransaction.Transaction, error)
+}
+
+// Input carries the optional date range and matching thresholds for the report.
+type Input struct {
+	StartDate     string
+	EndDate       string
+	WindowDays    int
+	MinSimilarity float64
+}
+
+// Group is a set of transactions that are likely duplicates of each other.
+// KeepID is the suggested survivor: the earliest created transaction of the group.
+type Group struct {
+	KeepID       string
+	Transactions []domaintransaction.Transaction
+}
+
+// UseCase implements the suspected duplicates report.
+type UseCase struct {
+	repo     Repository
+	defaults duplicate.Matcher
+}
+
+// New creates a new UseCase. The matcher provides the thresholds used when the
+// input does not override them.
+func New(repo Repository, defaults duplicate.Matcher) *UseCase {
+	return &UseCase{repo: repo, defaults: defaults}
+}
+
+// Execute scans the active transactions in the range and returns the duplicate groups,
+// most recent group first.
+func (uc *UseCase) Execute(ctx context.Context, in Input) ([]Group, error) {
+	matcher := uc.defaults
+	if in.WindowDays > 0 {
+		matcher.WindowDays = in.WindowDays
+	}
+	if in.MinSimilarity > 0 {
+		matcher.MinSimilarity = in.MinSimilarity
+	}
+
+	txs, err := uc.repo.ListActiveBetween(ctx, in.StartDate, in.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("duplicate report: %w", err)
+	}
+
+	return buildGroups(txs, matcher), nil
+}
+
+// buildGroups clusters transactions whose pairs match, using union-find so that
+// chains of matches (a~b, b~c) end up in a single group.
+func buildGroups(txs []domaintransaction.Transaction, m duplicate.Matcher) []Group {
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].AccountID != txs[j].AccountID {
+			return txs[i].AccountID < txs[j].AccountID
+		}
+		return txs[i].Date.Before(txs[j].Date)
+	})
+
+	parent := make([]int, len(txs))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range txs {
+		for j := i + 1; j < len(txs); j++ {
+			if txs[j].AccountID != txs[i].AccountID {
+				break
+			}
+			if txs[j].Date.Sub(txs[i].Date).Hours()/24 > float64(m.WindowDays) {
+				break
+			}
+			if m.Matches(txs[i], txs[j]) {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	members := make(map[int][]domaintransaction.Transaction)
+	for i := range txs {
+		root := find(i)
+		members[root] = append(members[root], txs[i])
+	}
+
+	groups := make([]Group, 0)
+	for _, group := range members {
+		if len(group) < 2 {
+			continue
+		}
+		groups = append(groups, Group{KeepID: earliestCreated(group), Transactions: group})
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		li, lj := latestDate(groups[i]), latestDate(groups[j])
+		if !li.Equal(lj) {
+			return li.After(lj)
+		}
+		return groups[i].KeepID < groups[j].KeepID
+	})
+
+	return groups
+}
+
+// earliestCreated returns the ID of the transaction created first within the group.
+func earliestCreated(group []domaintransaction.Transaction) string {
+	keep := group[0]
+	for _, t := range group[1:] {
+		if t.CreatedAt.Before(keep.CreatedAt) {
+			keep = t
+		}
+	}
+	return keep.ID
+}
+
+// latestDate returns the most recent transaction date of the group.
+func latestDate(g Group) (latest time.Time) {
+	for _, t := range g.Transactions {
+		if t.Date.After(latest) {
+			latest = t.Date
+		}
+	}
+	return latest
+}
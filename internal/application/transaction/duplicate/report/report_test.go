@@ -0,0 +1,77 @@
+package report_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate"
+	"github.com/financial-manager/api/internal/application/transaction/duplicate/report"
+	"github.com/financial-manager/api/internal/application/transaction/duplicate/report/mocks"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	a1 := buildTransaction("tx-a1", "acc-1", 30.0, "Uber trip", "2026-01-05")
+	a2 := buildTransaction("tx-a2", "acc-1", 30.0, "UBER TRIP", "2026-01-06")
+	a3 := buildTransaction("tx-a3", "acc-1", 30.0, "Uber trip", "2026-01-08")
+	b1 := buildTransaction("tx-b1", "acc-2", 99.9, "Gym", "2026-02-01")
+	b2 := buildTransaction("tx-b2", "acc-2", 99.9, "Gym", "2026-02-01")
+	lone := buildTransaction("tx-c1", "acc-1", 30.0, "Uber trip", "2026-03-01")
+
+	tests := []struct {
+		name    string
+		input   report.Input
+		repo    *mocks.Repository
+		want    []report.Group
+		wantErr error
+	}{
+		{
+			name:  "chains of matches form one group, most recent group first",
+			input: report.Input{},
+			repo:  buildMockRepo("", "", []domaintransaction.Transaction{lone, b2, a3, a1, b1, a2}, nil),
+			want: []report.Group{
+				{KeepID: "tx-b2", Transactions: []domaintransaction.Transaction{b2, b1}},
+				{KeepID: "tx-a1", Transactions: []domaintransaction.Transaction{a1, a2, a3}},
+			},
+		},
+		{
+			name:  "narrower window splits the chain",
+			input: report.Input{StartDate: "2026-01-01", EndDate: "2026-01-31", WindowDays: 1},
+			repo:  buildMockRepo("2026-01-01", "2026-01-31", []domaintransaction.Transaction{a1, a2, a3}, nil),
+			want: []report.Group{
+				{KeepID: "tx-a1", Transactions: []domaintransaction.Transaction{a1, a2}},
+			},
+		},
+		{
+			name:  "no duplicates returns empty slice",
+			input: report.Input{},
+			repo:  buildMockRepo("", "", []domaintransaction.Transaction{lone}, nil),
+			want:  []report.Group{},
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			input:   report.Input{},
+			repo:    buildMockRepo("", "", nil, errors.New("db error")),
+			wantErr: fmt.Errorf("duplicate report: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := report.New(tc.repo, duplicate.NewMatcher(0, 0))
+			got, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package report_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate/report/mocks"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// buildTransaction returns an active expense fixture dated and created on the given YYYY-MM-DD day.
+func buildTransaction(id, accountID string, amount float64, desc, date string) domaintransaction.Transaction {
+	d, _ := time.Parse("2006-01-02", date)
+	return domaintransaction.Transaction{
+		ID:          id,
+		AccountID:   accountID,
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      amount,
+		Description: desc,
+		Date:        d,
+		IsActive:    true,
+		CreatedAt:   d,
+		UpdatedAt:   d,
+	}
+}
+
+// buildMockRepo creates a mocks.Repository pre-configured for one ListActiveBetween call.
+func buildMockRepo(startDate, endDate string, txs []domaintransaction.Transaction, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("ListActiveBetween", mock.Anything, startDate, endDate).Return(txs, err).Once()
+	return m
+}
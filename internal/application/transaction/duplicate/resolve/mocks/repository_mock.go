@@ -0,0 +1,28 @@
+// Package mocks contains testify mock implementations for the resolve duplicates use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Repository is a testify mock for the resolve.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// GetByID mocks Repository.GetByID.
+func (m *Repository) GetByID(ctx context.Context, id string) (domaintransaction.Transaction, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domaintransaction.Transaction), args.Error(1)
+}
+
+// ApplyBulk mocks Repository.ApplyBulk.
+func (m *Repository) ApplyBulk(ctx context.Context, ops []domaintransaction.BulkOperation) (domaintransaction.BulkResult, error) {
+	args := m.Called(ctx, ops)
+	res, _ := args.Get(0).(domaintransaction.BulkResult)
+	return res, args.Error(1)
+}
@@ -0,0 +1,149 @@
+// Package resolve implements the use case that merges or deletes a group of duplicate transactions.
+package resolve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Repository is the port required by this use case. ApplyBulk applies the
+// merge and the removals in a single database transaction.
+type Repository interface {
+	GetByID(ctx context.Context, id string) (domaintransaction.Transaction, error)
+	ApplyBulk(ctx context.Context, ops []domaintransaction.BulkOperation) (domaintransaction.BulkResult, error)
+}
+
+// AccountRepository is the port that applies balance changes to accounts,
+// which live in the accounts database.
+type AccountRepository interface {
+	AdjustBalances(ctx context.Context, deltas map[string]float64) error
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
+
+// Input identifies the transaction to keep and the duplicates to remove.
+// When Merge is true, empty fields of the kept transaction are filled in from the removed ones.
+type Input struct {
+	KeepID    string
+	RemoveIDs []string
+	Merge     bool
+}
+
+// UseCase implements the duplicate resolution.
+type UseCase struct {
+	repo     Repository
+	accounts AccountRepository
+	clock    Clock
+}
+
+// New creates a new UseCase.
+func New(repo Repository, accounts AccountRepository, clock Clock) *UseCase {
+	return &UseCase{repo: repo, accounts: accounts, clock: clock}
+}
+
+// Execute soft-deletes the duplicates (reverting their balance impact) and returns the kept transaction.
+// The merge and the removals are applied together or not at all. Invalid input is reported
+// wrapping domaintransaction.ErrInvalidResolve and unknown transactions as domainshared.ErrNotFound.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (domaintransaction.Transaction, error) {
+	if err := validateInput(in); err != nil {
+		return domaintransaction.Transaction{}, err
+	}
+
+	keep, err := uc.get(ctx, in.KeepID)
+	if err != nil {
+		return domaintransaction.Transaction{}, err
+	}
+
+	removed := make([]domaintransaction.Transaction, 0, len(in.RemoveIDs))
+	for _, id := range in.RemoveIDs {
+		t, err := uc.get(ctx, id)
+		if err != nil {
+			return domaintransaction.Transaction{}, err
+		}
+		if t.AccountID != keep.AccountID || t.Type != keep.Type {
+			return domaintransaction.Transaction{}, fmt.Errorf("%w: transaction %s does not belong to the same account and type", domaintransaction.ErrInvalidResolve, id)
+		}
+		removed = append(removed, t)
+	}
+
+	now := uc.clock.Now().UTC()
+	ops := make([]domaintransaction.BulkOperation, 0, len(removed)+1)
+	if in.Merge && mergeInto(&keep, removed) {
+		keep.UpdatedAt = now
+		ops = append(ops, domaintransaction.BulkOperation{Action: domaintransaction.BulkUpdate, Transaction: keep})
+	}
+	for _, t := range removed {
+		ops = append(ops, domaintransaction.BulkOperation{
+			Action:      domaintransaction.BulkDelete,
+			Transaction: domaintransaction.Transaction{ID: t.ID, UpdatedAt: now},
+		})
+	}
+
+	res, err := uc.repo.ApplyBulk(ctx, ops)
+	if err != nil {
+		var bulkErr *domaintransaction.BulkError
+		if errors.As(err, &bulkErr) {
+			// A transaction was removed after it was read.
+			return domaintransaction.Transaction{}, domainshared.ErrNotFound
+		}
+		return domaintransaction.Transaction{}, fmt.Errorf("resolve duplicates: %w", err)
+	}
+	if err := uc.accounts.AdjustBalances(ctx, res.Balances); err != nil {
+		return domaintransaction.Transaction{}, fmt.Errorf("resolve duplicates: %w", err)
+	}
+
+	return keep, nil
+}
+
+// get returns the active transaction with the given id, or
+// domainshared.ErrNotFound when there is none.
+func (uc *UseCase) get(ctx context.Context, id string) (domaintransaction.Transaction, error) {
+	t, err := uc.repo.GetByID(ctx, id)
+	if errors.Is(err, domainshared.ErrNotFound) {
+		return domaintransaction.Transaction{}, domainshared.ErrNotFound
+	}
+	if err != nil {
+		return domaintransaction.Transaction{}, fmt.Errorf("resolve duplicates: %w", err)
+	}
+	return t, nil
+}
+
+// mergeInto copies the description and category of the removed transactions into
+// keep where keep has none, reporting whether keep changed.
+func mergeInto(keep *domaintransaction.Transaction, removed []domaintransaction.Transaction) bool {
+	changed := false
+	for _, t := range removed {
+		if keep.Description == "" && t.Description != "" {
+			keep.Description = t.Description
+			changed = true
+		}
+		if keep.CategoryID == "" && t.CategoryID != "" {
+			keep.CategoryID = t.CategoryID
+			changed = true
+		}
+	}
+	return changed
+}
+
+func validateInput(in Input) error {
+	if in.KeepID == "" {
+		return fmt.Errorf("%w: keep_id is required", domaintransaction.ErrInvalidResolve)
+	}
+	if len(in.RemoveIDs) == 0 {
+		return fmt.Errorf("%w: remove_ids must not be empty", domaintransaction.ErrInvalidResolve)
+	}
+	for _, id := range in.RemoveIDs {
+		if id == in.KeepID {
+			return fmt.Errorf("%w: keep_id cannot also be removed", domaintransaction.ErrInvalidResolve)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package resolve_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate/resolve"
+	"github.com/financial-manager/api/internal/application/transaction/duplicate/resolve/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	accountsqlite "github.com/financial-manager/api/internal/platform/account/sqlite"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	keep := buildTransaction("tx-keep", "", "")
+	dup := buildTransaction("tx-dup", "cat-1", "Pharmacy")
+	merged := keep
+	merged.CategoryID = "cat-1"
+	merged.Description = "Pharmacy"
+	merged.UpdatedAt = fixedTime()
+	otherAccount := buildTransaction("tx-other", "", "")
+	otherAccount.AccountID = "acc-2"
+	deleteDup := domaintransaction.BulkOperation{
+		Action:      domaintransaction.BulkDelete,
+		Transaction: domaintransaction.Transaction{ID: "tx-dup", UpdatedAt: fixedTime()},
+	}
+
+	tests := []struct {
+		name      string
+		input     resolve.Input
+		buildRepo func() *mocks.Repository
+		accounts  *mocks.AccountRepository
+		clock     *mocks.Clock
+		want      domaintransaction.Transaction
+		wantErr   error
+	}{
+		{
+			name:  "delete removes duplicates and keeps the survivor untouched",
+			input: resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"tx-dup"}},
+			buildRepo: func() *mocks.Repository {
+				m := buildMockRepo(keep, dup)
+				m.On("ApplyBulk", mock.Anything, []domaintransaction.BulkOperation{deleteDup}).Return(domaintransaction.BulkResult{
+					Transactions: []domaintransaction.Transaction{dup},
+					Balances:     map[string]float64{"acc-1": 45},
+				}, nil).Once()
+				return m
+			},
+			accounts: buildMockAccounts(map[string]float64{"acc-1": 45}, nil),
+			clock:    buildMockClock(),
+			want:     keep,
+		},
+		{
+			name:  "merge fills empty fields of the survivor in the same request as the deletes",
+			input: resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"tx-dup"}, Merge: true},
+			buildRepo: func() *mocks.Repository {
+				m := buildMockRepo(keep, dup)
+				m.On("ApplyBulk", mock.Anything, []domaintransaction.BulkOperation{
+					{Action: domaintransaction.BulkUpdate, Transaction: merged},
+					deleteDup,
+				}).Return(domaintransaction.BulkResult{
+					Transactions: []domaintransaction.Transaction{merged, dup},
+					Balances:     map[string]float64{"acc-1": 45},
+				}, nil).Once()
+				return m
+			},
+			accounts: buildMockAccounts(map[string]float64{"acc-1": 45}, nil),
+			clock:    buildMockClock(),
+			want:     merged,
+		},
+		{
+			name:      "missing keep_id returns validation error",
+			input:     resolve.Input{RemoveIDs: []string{"tx-dup"}},
+			buildRepo: func() *mocks.Repository { return &mocks.Repository{} },
+			clock:     &mocks.Clock{},
+			wantErr:   fmt.Errorf("%w: keep_id is required", domaintransaction.ErrInvalidResolve),
+		},
+		{
+			name:      "empty remove_ids returns validation error",
+			input:     resolve.Input{KeepID: "tx-keep"},
+			buildRepo: func() *mocks.Repository { return &mocks.Repository{} },
+			clock:     &mocks.Clock{},
+			wantErr:   fmt.Errorf("%w: remove_ids must not be empty", domaintransaction.ErrInvalidResolve),
+		},
+		{
+			name:      "keep_id inside remove_ids returns validation error",
+			input:     resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"tx-keep"}},
+			buildRepo: func() *mocks.Repository { return &mocks.Repository{} },
+			clock:     &mocks.Clock{},
+			wantErr:   fmt.Errorf("%w: keep_id cannot also be removed", domaintransaction.ErrInvalidResolve),
+		},
+		{
+			name:  "unknown duplicate returns ErrNotFound",
+			input: resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"missing"}},
+			buildRepo: func() *mocks.Repository {
+				m := buildMockRepo(keep)
+				m.On("GetByID", mock.Anything, "missing").Return(domaintransaction.Transaction{}, domainshared.ErrNotFound).Once()
+				return m
+			},
+			clock:   &mocks.Clock{},
+			wantErr: domainshared.ErrNotFound,
+		},
+		{
+			name:  "duplicate from another account is rejected",
+			input: resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"tx-other"}},
+			buildRepo: func() *mocks.Repository {
+				return buildMockRepo(keep, otherAccount)
+			},
+			clock:   &mocks.Clock{},
+			wantErr: fmt.Errorf("%w: transaction tx-other does not belong to the same account and type", domaintransaction.ErrInvalidResolve),
+		},
+		{
+			name:  "repository error on lookup is wrapped and propagated",
+			input: resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"tx-dup"}},
+			buildRepo: func() *mocks.Repository {
+				m := &mocks.Repository{}
+				m.On("GetByID", mock.Anything, "tx-keep").Return(domaintransaction.Transaction{}, errors.New("db error")).Once()
+				return m
+			},
+			clock:   &mocks.Clock{},
+			wantErr: fmt.Errorf("resolve duplicates: %w", errors.New("db error")),
+		},
+		{
+			name:  "duplicate removed after it was read returns ErrNotFound",
+			input: resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"tx-dup"}},
+			buildRepo: func() *mocks.Repository {
+				m := buildMockRepo(keep, dup)
+				m.On("ApplyBulk", mock.Anything, []domaintransaction.BulkOperation{deleteDup}).Return(nil, &domaintransaction.BulkError{
+					Items: []domaintransaction.BulkItemError{{Index: 0, Err: domaintransaction.ErrTransactionNotFound}},
+				}).Once()
+				return m
+			},
+			clock:   buildMockClock(),
+			wantErr: domainshared.ErrNotFound,
+		},
+		{
+			name:  "repository error on apply is wrapped and propagated",
+			input: resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"tx-dup"}},
+			buildRepo: func() *mocks.Repository {
+				m := buildMockRepo(keep, dup)
+				m.On("ApplyBulk", mock.Anything, []domaintransaction.BulkOperation{deleteDup}).Return(nil, errors.New("db error")).Once()
+				return m
+			},
+			clock:   buildMockClock(),
+			wantErr: fmt.Errorf("resolve duplicates: %w", errors.New("db error")),
+		},
+		{
+			name:  "balance error is wrapped and propagated",
+			input: resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"tx-dup"}},
+			buildRepo: func() *mocks.Repository {
+				m := buildMockRepo(keep, dup)
+				m.On("ApplyBulk", mock.Anything, []domaintransaction.BulkOperation{deleteDup}).Return(domaintransaction.BulkResult{
+					Transactions: []domaintransaction.Transaction{dup},
+					Balances:     map[string]float64{"acc-1": 45},
+				}, nil).Once()
+				return m
+			},
+			accounts: buildMockAccounts(map[string]float64{"acc-1": 45}, errors.New("db error")),
+			clock:    buildMockClock(),
+			wantErr:  fmt.Errorf("resolve duplicates: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := tc.buildRepo()
+			accounts := tc.accounts
+			if accounts == nil {
+				accounts = &mocks.AccountRepository{}
+			}
+			uc := resolve.New(repo, accounts, tc.clock)
+			got, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.want, got)
+			repo.AssertExpectations(t)
+			accounts.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_FromSQLite(t *testing.T) {
+	t.Parallel()
+
+	dbs := openTestDatabases(t)
+	_, err := dbs.Accounts.Exec(`INSERT INTO accounts (id, name, type, initial_balance, current_balance, currency, is_active, created_at, updated_at)
+		VALUES ('acc-1', 'Banco', 'bank', 1000, 910, 'USD', 1, '2026-01-01T00:00:00Z', '2026-01-01T00:00:00Z')`)
+	require.NoError(t, err)
+	for _, tx := range []struct{ id, description string }{{"tx-keep", ""}, {"tx-dup", "Pharmacy"}} {
+		_, err = dbs.Transactions.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at)
+			VALUES (?, 'acc-1', 'cat-1', 'expense', 45, ?, '2026-02-20', 1, '2026-02-20T00:00:00Z', '2026-02-20T00:00:00Z')`, tx.id, tx.description)
+		require.NoError(t, err)
+	}
+	_, err = dbs.Transactions.Exec(`INSERT INTO balance_snapshots (account_id, date, balance) VALUES ('acc-1', '2026-02-20', -90)`)
+	require.NoError(t, err)
+
+	repo := transactionsqlite.NewTransactionRepository(dbs.Transactions)
+	accounts := accountsqlite.NewAccountRepository(dbs.Accounts)
+	ctx := context.Background()
+
+	got, err := resolve.New(repo, accounts, buildMockClock()).Execute(ctx, resolve.Input{KeepID: "tx-keep", RemoveIDs: []string{"tx-dup"}, Merge: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Pharmacy", got.Description)
+	_, err = repo.GetByID(ctx, "tx-dup")
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+	account, err := accounts.GetByID(ctx, "acc-1")
+	require.NoError(t, err)
+	assert.InDelta(t, 955.0, account.CurrentBalance, 0.001)
+	balance, err := repo.BalanceOn(ctx, "acc-1", "2026-02-20")
+	require.NoError(t, err)
+	assert.InDelta(t, -45.0, balance, 0.001)
+}
@@ -0,0 +1,76 @@
+package resolve_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/transaction/duplicate/resolve/mocks"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	"github.com/financial-manager/api/internal/platform/database"
+	"github.com/financial-manager/api/internal/platform/database/migrator"
+	"github.com/financial-manager/api/internal/platform/database/sqlite"
+)
+
+const fixedTimestamp = "2026-02-28T10:00:00Z"
+
+// buildTransaction returns an active expense fixture on acc-1.
+func buildTransaction(id, categoryID, description string) domaintransaction.Transaction {
+	date, _ := time.Parse("2006-01-02", "2026-02-20")
+	return domaintransaction.Transaction{
+		ID:          id,
+		AccountID:   "acc-1",
+		CategoryID:  categoryID,
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      45.0,
+		Description: description,
+		Date:        date,
+		IsActive:    true,
+	}
+}
+
+// buildMockRepo creates a mocks.Repository that returns each given transaction once from GetByID.
+func buildMockRepo(txs ...domaintransaction.Transaction) *mocks.Repository {
+	m := &mocks.Repository{}
+	for _, t := range txs {
+		m.On("GetByID", mock.Anything, t.ID).Return(t, nil).Once()
+	}
+	return m
+}
+
+// buildMockAccounts creates a mocks.AccountRepository pre-configured for one AdjustBalances call.
+func buildMockAccounts(deltas map[string]float64, err error) *mocks.AccountRepository {
+	m := &mocks.AccountRepository{}
+	m.On("AdjustBalances", mock.Anything, deltas).Return(err).Once()
+	return m
+}
+
+// buildMockClock creates a mocks.Clock pre-configured to return fixedTime once.
+func buildMockClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(fixedTime()).Once()
+	return m
+}
+
+// fixedTime parses fixedTimestamp and panics on error (test helper).
+func fixedTime() time.Time {
+	t, err := time.Parse("2006-01-02T15:04:05Z", fixedTimestamp)
+	if err != nil {
+		panic(err)
+	}
+	return t.UTC()
+}
+
+// openTestDatabases opens the four migrated databases in a temporary directory.
+func openTestDatabases(t *testing.T) *database.Databases {
+	t.Helper()
+
+	dbs := database.New(sqlite.NewConnector(), migrator.New())
+	require.NoError(t, dbs.Open(context.Background(), t.TempDir()))
+	t.Cleanup(func() { _ = dbs.Close() })
+
+	return dbs
+}
@@ -0,0 +1,21 @@
+package duplicate_test
+
+import (
+	"time"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// buildTransaction returns an expense fixture dated on the given YYYY-MM-DD day.
+func buildTransaction(id, accountID string, amount float64, desc, date string) domaintransaction.Transaction {
+	d, _ := time.Parse("2006-01-02", date)
+	return domaintransaction.Transaction{
+		ID:          id,
+		AccountID:   accountID,
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      amount,
+		Description: desc,
+		Date:        d,
+		IsActive:    true,
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"time"
 
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -22,14 +24,35 @@ type Clock interface {
 	Now() time.Time
 }
 
+// DuplicateChecker finds the likely duplicates of a new transaction.
+type DuplicateChecker interface {
+	Execute(ctx context.Context, t domaintransaction.Transaction) ([]domaintransaction.Transaction, error)
+}
+
+// AnomalyScorer scores a new expense against its history and stores it when
+// unusual, reporting whether it was.
+type AnomalyScorer interface {
+	Execute(ctx context.Context, t domaintransaction.Transaction) (domaininsight.Anomaly, bool, error)
+}
+
 type UseCase struct {
-	repo  Repository
-	idGen IDGenerator
-	clock Clock
+	repo       Repository
+	idGen      IDGenerator
+	clock      Clock
+	duplicates DuplicateChecker
+	anomalies  AnomalyScorer
+}
+
+func New(repo Repository, idGen IDGenerator, clock Clock, duplicates DuplicateChecker, anomalies AnomalyScorer) *UseCase {
+	return &UseCase{repo: repo, idGen: idGen, clock: clock, duplicates: duplicates, anomalies: anomalies}
 }
 
-func New(repo Repository, idGen IDGenerator, clock Clock) *UseCase {
-	return &UseCase{repo: repo, idGen: idGen, clock: clock}
+// Output is the created expense with the likely duplicates found for it and,
+// when it was flagged as unusual, its anomaly.
+type Output struct {
+	Transaction domaintransaction.Transaction
+	Duplicates  []domaintransaction.Transaction
+	Anomaly     *domaininsight.Anomaly
 }
 
 type Input struct {
@@ -40,14 +63,18 @@ type Input struct {
 	Date        string  `json:"date"`
 }
 
-func (uc *UseCase) Execute(ctx context.Context, in Input) (domaintransaction.Transaction, error) {
+// Execute validates and stores a new expense, then looks for likely duplicates
+// of it and scores it for anomalies. The expense is already stored when the
+// checks run, so a failed check is logged and leaves its part of the Output
+// empty instead of failing the create.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
 	if err := validateInput(in); err != nil {
-		return domaintransaction.Transaction{}, err
+		return Output{}, err
 	}
 
 	date, err := time.Parse("2006-01-02", in.Date)
 	if err != nil {
-		return domaintransaction.Transaction{}, errors.New("invalid date format, use YYYY-MM-DD")
+		return Output{}, errors.New("invalid date format, use YYYY-MM-DD")
 	}
 
 	now := uc.clock.Now().UTC()
@@ -65,10 +92,23 @@ func (uc *UseCase) Execute(ctx context.Context, in Input) (domaintransaction.Tra
 	}
 
 	if err := uc.repo.Create(ctx, tx); err != nil {
-		return domaintransaction.Transaction{}, fmt.Errorf("create expense: %w", err)
+		return Output{}, fmt.Errorf("create expense: %w", err)
+	}
+
+	out := Output{Transaction: tx}
+	out.Duplicates, err = uc.duplicates.Execute(ctx, tx)
+	if err != nil {
+		log.Printf("create expense: duplicate check: %v", err)
+	}
+
+	anomaly, unusual, err := uc.anomalies.Execute(ctx, tx)
+	if err != nil {
+		log.Printf("create expense: anomaly score: %v", err)
+	} else if unusual {
+		out.Anomaly = &anomaly
 	}
 
-	return tx, nil
+	return out, nil
 }
 
 func validateInput(in Input) error {
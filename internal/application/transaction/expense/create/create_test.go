@@ -10,6 +10,7 @@ import (
 
 	"github.com/financial-manager/api/internal/application/transaction/expense/create"
 	"github.com/financial-manager/api/internal/application/transaction/expense/create/mocks"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -17,13 +18,15 @@ func TestUseCase_Execute(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name    string
-		input   create.Input
-		repo    *mocks.Repository
-		idGen   *mocks.IDGenerator
-		clock   *mocks.Clock
-		wantErr error
-		wantOut domaintransaction.Transaction
+		name       string
+		input      create.Input
+		repo       *mocks.Repository
+		idGen      *mocks.IDGenerator
+		clock      *mocks.Clock
+		duplicates *mocks.DuplicateChecker
+		anomalies  *mocks.AnomalyScorer
+		wantErr    error
+		wantOut    create.Output
 	}{
 		{
 			name: "valid input creates expense transaction",
@@ -34,58 +37,112 @@ func TestUseCase_Execute(t *testing.T) {
 				Description: "Groceries",
 				Date:        fixedDate,
 			},
-			repo:    buildMockRepo(validExpense, nil),
-			idGen:   buildMockIDGenerator(),
-			clock:   buildMockClock(),
-			wantOut: validExpense,
+			repo:       buildMockRepo(validExpense, nil),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: buildMockDuplicateChecker(validExpense, nil, nil),
+			anomalies:  buildMockAnomalyScorer(validExpense, domaininsight.Anomaly{Score: 0.2}, false, nil),
+			wantOut:    create.Output{Transaction: validExpense},
 		},
 		{
-			name:    "empty account_id returns validation error",
-			input:   create.Input{Amount: 100.0, Date: fixedDate},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("account_id is required"),
+			name:       "empty account_id returns validation error",
+			input:      create.Input{Amount: 100.0, Date: fixedDate},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			anomalies:  &mocks.AnomalyScorer{},
+			wantErr:    errors.New("account_id is required"),
 		},
 		{
-			name:    "zero amount returns validation error",
-			input:   create.Input{AccountID: "acc-001", Amount: 0, Date: fixedDate},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("amount must be positive"),
+			name:       "zero amount returns validation error",
+			input:      create.Input{AccountID: "acc-001", Amount: 0, Date: fixedDate},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			anomalies:  &mocks.AnomalyScorer{},
+			wantErr:    errors.New("amount must be positive"),
 		},
 		{
-			name:    "negative amount returns validation error",
-			input:   create.Input{AccountID: "acc-001", Amount: -100, Date: fixedDate},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("amount must be positive"),
+			name:       "negative amount returns validation error",
+			input:      create.Input{AccountID: "acc-001", Amount: -100, Date: fixedDate},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			anomalies:  &mocks.AnomalyScorer{},
+			wantErr:    errors.New("amount must be positive"),
 		},
 		{
-			name:    "empty date returns validation error",
-			input:   create.Input{AccountID: "acc-001", Amount: 100.0},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("date is required"),
+			name:       "empty date returns validation error",
+			input:      create.Input{AccountID: "acc-001", Amount: 100.0},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			anomalies:  &mocks.AnomalyScorer{},
+			wantErr:    errors.New("date is required"),
 		},
 		{
-			name:    "invalid date format returns validation error",
-			input:   create.Input{AccountID: "acc-001", Amount: 100.0, Date: "invalid-date"},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("invalid date format, use YYYY-MM-DD"),
+			name:       "invalid date format returns validation error",
+			input:      create.Input{AccountID: "acc-001", Amount: 100.0, Date: "invalid-date"},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			anomalies:  &mocks.AnomalyScorer{},
+			wantErr:    errors.New("invalid date format, use YYYY-MM-DD"),
 		},
 		{
-			name:    "repository error is wrapped and propagated",
-			input:   create.Input{AccountID: "acc-001", Amount: 100.0, Date: fixedDate},
-			repo:    buildMockRepo(errorExpense, errors.New("db unavailable")),
-			idGen:   buildMockIDGenerator(),
-			clock:   buildMockClock(),
-			wantErr: fmt.Errorf("create expense: %w", errors.New("db unavailable")),
+			name:       "likely duplicates are returned with the expense",
+			input:      create.Input{AccountID: "acc-001", CategoryID: "cat-001", Amount: 100.0, Description: "Groceries", Date: fixedDate},
+			repo:       buildMockRepo(validExpense, nil),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: buildMockDuplicateChecker(validExpense, []domaintransaction.Transaction{duplicateExpense}, nil),
+			anomalies:  buildMockAnomalyScorer(validExpense, domaininsight.Anomaly{}, false, nil),
+			wantOut:    create.Output{Transaction: validExpense, Duplicates: []domaintransaction.Transaction{duplicateExpense}},
+		},
+		{
+			name:       "failed duplicate check still creates the expense",
+			input:      create.Input{AccountID: "acc-001", CategoryID: "cat-001", Amount: 100.0, Description: "Groceries", Date: fixedDate},
+			repo:       buildMockRepo(validExpense, nil),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: buildMockDuplicateChecker(validExpense, nil, errors.New("db unavailable")),
+			anomalies:  buildMockAnomalyScorer(validExpense, domaininsight.Anomaly{}, false, nil),
+			wantOut:    create.Output{Transaction: validExpense},
+		},
+		{
+			name:       "unusual expense is returned with its anomaly",
+			input:      create.Input{AccountID: "acc-001", CategoryID: "cat-001", Amount: 100.0, Description: "Groceries", Date: fixedDate},
+			repo:       buildMockRepo(validExpense, nil),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: buildMockDuplicateChecker(validExpense, nil, nil),
+			anomalies:  buildMockAnomalyScorer(validExpense, unusualExpense, true, nil),
+			wantOut:    create.Output{Transaction: validExpense, Anomaly: &unusualExpense},
+		},
+		{
+			name:       "failed anomaly score still creates the expense",
+			input:      create.Input{AccountID: "acc-001", CategoryID: "cat-001", Amount: 100.0, Description: "Groceries", Date: fixedDate},
+			repo:       buildMockRepo(validExpense, nil),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: buildMockDuplicateChecker(validExpense, nil, nil),
+			anomalies:  buildMockAnomalyScorer(validExpense, domaininsight.Anomaly{}, false, errors.New("db unavailable")),
+			wantOut:    create.Output{Transaction: validExpense},
+		},
+		{
+			name:       "repository error is wrapped and propagated",
+			input:      create.Input{AccountID: "acc-001", Amount: 100.0, Date: fixedDate},
+			repo:       buildMockRepo(errorExpense, errors.New("db unavailable")),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: &mocks.DuplicateChecker{},
+			anomalies:  &mocks.AnomalyScorer{},
+			wantErr:    fmt.Errorf("create expense: %w", errors.New("db unavailable")),
 		},
 	}
 
@@ -93,7 +150,7 @@ func TestUseCase_Execute(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			uc := create.New(tc.repo, tc.idGen, tc.clock)
+			uc := create.New(tc.repo, tc.idGen, tc.clock, tc.duplicates, tc.anomalies)
 			out, err := uc.Execute(context.Background(), tc.input)
 
 			assert.Equal(t, tc.wantErr, err)
@@ -101,6 +158,8 @@ func TestUseCase_Execute(t *testing.T) {
 			tc.repo.AssertExpectations(t)
 			tc.idGen.AssertExpectations(t)
 			tc.clock.AssertExpectations(t)
+			tc.duplicates.AssertExpectations(t)
+			tc.anomalies.AssertExpectations(t)
 		})
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/financial-manager/api/internal/application/transaction/expense/create/mocks"
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -41,6 +42,46 @@ var errorExpense = domaintransaction.Transaction{
 	UpdatedAt: fixedTime(),
 }
 
+// duplicateExpense is an existing expense that looks like validExpense.
+var duplicateExpense = domaintransaction.Transaction{
+	ID:          "tx-000",
+	AccountID:   "acc-001",
+	CategoryID:  "cat-001",
+	Type:        domaintransaction.TransactionTypeExpense,
+	Amount:      100.0,
+	Description: validExpense.Description,
+	Date:        fixedDateOnly(),
+	IsActive:    true,
+	CreatedAt:   fixedTime(),
+	UpdatedAt:   fixedTime(),
+}
+
+// buildMockDuplicateChecker creates a mocks.DuplicateChecker expecting one check of t.
+func buildMockDuplicateChecker(t domaintransaction.Transaction, candidates []domaintransaction.Transaction, err error) *mocks.DuplicateChecker {
+	m := &mocks.DuplicateChecker{}
+	m.On("Execute", mock.Anything, t).Return(candidates, err).Once()
+	return m
+}
+
+// unusualExpense is the anomaly flagged for validExpense.
+var unusualExpense = domaininsight.Anomaly{
+	TransactionID: fixedID,
+	AccountID:     "acc-001",
+	CategoryID:    "cat-001",
+	Amount:        100.0,
+	Description:   "Groceries",
+	Date:          fixedDateOnly(),
+	Score:         1,
+	Reasons:       []domaininsight.Reason{{Code: domaininsight.ReasonNewPayee, Message: "first expense for this payee"}},
+}
+
+// buildMockAnomalyScorer creates a mocks.AnomalyScorer expecting one score of t.
+func buildMockAnomalyScorer(t domaintransaction.Transaction, a domaininsight.Anomaly, unusual bool, err error) *mocks.AnomalyScorer {
+	m := &mocks.AnomalyScorer{}
+	m.On("Execute", mock.Anything, t).Return(a, unusual, err).Once()
+	return m
+}
+
 // buildMockRepo creates a mocks.Repository pre-configured to accept one Create call.
 func buildMockRepo(t domaintransaction.Transaction, err error) *mocks.Repository {
 	m := &mocks.Repository{}
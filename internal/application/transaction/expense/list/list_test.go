@@ -10,6 +10,7 @@ import (
 
 	"github.com/financial-manager/api/internal/application/transaction/expense/list"
 	"github.com/financial-manager/api/internal/application/transaction/expense/list/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -21,25 +22,40 @@ func TestUseCase_Execute(t *testing.T) {
 		repo    *mocks.Repository
 		input   list.Input
 		wantErr error
-		wantOut []domaintransaction.Transaction
+		wantOut list.Output
 	}{
 		{
 			name:    "empty repository returns nil without error",
 			repo:    buildMockRepo(nil, nil),
 			input:   list.Input{},
-			wantOut: nil,
+			wantOut: list.Output{},
 		},
 		{
 			name:    "returns expense transactions from repository",
 			repo:    buildMockRepo([]domaintransaction.Transaction{expense1, expense2}, nil),
 			input:   list.Input{},
-			wantOut: []domaintransaction.Transaction{expense1, expense2},
+			wantOut: list.Output{Transactions: []domaintransaction.Transaction{expense1, expense2}},
 		},
 		{
 			name:    "returns filtered expense transactions",
 			repo:    buildMockRepo([]domaintransaction.Transaction{expense1}, nil),
 			input:   list.Input{AccountID: "acc-001"},
-			wantOut: []domaintransaction.Transaction{expense1},
+			wantOut: list.Output{Transactions: []domaintransaction.Transaction{expense1}},
+		},
+		{
+			name: "requested page is passed on with the next cursor",
+			repo: buildMockRepoPage(
+				domainshared.PageQuery{Limit: 1, Cursor: "abc", Sort: domaintransaction.SortAmount, Direction: domainshared.SortAsc},
+				[]domaintransaction.Transaction{expense1}, "def", nil,
+			),
+			input:   list.Input{Page: domainshared.PageQuery{Limit: 1, Cursor: "abc", Sort: domaintransaction.SortAmount, Direction: domainshared.SortAsc}},
+			wantOut: list.Output{Transactions: []domaintransaction.Transaction{expense1}, NextCursor: "def"},
+		},
+		{
+			name:    "limit above the maximum is capped",
+			repo:    buildMockRepoPage(domainshared.PageQuery{Limit: domainshared.MaxPageLimit, Sort: domaintransaction.SortDate, Direction: domainshared.SortDesc}, nil, "", nil),
+			input:   list.Input{Page: domainshared.PageQuery{Limit: 10000}},
+			wantOut: list.Output{},
 		},
 		{
 			name:    "repository error is propagated",
@@ -62,3 +78,30 @@ func TestUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCase_Execute_InvalidPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		page domainshared.PageQuery
+	}{
+		{name: "unknown sort", page: domainshared.PageQuery{Sort: "description"}},
+		{name: "unknown direction", page: domainshared.PageQuery{Direction: "up"}},
+		{name: "negative limit", page: domainshared.PageQuery{Limit: -1}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.Repository{}
+			uc := list.New(repo)
+			out, err := uc.Execute(context.Background(), list.Input{Page: tc.page})
+
+			assert.ErrorIs(t, err, domainshared.ErrInvalidPage)
+			assert.Empty(t, out)
+			repo.AssertNotCalled(t, "ListByType")
+		})
+	}
+}
@@ -6,16 +6,30 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/financial-manager/api/internal/application/transaction/expense/list/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
 const fixedDate = "2026-02-28"
 
+// defaultPage is the page the use case asks for when the input sets none.
+var defaultPage = domainshared.PageQuery{
+	Limit:     domainshared.DefaultPageLimit,
+	Sort:      domaintransaction.SortDate,
+	Direction: domainshared.SortDesc,
+}
+
 // buildMockRepo creates a mocks.Repository pre-configured to return the given
-// transactions and error for one ListByType call.
+// transactions and error for one ListByType call with the default page.
 func buildMockRepo(txs []domaintransaction.Transaction, err error) *mocks.Repository {
+	return buildMockRepoPage(defaultPage, txs, "", err)
+}
+
+// buildMockRepoPage creates a mocks.Repository pre-configured to return the
+// given transactions, next cursor and error for one ListByType call with page.
+func buildMockRepoPage(page domainshared.PageQuery, txs []domaintransaction.Transaction, next string, err error) *mocks.Repository {
 	m := &mocks.Repository{}
-	m.On("ListByType", mock.Anything, domaintransaction.TransactionTypeExpense, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(txs, err).Once()
+	m.On("ListByType", mock.Anything, domaintransaction.TransactionTypeExpense, mock.Anything, mock.Anything, mock.Anything, mock.Anything, page).Return(txs, next, err).Once()
 	return m
 }
 
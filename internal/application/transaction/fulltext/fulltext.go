@@ -0,0 +1,67 @@
+// Package fulltext implements the full-text transaction search use case.
+package fulltext
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const (
+	// DefaultLimit is the number of hits returned when the input sets none.
+	DefaultLimit = 20
+	// MaxLimit caps the number of hits of one search.
+	MaxLimit = 100
+)
+
+// Repository is the port for the full-text index over transaction descriptions.
+type Repository interface {
+	FullTextSearch(ctx context.Context, query string, limit int) ([]domaintransaction.SearchHit, error)
+}
+
+// UseCase implements the full-text transaction search use case.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Input is the text searched for in transaction descriptions and the maximum
+// number of hits, DefaultLimit when zero and capped at MaxLimit.
+type Input struct {
+	Query string `json:"query"`
+	Limit int    `json:"limit"`
+}
+
+// Execute returns the active transactions whose description matches every
+// word of the query, as a prefix and ignoring case and accents, most relevant
+// first. A blank query or a negative limit returns an error wrapping
+// domaintransaction.ErrInvalidSearch.
+func (uc *UseCase) Execute(ctx context.Context, in Input) ([]domaintransaction.SearchHit, error) {
+	query := strings.TrimSpace(in.Query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: query is required", domaintransaction.ErrInvalidSearch)
+	}
+
+	limit := in.Limit
+	switch {
+	case limit < 0:
+		return nil, fmt.Errorf("%w: limit cannot be negative", domaintransaction.ErrInvalidSearch)
+	case limit == 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	hits, err := uc.repo.FullTextSearch(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("full text search: %w", err)
+	}
+
+	return hits, nil
+}
@@ -0,0 +1,88 @@
+package fulltext_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/transaction/fulltext"
+	"github.com/financial-manager/api/internal/application/transaction/fulltext/mocks"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		repo    *mocks.Repository
+		input   fulltext.Input
+		wantErr error
+		wantOut []domaintransaction.SearchHit
+	}{
+		{
+			name:    "default limit and trimmed query",
+			repo:    buildMockRepo("farmacia", fulltext.DefaultLimit, []domaintransaction.SearchHit{pharmacyHit}, nil),
+			input:   fulltext.Input{Query: "  farmacia "},
+			wantOut: []domaintransaction.SearchHit{pharmacyHit},
+		},
+		{
+			name:    "limit is passed on",
+			repo:    buildMockRepo("farm", 5, []domaintransaction.SearchHit{}, nil),
+			input:   fulltext.Input{Query: "farm", Limit: 5},
+			wantOut: []domaintransaction.SearchHit{},
+		},
+		{
+			name:    "limit is capped",
+			repo:    buildMockRepo("farm", fulltext.MaxLimit, nil, nil),
+			input:   fulltext.Input{Query: "farm", Limit: 1000},
+			wantOut: nil,
+		},
+		{
+			name:    "repository error is wrapped",
+			repo:    buildMockRepo("farm", fulltext.DefaultLimit, nil, errors.New("db error")),
+			input:   fulltext.Input{Query: "farm"},
+			wantErr: fmt.Errorf("full text search: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := fulltext.New(tc.repo)
+			out, err := uc.Execute(context.Background(), tc.input)
+
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantOut, out)
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input fulltext.Input
+	}{
+		{name: "blank query", input: fulltext.Input{Query: "   "}},
+		{name: "negative limit", input: fulltext.Input{Query: "farm", Limit: -1}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.Repository{}
+			_, err := fulltext.New(repo).Execute(context.Background(), tc.input)
+
+			assert.ErrorIs(t, err, domaintransaction.ErrInvalidSearch)
+			repo.AssertNotCalled(t, "FullTextSearch")
+		})
+	}
+}
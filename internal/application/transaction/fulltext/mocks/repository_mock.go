@@ -0,0 +1,22 @@
+// Package mocks contains testify mock implementations for the fulltext use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Repository is a testify mock for the fulltext.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// FullTextSearch mocks Repository.FullTextSearch.
+func (m *Repository) FullTextSearch(ctx context.Context, query string, limit int) ([]domaintransaction.SearchHit, error) {
+	args := m.Called(ctx, query, limit)
+	hits, _ := args.Get(0).([]domaintransaction.SearchHit)
+	return hits, args.Error(1)
+}
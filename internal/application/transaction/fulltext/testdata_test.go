@@ -0,0 +1,31 @@
+package fulltext_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/transaction/fulltext/mocks"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// pharmacyHit is a canonical search hit fixture.
+var pharmacyHit = domaintransaction.SearchHit{
+	Transaction: domaintransaction.Transaction{
+		ID:          "tx-1",
+		AccountID:   "acc-001",
+		CategoryID:  "cat-001",
+		Type:        domaintransaction.TransactionTypeExpense,
+		Amount:      12.5,
+		Description: "Farmacia del Centro",
+		IsActive:    true,
+	},
+	Snippet: "<mark>Farmacia</mark> del Centro",
+	Rank:    -1.2,
+}
+
+// buildMockRepo creates a mocks.Repository pre-configured to return hits and
+// err for one FullTextSearch call with query and limit.
+func buildMockRepo(query string, limit int, hits []domaintransaction.SearchHit, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("FullTextSearch", mock.Anything, query, limit).Return(hits, err).Once()
+	return m
+}
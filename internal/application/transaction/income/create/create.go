@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"time"
 
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
@@ -22,14 +23,26 @@ type Clock interface {
 	Now() time.Time
 }
 
+// DuplicateChecker finds the likely duplicates of a new transaction.
+type DuplicateChecker interface {
+	Execute(ctx context.Context, t domaintransaction.Transaction) ([]domaintransaction.Transaction, error)
+}
+
 type UseCase struct {
-	repo  Repository
-	idGen IDGenerator
-	clock Clock
+	repo       Repository
+	idGen      IDGenerator
+	clock      Clock
+	duplicates DuplicateChecker
+}
+
+func New(repo Repository, idGen IDGenerator, clock Clock, duplicates DuplicateChecker) *UseCase {
+	return &UseCase{repo: repo, idGen: idGen, clock: clock, duplicates: duplicates}
 }
 
-func New(repo Repository, idGen IDGenerator, clock Clock) *UseCase {
-	return &UseCase{repo: repo, idGen: idGen, clock: clock}
+// Output is the created income with the likely duplicates found for it.
+type Output struct {
+	Transaction domaintransaction.Transaction
+	Duplicates  []domaintransaction.Transaction
 }
 
 type Input struct {
@@ -40,14 +53,17 @@ type Input struct {
 	Date        string  `json:"date"`
 }
 
-func (uc *UseCase) Execute(ctx context.Context, in Input) (domaintransaction.Transaction, error) {
+// Execute validates and stores a new income, then looks for likely duplicates
+// of it. The income is already stored when the check runs, so a failed check
+// is logged and leaves Duplicates empty instead of failing the create.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
 	if err := validateInput(in); err != nil {
-		return domaintransaction.Transaction{}, err
+		return Output{}, err
 	}
 
 	date, err := time.Parse("2006-01-02", in.Date)
 	if err != nil {
-		return domaintransaction.Transaction{}, errors.New("invalid date format, use YYYY-MM-DD")
+		return Output{}, errors.New("invalid date format, use YYYY-MM-DD")
 	}
 
 	now := uc.clock.Now().UTC()
@@ -65,10 +81,16 @@ func (uc *UseCase) Execute(ctx context.Context, in Input) (domaintransaction.Tra
 	}
 
 	if err := uc.repo.Create(ctx, tx); err != nil {
-		return domaintransaction.Transaction{}, fmt.Errorf("create income: %w", err)
+		return Output{}, fmt.Errorf("create income: %w", err)
+	}
+
+	out := Output{Transaction: tx}
+	out.Duplicates, err = uc.duplicates.Execute(ctx, tx)
+	if err != nil {
+		log.Printf("create income: duplicate check: %v", err)
 	}
 
-	return tx, nil
+	return out, nil
 }
 
 func validateInput(in Input) error {
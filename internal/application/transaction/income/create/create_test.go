@@ -17,13 +17,14 @@ func TestUseCase_Execute(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name    string
-		input   create.Input
-		repo    *mocks.Repository
-		idGen   *mocks.IDGenerator
-		clock   *mocks.Clock
-		wantErr error
-		wantOut domaintransaction.Transaction
+		name       string
+		input      create.Input
+		repo       *mocks.Repository
+		idGen      *mocks.IDGenerator
+		clock      *mocks.Clock
+		duplicates *mocks.DuplicateChecker
+		wantErr    error
+		wantOut    create.Output
 	}{
 		{
 			name: "valid input creates income transaction",
@@ -34,58 +35,83 @@ func TestUseCase_Execute(t *testing.T) {
 				Description: "Salary",
 				Date:        fixedDate,
 			},
-			repo:    buildMockRepo(validIncome, nil),
-			idGen:   buildMockIDGenerator(),
-			clock:   buildMockClock(),
-			wantOut: validIncome,
+			repo:       buildMockRepo(validIncome, nil),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: buildMockDuplicateChecker(validIncome, nil, nil),
+			wantOut:    create.Output{Transaction: validIncome},
 		},
 		{
-			name:    "empty account_id returns validation error",
-			input:   create.Input{Amount: 100.0, Date: fixedDate},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("account_id is required"),
+			name:       "empty account_id returns validation error",
+			input:      create.Input{Amount: 100.0, Date: fixedDate},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			wantErr:    errors.New("account_id is required"),
 		},
 		{
-			name:    "zero amount returns validation error",
-			input:   create.Input{AccountID: "acc-001", Amount: 0, Date: fixedDate},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("amount must be positive"),
+			name:       "zero amount returns validation error",
+			input:      create.Input{AccountID: "acc-001", Amount: 0, Date: fixedDate},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			wantErr:    errors.New("amount must be positive"),
 		},
 		{
-			name:    "negative amount returns validation error",
-			input:   create.Input{AccountID: "acc-001", Amount: -100, Date: fixedDate},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("amount must be positive"),
+			name:       "negative amount returns validation error",
+			input:      create.Input{AccountID: "acc-001", Amount: -100, Date: fixedDate},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			wantErr:    errors.New("amount must be positive"),
 		},
 		{
-			name:    "empty date returns validation error",
-			input:   create.Input{AccountID: "acc-001", Amount: 100.0},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("date is required"),
+			name:       "empty date returns validation error",
+			input:      create.Input{AccountID: "acc-001", Amount: 100.0},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			wantErr:    errors.New("date is required"),
 		},
 		{
-			name:    "invalid date format returns validation error",
-			input:   create.Input{AccountID: "acc-001", Amount: 100.0, Date: "invalid-date"},
-			repo:    &mocks.Repository{},
-			idGen:   &mocks.IDGenerator{},
-			clock:   &mocks.Clock{},
-			wantErr: errors.New("invalid date format, use YYYY-MM-DD"),
+			name:       "invalid date format returns validation error",
+			input:      create.Input{AccountID: "acc-001", Amount: 100.0, Date: "invalid-date"},
+			repo:       &mocks.Repository{},
+			idGen:      &mocks.IDGenerator{},
+			clock:      &mocks.Clock{},
+			duplicates: &mocks.DuplicateChecker{},
+			wantErr:    errors.New("invalid date format, use YYYY-MM-DD"),
 		},
 		{
-			name:    "repository error is wrapped and propagated",
-			input:   create.Input{AccountID: "acc-001", Amount: 100.0, Date: fixedDate},
-			repo:    buildMockRepo(errorIncome, errors.New("db unavailable")),
-			idGen:   buildMockIDGenerator(),
-			clock:   buildMockClock(),
-			wantErr: fmt.Errorf("create income: %w", errors.New("db unavailable")),
+			name:       "likely duplicates are returned with the income",
+			input:      create.Input{AccountID: "acc-001", CategoryID: "cat-001", Amount: 1000.0, Description: "Salary", Date: fixedDate},
+			repo:       buildMockRepo(validIncome, nil),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: buildMockDuplicateChecker(validIncome, []domaintransaction.Transaction{duplicateIncome}, nil),
+			wantOut:    create.Output{Transaction: validIncome, Duplicates: []domaintransaction.Transaction{duplicateIncome}},
+		},
+		{
+			name:       "failed duplicate check still creates the income",
+			input:      create.Input{AccountID: "acc-001", CategoryID: "cat-001", Amount: 1000.0, Description: "Salary", Date: fixedDate},
+			repo:       buildMockRepo(validIncome, nil),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: buildMockDuplicateChecker(validIncome, nil, errors.New("db unavailable")),
+			wantOut:    create.Output{Transaction: validIncome},
+		},
+		{
+			name:       "repository error is wrapped and propagated",
+			input:      create.Input{AccountID: "acc-001", Amount: 100.0, Date: fixedDate},
+			repo:       buildMockRepo(errorIncome, errors.New("db unavailable")),
+			idGen:      buildMockIDGenerator(),
+			clock:      buildMockClock(),
+			duplicates: &mocks.DuplicateChecker{},
+			wantErr:    fmt.Errorf("create income: %w", errors.New("db unavailable")),
 		},
 	}
 
@@ -93,7 +119,7 @@ func TestUseCase_Execute(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			uc := create.New(tc.repo, tc.idGen, tc.clock)
+			uc := create.New(tc.repo, tc.idGen, tc.clock, tc.duplicates)
 			out, err := uc.Execute(context.Background(), tc.input)
 
 			assert.Equal(t, tc.wantErr, err)
@@ -101,6 +127,7 @@ func TestUseCase_Execute(t *testing.T) {
 			tc.repo.AssertExpectations(t)
 			tc.idGen.AssertExpectations(t)
 			tc.clock.AssertExpectations(t)
+			tc.duplicates.AssertExpectations(t)
 		})
 	}
 }
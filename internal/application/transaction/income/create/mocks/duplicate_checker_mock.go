@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// DuplicateChecker is a testify mock for the create.DuplicateChecker interface.
+type DuplicateChecker struct {
+	mock.Mock
+}
+
+// Execute mocks DuplicateChecker.Execute.
+func (m *DuplicateChecker) Execute(ctx context.Context, t domaintransaction.Transaction) ([]domaintransaction.Transaction, error) {
+	args := m.Called(ctx, t)
+	candidates, _ := args.Get(0).([]domaintransaction.Transaction)
+	return candidates, args.Error(1)
+}
@@ -41,6 +41,27 @@ var errorIncome = domaintransaction.Transaction{
 	UpdatedAt: fixedTime(),
 }
 
+// duplicateIncome is an existing income that looks like validIncome.
+var duplicateIncome = domaintransaction.Transaction{
+	ID:          "tx-000",
+	AccountID:   "acc-001",
+	CategoryID:  "cat-001",
+	Type:        domaintransaction.TransactionTypeIncome,
+	Amount:      1000.0,
+	Description: validIncome.Description,
+	Date:        fixedDateOnly(),
+	IsActive:    true,
+	CreatedAt:   fixedTime(),
+	UpdatedAt:   fixedTime(),
+}
+
+// buildMockDuplicateChecker creates a mocks.DuplicateChecker expecting one check of t.
+func buildMockDuplicateChecker(t domaintransaction.Transaction, candidates []domaintransaction.Transaction, err error) *mocks.DuplicateChecker {
+	m := &mocks.DuplicateChecker{}
+	m.On("Execute", mock.Anything, t).Return(candidates, err).Once()
+	return m
+}
+
 // buildMockRepo creates a mocks.Repository pre-configured to accept one Create call.
 func buildMockRepo(t domaintransaction.Transaction, err error) *mocks.Repository {
 	m := &mocks.Repository{}
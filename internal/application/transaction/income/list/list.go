@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -14,10 +15,19 @@ type Input struct {
 	CategoryID string `json:"category_id"`
 	StartDate  string `json:"start_date"`
 	EndDate    string `json:"end_date"`
+	// Page selects the page; it is sorted by date, newest first, by default.
+	Page domainshared.PageQuery `json:"page"`
+}
+
+// Output is one page of incomes and the cursor of the next one, empty on the
+// last page.
+type Output struct {
+	Transactions []domaintransaction.Transaction
+	NextCursor   string
 }
 
 type Repository interface {
-	ListByType(ctx context.Context, tType domaintransaction.TransactionType, accountID, categoryID string, startDate, endDate string) ([]domaintransaction.Transaction, error)
+	ListByType(ctx context.Context, tType domaintransaction.TransactionType, accountID, categoryID string, startDate, endDate string, page domainshared.PageQuery) ([]domaintransaction.Transaction, string, error)
 }
 
 type UseCase struct {
@@ -28,11 +38,18 @@ func New(repo Repository) *UseCase {
 	return &UseCase{repo: repo}
 }
 
-func (uc *UseCase) Execute(ctx context.Context, in Input) ([]domaintransaction.Transaction, error) {
-	txs, err := uc.repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, in.AccountID, in.CategoryID, in.StartDate, in.EndDate)
+// Execute returns the page of incomes selected by in. An invalid page
+// returns an error wrapping domainshared.ErrInvalidPage.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
+	page, err := in.Page.Normalize(domaintransaction.SortDate, domainshared.SortDesc, domaintransaction.SortFields()...)
+	if err != nil {
+		return Output{}, fmt.Errorf("list incomes: %w", err)
+	}
+
+	txs, next, err := uc.repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, in.AccountID, in.CategoryID, in.StartDate, in.EndDate, page)
 	if err != nil {
-		return nil, fmt.Errorf("list incomes: %w", err)
+		return Output{}, fmt.Errorf("list incomes: %w", err)
 	}
 
-	return txs, nil
+	return Output{Transactions: txs, NextCursor: next}, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
@@ -15,7 +16,7 @@ type Repository struct {
 }
 
 // ListByType mocks Repository.ListByType.
-func (m *Repository) ListByType(ctx context.Context, tType domaintransaction.TransactionType, accountID, categoryID string, startDate, endDate string) ([]domaintransaction.Transaction, error) {
-	args := m.Called(ctx, tType, accountID, categoryID, startDate, endDate)
-	return args.Get(0).([]domaintransaction.Transaction), args.Error(1)
+func (m *Repository) ListByType(ctx context.Context, tType domaintransaction.TransactionType, accountID, categoryID string, startDate, endDate string, page domainshared.PageQuery) ([]domaintransaction.Transaction, string, error) {
+	args := m.Called(ctx, tType, accountID, categoryID, startDate, endDate, page)
+	return args.Get(0).([]domaintransaction.Transaction), args.String(1), args.Error(2)
 }
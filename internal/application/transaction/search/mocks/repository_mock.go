@@ -0,0 +1,30 @@
+// Package mocks contains testify mock implementations for the search use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Repository is a testify mock for the search.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// Search mocks Repository.Search.
+func (m *Repository) Search(ctx context.Context, f domaintransaction.SearchFilter, page domainshared.PageQuery) ([]domaintransaction.Transaction, string, error) {
+	args := m.Called(ctx, f, page)
+	txs, _ := args.Get(0).([]domaintransaction.Transaction)
+	return txs, args.String(1), args.Error(2)
+}
+
+// SearchTotals mocks Repository.SearchTotals.
+func (m *Repository) SearchTotals(ctx context.Context, f domaintransaction.SearchFilter) ([]domaintransaction.Total, error) {
+	args := m.Called(ctx, f)
+	totals, _ := args.Get(0).([]domaintransaction.Total)
+	return totals, args.Error(1)
+}
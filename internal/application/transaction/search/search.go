@@ -0,0 +1,166 @@
+// Package search implements the search transactions use case.
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const dateLayout = "2006-01-02"
+
+// Repository is the port for the searched transactions and their totals.
+type Repository interface {
+	Search(ctx context.Context, f domaintransaction.SearchFilter, page domainshared.PageQuery) ([]domaintransaction.Transaction, string, error)
+	SearchTotals(ctx context.Context, f domaintransaction.SearchFilter) ([]domaintransaction.Total, error)
+}
+
+// UseCase implements the search transactions use case.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Input filters the searched transactions. Every filter is optional and they
+// combine; values within a list are alternatives. Dates are YYYY-MM-DD.
+type Input struct {
+	Types          []string `json:"types"`
+	AccountIDs     []string `json:"account_ids"`
+	CategoryIDs    []string `json:"category_ids"`
+	MinAmount      *float64 `json:"min_amount"`
+	MaxAmount      *float64 `json:"max_amount"`
+	Query          string   `json:"query"`
+	StartDate      string   `json:"start_date"`
+	EndDate        string   `json:"end_date"`
+	IncludeDeleted bool     `json:"include_deleted"`
+	// Page selects the page; it is sorted by date, newest first, by default.
+	Page domainshared.PageQuery `json:"page"`
+}
+
+// Totals sums every transaction matching the search, not only the returned
+// page.
+type Totals struct {
+	Income  float64
+	Expense float64
+	Net     float64
+	Count   int
+}
+
+// Output is one page of matching transactions, the cursor of the next one,
+// empty on the last page, and the totals of the whole result.
+type Output struct {
+	Transactions []domaintransaction.Transaction
+	NextCursor   string
+	Totals       Totals
+}
+
+// Execute returns the page of transactions matching in with the totals of all
+// of them. Invalid filters return an error wrapping
+// domaintransaction.ErrInvalidSearch and an invalid page one wrapping
+// domainshared.ErrInvalidPage.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (Output, error) {
+	filter, err := toFilter(in)
+	if err != nil {
+		return Output{}, fmt.Errorf("search transactions: %w", err)
+	}
+
+	page, err := in.Page.Normalize(domaintransaction.SortDate, domainshared.SortDesc, domaintransaction.SortFields()...)
+	if err != nil {
+		return Output{}, fmt.Errorf("search transactions: %w", err)
+	}
+
+	txs, next, err := uc.repo.Search(ctx, filter, page)
+	if err != nil {
+		return Output{}, fmt.Errorf("search transactions: %w", err)
+	}
+
+	totals, err := uc.repo.SearchTotals(ctx, filter)
+	if err != nil {
+		return Output{}, fmt.Errorf("search transactions: totals: %w", err)
+	}
+
+	return Output{Transactions: txs, NextCursor: next, Totals: sumTotals(totals)}, nil
+}
+
+// Totals returns the totals of every transaction matching in, without
+// reading any page of them; the page of in is ignored. Invalid filters return
+// an error wrapping domaintransaction.ErrInvalidSearch.
+func (uc *UseCase) Totals(ctx context.Context, in Input) (Totals, error) {
+	filter, err := toFilter(in)
+	if err != nil {
+		return Totals{}, fmt.Errorf("search totals: %w", err)
+	}
+
+	totals, err := uc.repo.SearchTotals(ctx, filter)
+	if err != nil {
+		return Totals{}, fmt.Errorf("search totals: %w", err)
+	}
+
+	return sumTotals(totals), nil
+}
+
+// toFilter validates in and returns the search filter it describes.
+func toFilter(in Input) (domaintransaction.SearchFilter, error) {
+	f := domaintransaction.SearchFilter{
+		AccountIDs:     in.AccountIDs,
+		CategoryIDs:    in.CategoryIDs,
+		MinAmount:      in.MinAmount,
+		MaxAmount:      in.MaxAmount,
+		Query:          in.Query,
+		StartDate:      in.StartDate,
+		EndDate:        in.EndDate,
+		IncludeDeleted: in.IncludeDeleted,
+	}
+
+	for _, t := range in.Types {
+		tType := domaintransaction.TransactionType(t)
+		if tType != domaintransaction.TransactionTypeIncome && tType != domaintransaction.TransactionTypeExpense {
+			return domaintransaction.SearchFilter{}, fmt.Errorf("%w: type %q must be income or expense", domaintransaction.ErrInvalidSearch, t)
+		}
+		f.Types = append(f.Types, tType)
+	}
+
+	if (in.MinAmount != nil && *in.MinAmount < 0) || (in.MaxAmount != nil && *in.MaxAmount < 0) {
+		return domaintransaction.SearchFilter{}, fmt.Errorf("%w: amounts cannot be negative", domaintransaction.ErrInvalidSearch)
+	}
+	if in.MinAmount != nil && in.MaxAmount != nil && *in.MinAmount > *in.MaxAmount {
+		return domaintransaction.SearchFilter{}, fmt.Errorf("%w: min_amount is greater than max_amount", domaintransaction.ErrInvalidSearch)
+	}
+
+	for _, d := range []string{in.StartDate, in.EndDate} {
+		if d == "" {
+			continue
+		}
+		if _, err := time.Parse(dateLayout, d); err != nil {
+			return domaintransaction.SearchFilter{}, fmt.Errorf("%w: dates must be YYYY-MM-DD", domaintransaction.ErrInvalidSearch)
+		}
+	}
+	if in.StartDate != "" && in.EndDate != "" && in.StartDate > in.EndDate {
+		return domaintransaction.SearchFilter{}, fmt.Errorf("%w: start_date is after end_date", domaintransaction.ErrInvalidSearch)
+	}
+
+	return f, nil
+}
+
+// sumTotals folds the per-type totals into the search totals.
+func sumTotals(totals []domaintransaction.Total) Totals {
+	var out Totals
+	for _, t := range totals {
+		switch t.Type {
+		case domaintransaction.TransactionTypeIncome:
+			out.Income += t.Amount
+		case domaintransaction.TransactionTypeExpense:
+			out.Expense += t.Amount
+		}
+		out.Count += t.Count
+	}
+	out.Net = out.Income - out.Expense
+	return out
+}
@@ -0,0 +1,219 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/transaction/search"
+	"github.com/financial-manager/api/internal/application/transaction/search/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	byAmount := domainshared.PageQuery{Limit: 1, Cursor: "abc", Sort: domaintransaction.SortAmount, Direction: domainshared.SortAsc}
+
+	tests := []struct {
+		name    string
+		repo    *mocks.Repository
+		input   search.Input
+		wantOut search.Output
+	}{
+		{
+			name:    "no match returns zero totals",
+			repo:    buildMockRepo(domaintransaction.SearchFilter{}, defaultPage, nil, "", nil),
+			wantOut: search.Output{},
+		},
+		{
+			name: "totals sum every type",
+			repo: buildMockRepo(domaintransaction.SearchFilter{}, defaultPage, []domaintransaction.Transaction{salary, rent}, "", []domaintransaction.Total{
+				{Type: domaintransaction.TransactionTypeExpense, Amount: 400, Count: 1},
+				{Type: domaintransaction.TransactionTypeIncome, Amount: 1000, Count: 1},
+			}),
+			wantOut: search.Output{
+				Transactions: []domaintransaction.Transaction{salary, rent},
+				Totals:       search.Totals{Income: 1000, Expense: 400, Net: 600, Count: 2},
+			},
+		},
+		{
+			name: "filters and page are passed on",
+			repo: buildMockRepo(domaintransaction.SearchFilter{
+				Types:          []domaintransaction.TransactionType{domaintransaction.TransactionTypeExpense},
+				AccountIDs:     []string{"acc-001", "acc-002"},
+				CategoryIDs:    []string{"cat-001"},
+				MinAmount:      ptr(10),
+				MaxAmount:      ptr(500),
+				Query:          "rent",
+				StartDate:      "2026-02-01",
+				EndDate:        "2026-02-28",
+				IncludeDeleted: true,
+			}, byAmount, []domaintransaction.Transaction{rent}, "def", []domaintransaction.Total{
+				{Type: domaintransaction.TransactionTypeExpense, Amount: 800, Count: 2},
+			}),
+			input: search.Input{
+				Types:          []string{"expense"},
+				AccountIDs:     []string{"acc-001", "acc-002"},
+				CategoryIDs:    []string{"cat-001"},
+				MinAmount:      ptr(10),
+				MaxAmount:      ptr(500),
+				Query:          "rent",
+				StartDate:      "2026-02-01",
+				EndDate:        "2026-02-28",
+				IncludeDeleted: true,
+				Page:           byAmount,
+			},
+			wantOut: search.Output{
+				Transactions: []domaintransaction.Transaction{rent},
+				NextCursor:   "def",
+				Totals:       search.Totals{Expense: 800, Net: -800, Count: 2},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := search.New(tc.repo)
+			out, err := uc.Execute(context.Background(), tc.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, out)
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   search.Input
+		wantErr error
+	}{
+		{
+			name:    "unknown type",
+			input:   search.Input{Types: []string{"income", "transfer"}},
+			wantErr: domaintransaction.ErrInvalidSearch,
+		},
+		{
+			name:    "negative amount",
+			input:   search.Input{MinAmount: ptr(-1)},
+			wantErr: domaintransaction.ErrInvalidSearch,
+		},
+		{
+			name:    "min amount above max amount",
+			input:   search.Input{MinAmount: ptr(20), MaxAmount: ptr(10)},
+			wantErr: domaintransaction.ErrInvalidSearch,
+		},
+		{
+			name:    "malformed date",
+			input:   search.Input{StartDate: "02/01/2026"},
+			wantErr: domaintransaction.ErrInvalidSearch,
+		},
+		{
+			name:    "start date after end date",
+			input:   search.Input{StartDate: "2026-03-01", EndDate: "2026-02-01"},
+			wantErr: domaintransaction.ErrInvalidSearch,
+		},
+		{
+			name:    "invalid page",
+			input:   search.Input{Page: domainshared.PageQuery{Sort: "payee"}},
+			wantErr: domainshared.ErrInvalidPage,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.Repository{}
+			uc := search.New(repo)
+
+			_, err := uc.Execute(context.Background(), tc.input)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			repo.AssertNotCalled(t, "Search")
+		})
+	}
+}
+
+func TestUseCase_Execute_RepositoryErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("search error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.Repository{}
+		repo.On("Search", mock.Anything, mock.Anything, mock.Anything).Return(nil, "", errors.New("db error")).Once()
+
+		_, err := search.New(repo).Execute(context.Background(), search.Input{})
+
+		assert.EqualError(t, err, "search transactions: db error")
+		repo.AssertNotCalled(t, "SearchTotals")
+	})
+
+	t.Run("totals error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.Repository{}
+		repo.On("Search", mock.Anything, mock.Anything, mock.Anything).Return(nil, "", nil).Once()
+		repo.On("SearchTotals", mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		_, err := search.New(repo).Execute(context.Background(), search.Input{})
+
+		assert.EqualError(t, err, "search transactions: totals: db error")
+	})
+}
+
+func TestUseCase_Totals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sums the totals of the matching transactions", func(t *testing.T) {
+		t.Parallel()
+
+		filter := domaintransaction.SearchFilter{AccountIDs: []string{"acc-001"}}
+		repo := &mocks.Repository{}
+		repo.On("SearchTotals", mock.Anything, filter).Return([]domaintransaction.Total{
+			{Type: domaintransaction.TransactionTypeExpense, Amount: 400, Count: 2},
+			{Type: domaintransaction.TransactionTypeIncome, Amount: 1000, Count: 1},
+		}, nil).Once()
+
+		got, err := search.New(repo).Totals(context.Background(), search.Input{AccountIDs: []string{"acc-001"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, search.Totals{Income: 1000, Expense: 400, Net: 600, Count: 3}, got)
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Search")
+	})
+
+	t.Run("invalid filters", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.Repository{}
+
+		_, err := search.New(repo).Totals(context.Background(), search.Input{Types: []string{"transfer"}})
+
+		assert.ErrorIs(t, err, domaintransaction.ErrInvalidSearch)
+		repo.AssertNotCalled(t, "SearchTotals")
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &mocks.Repository{}
+		repo.On("SearchTotals", mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		_, err := search.New(repo).Totals(context.Background(), search.Input{})
+
+		assert.EqualError(t, err, "search totals: db error")
+	})
+}
@@ -0,0 +1,54 @@
+package search_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/transaction/search/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+const fixedDate = "2026-02-28"
+
+// defaultPage is the page the use case asks for when the input sets none.
+var defaultPage = domainshared.PageQuery{
+	Limit:     domainshared.DefaultPageLimit,
+	Sort:      domaintransaction.SortDate,
+	Direction: domainshared.SortDesc,
+}
+
+// salary and rent are canonical transaction fixtures for search tests.
+var (
+	salary = buildTransaction("tx-1", domaintransaction.TransactionTypeIncome, 1000.0)
+	rent   = buildTransaction("tx-2", domaintransaction.TransactionTypeExpense, 400.0)
+)
+
+// buildMockRepo creates a mocks.Repository pre-configured for one Search and
+// one SearchTotals call with filter and page.
+func buildMockRepo(filter domaintransaction.SearchFilter, page domainshared.PageQuery, txs []domaintransaction.Transaction, next string, totals []domaintransaction.Total) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("Search", mock.Anything, filter, page).Return(txs, next, nil).Once()
+	m.On("SearchTotals", mock.Anything, filter).Return(totals, nil).Once()
+	return m
+}
+
+// buildTransaction returns a valid Transaction for use in tests.
+func buildTransaction(id string, tType domaintransaction.TransactionType, amount float64) domaintransaction.Transaction {
+	date, _ := time.Parse("2006-01-02", fixedDate)
+	return domaintransaction.Transaction{
+		ID:         id,
+		AccountID:  "acc-001",
+		CategoryID: "cat-001",
+		Type:       tType,
+		Amount:     amount,
+		Date:       date,
+		IsActive:   true,
+	}
+}
+
+// ptr returns a pointer to v.
+func ptr(v float64) *float64 {
+	return &v
+}
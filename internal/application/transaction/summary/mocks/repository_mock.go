@@ -14,8 +14,8 @@ type Repository struct {
 	mock.Mock
 }
 
-// ListByType mocks Repository.ListByType.
-func (m *Repository) ListByType(ctx context.Context, tType domaintransaction.TransactionType, accountID, categoryID string, startDate, endDate string) ([]domaintransaction.Transaction, error) {
-	args := m.Called(ctx, tType, accountID, categoryID, startDate, endDate)
-	return args.Get(0).([]domaintransaction.Transaction), args.Error(1)
+// Totals mocks Repository.Totals.
+func (m *Repository) Totals(ctx context.Context, q domaintransaction.TotalsQuery) ([]domaintransaction.Total, error) {
+	args := m.Called(ctx, q)
+	return args.Get(0).([]domaintransaction.Total), args.Error(1)
 }
@@ -4,56 +4,95 @@ package summary
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/financial-manager/api/internal/application/report/period"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
+// Repository is the port for the transaction totals the summary is built from.
 type Repository interface {
-	ListByType(ctx context.Context, tType domaintransaction.TransactionType, accountID, categoryID string, startDate, endDate string) ([]domaintransaction.Transaction, error)
+	Totals(ctx context.Context, q domaintransaction.TotalsQuery) ([]domaintransaction.Total, error)
+}
+
+// MonthStart is the port for the day of the month months start on.
+type MonthStart interface {
+	Execute(ctx context.Context) (int, error)
 }
 
 type UseCase struct {
-	repo Repository
+	repo       Repository
+	monthStart MonthStart
 }
 
+// Summary holds the totals of the summarized transactions. Label, StartDate
+// and EndDate describe the month when one was requested.
 type Summary struct {
 	TotalIncome  float64 `json:"total_income"`
 	TotalExpense float64 `json:"total_expense"`
 	Balance      float64 `json:"balance"`
+	Label        string  `json:"label,omitempty"`
+	StartDate    string  `json:"start_date,omitempty"`
+	EndDate      string  `json:"end_date,omitempty"`
 }
 
-func New(repo Repository) *UseCase {
-	return &UseCase{repo: repo}
+func New(repo Repository, monthStart MonthStart) *UseCase {
+	return &UseCase{repo: repo, monthStart: monthStart}
 }
 
+// Input filters the summarized transactions. Month, as YYYY-MM, selects the
+// month starting on the configured month start day of that calendar month and
+// cannot be combined with StartDate or EndDate.
 type Input struct {
 	AccountID string `json:"account_id"`
 	StartDate string `json:"start_date"`
 	EndDate   string `json:"end_date"`
+	Month     string `json:"month"`
 }
 
+// Execute sums the income and expense transactions matching in.
+// An invalid Month returns an error wrapping domainreport.ErrInvalidQuery.
 func (uc *UseCase) Execute(ctx context.Context, in Input) (Summary, error) {
-	incomes, err := uc.repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, in.AccountID, "", in.StartDate, in.EndDate)
-	if err != nil {
-		return Summary{}, fmt.Errorf("get summary: %w", err)
+	var out Summary
+	if in.Month != "" {
+		if in.StartDate != "" || in.EndDate != "" {
+			return Summary{}, fmt.Errorf("%w: use either month or start_date and end_date", domainreport.ErrInvalidQuery)
+		}
+		month, err := time.Parse("2006-01", in.Month)
+		if err != nil {
+			return Summary{}, fmt.Errorf("%w: month must be YYYY-MM", domainreport.ErrInvalidQuery)
+		}
+		monthStartDay, err := uc.monthStart.Execute(ctx)
+		if err != nil {
+			return Summary{}, fmt.Errorf("get summary: %w", err)
+		}
+
+		start := period.Start(month.AddDate(0, 0, monthStartDay-1), domainreport.IntervalMonth, monthStartDay)
+		end := period.Next(start, domainreport.IntervalMonth).AddDate(0, 0, -1)
+		in.StartDate, in.EndDate = start.Format(period.DateLayout), end.Format(period.DateLayout)
+		out.Label = period.Label(start, domainreport.IntervalMonth, monthStartDay)
+		out.StartDate, out.EndDate = in.StartDate, in.EndDate
 	}
 
-	expenses, err := uc.repo.ListByType(ctx, domaintransaction.TransactionTypeExpense, in.AccountID, "", in.StartDate, in.EndDate)
+	totals, err := uc.repo.Totals(ctx, domaintransaction.TotalsQuery{
+		AccountID: in.AccountID,
+		StartDate: in.StartDate,
+		EndDate:   in.EndDate,
+	})
 	if err != nil {
 		return Summary{}, fmt.Errorf("get summary: %w", err)
 	}
 
-	var totalIncome, totalExpense float64
-	for _, tx := range incomes {
-		totalIncome += tx.Amount
-	}
-	for _, tx := range expenses {
-		totalExpense += tx.Amount
+	for _, t := range totals {
+		switch t.Type {
+		case domaintransaction.TransactionTypeIncome:
+			out.TotalIncome += t.Amount
+		case domaintransaction.TransactionTypeExpense:
+			out.TotalExpense += t.Amount
+		}
 	}
+	out.Balance = out.TotalIncome - out.TotalExpense
 
-	return Summary{
-		TotalIncome:  totalIncome,
-		TotalExpense: totalExpense,
-		Balance:      totalIncome - totalExpense,
-	}, nil
+	return out, nil
 }
@@ -10,47 +10,46 @@ import (
 
 	"github.com/financial-manager/api/internal/application/transaction/summary"
 	"github.com/financial-manager/api/internal/application/transaction/summary/mocks"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
 func TestUseCase_Execute(t *testing.T) {
 	t.Parallel()
 
+	dbErr := errors.New("db error")
+	ranged := domaintransaction.TotalsQuery{AccountID: "acc-1", StartDate: "2026-02-01", EndDate: "2026-02-28"}
+
 	tests := []struct {
-		name        string
-		incomeRepo  *mocks.Repository
-		expenseRepo *mocks.Repository
-		input       summary.Input
-		wantErr     error
-		wantOut     summary.Summary
+		name    string
+		repo    *mocks.Repository
+		input   summary.Input
+		wantErr error
+		wantOut summary.Summary
 	}{
 		{
-			name:        "empty transactions returns zero summary",
-			incomeRepo:  buildMockRepoIncome(nil, nil),
-			expenseRepo: buildMockRepoExpense(nil, nil),
-			input:       summary.Input{},
-			wantOut:     summary.Summary{TotalIncome: 0, TotalExpense: 0, Balance: 0},
+			name:    "no transactions returns zero summary",
+			repo:    buildMockRepo(domaintransaction.TotalsQuery{}, []domaintransaction.Total{}, nil),
+			input:   summary.Input{},
+			wantOut: summary.Summary{TotalIncome: 0, TotalExpense: 0, Balance: 0},
 		},
 		{
-			name:        "calculates summary correctly",
-			incomeRepo:  buildMockRepoIncome([]domaintransaction.Transaction{income100, income500}, nil),
-			expenseRepo: buildMockRepoExpense([]domaintransaction.Transaction{expense50, expense200}, nil),
-			input:       summary.Input{},
-			wantOut:     summary.Summary{TotalIncome: 600.0, TotalExpense: 250.0, Balance: 350.0},
+			name:    "calculates summary correctly",
+			repo:    buildMockRepo(domaintransaction.TotalsQuery{}, []domaintransaction.Total{expenseTotal(250, 2), incomeTotal(600, 2)}, nil),
+			input:   summary.Input{},
+			wantOut: summary.Summary{TotalIncome: 600.0, TotalExpense: 250.0, Balance: 350.0},
 		},
 		{
-			name:        "repository error for incomes is propagated",
-			incomeRepo:  buildMockRepoIncome(nil, errors.New("db error")),
-			expenseRepo: &mocks.Repository{},
-			input:       summary.Input{},
-			wantErr:     fmt.Errorf("get summary: %w", errors.New("db error")),
+			name:    "filters are passed to the repository",
+			repo:    buildMockRepo(ranged, []domaintransaction.Total{incomeTotal(100, 1)}, nil),
+			input:   summary.Input{AccountID: "acc-1", StartDate: "2026-02-01", EndDate: "2026-02-28"},
+			wantOut: summary.Summary{TotalIncome: 100.0, Balance: 100.0},
 		},
 		{
-			name:        "repository error for expenses is propagated",
-			incomeRepo:  buildMockRepoIncome(nil, nil),
-			expenseRepo: buildMockRepoExpense(nil, errors.New("db error")),
-			input:       summary.Input{},
-			wantErr:     fmt.Errorf("get summary: %w", errors.New("db error")),
+			name:    "repository error is propagated",
+			repo:    buildMockRepo(domaintransaction.TotalsQuery{}, nil, dbErr),
+			input:   summary.Input{},
+			wantErr: fmt.Errorf("get summary: %w", dbErr),
 		},
 	}
 
@@ -58,25 +57,99 @@ func TestUseCase_Execute(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			// Since we need to call ListByType twice with different types,
-			// we merge the mocks
-			repo := &mocks.Repository{}
-			if tc.incomeRepo != nil {
-				repo = tc.incomeRepo
-			}
-			if tc.expenseRepo != nil {
-				// Copy expectations from expenseRepo
-				for _, call := range tc.expenseRepo.ExpectedCalls {
-					repo.On(call.Method, call.Arguments...).Return(call.ReturnArguments...)
-				}
-			}
-
-			uc := summary.New(repo)
+			uc := summary.New(tc.repo, &mocks.MonthStart{})
 			out, err := uc.Execute(context.Background(), tc.input)
 
 			assert.Equal(t, tc.wantErr, err)
 			assert.Equal(t, tc.wantOut, out)
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUseCase_Execute_Month(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		monthStartDay int
+		from, to      string
+		want          summary.Summary
+	}{
+		{
+			name:          "calendar month",
+			monthStartDay: 1,
+			from:          "2026-02-01", to: "2026-02-28",
+			want: summary.Summary{TotalIncome: 100, TotalExpense: 50, Balance: 50, Label: "Feb 2026", StartDate: "2026-02-01", EndDate: "2026-02-28"},
+		},
+		{
+			name:          "month starting on the 25th",
+			monthStartDay: 25,
+			from:          "2026-02-25", to: "2026-03-24",
+			want: summary.Summary{TotalIncome: 100, TotalExpense: 50, Balance: 50, Label: "25 Feb – 24 Mar", StartDate: "2026-02-25", EndDate: "2026-03-24"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := domaintransaction.TotalsQuery{AccountID: "acc-1", StartDate: tc.from, EndDate: tc.to}
+			repo := buildMockRepo(q, []domaintransaction.Total{expenseTotal(50, 1), incomeTotal(100, 1)}, nil)
+			uc := summary.New(repo, buildMonthStart(tc.monthStartDay, nil))
+
+			out, err := uc.Execute(context.Background(), summary.Input{AccountID: "acc-1", Month: "2026-02"})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, out)
 			repo.AssertExpectations(t)
 		})
 	}
 }
+
+func TestUseCase_Execute_MonthErrors(t *testing.T) {
+	t.Parallel()
+
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name       string
+		input      summary.Input
+		monthStart *mocks.MonthStart
+		wantErr    error
+	}{
+		{
+			name:       "malformed month",
+			input:      summary.Input{Month: "02/2026"},
+			monthStart: &mocks.MonthStart{},
+			wantErr:    domainreport.ErrInvalidQuery,
+		},
+		{
+			name:       "month with a date range",
+			input:      summary.Input{Month: "2026-02", StartDate: "2026-02-01"},
+			monthStart: &mocks.MonthStart{},
+			wantErr:    domainreport.ErrInvalidQuery,
+		},
+		{
+			name:       "month start error is propagated",
+			input:      summary.Input{Month: "2026-02"},
+			monthStart: buildMonthStart(0, dbErr),
+			wantErr:    dbErr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mocks.Repository{}
+			uc := summary.New(repo, tc.monthStart)
+
+			out, err := uc.Execute(context.Background(), tc.input)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Empty(t, out)
+			repo.AssertNotCalled(t, "Totals")
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// AccountRepository is a testify mock for the update.AccountRepository interface.
+type AccountRepository struct {
+	mock.Mock
+}
+
+// AdjustBalances mocks AccountRepository.AdjustBalances.
+func (m *AccountRepository) AdjustBalances(ctx context.Context, deltas map[string]float64) error {
+	return m.Called(ctx, deltas).Error(0)
+}
@@ -1,12 +1,18 @@
 package update_test
 
 import (
+	"context"
+	"testing"
 	"time"
 
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/financial-manager/api/internal/application/transaction/update/mocks"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	"github.com/financial-manager/api/internal/platform/database"
+	"github.com/financial-manager/api/internal/platform/database/migrator"
+	"github.com/financial-manager/api/internal/platform/database/sqlite"
 )
 
 const fixedTimestamp = "2026-02-28T10:00:00Z"
@@ -45,6 +51,26 @@ func buildMockRepoFull(id string, fetched, updated domaintransaction.Transaction
 	return m
 }
 
+// buildExpense returns an expense of the seeded account for use in tests.
+func buildExpense(id string, amount float64) domaintransaction.Transaction {
+	tx := buildTransaction(id, "acc-001", "cat-001", "Groceries", amount)
+	tx.Type = domaintransaction.TransactionTypeExpense
+	return tx
+}
+
+// withUpdatedAt returns tx stamped with updatedAt.
+func withUpdatedAt(tx domaintransaction.Transaction, updatedAt time.Time) domaintransaction.Transaction {
+	tx.UpdatedAt = updatedAt
+	return tx
+}
+
+// buildMockAccounts creates a mocks.AccountRepository pre-configured for one AdjustBalances call.
+func buildMockAccounts(deltas map[string]float64, err error) *mocks.AccountRepository {
+	m := &mocks.AccountRepository{}
+	m.On("AdjustBalances", mock.Anything, deltas).Return(err).Once()
+	return m
+}
+
 // buildMockClock creates a mocks.Clock pre-configured to return fixedTime once.
 func buildMockClock() *mocks.Clock {
 	m := &mocks.Clock{}
@@ -60,3 +86,23 @@ func fixedTime() time.Time {
 	}
 	return t.UTC()
 }
+
+// openTestDatabases opens the four migrated databases in a temporary directory.
+func openTestDatabases(t *testing.T) *database.Databases {
+	t.Helper()
+
+	dbs := database.New(sqlite.NewConnector(), migrator.New())
+	require.NoError(t, dbs.Open(context.Background(), t.TempDir()))
+	t.Cleanup(func() { _ = dbs.Close() })
+
+	return dbs
+}
+
+// accountBalance returns the current balance of an account in the accounts database.
+func accountBalance(t *testing.T, dbs *database.Databases, id string) float64 {
+	t.Helper()
+
+	var balance float64
+	require.NoError(t, dbs.Accounts.QueryRow("SELECT current_balance FROM accounts WHERE id = ?", id).Scan(&balance))
+	return balance
+}
@@ -16,17 +16,24 @@ type Repository interface {
 	Update(ctx context.Context, t domaintransaction.Transaction) error
 }
 
+// AccountRepository is the port that applies balance changes to accounts,
+// which live in the accounts database.
+type AccountRepository interface {
+	AdjustBalances(ctx context.Context, deltas map[string]float64) error
+}
+
 type Clock interface {
 	Now() time.Time
 }
 
 type UseCase struct {
-	repo  Repository
-	clock Clock
+	repo     Repository
+	accounts AccountRepository
+	clock    Clock
 }
 
-func New(repo Repository, clock Clock) *UseCase {
-	return &UseCase{repo: repo, clock: clock}
+func New(repo Repository, accounts AccountRepository, clock Clock) *UseCase {
+	return &UseCase{repo: repo, accounts: accounts, clock: clock}
 }
 
 type Input struct {
@@ -37,6 +44,8 @@ type Input struct {
 	Date        string  `json:"date"`
 }
 
+// Execute applies the non-empty fields of in to the transaction and, when
+// its amount changed, moves the difference into the account balance.
 func (uc *UseCase) Execute(ctx context.Context, in Input) (domaintransaction.Transaction, error) {
 	if err := validateInput(in); err != nil {
 		return domaintransaction.Transaction{}, err
@@ -46,6 +55,7 @@ func (uc *UseCase) Execute(ctx context.Context, in Input) (domaintransaction.Tra
 	if err != nil {
 		return domaintransaction.Transaction{}, domainshared.ErrNotFound
 	}
+	before := tx.SignedAmount()
 
 	if in.Amount > 0 {
 		tx.Amount = in.Amount
@@ -70,6 +80,12 @@ func (uc *UseCase) Execute(ctx context.Context, in Input) (domaintransaction.Tra
 		return domaintransaction.Transaction{}, fmt.Errorf("update transaction: %w", err)
 	}
 
+	if delta := tx.SignedAmount() - before; delta != 0 {
+		if err := uc.accounts.AdjustBalances(ctx, map[string]float64{tx.AccountID: delta}); err != nil {
+			return domaintransaction.Transaction{}, fmt.Errorf("update transaction: %w", err)
+		}
+	}
+
 	return tx, nil
 }
 
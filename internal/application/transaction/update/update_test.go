@@ -8,11 +8,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/financial-manager/api/internal/application/transaction/update"
 	"github.com/financial-manager/api/internal/application/transaction/update/mocks"
 	domainshared "github.com/financial-manager/api/internal/domain/shared"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	accountsqlite "github.com/financial-manager/api/internal/platform/account/sqlite"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
 )
 
 func TestUseCase_Execute(t *testing.T) {
@@ -22,12 +25,13 @@ func TestUseCase_Execute(t *testing.T) {
 	newDate, _ := time.Parse("2006-01-02", "2026-03-01")
 
 	tests := []struct {
-		name    string
-		repo    *mocks.Repository
-		clock   *mocks.Clock
-		input   update.Input
-		wantErr error
-		wantOut domaintransaction.Transaction
+		name     string
+		repo     *mocks.Repository
+		accounts *mocks.AccountRepository
+		clock    *mocks.Clock
+		input    update.Input
+		wantErr  error
+		wantOut  domaintransaction.Transaction
 	}{
 		{
 			name: "valid update returns updated transaction",
@@ -54,8 +58,9 @@ func TestUseCase_Execute(t *testing.T) {
 				Description: "Updated Description", Date: newDate, IsActive: true,
 				UpdatedAt: updatedAt,
 			}, nil),
-			clock: buildMockClock(),
-			input: update.Input{ID: "tx-1", CategoryID: "cat-002", Amount: 200.0, Description: "Updated Description", Date: "2026-03-01"},
+			accounts: buildMockAccounts(map[string]float64{"acc-001": 100.0}, nil),
+			clock:    buildMockClock(),
+			input:    update.Input{ID: "tx-1", CategoryID: "cat-002", Amount: 200.0, Description: "Updated Description", Date: "2026-03-01"},
 			wantOut: domaintransaction.Transaction{
 				ID: "tx-1", AccountID: "acc-001", CategoryID: "cat-002",
 				Type: domaintransaction.TransactionTypeIncome, Amount: 200.0,
@@ -96,6 +101,22 @@ func TestUseCase_Execute(t *testing.T) {
 			input:   update.Input{ID: "tx-2", Description: "New Description"},
 			wantErr: fmt.Errorf("update transaction: %w", errors.New("db write error")),
 		},
+		{
+			name:     "expense amount change moves the difference into the account balance",
+			repo:     buildMockRepoFull("tx-3", buildExpense("tx-3", 100.0), withUpdatedAt(buildExpense("tx-3", 40.0), updatedAt), nil),
+			accounts: buildMockAccounts(map[string]float64{"acc-001": 60.0}, nil),
+			clock:    buildMockClock(),
+			input:    update.Input{ID: "tx-3", Amount: 40.0},
+			wantOut:  withUpdatedAt(buildExpense("tx-3", 40.0), updatedAt),
+		},
+		{
+			name:     "AdjustBalances error is wrapped and propagated",
+			repo:     buildMockRepoFull("tx-3", buildExpense("tx-3", 100.0), withUpdatedAt(buildExpense("tx-3", 40.0), updatedAt), nil),
+			accounts: buildMockAccounts(map[string]float64{"acc-001": 60.0}, errors.New("db write error")),
+			clock:    buildMockClock(),
+			input:    update.Input{ID: "tx-3", Amount: 40.0},
+			wantErr:  fmt.Errorf("update transaction: %w", errors.New("db write error")),
+		},
 		{
 			name:    "invalid date format returns validation error",
 			repo:    buildMockRepoGetByID("tx-1", seeded, nil),
@@ -109,13 +130,46 @@ func TestUseCase_Execute(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			uc := update.New(tc.repo, tc.clock)
+			accounts := tc.accounts
+			if accounts == nil {
+				accounts = &mocks.AccountRepository{}
+			}
+
+			uc := update.New(tc.repo, accounts, tc.clock)
 			out, err := uc.Execute(context.Background(), tc.input)
 
 			assert.Equal(t, tc.wantErr, err)
 			assert.Equal(t, tc.wantOut, out)
 			tc.repo.AssertExpectations(t)
+			accounts.AssertExpectations(t)
 			tc.clock.AssertExpectations(t)
 		})
 	}
 }
+
+func TestUseCase_Execute_FromSQLite(t *testing.T) {
+	t.Parallel()
+
+	dbs := openTestDatabases(t)
+	_, err := dbs.Accounts.Exec(`INSERT INTO accounts (id, name, type, initial_balance, current_balance, currency, is_active, created_at, updated_at)
+		VALUES ('acc-001', 'Banco', 'bank', 1000, 900, 'USD', 1, '2026-01-01T00:00:00Z', '2026-01-01T00:00:00Z')`)
+	require.NoError(t, err)
+	_, err = dbs.Transactions.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at)
+		VALUES ('tx-1', 'acc-001', 'cat-001', 'expense', 100, 'Groceries', '2026-02-28', 1, '2026-02-28T00:00:00Z', '2026-02-28T00:00:00Z')`)
+	require.NoError(t, err)
+	_, err = dbs.Transactions.Exec(`INSERT INTO balance_snapshots (account_id, date, balance) VALUES ('acc-001', '2026-02-28', -100)`)
+	require.NoError(t, err)
+
+	repo := transactionsqlite.NewTransactionRepository(dbs.Transactions)
+	uc := update.New(repo, accountsqlite.NewAccountRepository(dbs.Accounts), buildMockClock())
+	ctx := context.Background()
+
+	out, err := uc.Execute(ctx, update.Input{ID: "tx-1", Amount: 250})
+
+	require.NoError(t, err)
+	assert.InDelta(t, 250.0, out.Amount, 0.001)
+	assert.InDelta(t, 750.0, accountBalance(t, dbs, "acc-001"), 0.001)
+	balance, err := repo.BalanceOn(ctx, "acc-001", fixedDate)
+	require.NoError(t, err)
+	assert.InDelta(t, -250.0, balance, 0.001)
+}
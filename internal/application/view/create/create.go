@@ -0,0 +1,108 @@
+// Package create implements the create view use case.
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// Input carries the data required to save a new view.
+type Input struct {
+	Name   string
+	Filter domainview.Filter
+}
+
+// UseCase implements the create view use case.
+type UseCase struct {
+	repo  Repository
+	idGen IDGenerator
+	clock Clock
+}
+
+// New creates a new UseCase.
+func New(repo Repository, idGen IDGenerator, clock Clock) *UseCase {
+	return &UseCase{repo: repo, idGen: idGen, clock: clock}
+}
+
+// Execute validates the filter and saves it under a unique name.
+// Invalid input returns an error wrapping domainview.ErrInvalidView.
+func (uc *UseCase) Execute(ctx context.Context, in Input) (domainview.View, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return domainview.View{}, fmt.Errorf("%w: name is required", domainview.ErrInvalidView)
+	}
+	filter := in.Filter
+	filter.Query = strings.TrimSpace(filter.Query)
+	if err := validateFilter(filter); err != nil {
+		return domainview.View{}, err
+	}
+
+	_, err := uc.repo.GetByName(ctx, name)
+	if err == nil {
+		return domainview.View{}, domainview.ErrViewNameTaken
+	}
+	if !errors.Is(err, domainshared.ErrNotFound) {
+		return domainview.View{}, fmt.Errorf("get view: %w", err)
+	}
+
+	now := uc.clock.Now().UTC()
+	view := domainview.View{
+		ID:        uc.idGen.NewID(),
+		Name:      name,
+		Filter:    filter,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := uc.repo.Create(ctx, view); err != nil {
+		return domainview.View{}, fmt.Errorf("create view: %w", err)
+	}
+
+	return view, nil
+}
+
+func validateFilter(f domainview.Filter) error {
+	for _, t := range f.Types {
+		switch domaintransaction.TransactionType(t) {
+		case domaintransaction.TransactionTypeIncome, domaintransaction.TransactionTypeExpense:
+		default:
+			return fmt.Errorf("%w: types must be 'income' or 'expense'", domainview.ErrInvalidView)
+		}
+	}
+	if f.Range != "" {
+		if !slices.Contains(domainview.Ranges, f.Range) {
+			return fmt.Errorf("%w: unknown range %q", domainview.ErrInvalidView, f.Range)
+		}
+		if f.StartDate != "" || f.EndDate != "" {
+			return fmt.Errorf("%w: range and dates are mutually exclusive", domainview.ErrInvalidView)
+		}
+	}
+	for _, d := range []string{f.StartDate, f.EndDate} {
+		if d == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", d); err != nil {
+			return fmt.Errorf("%w: dates must be YYYY-MM-DD", domainview.ErrInvalidView)
+		}
+	}
+	if f.StartDate != "" && f.EndDate != "" && f.StartDate > f.EndDate {
+		return fmt.Errorf("%w: start_date must not be after end_date", domainview.ErrInvalidView)
+	}
+	for _, a := range []*float64{f.MinAmount, f.MaxAmount} {
+		if a != nil && *a < 0 {
+			return fmt.Errorf("%w: amounts must not be negative", domainview.ErrInvalidView)
+		}
+	}
+	if f.MinAmount != nil && f.MaxAmount != nil && *f.MinAmount > *f.MaxAmount {
+		return fmt.Errorf("%w: min_amount must not exceed max_amount", domainview.ErrInvalidView)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package create_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/view/create"
+	"github.com/financial-manager/api/internal/application/view/create/mocks"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	withQuery := groceriesFilter
+	withQuery.Query = "  mercadona "
+
+	tests := []struct {
+		name      string
+		input     create.Input
+		repo      *mocks.Repository
+		idGen     *mocks.IDGenerator
+		clock     *mocks.Clock
+		wantErr   error
+		wantErrIs error
+		wantView  domainview.View
+	}{
+		{
+			name:     "valid input saves the trimmed view",
+			input:    create.Input{Name: "  Groceries ", Filter: withQuery},
+			repo:     buildMockRepo(validView, nil),
+			idGen:    buildMockIDGenerator(),
+			clock:    buildMockClock(),
+			wantView: validView,
+		},
+		{
+			name:      "empty name returns ErrInvalidView",
+			input:     create.Input{Name: " "},
+			wantErrIs: domainview.ErrInvalidView,
+		},
+		{
+			name:      "unknown type returns ErrInvalidView",
+			input:     create.Input{Name: "Bad", Filter: domainview.Filter{Types: []string{"transfer"}}},
+			wantErrIs: domainview.ErrInvalidView,
+		},
+		{
+			name:      "unknown range returns ErrInvalidView",
+			input:     create.Input{Name: "Bad", Filter: domainview.Filter{Range: "next_week"}},
+			wantErrIs: domainview.ErrInvalidView,
+		},
+		{
+			name: "range with dates returns ErrInvalidView",
+			input: create.Input{Name: "Bad", Filter: domainview.Filter{
+				Range: domainview.RangeThisMonth, StartDate: "2026-01-01",
+			}},
+			wantErrIs: domainview.ErrInvalidView,
+		},
+		{
+			name:      "malformed date returns ErrInvalidView",
+			input:     create.Input{Name: "Bad", Filter: domainview.Filter{EndDate: "01/02/2026"}},
+			wantErrIs: domainview.ErrInvalidView,
+		},
+		{
+			name: "reversed dates return ErrInvalidView",
+			input: create.Input{Name: "Bad", Filter: domainview.Filter{
+				StartDate: "2026-02-01", EndDate: "2026-01-01",
+			}},
+			wantErrIs: domainview.ErrInvalidView,
+		},
+		{
+			name:      "negative amount returns ErrInvalidView",
+			input:     create.Input{Name: "Bad", Filter: domainview.Filter{MinAmount: ptr(-1)}},
+			wantErrIs: domainview.ErrInvalidView,
+		},
+		{
+			name:      "reversed amounts return ErrInvalidView",
+			input:     create.Input{Name: "Bad", Filter: domainview.Filter{MinAmount: ptr(50), MaxAmount: ptr(10)}},
+			wantErrIs: domainview.ErrInvalidView,
+		},
+		{
+			name:    "taken name returns ErrViewNameTaken",
+			input:   create.Input{Name: "Groceries"},
+			repo:    buildMockRepoForLookup("Groceries", validView, nil),
+			wantErr: domainview.ErrViewNameTaken,
+		},
+		{
+			name:    "lookup error is wrapped and propagated",
+			input:   create.Input{Name: "Groceries"},
+			repo:    buildMockRepoForLookup("Groceries", domainview.View{}, errors.New("db unavailable")),
+			wantErr: fmt.Errorf("get view: %w", errors.New("db unavailable")),
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			input:   create.Input{Name: "Groceries", Filter: groceriesFilter},
+			repo:    buildMockRepo(validView, errors.New("db unavailable")),
+			idGen:   buildMockIDGenerator(),
+			clock:   buildMockClock(),
+			wantErr: fmt.Errorf("create view: %w", errors.New("db unavailable")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tc.repo == nil {
+				tc.repo = &mocks.Repository{}
+			}
+			if tc.idGen == nil {
+				tc.idGen = &mocks.IDGenerator{}
+			}
+			if tc.clock == nil {
+				tc.clock = &mocks.Clock{}
+			}
+
+			got, err := create.New(tc.repo, tc.idGen, tc.clock).Execute(context.Background(), tc.input)
+
+			switch {
+			case tc.wantErr != nil:
+				assert.Equal(t, tc.wantErr, err)
+			case tc.wantErrIs != nil:
+				assert.ErrorIs(t, err, tc.wantErrIs)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantView, got)
+			}
+			tc.repo.AssertExpectations(t)
+			tc.idGen.AssertExpectations(t)
+			tc.clock.AssertExpectations(t)
+		})
+	}
+}
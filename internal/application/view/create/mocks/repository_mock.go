@@ -0,0 +1,27 @@
+// Package mocks contains testify mock implementations for the create use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// Repository is a testify mock for the create.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// GetByName mocks Repository.GetByName.
+func (m *Repository) GetByName(ctx context.Context, name string) (domainview.View, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(domainview.View), args.Error(1)
+}
+
+// Create mocks Repository.Create.
+func (m *Repository) Create(ctx context.Context, view domainview.View) error {
+	args := m.Called(ctx, view)
+	return args.Error(0)
+}
@@ -0,0 +1,67 @@
+package create_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/view/create/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+const fixedID = "fixed-uuid-view0001"
+
+// groceriesFilter is a valid filter using a relative range.
+var groceriesFilter = domainview.Filter{
+	Types:       []string{"expense"},
+	CategoryIDs: []string{"cat-food", "cat-home"},
+	Range:       domainview.RangeLast30Days,
+	Query:       "mercadona",
+}
+
+// validView is the view produced by a successful create with groceriesFilter.
+var validView = domainview.View{
+	ID:        fixedID,
+	Name:      "Groceries",
+	Filter:    groceriesFilter,
+	CreatedAt: fixedTime(),
+	UpdatedAt: fixedTime(),
+}
+
+// buildMockRepo creates a mocks.Repository where name is free and Create returns err.
+func buildMockRepo(view domainview.View, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("GetByName", mock.Anything, view.Name).Return(domainview.View{}, domainshared.ErrNotFound).Once()
+	m.On("Create", mock.Anything, view).Return(err).Once()
+	return m
+}
+
+// buildMockRepoForLookup creates a mocks.Repository whose GetByName returns the given result.
+func buildMockRepoForLookup(name string, existing domainview.View, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("GetByName", mock.Anything, name).Return(existing, err).Once()
+	return m
+}
+
+// buildMockIDGenerator creates a mocks.IDGenerator pre-configured to return fixedID once.
+func buildMockIDGenerator() *mocks.IDGenerator {
+	m := &mocks.IDGenerator{}
+	m.On("NewID").Return(fixedID).Once()
+	return m
+}
+
+// buildMockClock creates a mocks.Clock pre-configured to return fixedTime once.
+func buildMockClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(fixedTime()).Once()
+	return m
+}
+
+func fixedTime() time.Time {
+	return time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,63 @@
+package delete_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/view/delete"
+	"github.com/financial-manager/api/internal/application/view/delete/mocks"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		id      string
+		repo    *mocks.Repository
+		wantErr error
+	}{
+		{
+			name: "deletes the view",
+			id:   "v-1",
+			repo: buildMockRepo("v-1", nil),
+		},
+		{
+			name:    "empty id returns validation error",
+			repo:    &mocks.Repository{},
+			wantErr: errors.New("view id is required"),
+		},
+		{
+			name:    "missing view wraps ErrNotFound",
+			id:      "v-1",
+			repo:    buildMockRepo("v-1", domainshared.ErrNotFound),
+			wantErr: fmt.Errorf("view not found: %w", domainshared.ErrNotFound),
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			id:      "v-1",
+			repo:    buildMockRepo("v-1", errors.New("db unavailable")),
+			wantErr: fmt.Errorf("delete view: %w", errors.New("db unavailable")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := delete.New(tc.repo).Execute(context.Background(), tc.id)
+
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package delete
+
+import "context"
+
+// Repository is the narrow write port required by this use case.
+type Repository interface {
+	Delete(ctx context.Context, id string) error
+}
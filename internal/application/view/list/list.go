@@ -0,0 +1,29 @@
+// Package list implements the list views use case.
+package list
+
+import (
+	"context"
+	"fmt"
+
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// UseCase implements the list views use case.
+type UseCase struct {
+	repo Repository
+}
+
+// New creates a new UseCase.
+func New(repo Repository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// Execute returns every saved view ordered by name.
+func (uc *UseCase) Execute(ctx context.Context) ([]domainview.View, error) {
+	views, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list views: %w", err)
+	}
+
+	return views, nil
+}
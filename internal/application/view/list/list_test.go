@@ -0,0 +1,59 @@
+package list_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/application/view/list"
+	"github.com/financial-manager/api/internal/application/view/list/mocks"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	views := []domainview.View{{ID: "v-1", Name: "All"}, {ID: "v-2", Name: "Yearly"}}
+
+	tests := []struct {
+		name      string
+		repo      *mocks.Repository
+		wantErr   error
+		wantViews []domainview.View
+	}{
+		{
+			name:      "returns saved views",
+			repo:      buildMockRepo(views, nil),
+			wantViews: views,
+		},
+		{
+			name:      "returns empty list",
+			repo:      buildMockRepo([]domainview.View{}, nil),
+			wantViews: []domainview.View{},
+		},
+		{
+			name:    "repository error is wrapped and propagated",
+			repo:    buildMockRepo(nil, errors.New("db unavailable")),
+			wantErr: fmt.Errorf("list views: %w", errors.New("db unavailable")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := list.New(tc.repo).Execute(context.Background())
+
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.wantViews, got)
+			}
+			tc.repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Package mocks contains testify mock implementations for the list use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// Repository is a testify mock for the list.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// List mocks Repository.List.
+func (m *Repository) List(ctx context.Context) ([]domainview.View, error) {
+	args := m.Called(ctx)
+	views, _ := args.Get(0).([]domainview.View)
+	return views, args.Error(1)
+}
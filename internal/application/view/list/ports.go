@@ -0,0 +1,12 @@
+package list
+
+import (
+	"context"
+
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// Repository is the narrow read port required by this use case.
+type Repository interface {
+	List(ctx context.Context) ([]domainview.View, error)
+}
@@ -0,0 +1,15 @@
+package list_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/view/list/mocks"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// buildMockRepo creates a mocks.Repository returning the given views and error once.
+func buildMockRepo(views []domainview.View, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("List", mock.Anything).Return(views, err).Once()
+	return m
+}
@@ -0,0 +1,21 @@
+// Package mocks contains testify mock implementations for the resolve use case interfaces.
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// Repository is a testify mock for the resolve.Repository interface.
+type Repository struct {
+	mock.Mock
+}
+
+// GetByID mocks Repository.GetByID.
+func (m *Repository) GetByID(ctx context.Context, id string) (domainview.View, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domainview.View), args.Error(1)
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// Timezone is a testify mock for the resolve.Timezone interface.
+type Timezone struct {
+	mock.Mock
+}
+
+// Execute mocks Timezone.Execute.
+func (m *Timezone) Execute(ctx context.Context) (*time.Location, error) {
+	args := m.Called(ctx)
+	loc, _ := args.Get(0).(*time.Location)
+	return loc, args.Error(1)
+}
@@ -0,0 +1,28 @@
+package resolve
+
+import (
+	"context"
+	"time"
+
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// Repository is the narrow read port required by this use case.
+type Repository interface {
+	GetByID(ctx context.Context, id string) (domainview.View, error)
+}
+
+// Timezone is the port for the user's timezone, which decides what "today" is.
+type Timezone interface {
+	Execute(ctx context.Context) (*time.Location, error)
+}
+
+// MonthStart is the port for the day of the month months start on.
+type MonthStart interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Clock is the port for current-time access.
+type Clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,154 @@
+// Package resolve implements the resolve view use case, which turns a saved
+// view into the concrete filters of a transaction search or export.
+package resolve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/financial-manager/api/internal/application/report/period"
+	"github.com/financial-manager/api/internal/application/transaction/search"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// UseCase implements the resolve view use case.
+type UseCase struct {
+	repo       Repository
+	timezone   Timezone
+	monthStart MonthStart
+	clock      Clock
+}
+
+// New creates a new UseCase.
+func New(repo Repository, timezone Timezone, monthStart MonthStart, clock Clock) *UseCase {
+	return &UseCase{repo: repo, timezone: timezone, monthStart: monthStart, clock: clock}
+}
+
+// Output is a view with its dates resolved: StartDate and EndDate are the
+// inclusive YYYY-MM-DD bounds of its range on the current day, or its fixed
+// dates. Either may be empty when the view is not bounded on that side.
+type Output struct {
+	View      domainview.View
+	StartDate string
+	EndDate   string
+}
+
+// Execute returns the view with the given id and its resolved dates. Relative
+// ranges are resolved against today in the user's timezone; months, quarters
+// and years start on the configured month start day.
+func (uc *UseCase) Execute(ctx context.Context, id string) (Output, error) {
+	if id == "" {
+		return Output{}, errors.New("view id is required")
+	}
+
+	view, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domainshared.ErrNotFound) {
+			return Output{}, fmt.Errorf("view not found: %w", err)
+		}
+		return Output{}, fmt.Errorf("get view: %w", err)
+	}
+
+	out := Output{View: view, StartDate: view.Filter.StartDate, EndDate: view.Filter.EndDate}
+	if view.Filter.Range == "" {
+		return out, nil
+	}
+
+	loc, err := uc.timezone.Execute(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("resolve view: %w", err)
+	}
+	monthStartDay, err := uc.monthStart.Execute(ctx)
+	if err != nil {
+		return Output{}, fmt.Errorf("resolve view: %w", err)
+	}
+	now := uc.clock.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	from, to, err := resolveRange(view.Filter.Range, today, monthStartDay)
+	if err != nil {
+		return Output{}, err
+	}
+	out.StartDate = from.Format(period.DateLayout)
+	out.EndDate = to.Format(period.DateLayout)
+
+	return out, nil
+}
+
+// Search returns the search input selecting the transactions of the view on
+// the given page.
+func (o Output) Search(page domainshared.PageQuery) search.Input {
+	f := o.View.Filter
+	return search.Input{
+		Types:       f.Types,
+		AccountIDs:  f.AccountIDs,
+		CategoryIDs: f.CategoryIDs,
+		MinAmount:   f.MinAmount,
+		MaxAmount:   f.MaxAmount,
+		Query:       f.Query,
+		StartDate:   o.StartDate,
+		EndDate:     o.EndDate,
+		Page:        page,
+	}
+}
+
+// ExportFilters returns the export filters selecting the transactions of the
+// view. Exports filter on a single type, so a view of both types exports both.
+func (o Output) ExportFilters() domainexport.Filters {
+	f := o.View.Filter
+	filters := domainexport.Filters{
+		StartDate:   o.StartDate,
+		EndDate:     o.EndDate,
+		AccountIDs:  f.AccountIDs,
+		CategoryIDs: f.CategoryIDs,
+		MinAmount:   f.MinAmount,
+		MaxAmount:   f.MaxAmount,
+		Query:       f.Query,
+	}
+	if len(f.Types) == 1 {
+		filters.Type = f.Types[0]
+	}
+	return filters
+}
+
+// resolveRange returns the inclusive bounds of r on today. Current periods
+// end today and previous periods are whole.
+func resolveRange(r domainview.Range, today time.Time, monthStartDay int) (time.Time, time.Time, error) {
+	switch r {
+	case domainview.RangeLast7Days:
+		return today.AddDate(0, 0, -6), today, nil
+	case domainview.RangeLast30Days:
+		return today.AddDate(0, 0, -29), today, nil
+	case domainview.RangeLast90Days:
+		return today.AddDate(0, 0, -89), today, nil
+	case domainview.RangeThisMonth:
+		return period.Start(today, domainreport.IntervalMonth, monthStartDay), today, nil
+	case domainview.RangeThisQuarter:
+		return period.Start(today, domainreport.IntervalQuarter, monthStartDay), today, nil
+	case domainview.RangeThisYear:
+		return period.Start(today, domainreport.IntervalYear, monthStartDay), today, nil
+	case domainview.RangeLastMonth:
+		from, to := previous(today, domainreport.IntervalMonth, monthStartDay)
+		return from, to, nil
+	case domainview.RangeLastQuarter:
+		from, to := previous(today, domainreport.IntervalQuarter, monthStartDay)
+		return from, to, nil
+	case domainview.RangeLastYear:
+		from, to := previous(today, domainreport.IntervalYear, monthStartDay)
+		return from, to, nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: unknown range %q", domainview.ErrInvalidView, r)
+	}
+}
+
+// previous returns the first and last day of the interval period before the
+// one today falls in.
+func previous(today time.Time, interval domainreport.Interval, monthStartDay int) (time.Time, time.Time) {
+	to := period.Start(today, interval, monthStartDay).AddDate(0, 0, -1)
+	return period.Start(to, interval, monthStartDay), to
+}
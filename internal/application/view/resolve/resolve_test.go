@@ -0,0 +1,218 @@
+package resolve_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/application/transaction/search"
+	"github.com/financial-manager/api/internal/application/view/resolve"
+	"github.com/financial-manager/api/internal/application/view/resolve/mocks"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+func TestUseCase_Execute_Ranges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		rng           domainview.Range
+		monthStartDay int
+		wantStart     string
+		wantEnd       string
+	}{
+		{name: "last 7 days", rng: domainview.RangeLast7Days, monthStartDay: 1, wantStart: "2026-03-12", wantEnd: "2026-03-18"},
+		{name: "last 30 days", rng: domainview.RangeLast30Days, monthStartDay: 1, wantStart: "2026-02-17", wantEnd: "2026-03-18"},
+		{name: "last 90 days", rng: domainview.RangeLast90Days, monthStartDay: 1, wantStart: "2025-12-19", wantEnd: "2026-03-18"},
+		{name: "this month", rng: domainview.RangeThisMonth, monthStartDay: 1, wantStart: "2026-03-01", wantEnd: "2026-03-18"},
+		{name: "last month", rng: domainview.RangeLastMonth, monthStartDay: 1, wantStart: "2026-02-01", wantEnd: "2026-02-28"},
+		{name: "this quarter", rng: domainview.RangeThisQuarter, monthStartDay: 1, wantStart: "2026-01-01", wantEnd: "2026-03-18"},
+		{name: "last quarter", rng: domainview.RangeLastQuarter, monthStartDay: 1, wantStart: "2025-10-01", wantEnd: "2025-12-31"},
+		{name: "this year", rng: domainview.RangeThisYear, monthStartDay: 1, wantStart: "2026-01-01", wantEnd: "2026-03-18"},
+		{name: "last year", rng: domainview.RangeLastYear, monthStartDay: 1, wantStart: "2025-01-01", wantEnd: "2025-12-31"},
+		{name: "this month starting on the 25th", rng: domainview.RangeThisMonth, monthStartDay: 25, wantStart: "2026-02-25", wantEnd: "2026-03-18"},
+		{name: "last month starting on the 25th", rng: domainview.RangeLastMonth, monthStartDay: 25, wantStart: "2026-01-25", wantEnd: "2026-02-24"},
+		{name: "last quarter starting on the 25th", rng: domainview.RangeLastQuarter, monthStartDay: 25, wantStart: "2025-10-25", wantEnd: "2026-01-24"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			view := buildView(tc.rng)
+			uc := resolve.New(buildMockRepo("v-1", view, nil), buildTimezone(time.UTC, nil), buildMonthStart(tc.monthStartDay, nil), buildClock())
+
+			got, err := uc.Execute(context.Background(), "v-1")
+
+			require.NoError(t, err)
+			assert.Equal(t, resolve.Output{View: view, StartDate: tc.wantStart, EndDate: tc.wantEnd}, got)
+		})
+	}
+}
+
+func TestUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	fixed := domainview.View{ID: "v-1", Filter: domainview.Filter{StartDate: "2026-01-01", EndDate: "2026-01-31"}}
+
+	tests := []struct {
+		name       string
+		id         string
+		repo       *mocks.Repository
+		timezone   *mocks.Timezone
+		monthStart *mocks.MonthStart
+		want       resolve.Output
+		wantErr    error
+	}{
+		{
+			name:       "today is taken in the user's timezone",
+			id:         "v-1",
+			repo:       buildMockRepo("v-1", buildView(domainview.RangeLast7Days), nil),
+			timezone:   buildTimezone(madrid, nil),
+			monthStart: buildMonthStart(1, nil),
+			want:       resolve.Output{View: buildView(domainview.RangeLast7Days), StartDate: "2026-03-13", EndDate: "2026-03-19"},
+		},
+		{
+			name:       "fixed dates are kept without reading the settings",
+			id:         "v-1",
+			repo:       buildMockRepo("v-1", fixed, nil),
+			timezone:   &mocks.Timezone{},
+			monthStart: &mocks.MonthStart{},
+			want:       resolve.Output{View: fixed, StartDate: "2026-01-01", EndDate: "2026-01-31"},
+		},
+		{
+			name:       "empty id returns validation error",
+			repo:       &mocks.Repository{},
+			timezone:   &mocks.Timezone{},
+			monthStart: &mocks.MonthStart{},
+			wantErr:    errors.New("view id is required"),
+		},
+		{
+			name:       "missing view wraps ErrNotFound",
+			id:         "v-1",
+			repo:       buildMockRepo("v-1", domainview.View{}, domainshared.ErrNotFound),
+			timezone:   &mocks.Timezone{},
+			monthStart: &mocks.MonthStart{},
+			wantErr:    fmt.Errorf("view not found: %w", domainshared.ErrNotFound),
+		},
+		{
+			name:       "repository error is wrapped and propagated",
+			id:         "v-1",
+			repo:       buildMockRepo("v-1", domainview.View{}, errors.New("db unavailable")),
+			timezone:   &mocks.Timezone{},
+			monthStart: &mocks.MonthStart{},
+			wantErr:    fmt.Errorf("get view: %w", errors.New("db unavailable")),
+		},
+		{
+			name:       "timezone error is wrapped and propagated",
+			id:         "v-1",
+			repo:       buildMockRepo("v-1", buildView(domainview.RangeThisMonth), nil),
+			timezone:   buildTimezone(nil, errors.New("db unavailable")),
+			monthStart: &mocks.MonthStart{},
+			wantErr:    fmt.Errorf("resolve view: %w", errors.New("db unavailable")),
+		},
+		{
+			name:       "month start error is wrapped and propagated",
+			id:         "v-1",
+			repo:       buildMockRepo("v-1", buildView(domainview.RangeThisMonth), nil),
+			timezone:   buildTimezone(time.UTC, nil),
+			monthStart: buildMonthStart(0, errors.New("db unavailable")),
+			wantErr:    fmt.Errorf("resolve view: %w", errors.New("db unavailable")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolve.New(tc.repo, tc.timezone, tc.monthStart, buildClock()).Execute(context.Background(), tc.id)
+
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			}
+			tc.repo.AssertExpectations(t)
+			tc.timezone.AssertExpectations(t)
+			tc.monthStart.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOutput_Search(t *testing.T) {
+	t.Parallel()
+
+	out := resolve.Output{
+		View: domainview.View{Filter: domainview.Filter{
+			Types:       []string{"expense"},
+			AccountIDs:  []string{"acc-1"},
+			CategoryIDs: []string{"cat-1", "cat-2"},
+			Range:       domainview.RangeThisMonth,
+			MinAmount:   ptr(10),
+			Query:       "mercadona",
+		}},
+		StartDate: "2026-03-01",
+		EndDate:   "2026-03-18",
+	}
+	page := domainshared.PageQuery{Limit: 10}
+
+	assert.Equal(t, search.Input{
+		Types:       []string{"expense"},
+		AccountIDs:  []string{"acc-1"},
+		CategoryIDs: []string{"cat-1", "cat-2"},
+		MinAmount:   ptr(10),
+		Query:       "mercadona",
+		StartDate:   "2026-03-01",
+		EndDate:     "2026-03-18",
+		Page:        page,
+	}, out.Search(page))
+}
+
+func TestOutput_ExportFilters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		types []string
+		want  string
+	}{
+		{name: "a single type filters on it", types: []string{"income"}, want: "income"},
+		{name: "both types do not filter", types: []string{"income", "expense"}},
+		{name: "no types do not filter"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			out := resolve.Output{
+				View: domainview.View{Filter: domainview.Filter{
+					Types:      tc.types,
+					AccountIDs: []string{"acc-1"},
+					MaxAmount:  ptr(99),
+					Query:      "nómina",
+				}},
+				StartDate: "2026-01-01",
+			}
+
+			assert.Equal(t, domainexport.Filters{
+				Type:       tc.want,
+				StartDate:  "2026-01-01",
+				AccountIDs: []string{"acc-1"},
+				MaxAmount:  ptr(99),
+				Query:      "nómina",
+			}, out.ExportFilters())
+		})
+	}
+}
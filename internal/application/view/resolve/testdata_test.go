@@ -0,0 +1,50 @@
+package resolve_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/financial-manager/api/internal/application/view/resolve/mocks"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// now is 18 Mar 2026 at 23:30 UTC, already 19 Mar in Madrid.
+var now = time.Date(2026, 3, 18, 23, 30, 0, 0, time.UTC)
+
+// buildMockRepo creates a mocks.Repository whose GetByID of id returns view and err once.
+func buildMockRepo(id string, view domainview.View, err error) *mocks.Repository {
+	m := &mocks.Repository{}
+	m.On("GetByID", mock.Anything, id).Return(view, err).Once()
+	return m
+}
+
+// buildTimezone creates a mocks.Timezone returning loc and err.
+func buildTimezone(loc *time.Location, err error) *mocks.Timezone {
+	m := &mocks.Timezone{}
+	m.On("Execute", mock.Anything).Return(loc, err)
+	return m
+}
+
+// buildMonthStart creates a mocks.MonthStart returning day and err.
+func buildMonthStart(day int, err error) *mocks.MonthStart {
+	m := &mocks.MonthStart{}
+	m.On("Execute", mock.Anything).Return(day, err)
+	return m
+}
+
+// buildClock creates a mocks.Clock returning now.
+func buildClock() *mocks.Clock {
+	m := &mocks.Clock{}
+	m.On("Now").Return(now)
+	return m
+}
+
+// buildView returns a view with the given relative range.
+func buildView(r domainview.Range) domainview.View {
+	return domainview.View{ID: "v-1", Name: "Groceries", Filter: domainview.Filter{Range: r}}
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
@@ -6,3 +6,7 @@ import "errors"
 // ErrAccountHasTransactions is returned when attempting to delete an account
 // that still has associated transactions.
 var ErrAccountHasTransactions = errors.New("account has transactions and cannot be deleted")
+
+// ErrInvalidBalanceDate is returned when a historical balance is requested
+// for a date that is not formatted YYYY-MM-DD.
+var ErrInvalidBalanceDate = errors.New("date must be YYYY-MM-DD")
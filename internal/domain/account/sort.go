@@ -0,0 +1,14 @@
+// Package account contains the Account entity and its value objects.
+package account
+
+// Fields account lists can be sorted by. Ties are broken by ID.
+const (
+	SortCreatedAt = "created_at"
+	SortName      = "name"
+	SortBalance   = "current_balance"
+)
+
+// SortFields returns every field account lists can be sorted by.
+func SortFields() []string {
+	return []string{SortCreatedAt, SortName, SortBalance}
+}
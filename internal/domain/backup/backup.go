@@ -0,0 +1,23 @@
+// Package backup contains the value objects used by backup archives and scheduled backups.
+package backup
+
+// Snapshot is a consistent copy of one application database written to disk.
+type Snapshot struct {
+	// Name is the database filename, e.g. "accounts.db".
+	Name string
+	// Path is where the copy was written.
+	Path string
+	// Migrations lists the IDs recorded in the database's schema_migrations table, in order.
+	Migrations []string
+}
+
+// Retention decides which scheduled backups are kept. A backup survives
+// pruning if any rule keeps it; when every rule is zero nothing is pruned.
+type Retention struct {
+	// KeepLast keeps the most recent N backups.
+	KeepLast int
+	// KeepDaily keeps the newest backup of each of the last N days that have one.
+	KeepDaily int
+	// KeepMonthly keeps the newest backup of each of the last N months that have one.
+	KeepMonthly int
+}
@@ -0,0 +1,7 @@
+// Package backup contains the value objects used by backup archives and scheduled backups.
+package backup
+
+import "errors"
+
+// ErrInvalidArchive is returned when a backup archive is malformed, incomplete or fails its checksums.
+var ErrInvalidArchive = errors.New("invalid backup archive")
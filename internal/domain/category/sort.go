@@ -0,0 +1,13 @@
+// Package category contains the Category entity and its value objects.
+package category
+
+// Fields category lists can be sorted by. Ties are broken by ID.
+const (
+	SortCreatedAt = "created_at"
+	SortName      = "name"
+)
+
+// SortFields returns every field category lists can be sorted by.
+func SortFields() []string {
+	return []string{SortCreatedAt, SortName}
+}
@@ -0,0 +1,11 @@
+// Package export contains the export options and the saved export Preset entity.
+package export
+
+import "errors"
+
+var (
+	// ErrInvalidOptions is returned when export filters or CSV options are not valid.
+	ErrInvalidOptions = errors.New("invalid export options")
+	// ErrPresetNameTaken is returned when a preset with the same name already exists.
+	ErrPresetNameTaken = errors.New("export preset name already exists")
+)
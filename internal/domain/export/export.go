@@ -0,0 +1,73 @@
+// Package export contains the export options and the saved export Preset entity.
+package export
+
+import "time"
+
+type (
+	// Column identifies a column of the CSV export.
+	Column string
+
+	// Filters narrows the transactions included in an export. Empty fields and
+	// nil amounts do not filter.
+	Filters struct {
+		Type       string // "income", "expense", or empty for all
+		StartDate  string
+		EndDate    string
+		AccountID  string
+		CategoryID string
+		MinAmount  *float64
+		MaxAmount  *float64
+		// AccountIDs and CategoryIDs match any of their IDs, on top of
+		// AccountID and CategoryID.
+		AccountIDs  []string
+		CategoryIDs []string
+		// Query matches descriptions containing it, ignoring case.
+		Query string
+	}
+
+	// CSVOptions controls the layout of the CSV export. Zero values select the
+	// default layout: every default column, comma delimiter, dot decimals,
+	// YYYY-MM-DD dates, no BOM and absolute amounts.
+	CSVOptions struct {
+		Filters
+		Columns          []Column
+		Delimiter        string
+		DecimalSeparator string
+		DateFormat       string
+		BOM              bool
+		SignedAmounts    bool
+	}
+
+	// Preset is a named, saved set of CSV export options.
+	Preset struct {
+		ID        string
+		Name      string
+		Options   CSVOptions
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+)
+
+const (
+	// ColumnID is the transaction identifier.
+	ColumnID Column = "id"
+	// ColumnDate is the transaction date.
+	ColumnDate Column = "date"
+	// ColumnType is the transaction type, income or expense.
+	ColumnType Column = "type"
+	// ColumnAmount is the transaction amount.
+	ColumnAmount Column = "amount"
+	// ColumnCurrency is the currency of the transaction's account.
+	ColumnCurrency Column = "currency"
+	// ColumnCategory is the category name.
+	ColumnCategory Column = "category"
+	// ColumnAccount is the account name.
+	ColumnAccount Column = "account"
+	// ColumnDescription is the transaction description.
+	ColumnDescription Column = "description"
+)
+
+// DefaultColumns returns the column layout used when CSVOptions.Columns is empty.
+func DefaultColumns() []Column {
+	return []Column{ColumnDate, ColumnType, ColumnAmount, ColumnCategory, ColumnAccount, ColumnDescription}
+}
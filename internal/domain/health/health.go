@@ -12,6 +12,8 @@ type (
 		Status    Status
 		Timestamp time.Time
 		Version   string
+		// LastBackup is when the last scheduled backup succeeded; zero if none is known.
+		LastBackup time.Time
 	}
 )
 
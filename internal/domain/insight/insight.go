@@ -0,0 +1,40 @@
+// Package insight contains the Anomaly entity raised for unusual transactions.
+package insight
+
+import "time"
+
+type (
+	// ReasonCode identifies why a transaction was flagged as unusual.
+	ReasonCode string
+
+	// Reason explains one of the signals that flagged a transaction.
+	Reason struct {
+		Code    ReasonCode
+		Message string
+	}
+
+	// Anomaly is an expense flagged as unusual against the history of its
+	// category and payee. Score is normalised so that 1 is the flagging
+	// threshold of the strongest signal; the transaction fields are those
+	// of the flagged transaction at the time it is read.
+	Anomaly struct {
+		TransactionID string
+		AccountID     string
+		CategoryID    string
+		Amount        float64
+		Description   string
+		Date          time.Time
+		Score         float64
+		Reasons       []Reason
+		CreatedAt     time.Time
+	}
+)
+
+const (
+	// ReasonUnusualAmount flags an amount far above the usual for its category.
+	ReasonUnusualAmount ReasonCode = "unusual_amount"
+	// ReasonUnusualFrequency flags a payee charged much more often than usual.
+	ReasonUnusualFrequency ReasonCode = "unusual_frequency"
+	// ReasonNewPayee flags the first expense seen for a payee.
+	ReasonNewPayee ReasonCode = "new_payee"
+)
@@ -0,0 +1,12 @@
+// Package report contains the value objects shared by the reporting use cases.
+package report
+
+import "errors"
+
+var (
+	// ErrInvalidQuery is returned when report parameters are missing or malformed.
+	ErrInvalidQuery = errors.New("invalid report query")
+	// ErrNoExchangeRate is returned when an amount cannot be converted to the
+	// report currency because no exchange rate is known.
+	ErrNoExchangeRate = errors.New("no exchange rate")
+)
@@ -0,0 +1,64 @@
+// Package report contains the value objects shared by the reporting use cases.
+package report
+
+import "time"
+
+type (
+	// Interval is the length of the periods a report is split into.
+	Interval string
+
+	// GroupBy selects the dimension a report breaks each period down by.
+	GroupBy string
+
+	// Range is the span a report covers, split into Interval periods. From
+	// and To are inclusive calendar dates. Months, quarters and years start
+	// on MonthStartDay of their first month; zero means the first.
+	Range struct {
+		From          time.Time
+		To            time.Time
+		Interval      Interval
+		MonthStartDay int
+	}
+
+	// CashFlowQuery selects the transactions aggregated by the cash-flow report.
+	CashFlowQuery struct {
+		Range
+		GroupBy GroupBy
+	}
+
+	// CashFlowRow is the income and expense total of one period, and of one
+	// group within it when the query is grouped.
+	CashFlowRow struct {
+		PeriodStart time.Time
+		GroupID     string
+		Income      float64
+		Expense     float64
+	}
+
+	// BalanceChange is the net amount, income minus expense, that the
+	// transactions of one period added to an account. A zero PeriodStart
+	// holds the change accumulated before the start of the range.
+	BalanceChange struct {
+		AccountID   string
+		PeriodStart time.Time
+		Amount      float64
+	}
+)
+
+const (
+	// IntervalWeek splits a report into ISO weeks starting on Monday.
+	IntervalWeek Interval = "week"
+	// IntervalMonth splits a report into months.
+	IntervalMonth Interval = "month"
+	// IntervalQuarter splits a report into quarters.
+	IntervalQuarter Interval = "quarter"
+	// IntervalYear splits a report into years.
+	IntervalYear Interval = "year"
+
+	// GroupByNone reports totals only.
+	GroupByNone GroupBy = ""
+	// GroupByAccount breaks each period down by account.
+	GroupByAccount GroupBy = "account"
+	// GroupByCategory breaks each period down by category.
+	GroupByCategory GroupBy = "category"
+)
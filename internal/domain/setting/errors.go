@@ -0,0 +1,11 @@
+// Package setting contains the keys of the application settings.
+package setting
+
+import "errors"
+
+var (
+	// ErrInvalidTimezone is returned when a timezone is not a known IANA name.
+	ErrInvalidTimezone = errors.New("invalid timezone")
+	// ErrInvalidMonthStartDay is returned when a month start day is outside 1..28.
+	ErrInvalidMonthStartDay = errors.New("month start day must be between 1 and 28")
+)
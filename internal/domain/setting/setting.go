@@ -0,0 +1,16 @@
+// Package setting contains the keys of the application settings.
+package setting
+
+const (
+	// KeyTimezone is the IANA timezone used to resolve "today" and the period
+	// boundaries for the user, e.g. "America/Bogota".
+	KeyTimezone = "timezone"
+	// KeyMonthStartDay is the day of the month on which months start for
+	// the period reports, e.g. 25 when the salary arrives on the 25th.
+	KeyMonthStartDay = "month_start_day"
+
+	// DefaultMonthStartDay keeps calendar months.
+	DefaultMonthStartDay = 1
+	// MaxMonthStartDay is the last day every month has, so every month has a start.
+	MaxMonthStartDay = 28
+)
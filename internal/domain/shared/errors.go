@@ -5,3 +5,8 @@ import "errors"
 
 // ErrNotFound is returned when a requested resource does not exist.
 var ErrNotFound = errors.New("not found")
+
+// ErrInvalidPage is returned when a page request has an unknown sort field or
+// direction, a negative limit, or a cursor that is malformed or was issued for
+// a different sort.
+var ErrInvalidPage = errors.New("invalid page request")
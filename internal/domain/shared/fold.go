@@ -0,0 +1,18 @@
+package shared
+
+import "strings"
+
+// FoldAccents replaces the accented letters found in Spanish text with their
+// ASCII base letter.
+func FoldAccents(s string) string {
+	return accentFolder().Replace(s)
+}
+
+// accentFolder builds the replacer that maps each accented letter to its base
+// letter.
+func accentFolder() *strings.Replacer {
+	return strings.NewReplacer(
+		"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n",
+		"Á", "A", "É", "E", "Í", "I", "Ó", "O", "Ú", "U", "Ü", "U", "Ñ", "N",
+	)
+}
@@ -0,0 +1,28 @@
+package shared_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/domain/shared"
+)
+
+func TestFoldAccents(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "lowercase", in: "añejo café pingüino", want: "anejo cafe pinguino"},
+		{name: "uppercase", in: "ÁRBOL ÑANDÚ", want: "ARBOL NANDU"},
+		{name: "plain ascii", in: "Groceries 42", want: "Groceries 42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, shared.FoldAccents(tt.in))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Package shared contains domain-level errors and types shared across all domain packages.
+package shared
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// SortDirection orders a list ascending or descending.
+type SortDirection string
+
+const (
+	// SortAsc orders a list from the lowest value up.
+	SortAsc SortDirection = "asc"
+	// SortDesc orders a list from the highest value down.
+	SortDesc SortDirection = "desc"
+)
+
+const (
+	// DefaultPageLimit is the page size used when a request sets none.
+	DefaultPageLimit = 50
+	// MaxPageLimit caps the page size a request may ask for.
+	MaxPageLimit = 500
+)
+
+// PageQuery selects one page of a keyset-paginated list ordered by Sort and
+// then by ID. Cursor is the NextCursor of the previous page, or empty for the
+// first page.
+type PageQuery struct {
+	Limit     int
+	Cursor    string
+	Sort      string
+	Direction SortDirection
+}
+
+// Normalize returns q with defaults applied: defaultSort and defaultDirection
+// when unset and DefaultPageLimit for a zero limit, capped at MaxPageLimit.
+// It returns an error wrapping ErrInvalidPage when the sort is not one of
+// allowed, the direction is unknown or the limit is negative.
+func (q PageQuery) Normalize(defaultSort string, defaultDirection SortDirection, allowed ...string) (PageQuery, error) {
+	if q.Sort == "" {
+		q.Sort = defaultSort
+	}
+	if !slices.Contains(allowed, q.Sort) {
+		return PageQuery{}, fmt.Errorf("%w: sort must be one of %v", ErrInvalidPage, allowed)
+	}
+
+	switch q.Direction {
+	case "":
+		q.Direction = defaultDirection
+	case SortAsc, SortDesc:
+	default:
+		return PageQuery{}, fmt.Errorf("%w: order must be 'asc' or 'desc'", ErrInvalidPage)
+	}
+
+	switch {
+	case q.Limit < 0:
+		return PageQuery{}, fmt.Errorf("%w: limit must not be negative", ErrInvalidPage)
+	case q.Limit == 0:
+		q.Limit = DefaultPageLimit
+	case q.Limit > MaxPageLimit:
+		q.Limit = MaxPageLimit
+	}
+
+	return q, nil
+}
+
+// Cursor is the position a page starts after: the sort value and ID of the
+// last item of the previous page, along with the ordering it was read in.
+type Cursor struct {
+	Sort      string        `json:"s"`
+	Direction SortDirection `json:"d"`
+	Value     any           `json:"v"`
+	ID        string        `json:"id"`
+}
+
+// Encode returns the opaque string form of c handed out as a next cursor.
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// CursorValue reports whether v, decoded from JSON, is a valid cursor value
+// for a sort field.
+type CursorValue func(v any) bool
+
+// NumberValue accepts the cursor values of numeric sort fields.
+func NumberValue(v any) bool {
+	_, ok := v.(float64)
+	return ok
+}
+
+// TextValue accepts the cursor values of text sort fields.
+func TextValue(v any) bool {
+	_, ok := v.(string)
+	return ok
+}
+
+// TimeValue returns a CursorValue accepting the strings formatted with layout.
+func TimeValue(layout string) CursorValue {
+	return func(v any) bool {
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		_, err := time.Parse(layout, s)
+		return err == nil
+	}
+}
+
+// DecodeCursor parses the cursor of q. It reports false for the first page and
+// returns an error wrapping ErrInvalidPage when the cursor is malformed, was
+// issued for another sort or direction, or its value is rejected by valid.
+func DecodeCursor(q PageQuery, valid CursorValue) (Cursor, bool, error) {
+	if q.Cursor == "" {
+		return Cursor{}, false, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(q.Cursor)
+	if err != nil {
+		return Cursor{}, false, fmt.Errorf("%w: malformed cursor", ErrInvalidPage)
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" || c.Value == nil {
+		return Cursor{}, false, fmt.Errorf("%w: malformed cursor", ErrInvalidPage)
+	}
+	if c.Sort != q.Sort || c.Direction != q.Direction {
+		return Cursor{}, false, fmt.Errorf("%w: cursor was issued for another sort order", ErrInvalidPage)
+	}
+	if !valid(c.Value) {
+		return Cursor{}, false, fmt.Errorf("%w: malformed cursor", ErrInvalidPage)
+	}
+
+	return c, true, nil
+}
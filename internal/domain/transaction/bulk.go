@@ -0,0 +1,79 @@
+// Package transaction contains the Transaction entity and its value objects.
+package transaction
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// BulkAction is the change a bulk operation makes.
+	BulkAction string
+
+	// BulkOperation is one change of a bulk request. Create carries the
+	// whole new transaction. Update carries the ID and the new category,
+	// amount, description and date, where zero values keep the current ones.
+	// Delete carries the ID, and Recategorize the ID and the new category.
+	// Updates carry their UpdatedAt.
+	BulkOperation struct {
+		Action      BulkAction
+		Transaction Transaction
+	}
+
+	// BulkResult is the outcome of an applied bulk request. Transactions
+	// holds the transaction of each operation in its state after the
+	// request. Balances holds, per account ID, the net amount the request
+	// added to the account balance; accounts whose changes cancelled out are
+	// left out.
+	BulkResult struct {
+		Transactions []Transaction
+		Balances     map[string]float64
+	}
+
+	// BulkItemError is the error of the operation at Index of a bulk request.
+	BulkItemError struct {
+		Index int
+		Err   error
+	}
+
+	// BulkError reports every failed operation of a bulk request, none of
+	// which was applied. It wraps ErrInvalidBulk.
+	BulkError struct {
+		Items []BulkItemError
+	}
+)
+
+const (
+	// BulkCreate creates a transaction.
+	BulkCreate BulkAction = "create"
+	// BulkUpdate updates the category, amount, description or date of a transaction.
+	BulkUpdate BulkAction = "update"
+	// BulkDelete soft-deletes a transaction.
+	BulkDelete BulkAction = "delete"
+	// BulkRecategorize moves a transaction to another category.
+	BulkRecategorize BulkAction = "recategorize"
+)
+
+// Error implements error.
+func (e BulkItemError) Error() string {
+	return fmt.Sprintf("operation %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error of the operation.
+func (e BulkItemError) Unwrap() error {
+	return e.Err
+}
+
+// Error implements error.
+func (e *BulkError) Error() string {
+	msgs := make([]string, len(e.Items))
+	for i, item := range e.Items {
+		msgs[i] = item.Error()
+	}
+	return fmt.Sprintf("%v: %s", ErrInvalidBulk, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns ErrInvalidBulk.
+func (e *BulkError) Unwrap() error {
+	return ErrInvalidBulk
+}
@@ -8,3 +8,6 @@ var ErrAccountNotFound = errors.New("account not found")
 var ErrCategoryNotFound = errors.New("category not found")
 var ErrInvalidAmount = errors.New("amount must be positive")
 var ErrInsufficientBalance = errors.New("insufficient balance in account")
+var ErrInvalidSearch = errors.New("invalid search")
+var ErrInvalidBulk = errors.New("invalid bulk request")
+var ErrInvalidResolve = errors.New("invalid duplicate resolution")
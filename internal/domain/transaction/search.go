@@ -0,0 +1,30 @@
+// Package transaction contains the Transaction entity and its value objects.
+package transaction
+
+// SearchFilter selects the transactions of a search. Each filter narrows the
+// result and empty filters match every transaction; values within a list
+// filter are alternatives. Dates are inclusive YYYY-MM-DD and amounts are
+// inclusive bounds.
+type SearchFilter struct {
+	Types       []TransactionType
+	AccountIDs  []string
+	CategoryIDs []string
+	MinAmount   *float64
+	MaxAmount   *float64
+	// Query matches transactions whose description contains it, ignoring case.
+	Query     string
+	StartDate string
+	EndDate   string
+	// IncludeDeleted also matches soft-deleted transactions.
+	IncludeDeleted bool
+}
+
+// SearchHit is a transaction matching a full-text query.
+type SearchHit struct {
+	Transaction Transaction
+	// Snippet is an HTML-escaped excerpt of the description around the
+	// match, with the matched terms wrapped in <mark> elements.
+	Snippet string
+	// Rank orders hits by relevance; lower ranks are more relevant.
+	Rank float64
+}
@@ -0,0 +1,14 @@
+// Package transaction contains the Transaction entity and its value objects.
+package transaction
+
+// Fields transaction lists can be sorted by. Ties are broken by ID.
+const (
+	SortDate      = "date"
+	SortAmount    = "amount"
+	SortCreatedAt = "created_at"
+)
+
+// SortFields returns every field transaction lists can be sorted by.
+func SortFields() []string {
+	return []string{SortDate, SortAmount, SortCreatedAt}
+}
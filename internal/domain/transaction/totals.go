@@ -0,0 +1,37 @@
+// Package transaction contains the Transaction entity and its value objects.
+package transaction
+
+type (
+	// TotalsGroupBy selects the dimension the type totals of an aggregate
+	// query are broken down by.
+	TotalsGroupBy string
+
+	// TotalsQuery selects the active transactions summed by an aggregate
+	// query. Empty filters match every transaction; dates are inclusive
+	// YYYY-MM-DD.
+	TotalsQuery struct {
+		AccountID  string
+		CategoryID string
+		StartDate  string
+		EndDate    string
+		GroupBy    TotalsGroupBy
+	}
+
+	// Total is the summed amount and the number of the transactions of one
+	// type, and of one group within it when the query is grouped.
+	Total struct {
+		Type    TransactionType
+		GroupID string
+		Amount  float64
+		Count   int
+	}
+)
+
+const (
+	// TotalsByType sums each transaction type as a whole.
+	TotalsByType TotalsGroupBy = ""
+	// TotalsByCategory breaks each type down by category.
+	TotalsByCategory TotalsGroupBy = "category"
+	// TotalsByAccount breaks each type down by account.
+	TotalsByAccount TotalsGroupBy = "account"
+)
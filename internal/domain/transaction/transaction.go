@@ -28,3 +28,12 @@ const (
 	// TransactionTypeExpense represents an expense transaction.
 	TransactionTypeExpense TransactionType = "expense"
 )
+
+// SignedAmount returns the amount t adds to its account balance: positive
+// for incomes and negative for expenses.
+func (t Transaction) SignedAmount() float64 {
+	if t.Type == TransactionTypeIncome {
+		return t.Amount
+	}
+	return -t.Amount
+}
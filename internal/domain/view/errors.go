@@ -0,0 +1,11 @@
+// Package view contains the saved transaction View entity.
+package view
+
+import "errors"
+
+var (
+	// ErrInvalidView is returned when the name or the filter of a view is not valid.
+	ErrInvalidView = errors.New("invalid view")
+	// ErrViewNameTaken is returned when a view with the same name already exists.
+	ErrViewNameTaken = errors.New("view name already exists")
+)
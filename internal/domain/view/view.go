@@ -0,0 +1,65 @@
+// Package view contains the saved transaction View entity.
+package view
+
+import "time"
+
+type (
+	// Range is a date range relative to today, resolved each time a view is
+	// used so the view keeps following the calendar.
+	Range string
+
+	// Filter is the combination of transaction filters a view saves. Empty
+	// fields and nil amounts do not filter; values within a list filter are
+	// alternatives. A view is dated either by a relative Range or by fixed
+	// inclusive StartDate and EndDate YYYY-MM-DD dates, never both.
+	Filter struct {
+		Types       []string // "income" and/or "expense"
+		AccountIDs  []string
+		CategoryIDs []string
+		Range       Range
+		StartDate   string
+		EndDate     string
+		MinAmount   *float64
+		MaxAmount   *float64
+		// Query matches transactions whose description contains it, ignoring case.
+		Query string
+	}
+
+	// View is a named, saved transaction filter.
+	View struct {
+		ID        string
+		Name      string
+		Filter    Filter
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+)
+
+const (
+	// RangeLast7Days is the last seven days, including today.
+	RangeLast7Days Range = "last_7_days"
+	// RangeLast30Days is the last thirty days, including today.
+	RangeLast30Days Range = "last_30_days"
+	// RangeLast90Days is the last ninety days, including today.
+	RangeLast90Days Range = "last_90_days"
+	// RangeThisMonth is the current month up to today.
+	RangeThisMonth Range = "this_month"
+	// RangeLastMonth is the whole month before the current one.
+	RangeLastMonth Range = "last_month"
+	// RangeThisQuarter is the current quarter up to today.
+	RangeThisQuarter Range = "this_quarter"
+	// RangeLastQuarter is the whole quarter before the current one.
+	RangeLastQuarter Range = "last_quarter"
+	// RangeThisYear is the current year up to today.
+	RangeThisYear Range = "this_year"
+	// RangeLastYear is the whole year before the current one.
+	RangeLastYear Range = "last_year"
+)
+
+// Ranges lists every supported Range.
+var Ranges = []Range{
+	RangeLast7Days, RangeLast30Days, RangeLast90Days,
+	RangeThisMonth, RangeLastMonth,
+	RangeThisQuarter, RangeLastQuarter,
+	RangeThisYear, RangeLastYear,
+}
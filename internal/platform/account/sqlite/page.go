@@ -0,0 +1,31 @@
+package sqlite
+
+import (
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
+)
+
+// sortKeys returns the keyset sort key of each account sort field.
+func sortKeys() map[string]dbsqlite.SortKey {
+	return map[string]dbsqlite.SortKey{
+		domainaccount.SortCreatedAt: {Column: "created_at", Value: domainshared.TimeValue(timeLayout)},
+		domainaccount.SortName:      {Column: "name COLLATE NOCASE", Value: domainshared.TextValue},
+		domainaccount.SortBalance:   {Column: "current_balance", Value: domainshared.NumberValue},
+	}
+}
+
+// cursorOf returns a function giving the sort value and ID of an account
+// for a cursor of page.
+func cursorOf(page domainshared.PageQuery) func(domainaccount.Account) (any, string) {
+	return func(a domainaccount.Account) (any, string) {
+		switch page.Sort {
+		case domainaccount.SortName:
+			return a.Name, a.ID
+		case domainaccount.SortBalance:
+			return a.CurrentBalance, a.ID
+		default:
+			return a.CreatedAt.UTC().Format(timeLayout), a.ID
+		}
+	}
+}
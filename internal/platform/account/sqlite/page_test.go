@@ -0,0 +1,136 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	accountsqlite "github.com/financial-manager/api/internal/platform/account/sqlite"
+)
+
+// seedPagedAccounts creates four active accounts, whose names differ in case
+// and whose balances tie, and one inactive account.
+func seedPagedAccounts(t *testing.T) *accountsqlite.AccountRepository {
+	t.Helper()
+	repo := accountsqlite.NewAccountRepository(newTestDB(t))
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	for i, f := range []struct {
+		id, name string
+		balance  float64
+		active   bool
+	}{
+		{"a1", "savings", 300, true},
+		{"a2", "Bank", 100, true},
+		{"a3", "cash", 300, true},
+		{"a4", "Wallet", 50, true},
+		{"a5", "Closed", 999, false},
+	} {
+		acc := buildTestAccount(f.id, f.name)
+		acc.CurrentBalance = f.balance
+		acc.IsActive = f.active
+		acc.CreatedAt = created.Add(time.Duration(i) * time.Hour)
+		acc.UpdatedAt = acc.CreatedAt
+		require.NoError(t, repo.Create(context.Background(), acc))
+	}
+	return repo
+}
+
+func TestAccountRepository_ListPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		sort      string
+		direction domainshared.SortDirection
+		want      []string
+	}{
+		{
+			name:      "created_at ascending",
+			sort:      domainaccount.SortCreatedAt,
+			direction: domainshared.SortAsc,
+			want:      []string{"a1", "a2", "a3", "a4"},
+		},
+		{
+			name:      "name ascending ignores case",
+			sort:      domainaccount.SortName,
+			direction: domainshared.SortAsc,
+			want:      []string{"a2", "a3", "a1", "a4"},
+		},
+		{
+			name:      "balance descending",
+			sort:      domainaccount.SortBalance,
+			direction: domainshared.SortDesc,
+			want:      []string{"a3", "a1", "a2", "a4"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := seedPagedAccounts(t)
+			page := domainshared.PageQuery{Limit: 3, Sort: tc.sort, Direction: tc.direction}
+
+			first, next, err := repo.ListPage(context.Background(), page)
+			require.NoError(t, err)
+			require.Len(t, first, 3)
+			require.NotEmpty(t, next)
+
+			page.Cursor = next
+			second, next, err := repo.ListPage(context.Background(), page)
+			require.NoError(t, err)
+			assert.Empty(t, next)
+
+			var got []string
+			for _, acc := range append(first, second...) {
+				got = append(got, acc.ID)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAccountRepository_ListPage_InvalidCursor(t *testing.T) {
+	t.Parallel()
+	repo := seedPagedAccounts(t)
+
+	after := func(sort string, value any) domainshared.PageQuery {
+		cursor := domainshared.Cursor{Sort: sort, Direction: domainshared.SortAsc, Value: value, ID: "x"}
+		return domainshared.PageQuery{Limit: 2, Cursor: cursor.Encode(), Sort: sort, Direction: domainshared.SortAsc}
+	}
+
+	tests := []struct {
+		name string
+		page domainshared.PageQuery
+	}{
+		{name: "malformed cursor", page: domainshared.PageQuery{Limit: 2, Cursor: "%%%", Sort: domainaccount.SortName, Direction: domainshared.SortAsc}},
+		{name: "number value for a name sort", page: after(domainaccount.SortName, 42)},
+		{name: "created_at value that is not a time", page: after(domainaccount.SortCreatedAt, "yesterday")},
+		{name: "text value for a balance sort", page: after(domainaccount.SortBalance, "100")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := repo.ListPage(context.Background(), tc.page)
+			assert.ErrorIs(t, err, domainshared.ErrInvalidPage)
+		})
+	}
+}
+
+func TestAccountRepository_ListPage_QueryError(t *testing.T) {
+	t.Parallel()
+	db, err := sql.Open("sqlite", "file:?mode=invalid")
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := accountsqlite.NewAccountRepository(db)
+	_, _, err = repo.ListPage(context.Background(), domainshared.PageQuery{
+		Limit: 2, Sort: domainaccount.SortName, Direction: domainshared.SortAsc,
+	})
+	require.Error(t, err)
+}
@@ -6,10 +6,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"log"
+	"sort"
 	"time"
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
 )
 
 const timeLayout = "2006-01-02T15:04:05Z"
@@ -96,6 +99,45 @@ func (r *AccountRepository) List(ctx context.Context) ([]domainaccount.Account,
 	return accounts, nil
 }
 
+// ListPage returns one page of active accounts, ordered by the page's sort
+// field and then by ID, and the cursor of the next page, or "" on the last one.
+func (r *AccountRepository) ListPage(ctx context.Context, page domainshared.PageQuery) ([]domainaccount.Account, string, error) {
+	q := `SELECT id, name, type, initial_balance, current_balance, currency, color, icon, is_active, created_at, updated_at
+		FROM accounts WHERE is_active = 1`
+
+	after, args, orderBy, err := dbsqlite.Keyset(page, sortKeys())
+	if err != nil {
+		return nil, "", fmt.Errorf("account sqlite: list page: %w", err)
+	}
+	if after != "" {
+		q += " AND " + after
+	}
+	q += " ORDER BY " + orderBy + " LIMIT ?"
+	args = append(args, dbsqlite.PageLimit(page))
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("account sqlite: list page: %w", err)
+	}
+	defer rows.Close()
+
+	accounts := make([]domainaccount.Account, 0)
+	for rows.Next() {
+		acc, err := scanAccount(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("account sqlite: list page scan: %w", err)
+		}
+		accounts = append(accounts, acc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("account sqlite: list page rows: %w", err)
+	}
+
+	accounts, next := dbsqlite.NextCursor(page, accounts, cursorOf(page))
+	return accounts, next, nil
+}
+
 // Update modifies name, color, icon, and updated_at for an existing account.
 // Type, initial_balance, and current_balance are immutable via this method.
 func (r *AccountRepository) Update(ctx context.Context, a domainaccount.Account) error {
@@ -125,6 +167,46 @@ func (r *AccountRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// AdjustBalances adds each delta to the current balance of the account it is
+// keyed by, in a single database transaction. Zero deltas are skipped and
+// accounts are written in ID order.
+func (r *AccountRepository) AdjustBalances(ctx context.Context, deltas map[string]float64) error {
+	ids := make([]string, 0, len(deltas))
+	for id, delta := range deltas {
+		if delta != 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	sort.Strings(ids)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("account sqlite: begin: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("rollback: %v", err)
+		}
+	}()
+
+	const q = `UPDATE accounts SET current_balance = current_balance + ?, updated_at = ? WHERE id = ?`
+	now := time.Now().UTC().Format(timeLayout)
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, q, deltas[id], now, id); err != nil {
+			return fmt.Errorf("account sqlite: adjust balance: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("account sqlite: commit: %w", err)
+	}
+
+	return nil
+}
+
 // HasTransactions checks if the account has any active transactions.
 func (r *AccountRepository) HasTransactions(ctx context.Context, id string) (bool, error) {
 	const q = `SELECT EXISTS(SELECT 1 FROM transactions WHERE account_id = ? AND is_active = 1 LIMIT 1)`
@@ -112,6 +112,38 @@ func TestAccountRepository_Delete_SoftDeleteOnly(t *testing.T) {
 	assert.Empty(t, accounts)
 }
 
+func TestAccountRepository_AdjustBalances(t *testing.T) {
+	t.Parallel()
+	repo := accountsqlite.NewAccountRepository(newTestDB(t))
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, buildTestAccount("acc-1", "Cash")))
+	require.NoError(t, repo.Create(ctx, buildTestAccount("acc-2", "Bank")))
+
+	require.NoError(t, repo.AdjustBalances(ctx, map[string]float64{"acc-1": 50, "acc-2": -30.5}))
+	require.NoError(t, repo.AdjustBalances(ctx, map[string]float64{"acc-1": -20, "acc-2": 0}))
+
+	got, err := repo.GetByID(ctx, "acc-1")
+	require.NoError(t, err)
+	assert.InDelta(t, 130.0, got.CurrentBalance, 0.001)
+	assert.InDelta(t, 100.0, got.InitialBalance, 0.001)
+
+	got, err = repo.GetByID(ctx, "acc-2")
+	require.NoError(t, err)
+	assert.InDelta(t, 69.5, got.CurrentBalance, 0.001)
+}
+
+func TestAccountRepository_AdjustBalances_QueryError(t *testing.T) {
+	t.Parallel()
+	db, err := sql.Open("sqlite", "file:?mode=invalid")
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := accountsqlite.NewAccountRepository(db)
+	err = repo.AdjustBalances(context.Background(), map[string]float64{"test": 10})
+	require.Error(t, err)
+}
+
 func TestAccountRepository_HasTransactions_ReturnsFalse(t *testing.T) {
 	t.Parallel()
 	repo := accountsqlite.NewAccountRepository(newTestDB(t))
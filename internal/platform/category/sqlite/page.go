@@ -0,0 +1,26 @@
+package sqlite
+
+import (
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
+)
+
+// sortKeys returns the keyset sort key of each category sort field.
+func sortKeys() map[string]dbsqlite.SortKey {
+	return map[string]dbsqlite.SortKey{
+		domaincategory.SortCreatedAt: {Column: "created_at", Value: domainshared.TimeValue(timeLayout)},
+		domaincategory.SortName:      {Column: "name COLLATE NOCASE", Value: domainshared.TextValue},
+	}
+}
+
+// cursorOf returns a function giving the sort value and ID of a category
+// for a cursor of page.
+func cursorOf(page domainshared.PageQuery) func(domaincategory.Category) (any, string) {
+	return func(c domaincategory.Category) (any, string) {
+		if page.Sort == domaincategory.SortName {
+			return c.Name, c.ID
+		}
+		return c.CreatedAt.UTC().Format(timeLayout), c.ID
+	}
+}
@@ -0,0 +1,143 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	categorysqlite "github.com/financial-manager/api/internal/platform/category/sqlite"
+)
+
+// seedPagedCategories creates four active expense categories, whose names
+// differ in case, one income category and one inactive expense category.
+func seedPagedCategories(t *testing.T) *categorysqlite.CategoryRepository {
+	t.Helper()
+	repo := categorysqlite.NewCategoryRepository(newTestDB(t))
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	for i, f := range []struct {
+		id, name string
+		catType  domaincategory.Type
+		active   bool
+	}{
+		{"c1", "rent", domaincategory.TypeExpense, true},
+		{"c2", "Food", domaincategory.TypeExpense, true},
+		{"c3", "health", domaincategory.TypeExpense, true},
+		{"c4", "Transport", domaincategory.TypeExpense, true},
+		{"c5", "Salary", domaincategory.TypeIncome, true},
+		{"c6", "Archived", domaincategory.TypeExpense, false},
+	} {
+		cat := buildTestCategory(f.id, f.name)
+		cat.Type = f.catType
+		cat.IsActive = f.active
+		cat.CreatedAt = created.Add(time.Duration(i) * time.Hour)
+		cat.UpdatedAt = cat.CreatedAt
+		require.NoError(t, repo.Create(context.Background(), cat))
+	}
+	return repo
+}
+
+func TestCategoryRepository_ListPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		sort      string
+		direction domainshared.SortDirection
+		want      []string
+	}{
+		{
+			name:      "created_at descending",
+			sort:      domaincategory.SortCreatedAt,
+			direction: domainshared.SortDesc,
+			want:      []string{"c4", "c3", "c2", "c1"},
+		},
+		{
+			name:      "name ascending ignores case",
+			sort:      domaincategory.SortName,
+			direction: domainshared.SortAsc,
+			want:      []string{"c2", "c3", "c1", "c4"},
+		},
+	}
+
+	expense := domaincategory.TypeExpense
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := seedPagedCategories(t)
+			page := domainshared.PageQuery{Limit: 3, Sort: tc.sort, Direction: tc.direction}
+
+			first, next, err := repo.ListPage(context.Background(), &expense, page)
+			require.NoError(t, err)
+			require.Len(t, first, 3)
+			require.NotEmpty(t, next)
+
+			page.Cursor = next
+			second, next, err := repo.ListPage(context.Background(), &expense, page)
+			require.NoError(t, err)
+			assert.Empty(t, next)
+
+			var got []string
+			for _, cat := range append(first, second...) {
+				got = append(got, cat.ID)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCategoryRepository_ListPage_AllTypes(t *testing.T) {
+	t.Parallel()
+	repo := seedPagedCategories(t)
+
+	got, next, err := repo.ListPage(context.Background(), nil, domainshared.PageQuery{
+		Limit: 10, Sort: domaincategory.SortCreatedAt, Direction: domainshared.SortAsc,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, next)
+	assert.Len(t, got, 5)
+}
+
+func TestCategoryRepository_ListPage_InvalidCursor(t *testing.T) {
+	t.Parallel()
+	repo := seedPagedCategories(t)
+
+	after := func(sort string, value any) domainshared.PageQuery {
+		cursor := domainshared.Cursor{Sort: sort, Direction: domainshared.SortAsc, Value: value, ID: "x"}
+		return domainshared.PageQuery{Limit: 2, Cursor: cursor.Encode(), Sort: sort, Direction: domainshared.SortAsc}
+	}
+
+	tests := []struct {
+		name string
+		page domainshared.PageQuery
+	}{
+		{name: "malformed cursor", page: domainshared.PageQuery{Limit: 2, Cursor: "%%%", Sort: domaincategory.SortName, Direction: domainshared.SortAsc}},
+		{name: "number value for a name sort", page: after(domaincategory.SortName, 42)},
+		{name: "created_at value that is not a time", page: after(domaincategory.SortCreatedAt, "yesterday")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := repo.ListPage(context.Background(), nil, tc.page)
+			assert.ErrorIs(t, err, domainshared.ErrInvalidPage)
+		})
+	}
+}
+
+func TestCategoryRepository_ListPage_QueryError(t *testing.T) {
+	t.Parallel()
+	db, err := sql.Open("sqlite", "file:?mode=invalid")
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := categorysqlite.NewCategoryRepository(db)
+	_, _, err = repo.ListPage(context.Background(), nil, domainshared.PageQuery{
+		Limit: 2, Sort: domaincategory.SortName, Direction: domainshared.SortAsc,
+	})
+	require.Error(t, err)
+}
@@ -10,6 +10,7 @@ import (
 
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
 	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
 )
 
 const timeLayout = "2006-01-02T15:04:05Z"
@@ -108,6 +109,53 @@ func (r *CategoryRepository) List(ctx context.Context, categoryType *domaincateg
 	return categories, nil
 }
 
+// ListPage returns one page of active categories, optionally filtered by type,
+// ordered by the page's sort field and then by ID, and the cursor of the next
+// page, or "" on the last one.
+func (r *CategoryRepository) ListPage(ctx context.Context, categoryType *domaincategory.Type, page domainshared.PageQuery) ([]domaincategory.Category, string, error) {
+	q := `SELECT id, name, type, color, icon, is_system, is_active, created_at, updated_at
+		FROM categories WHERE is_active = 1`
+	var args []interface{}
+
+	if categoryType != nil {
+		q += " AND type = ?"
+		args = append(args, string(*categoryType))
+	}
+
+	after, afterArgs, orderBy, err := dbsqlite.Keyset(page, sortKeys())
+	if err != nil {
+		return nil, "", fmt.Errorf("category sqlite: list page: %w", err)
+	}
+	if after != "" {
+		q += " AND " + after
+		args = append(args, afterArgs...)
+	}
+	q += " ORDER BY " + orderBy + " LIMIT ?"
+	args = append(args, dbsqlite.PageLimit(page))
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("category sqlite: list page: %w", err)
+	}
+	defer rows.Close()
+
+	categories := make([]domaincategory.Category, 0)
+	for rows.Next() {
+		cat, err := scanCategory(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("category sqlite: list page scan: %w", err)
+		}
+		categories = append(categories, cat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("category sqlite: list page rows: %w", err)
+	}
+
+	categories, next := dbsqlite.NextCursor(page, categories, cursorOf(page))
+	return categories, next, nil
+}
+
 // Update modifies name, color, icon, and updated_at for an existing category.
 func (r *CategoryRepository) Update(ctx context.Context, c domaincategory.Category) error {
 	const q = `UPDATE categories SET name = ?, color = ?, icon = ?, updated_at = ? WHERE id = ?`
@@ -3,6 +3,8 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"time"
 )
 
 // Config holds all application-level configuration values.
@@ -10,6 +12,30 @@ type Config struct {
 	Port        string
 	Env         string
 	DatabaseDir string
+	// DuplicateWindowDays is how many days apart two transactions may be and
+	// still be flagged as likely duplicates.
+	DuplicateWindowDays int
+	// ReportLocale is the default language of generated reports ("en" or "es").
+	ReportLocale string
+	// BackupInterval is how often the databases are backed up; zero disables scheduled backups.
+	BackupInterval time.Duration
+	// BackupDir is the directory scheduled backups are written to.
+	BackupDir string
+	// BackupKeepLast, BackupKeepDaily and BackupKeepMonthly make up the backup
+	// retention policy. A backup is kept while any of them applies.
+	BackupKeepLast    int
+	BackupKeepDaily   int
+	BackupKeepMonthly int
+	// BackupPassphrase, when set, encrypts the database files of scheduled backups.
+	BackupPassphrase string
+	// CategoryTrendThreshold is the default percentage above its rolling
+	// average at which the category trend report flags a category.
+	CategoryTrendThreshold float64
+	// BaseCurrency is the currency reports are converted to by default.
+	BaseCurrency string
+	// ExchangeRates lists fixed rates to BaseCurrency as CODE=rate pairs
+	// separated by commas, e.g. "EUR=1.08,GBP=1.27".
+	ExchangeRates string
 }
 
 // Load reads configuration from environment variables with sensible defaults.
@@ -18,6 +44,21 @@ func Load() *Config {
 		Port:        getEnv("PORT", "8080"),
 		Env:         getEnv("ENV", "development"),
 		DatabaseDir: getEnv("DB_DIR", "~/FinancialManager/databases/"),
+
+		DuplicateWindowDays: getEnvInt("DUPLICATE_WINDOW_DAYS", 3),
+		ReportLocale:        getEnv("REPORT_LOCALE", "en"),
+
+		BackupInterval:    getEnvDuration("BACKUP_INTERVAL", 0),
+		BackupDir:         getEnv("BACKUP_DIR", "~/FinancialManager/backups/"),
+		BackupKeepLast:    getEnvInt("BACKUP_KEEP_LAST", 7),
+		BackupKeepDaily:   getEnvInt("BACKUP_KEEP_DAILY", 7),
+		BackupKeepMonthly: getEnvInt("BACKUP_KEEP_MONTHLY", 12),
+		BackupPassphrase:  os.Getenv("BACKUP_PASSPHRASE"),
+
+		CategoryTrendThreshold: getEnvFloat("CATEGORY_TREND_THRESHOLD", 20),
+
+		BaseCurrency:  getEnv("BASE_CURRENCY", "USD"),
+		ExchangeRates: os.Getenv("EXCHANGE_RATES"),
 	}
 }
 
@@ -28,3 +69,30 @@ func getEnv(key, defaultValue string) string {
 
 	return defaultValue
 }
+
+func getEnvInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
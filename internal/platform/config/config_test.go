@@ -3,6 +3,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -16,6 +17,8 @@ func TestLoad(t *testing.T) {
 		wantPort        string
 		wantEnv         string
 		wantDatabaseDir string
+		wantWindowDays  int
+		wantLocale      string
 	}{
 		{
 			name:            "returns defaults when no env vars set",
@@ -23,6 +26,8 @@ func TestLoad(t *testing.T) {
 			wantPort:        "8080",
 			wantEnv:         "development",
 			wantDatabaseDir: "~/FinancialManager/databases/",
+			wantWindowDays:  3,
+			wantLocale:      "en",
 		},
 		{
 			name:            "uses PORT env var when set",
@@ -30,6 +35,8 @@ func TestLoad(t *testing.T) {
 			wantPort:        "9090",
 			wantEnv:         "development",
 			wantDatabaseDir: "~/FinancialManager/databases/",
+			wantWindowDays:  3,
+			wantLocale:      "en",
 		},
 		{
 			name:            "uses ENV env var when set",
@@ -37,6 +44,8 @@ func TestLoad(t *testing.T) {
 			wantPort:        "8080",
 			wantEnv:         "production",
 			wantDatabaseDir: "~/FinancialManager/databases/",
+			wantWindowDays:  3,
+			wantLocale:      "en",
 		},
 		{
 			name:            "uses both PORT and ENV when set",
@@ -44,6 +53,8 @@ func TestLoad(t *testing.T) {
 			wantPort:        "3000",
 			wantEnv:         "staging",
 			wantDatabaseDir: "~/FinancialManager/databases/",
+			wantWindowDays:  3,
+			wantLocale:      "en",
 		},
 		{
 			name:            "uses DB_DIR env var when set",
@@ -51,6 +62,35 @@ func TestLoad(t *testing.T) {
 			wantPort:        "8080",
 			wantEnv:         "development",
 			wantDatabaseDir: "/custom/db/path/",
+			wantWindowDays:  3,
+			wantLocale:      "en",
+		},
+		{
+			name:            "uses DUPLICATE_WINDOW_DAYS env var when set",
+			env:             map[string]string{"DUPLICATE_WINDOW_DAYS": "7"},
+			wantPort:        "8080",
+			wantEnv:         "development",
+			wantDatabaseDir: "~/FinancialManager/databases/",
+			wantWindowDays:  7,
+			wantLocale:      "en",
+		},
+		{
+			name:            "ignores non-numeric DUPLICATE_WINDOW_DAYS",
+			env:             map[string]string{"DUPLICATE_WINDOW_DAYS": "week"},
+			wantPort:        "8080",
+			wantEnv:         "development",
+			wantDatabaseDir: "~/FinancialManager/databases/",
+			wantWindowDays:  3,
+			wantLocale:      "en",
+		},
+		{
+			name:            "uses REPORT_LOCALE env var when set",
+			env:             map[string]string{"REPORT_LOCALE": "es"},
+			wantPort:        "8080",
+			wantEnv:         "development",
+			wantDatabaseDir: "~/FinancialManager/databases/",
+			wantWindowDays:  3,
+			wantLocale:      "es",
 		},
 	}
 
@@ -65,6 +105,122 @@ func TestLoad(t *testing.T) {
 			assert.Equal(t, tc.wantPort, cfg.Port)
 			assert.Equal(t, tc.wantEnv, cfg.Env)
 			assert.Equal(t, tc.wantDatabaseDir, cfg.DatabaseDir)
+			assert.Equal(t, tc.wantWindowDays, cfg.DuplicateWindowDays)
+			assert.Equal(t, tc.wantLocale, cfg.ReportLocale)
+		})
+	}
+}
+
+func TestLoad_Backup(t *testing.T) {
+	tests := []struct {
+		name          string
+		env           map[string]string
+		wantInterval  time.Duration
+		wantDir       string
+		wantRetention [3]int
+		wantPass      string
+	}{
+		{
+			name:          "returns defaults when no env vars set",
+			env:           map[string]string{},
+			wantInterval:  0,
+			wantDir:       "~/FinancialManager/backups/",
+			wantRetention: [3]int{7, 7, 12},
+		},
+		{
+			name: "uses backup env vars when set",
+			env: map[string]string{
+				"BACKUP_INTERVAL":     "6h",
+				"BACKUP_DIR":          "/srv/backups",
+				"BACKUP_KEEP_LAST":    "3",
+				"BACKUP_KEEP_DAILY":   "14",
+				"BACKUP_KEEP_MONTHLY": "0",
+				"BACKUP_PASSPHRASE":   "correct horse battery",
+			},
+			wantInterval:  6 * time.Hour,
+			wantDir:       "/srv/backups",
+			wantRetention: [3]int{3, 14, 0},
+			wantPass:      "correct horse battery",
+		},
+		{
+			name:          "ignores an unparsable BACKUP_INTERVAL",
+			env:           map[string]string{"BACKUP_INTERVAL": "daily"},
+			wantInterval:  0,
+			wantDir:       "~/FinancialManager/backups/",
+			wantRetention: [3]int{7, 7, 12},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			cfg := config.Load()
+
+			assert.Equal(t, tc.wantInterval, cfg.BackupInterval)
+			assert.Equal(t, tc.wantDir, cfg.BackupDir)
+			assert.Equal(t, tc.wantRetention, [3]int{cfg.BackupKeepLast, cfg.BackupKeepDaily, cfg.BackupKeepMonthly})
+			assert.Equal(t, tc.wantPass, cfg.BackupPassphrase)
+		})
+	}
+}
+
+func TestLoad_Currency(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       map[string]string
+		wantBase  string
+		wantRates string
+	}{
+		{
+			name:     "returns defaults when no env vars set",
+			env:      map[string]string{},
+			wantBase: "USD",
+		},
+		{
+			name:      "uses currency env vars when set",
+			env:       map[string]string{"BASE_CURRENCY": "EUR", "EXCHANGE_RATES": "USD=0.92"},
+			wantBase:  "EUR",
+			wantRates: "USD=0.92",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			cfg := config.Load()
+
+			assert.Equal(t, tc.wantBase, cfg.BaseCurrency)
+			assert.Equal(t, tc.wantRates, cfg.ExchangeRates)
+		})
+	}
+}
+
+func TestLoad_CategoryTrendThreshold(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want float64
+	}{
+		{name: "returns default when not set", env: map[string]string{}, want: 20},
+		{name: "uses CATEGORY_TREND_THRESHOLD when set", env: map[string]string{"CATEGORY_TREND_THRESHOLD": "12.5"}, want: 12.5},
+		{name: "ignores a non-numeric value", env: map[string]string{"CATEGORY_TREND_THRESHOLD": "high"}, want: 20},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			cfg := config.Load()
+
+			assert.Equal(t, tc.want, cfg.CategoryTrendThreshold)
 		})
 	}
 }
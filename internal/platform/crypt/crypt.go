@@ -0,0 +1,255 @@
+// Package crypt encrypts streams with a passphrase. The key is derived with
+// Argon2id and the data is sealed with ChaCha20-Poly1305 in fixed-size chunks,
+// so files of any size can be encrypted and decrypted without buffering them
+// whole, and truncated, reordered or modified files are rejected.
+//
+// An encrypted stream is a header followed by chunks:
+//
+//	magic "FMCRYPT1" | time uint32 | memory uint32 | threads uint8 | salt [16]byte
+//	chunk*: ChaCha20-Poly1305(plaintext ≤ 64 KiB), nonce = chunk counter | final flag
+//
+// The header is authenticated as additional data of every chunk.
+package crypt
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	magic      = "FMCRYPT1"
+	saltSize   = 16
+	headerSize = len(magic) + 4 + 4 + 1 + saltSize
+	chunkSize  = 64 * 1024
+	keySize    = chacha20poly1305.KeySize
+
+	// defaultTime and defaultMemory are the Argon2 passes and memory (KiB) of
+	// DefaultParams.
+	defaultTime   = 3
+	defaultMemory = 64 * 1024
+	// maxTime and maxMemory bound the Argon2 passes and memory a header may
+	// request to four times the defaults, so a crafted file cannot make
+	// decryption run long or allocate more than 256 MiB.
+	maxTime   = 4 * defaultTime
+	maxMemory = 4 * defaultMemory
+	// MinPassphraseLength is the shortest passphrase accepted for encryption.
+	MinPassphraseLength = 8
+)
+
+var (
+	// ErrPassphraseTooShort is returned when encrypting with a passphrase
+	// shorter than MinPassphraseLength.
+	ErrPassphraseTooShort = fmt.Errorf("passphrase must be at least %d characters", MinPassphraseLength)
+	// ErrNotEncrypted is returned when decrypting data without the crypt header.
+	ErrNotEncrypted = errors.New("data is not encrypted")
+	// ErrDecrypt is returned when the passphrase is wrong or the data was
+	// modified or truncated.
+	ErrDecrypt = errors.New("wrong passphrase or corrupted data")
+)
+
+// Params are the Argon2id cost parameters used to derive the key.
+type Params struct {
+	Time    uint32
+	Memory  uint32 // KiB
+	Threads uint8
+}
+
+// DefaultParams returns the RFC 9106 second recommended option.
+func DefaultParams() Params {
+	return Params{Time: defaultTime, Memory: defaultMemory, Threads: 4}
+}
+
+// Cipher encrypts streams with the Argon2id parameters in Params.
+type Cipher struct {
+	Params Params
+}
+
+// Encrypt returns a writer that encrypts everything written to it with
+// passphrase and writes the result to w. Nothing reaches w until the first
+// Write or Close; Close must be called to write the final chunk.
+func (c Cipher) Encrypt(w io.Writer, passphrase string) (io.WriteCloser, error) {
+	if len(passphrase) < MinPassphraseLength {
+		return nil, ErrPassphraseTooShort
+	}
+
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	binary.BigEndian.PutUint32(header[8:], c.Params.Time)
+	binary.BigEndian.PutUint32(header[12:], c.Params.Memory)
+	header[16] = c.Params.Threads
+	if _, err := rand.Read(header[17:]); err != nil {
+		return nil, fmt.Errorf("crypt: generate salt: %w", err)
+	}
+
+	aead, err := newAEAD(passphrase, header)
+	if err != nil {
+		return nil, err
+	}
+	return &writer{w: w, aead: aead, header: header, buf: make([]byte, 0, chunkSize)}, nil
+}
+
+// IsEncrypted reports whether data starts with the crypt header magic.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(magic))
+}
+
+// Decrypt returns a reader of the plaintext of the encrypted stream r. The
+// returned reader fails with ErrDecrypt as soon as a chunk does not
+// authenticate, so a consumer never sees unauthenticated data.
+func Decrypt(r io.Reader, passphrase string) (io.Reader, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil || !IsEncrypted(header) {
+		return nil, ErrNotEncrypted
+	}
+	if binary.BigEndian.Uint32(header[8:]) > maxTime || binary.BigEndian.Uint32(header[12:]) > maxMemory {
+		return nil, fmt.Errorf("%w: unsupported key derivation parameters", ErrDecrypt)
+	}
+
+	aead, err := newAEAD(passphrase, header)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{r: bufio.NewReaderSize(r, chunkSize+aead.Overhead()+1), aead: aead, header: header}, nil
+}
+
+// newAEAD derives the key for header's parameters and salt.
+func newAEAD(passphrase string, header []byte) (cipher.AEAD, error) {
+	t := binary.BigEndian.Uint32(header[8:])
+	m := binary.BigEndian.Uint32(header[12:])
+	p := header[16]
+	if t == 0 || m == 0 || p == 0 {
+		return nil, fmt.Errorf("crypt: invalid key derivation parameters")
+	}
+
+	key := argon2.IDKey([]byte(passphrase), header[17:], t, m, p, keySize)
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, fmt.Errorf("crypt: %w", err)
+	}
+	return aead, nil
+}
+
+// nonce returns the nonce of chunk n, marking the last chunk of the stream.
+func nonce(n uint64, final bool) []byte {
+	b := make([]byte, chacha20poly1305.NonceSize)
+	binary.BigEndian.PutUint64(b[3:11], n)
+	if final {
+		b[11] = 1
+	}
+	return b
+}
+
+type writer struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	header []byte
+	buf    []byte
+	n      uint64
+	wrote  bool
+	closed bool
+}
+
+func (e *writer) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("crypt: write after close")
+	}
+	written := 0
+	for len(p) > 0 {
+		// A full buffer is only sealed once more data arrives, so the last
+		// chunk is always sealed by Close with the final flag.
+		if len(e.buf) == chunkSize {
+			if err := e.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(e.buf[len(e.buf):chunkSize], p)
+		e.buf = e.buf[:len(e.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+// Close seals the final chunk. It does not close the underlying writer.
+func (e *writer) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.seal(true)
+}
+
+func (e *writer) seal(final bool) error {
+	if !e.wrote {
+		if _, err := e.w.Write(e.header); err != nil {
+			return err
+		}
+		e.wrote = true
+	}
+	out := e.aead.Seal(nil, nonce(e.n, final), e.buf, e.header)
+	if _, err := e.w.Write(out); err != nil {
+		return err
+	}
+	e.n++
+	e.buf = e.buf[:0]
+	return nil
+}
+
+type reader struct {
+	r      *bufio.Reader
+	aead   cipher.AEAD
+	header []byte
+	plain  []byte
+	n      uint64
+	done   bool
+	err    error
+}
+
+func (d *reader) Read(p []byte) (int, error) {
+	for len(d.plain) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		if d.done {
+			return 0, io.EOF
+		}
+		d.err = d.next()
+	}
+	n := copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+// next reads and opens the following chunk.
+func (d *reader) next() error {
+	sealed := make([]byte, chunkSize+d.aead.Overhead())
+	n, err := io.ReadFull(d.r, sealed)
+	switch {
+	case errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+		d.done = true
+	case err != nil:
+		return err
+	default:
+		// A full chunk is the last one when nothing follows it.
+		if _, err := d.r.Peek(1); errors.Is(err, io.EOF) {
+			d.done = true
+		}
+	}
+
+	plain, err := d.aead.Open(nil, nonce(d.n, d.done), sealed[:n], d.header)
+	if err != nil {
+		return ErrDecrypt
+	}
+	d.n++
+	d.plain = plain
+	return nil
+}
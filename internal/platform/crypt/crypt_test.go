@@ -0,0 +1,157 @@
+package crypt_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/platform/crypt"
+)
+
+func TestCipher_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		size int
+		step int
+	}{
+		{name: "empty", size: 0, step: 1},
+		{name: "small", size: 100, step: 7},
+		{name: "exactly one chunk", size: 64 * 1024, step: 4096},
+		{name: "several chunks", size: 200_000, step: 30_000},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plain := pattern(tc.size)
+			sealed := encrypt(t, plain, testPassphrase, tc.step)
+
+			assert.True(t, crypt.IsEncrypted(sealed))
+			got, err := decrypt(sealed, testPassphrase)
+			require.NoError(t, err)
+			assert.Equal(t, plain, append([]byte{}, got...))
+		})
+	}
+}
+
+func TestCipher_Encrypt_RejectsShortPassphrase(t *testing.T) {
+	t.Parallel()
+
+	_, err := fastCipher.Encrypt(&bytes.Buffer{}, "short")
+
+	assert.ErrorIs(t, err, crypt.ErrPassphraseTooShort)
+}
+
+func TestCipher_Encrypt_WritesNothingBeforeFirstWrite(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	_, err := fastCipher.Encrypt(&buf, testPassphrase)
+
+	require.NoError(t, err)
+	assert.Zero(t, buf.Len())
+}
+
+func TestDecrypt_Rejects(t *testing.T) {
+	t.Parallel()
+
+	sealed := encrypt(t, pattern(150_000), testPassphrase, 10_000)
+
+	tests := []struct {
+		name       string
+		data       []byte
+		passphrase string
+		wantErr    error
+	}{
+		{
+			name:       "wrong passphrase",
+			data:       sealed,
+			passphrase: "another passphrase",
+			wantErr:    crypt.ErrDecrypt,
+		},
+		{
+			name:       "modified byte",
+			data:       flip(sealed, len(sealed)/2),
+			passphrase: testPassphrase,
+			wantErr:    crypt.ErrDecrypt,
+		},
+		{
+			name:       "modified header",
+			data:       flip(sealed, 20),
+			passphrase: testPassphrase,
+			wantErr:    crypt.ErrDecrypt,
+		},
+		{
+			name:       "truncated at a chunk boundary",
+			data:       sealed[:29+64*1024+16],
+			passphrase: testPassphrase,
+			wantErr:    crypt.ErrDecrypt,
+		},
+		{
+			name:       "truncated mid chunk",
+			data:       sealed[:len(sealed)-5],
+			passphrase: testPassphrase,
+			wantErr:    crypt.ErrDecrypt,
+		},
+		{
+			name:       "excessive time cost",
+			data:       withUint32(sealed, 8, 1<<20),
+			passphrase: testPassphrase,
+			wantErr:    crypt.ErrDecrypt,
+		},
+		{
+			name:       "excessive memory cost",
+			data:       withUint32(sealed, 12, 1<<30),
+			passphrase: testPassphrase,
+			wantErr:    crypt.ErrDecrypt,
+		},
+		{
+			name:       "time cost above four times the default",
+			data:       withUint32(sealed, 8, 4*crypt.DefaultParams().Time+1),
+			passphrase: testPassphrase,
+			wantErr:    crypt.ErrDecrypt,
+		},
+		{
+			name:       "memory cost above four times the default",
+			data:       withUint32(sealed, 12, 4*crypt.DefaultParams().Memory+1),
+			passphrase: testPassphrase,
+			wantErr:    crypt.ErrDecrypt,
+		},
+		{
+			name:       "plain data",
+			data:       []byte(`{"accounts": []}`),
+			passphrase: testPassphrase,
+			wantErr:    crypt.ErrNotEncrypted,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := decrypt(tc.data, tc.passphrase)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+// flip returns a copy of data with the byte at i inverted.
+func flip(data []byte, i int) []byte {
+	out := append([]byte{}, data...)
+	out[i] ^= 0xff
+	return out
+}
+
+// withUint32 returns a copy of data with the big-endian v written at i.
+func withUint32(data []byte, i int, v uint32) []byte {
+	out := append([]byte{}, data...)
+	binary.BigEndian.PutUint32(out[i:], v)
+	return out
+}
@@ -0,0 +1,50 @@
+package crypt_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/financial-manager/api/internal/platform/crypt"
+)
+
+const testPassphrase = "correct horse battery"
+
+// fastCipher uses cheap Argon2 parameters to keep the tests quick.
+var fastCipher = crypt.Cipher{Params: crypt.Params{Time: 1, Memory: 64, Threads: 1}}
+
+// encrypt seals plain with passphrase, writing it in pieces of size step.
+func encrypt(t *testing.T, plain []byte, passphrase string, step int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := fastCipher.Encrypt(&buf, passphrase)
+	require.NoError(t, err)
+	for len(plain) > 0 {
+		n := min(step, len(plain))
+		_, err := w.Write(plain[:n])
+		require.NoError(t, err)
+		plain = plain[n:]
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// decrypt opens sealed with passphrase and reads all of it.
+func decrypt(sealed []byte, passphrase string) ([]byte, error) {
+	r, err := crypt.Decrypt(bytes.NewReader(sealed), passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// pattern returns n deterministic bytes.
+func pattern(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i * 31)
+	}
+	return b
+}
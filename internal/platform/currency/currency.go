@@ -0,0 +1,64 @@
+// Package currency provides a fixed exchange rate currency converter.
+package currency
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+// Rates converts amounts with fixed exchange rates, each expressed as the
+// value of one unit of a currency in the base currency. The date of a
+// conversion is ignored.
+type Rates struct {
+	base  string
+	rates map[string]float64
+}
+
+// ParseRates builds Rates for base from a comma-separated list of
+// CODE=rate pairs, for example "EUR=1.08,GBP=1.27". An empty spec only
+// converts base to itself.
+func ParseRates(base, spec string) (Rates, error) {
+	base = strings.ToUpper(strings.TrimSpace(base))
+	r := Rates{base: base, rates: map[string]float64{base: 1}}
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		code, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return Rates{}, fmt.Errorf("currency: parse rates: %q is not CODE=rate", pair)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || rate <= 0 {
+			return Rates{}, fmt.Errorf("currency: parse rates: invalid rate %q for %s", value, code)
+		}
+		r.rates[strings.ToUpper(strings.TrimSpace(code))] = rate
+	}
+
+	return r, nil
+}
+
+// Convert converts amount from one currency to another through the base
+// currency. Unknown currencies yield an error wrapping domainreport.ErrNoExchangeRate.
+func (r Rates) Convert(_ context.Context, amount float64, from, to string, _ time.Time) (float64, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return amount, nil
+	}
+	fromRate, ok := r.rates[from]
+	if !ok {
+		return 0, fmt.Errorf("currency: %s to %s: %w for %s", from, to, domainreport.ErrNoExchangeRate, from)
+	}
+	toRate, ok := r.rates[to]
+	if !ok {
+		return 0, fmt.Errorf("currency: %s to %s: %w for %s", from, to, domainreport.ErrNoExchangeRate, to)
+	}
+
+	return amount * fromRate / toRate, nil
+}
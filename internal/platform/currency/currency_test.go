@@ -0,0 +1,59 @@
+package currency_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+	"github.com/financial-manager/api/internal/platform/currency"
+)
+
+func TestParseRates_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{"EUR", "EUR=abc", "EUR=0", "EUR=-1"} {
+		_, err := currency.ParseRates("USD", spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestRates_Convert(t *testing.T) {
+	t.Parallel()
+
+	rates, err := currency.ParseRates("usd", " EUR=2, gbp=4 ,")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		amount   float64
+		from, to string
+		want     float64
+		wantErr  error
+	}{
+		{name: "same currency is unchanged", amount: 10, from: "ARS", to: "ars", want: 10},
+		{name: "to base", amount: 10, from: "EUR", to: "USD", want: 20},
+		{name: "from base", amount: 10, from: "USD", to: "EUR", want: 5},
+		{name: "cross rate", amount: 10, from: "GBP", to: "EUR", want: 20},
+		{name: "unknown source", amount: 10, from: "ARS", to: "USD", wantErr: domainreport.ErrNoExchangeRate},
+		{name: "unknown target", amount: 10, from: "USD", to: "ARS", wantErr: domainreport.ErrNoExchangeRate},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rates.Convert(context.Background(), tc.amount, tc.from, tc.to, time.Time{})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -90,68 +90,6 @@ func (r *DashboardRepository) ListRecentTransactions(ctx context.Context, limit
 	return transactions, nil
 }
 
-// ListExpenseTransactions returns expense transactions filtered by optional criteria.
-func (r *DashboardRepository) ListExpenseTransactions(ctx context.Context, accountID, categoryID, startDate, endDate string) ([]domaintransaction.Transaction, error) {
-	return r.listByType(ctx, domaintransaction.TransactionTypeExpense, accountID, categoryID, startDate, endDate)
-}
-
-// ListIncomeTransactions returns income transactions filtered by optional criteria.
-func (r *DashboardRepository) ListIncomeTransactions(ctx context.Context, accountID, categoryID, startDate, endDate string) ([]domaintransaction.Transaction, error) {
-	return r.listByType(ctx, domaintransaction.TransactionTypeIncome, accountID, categoryID, startDate, endDate)
-}
-
-// listByType returns transactions filtered by type and optional criteria.
-func (r *DashboardRepository) listByType(ctx context.Context, tType domaintransaction.TransactionType, accountID, categoryID, startDate, endDate string) ([]domaintransaction.Transaction, error) {
-	q := `SELECT id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at
-		FROM transactions WHERE type = ? AND is_active = 1`
-	args := []interface{}{string(tType)}
-
-	if accountID != "" {
-		q += " AND account_id = ?"
-		args = append(args, accountID)
-	}
-	if categoryID != "" {
-		q += " AND category_id = ?"
-		args = append(args, categoryID)
-	}
-	if startDate != "" {
-		q += " AND date >= ?"
-		args = append(args, startDate)
-	}
-	if endDate != "" {
-		q += " AND date <= ?"
-		args = append(args, endDate)
-	}
-	q += " ORDER BY date DESC"
-
-	rows, err := r.transactionsDB.QueryContext(ctx, q, args...)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	transactions := make([]domaintransaction.Transaction, 0)
-	for rows.Next() {
-		var t domaintransaction.Transaction
-		var tType string
-		var isActive int
-		var date, createdAt, updatedAt string
-		err := rows.Scan(&t.ID, &t.AccountID, &t.CategoryID, &tType, &t.Amount, &t.Description, &date, &isActive, &createdAt, &updatedAt)
-		if err != nil {
-			return nil, err
-		}
-		t.Type = domaintransaction.TransactionType(tType)
-		t.IsActive = isActive == 1
-		transactions = append(transactions, t)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return transactions, nil
-}
-
 // ListCategories returns all active categories.
 func (r *DashboardRepository) ListCategories(ctx context.Context) ([]domaincategory.Category, error) {
 	const q = `SELECT id, name, type, color, icon, is_system, is_active, created_at, updated_at
@@ -14,7 +14,6 @@ import (
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
-	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 	dashboardsqlite "github.com/financial-manager/api/internal/platform/dashboard/sqlite"
 )
 
@@ -102,104 +101,6 @@ func TestDashboardRepository_ListRecentTransactions_ReturnsOnlyActive(t *testing
 	require.Equal(t, "t1", transactions[0].ID)
 }
 
-func TestDashboardRepository_ListExpenseTransactions_ReturnsFilteredByType(t *testing.T) {
-	t.Parallel()
-	transactionsDB := newDashboardTestDB(t, transactionsSchema)
-	now := time.Now().UTC().Truncate(time.Second)
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t1", "a1", "c1", "income", 100.0, "Income", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t2", "a1", "c1", "expense", 50.0, "Expense", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-
-	repo := dashboardsqlite.NewDashboardRepository(accountsDBForDashboardTest(t), transactionsDB, categoriesDBForDashboardTest(t))
-	transactions, err := repo.ListExpenseTransactions(context.Background(), "", "", "", "")
-	require.NoError(t, err)
-	require.Len(t, transactions, 1)
-	require.Equal(t, domaintransaction.TransactionTypeExpense, transactions[0].Type)
-}
-
-func TestDashboardRepository_ListExpenseTransactions_WithAccountFilter(t *testing.T) {
-	t.Parallel()
-	transactionsDB := newDashboardTestDB(t, transactionsSchema)
-	now := time.Now().UTC().Truncate(time.Second)
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t1", "a1", "c1", "expense", 100.0, "Account1", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t2", "a2", "c1", "expense", 50.0, "Account2", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-
-	repo := dashboardsqlite.NewDashboardRepository(accountsDBForDashboardTest(t), transactionsDB, categoriesDBForDashboardTest(t))
-	transactions, err := repo.ListExpenseTransactions(context.Background(), "a1", "", "", "")
-	require.NoError(t, err)
-	require.Len(t, transactions, 1)
-	require.Equal(t, "a1", transactions[0].AccountID)
-}
-
-func TestDashboardRepository_ListExpenseTransactions_WithDateRange(t *testing.T) {
-	t.Parallel()
-	transactionsDB := newDashboardTestDB(t, transactionsSchema)
-	now := time.Now().UTC().Truncate(time.Second)
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t1", "a1", "c1", "expense", 100.0, "Old", "2025-01-01T00:00:00Z", 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t2", "a1", "c1", "expense", 50.0, "New", "2026-01-01T00:00:00Z", 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-
-	repo := dashboardsqlite.NewDashboardRepository(accountsDBForDashboardTest(t), transactionsDB, categoriesDBForDashboardTest(t))
-	transactions, err := repo.ListExpenseTransactions(context.Background(), "", "", "2026-01-01", "")
-	require.NoError(t, err)
-	require.Len(t, transactions, 1)
-	require.Equal(t, "t2", transactions[0].ID)
-}
-
-func TestDashboardRepository_ListExpenseTransactions_ReturnsEmptySlice(t *testing.T) {
-	t.Parallel()
-	repo := dashboardsqlite.NewDashboardRepository(accountsDBForDashboardTest(t), transactionsDBForDashboardTest(t), categoriesDBForDashboardTest(t))
-
-	transactions, err := repo.ListExpenseTransactions(context.Background(), "", "", "", "")
-	require.NoError(t, err)
-	require.Empty(t, transactions)
-}
-
-func TestDashboardRepository_ListIncomeTransactions_ReturnsFilteredByType(t *testing.T) {
-	t.Parallel()
-	transactionsDB := newDashboardTestDB(t, transactionsSchema)
-	now := time.Now().UTC().Truncate(time.Second)
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t1", "a1", "c1", "income", 100.0, "Income", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t2", "a1", "c1", "expense", 50.0, "Expense", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-
-	repo := dashboardsqlite.NewDashboardRepository(accountsDBForDashboardTest(t), transactionsDB, categoriesDBForDashboardTest(t))
-	transactions, err := repo.ListIncomeTransactions(context.Background(), "", "", "", "")
-	require.NoError(t, err)
-	require.Len(t, transactions, 1)
-	require.Equal(t, domaintransaction.TransactionTypeIncome, transactions[0].Type)
-}
-
-func TestDashboardRepository_ListIncomeTransactions_WithCategoryFilter(t *testing.T) {
-	t.Parallel()
-	transactionsDB := newDashboardTestDB(t, transactionsSchema)
-	now := time.Now().UTC().Truncate(time.Second)
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t1", "a1", "c1", "income", 100.0, "Cat1", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t2", "a1", "c2", "income", 50.0, "Cat2", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
-
-	repo := dashboardsqlite.NewDashboardRepository(accountsDBForDashboardTest(t), transactionsDB, categoriesDBForDashboardTest(t))
-	transactions, err := repo.ListIncomeTransactions(context.Background(), "", "c1", "", "")
-	require.NoError(t, err)
-	require.Len(t, transactions, 1)
-	require.Equal(t, "c1", transactions[0].CategoryID)
-}
-
-func TestDashboardRepository_ListIncomeTransactions_ReturnsEmptySlice(t *testing.T) {
-	t.Parallel()
-	repo := dashboardsqlite.NewDashboardRepository(accountsDBForDashboardTest(t), transactionsDBForDashboardTest(t), categoriesDBForDashboardTest(t))
-
-	transactions, err := repo.ListIncomeTransactions(context.Background(), "", "", "", "")
-	require.NoError(t, err)
-	require.Empty(t, transactions)
-}
-
 func TestDashboardRepository_ListCategories_ReturnsOnlyActive(t *testing.T) {
 	t.Parallel()
 	categoriesDB := newDashboardTestDB(t, categoriesSchema)
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io/fs"
 	"path/filepath"
+
+	domainbackup "github.com/financial-manager/api/internal/domain/backup"
 )
 
 // connector opens a single database connection at the given file path.
@@ -51,14 +53,7 @@ func New(c connector, r runner) *Databases {
 // If any database fails to open or migrate, all opened connections are closed
 // before returning the error.
 func (d *Databases) Open(ctx context.Context, baseDir string) error {
-	entries := []dbEntry{
-		{"categories.db", &d.Categories, "migrations/categories"},
-		{"accounts.db", &d.Accounts, "migrations/accounts"},
-		{"transactions.db", &d.Transactions, "migrations/transactions"},
-		{"settings.db", &d.Settings, "migrations/settings"},
-	}
-
-	for _, entry := range entries {
+	for _, entry := range d.entries() {
 		db, err := d.connector.Open(ctx, filepath.Join(baseDir, entry.name))
 		if err != nil {
 			_ = d.Close()
@@ -76,6 +71,64 @@ func (d *Databases) Open(ctx context.Context, baseDir string) error {
 	return nil
 }
 
+// Snapshot writes a consistent copy of every open database into dir using
+// VACUUM INTO, which reads from a single transaction while writers continue.
+// Each snapshot records the migration IDs applied to its database.
+func (d *Databases) Snapshot(ctx context.Context, dir string) ([]domainbackup.Snapshot, error) {
+	entries := d.entries()
+	snapshots := make([]domainbackup.Snapshot, 0, len(entries))
+	for _, entry := range entries {
+		db := *entry.target
+		if db == nil {
+			return nil, fmt.Errorf("database: snapshot %s: database is not open", entry.name)
+		}
+
+		path := filepath.Join(dir, entry.name)
+		if _, err := db.ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+			return nil, fmt.Errorf("database: snapshot %s: %w", entry.name, err)
+		}
+
+		migrations, err := appliedMigrations(ctx, db)
+		if err != nil {
+			return nil, fmt.Errorf("database: snapshot %s: %w", entry.name, err)
+		}
+
+		snapshots = append(snapshots, domainbackup.Snapshot{Name: entry.name, Path: path, Migrations: migrations})
+	}
+
+	return snapshots, nil
+}
+
+// entries lists every database with its connection field and migration directory.
+func (d *Databases) entries() []dbEntry {
+	return []dbEntry{
+		{"categories.db", &d.Categories, "migrations/categories"},
+		{"accounts.db", &d.Accounts, "migrations/accounts"},
+		{"transactions.db", &d.Transactions, "migrations/transactions"},
+		{"settings.db", &d.Settings, "migrations/settings"},
+	}
+}
+
+// appliedMigrations returns the IDs in schema_migrations in ascending order.
+func appliedMigrations(ctx context.Context, db *sql.DB) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT id FROM schema_migrations ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
 // Close closes all database connections, accumulating any errors encountered.
 func (d *Databases) Close() error {
 	return errors.Join(
@@ -43,6 +43,7 @@ func TestDatabases_Open(t *testing.T) {
 				assertTableExists(t, dbs.Accounts, "accounts")
 				assertTableExists(t, dbs.Transactions, "transactions")
 				assertTableExists(t, dbs.Settings, "settings")
+				assertTableExists(t, dbs.Settings, "export_presets")
 			},
 		},
 		{
@@ -159,6 +160,65 @@ func TestDatabases_Open_FilesCreated(t *testing.T) {
 	}
 }
 
+func TestDatabases_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dbs := buildDatabases()
+	require.NoError(t, dbs.Open(context.Background(), dir))
+	t.Cleanup(func() { _ = dbs.Close() })
+
+	out := t.TempDir()
+	snapshots, err := dbs.Snapshot(context.Background(), out)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 4)
+
+	names := make([]string, 0, len(snapshots))
+	for _, s := range snapshots {
+		names = append(names, s.Name)
+		assert.Equal(t, filepath.Join(out, s.Name), s.Path)
+		assert.FileExists(t, s.Path)
+		assert.NotEmpty(t, s.Migrations)
+	}
+	assert.Equal(t, []string{"categories.db", "accounts.db", "transactions.db", "settings.db"}, names)
+}
+
+func TestDatabases_Snapshot_DoesNotBlockWriters(t *testing.T) {
+	t.Parallel()
+
+	dbs := buildDatabases()
+	require.NoError(t, dbs.Open(context.Background(), t.TempDir()))
+	t.Cleanup(func() { _ = dbs.Close() })
+
+	// A writer holds the write lock of accounts.db for the whole snapshot.
+	writer, err := dbs.Accounts.BeginTx(context.Background(), nil)
+	require.NoError(t, err)
+	defer func() { _ = writer.Rollback() }()
+	_, err = writer.Exec(`INSERT INTO accounts (id, name, type, current_balance, created_at, updated_at)
+		VALUES ('acc-1', 'Cash', 'cash', 100, '2026-01-01T00:00:00Z', '2026-01-01T00:00:00Z')`)
+	require.NoError(t, err)
+
+	out := t.TempDir()
+	_, err = dbs.Snapshot(context.Background(), out)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit())
+
+	snapshot, err := sql.Open("sqlite", filepath.Join(out, "accounts.db"))
+	require.NoError(t, err)
+	defer snapshot.Close()
+	var count int
+	require.NoError(t, snapshot.QueryRow(`SELECT count(*) FROM accounts`).Scan(&count))
+	assert.Zero(t, count)
+}
+
+func TestDatabases_Snapshot_NotOpen(t *testing.T) {
+	t.Parallel()
+
+	dbs := buildDatabases()
+	_, err := dbs.Snapshot(context.Background(), t.TempDir())
+	assert.Error(t, err)
+}
+
 func TestDatabases_Close(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS anomalies (
+    transaction_id TEXT PRIMARY KEY,
+    score          REAL NOT NULL,
+    reasons        TEXT NOT NULL,
+    created_at     TEXT NOT NULL,
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id)
+);
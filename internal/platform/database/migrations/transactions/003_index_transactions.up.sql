@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_transactions_date_type_account_category
+    ON transactions (date, type, account_id, category_id, is_active, amount);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    account_id TEXT NOT NULL,
+    date       TEXT NOT NULL,
+    balance    REAL NOT NULL,
+    PRIMARY KEY (account_id, date)
+) WITHOUT ROWID;
+
+INSERT INTO balance_snapshots (account_id, date, balance)
+SELECT account_id, substr(date, 1, 10) AS day,
+       SUM(SUM(CASE type WHEN 'income' THEN amount ELSE -amount END))
+           OVER (PARTITION BY account_id ORDER BY substr(date, 1, 10))
+FROM transactions
+WHERE is_active = 1
+GROUP BY account_id, day;
@@ -0,0 +1,42 @@
+-- Full-text index over transaction descriptions. Transactions have a TEXT
+-- primary key, and VACUUM INTO backups do not preserve the implicit rowids
+-- of such tables, so the index rows are keyed by the rowid of
+-- transactions_fts_map instead, an INTEGER PRIMARY KEY that is preserved.
+-- The triggers find an index row through the map's unique transaction_id,
+-- without scanning the index.
+CREATE TABLE IF NOT EXISTS transactions_fts_map (
+    rowid          INTEGER PRIMARY KEY,
+    transaction_id TEXT NOT NULL UNIQUE
+);
+
+CREATE VIRTUAL TABLE IF NOT EXISTS transactions_fts USING fts5(
+    description,
+    tokenize = 'unicode61 remove_diacritics 2',
+    prefix = '2 3'
+);
+
+INSERT INTO transactions_fts_map (transaction_id)
+SELECT id FROM transactions;
+
+INSERT INTO transactions_fts (rowid, description)
+SELECT m.rowid, t.description
+FROM transactions_fts_map m
+JOIN transactions t ON t.id = m.transaction_id;
+
+CREATE TRIGGER IF NOT EXISTS transactions_fts_insert AFTER INSERT ON transactions BEGIN
+    INSERT INTO transactions_fts_map (transaction_id) VALUES (new.id);
+    INSERT INTO transactions_fts (rowid, description)
+    SELECT rowid, new.description FROM transactions_fts_map WHERE transaction_id = new.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS transactions_fts_update AFTER UPDATE OF description ON transactions
+WHEN old.description IS NOT new.description BEGIN
+    UPDATE transactions_fts SET description = new.description
+    WHERE rowid = (SELECT rowid FROM transactions_fts_map WHERE transaction_id = old.id);
+END;
+
+CREATE TRIGGER IF NOT EXISTS transactions_fts_delete AFTER DELETE ON transactions BEGIN
+    DELETE FROM transactions_fts
+    WHERE rowid = (SELECT rowid FROM transactions_fts_map WHERE transaction_id = old.id);
+    DELETE FROM transactions_fts_map WHERE transaction_id = old.id;
+END;
@@ -20,11 +20,16 @@ func NewConnector() *Connector {
 	return &Connector{}
 }
 
+// dsnPragmas are applied to every connection. WAL lets readers, including
+// VACUUM INTO snapshots, run alongside a writer; busy_timeout makes a second
+// writer wait for the lock instead of failing immediately.
+const dsnPragmas = "?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)"
+
 // Open opens a SQLite database at the given path, creating all necessary parent
 // directories. It expands a leading ~ to the user's home directory. The returned
-// *sql.DB is already verified with PingContext.
+// *sql.DB is already verified with PingContext and runs in WAL mode.
 func (c *Connector) Open(ctx context.Context, path string) (*sql.DB, error) {
-	expanded, err := expandTilde(path)
+	expanded, err := ExpandTilde(path)
 	if err != nil {
 		return nil, fmt.Errorf("sqlite: expand path %s: %w", path, err)
 	}
@@ -34,7 +39,7 @@ func (c *Connector) Open(ctx context.Context, path string) (*sql.DB, error) {
 		return nil, fmt.Errorf("sqlite: create directory %s: %w", dir, err)
 	}
 
-	db, err := sql.Open("sqlite", expanded)
+	db, err := sql.Open("sqlite", expanded+dsnPragmas)
 	if err != nil {
 		return nil, fmt.Errorf("sqlite: open %s: %w", expanded, err)
 	}
@@ -47,8 +52,8 @@ func (c *Connector) Open(ctx context.Context, path string) (*sql.DB, error) {
 	return db, nil
 }
 
-// expandTilde replaces a leading ~ with the current user's home directory.
-func expandTilde(path string) (string, error) {
+// ExpandTilde replaces a leading ~ with the current user's home directory.
+func ExpandTilde(path string) (string, error) {
 	if !strings.HasPrefix(path, "~") {
 		return path, nil
 	}
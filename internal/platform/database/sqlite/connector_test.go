@@ -109,6 +109,18 @@ func TestConnector_Open(t *testing.T) {
 	}
 }
 
+func TestConnector_Open_UsesWAL(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlite.NewConnector().Open(context.Background(), filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	var mode string
+	require.NoError(t, db.QueryRow("PRAGMA journal_mode").Scan(&mode))
+	assert.Equal(t, "wal", mode)
+}
+
 // TestConnector_Open_TildeExpansion_NoHome verifies that Open returns a descriptive
 // error when HOME is unset and a tilde path is provided. This test runs sequentially
 // (no t.Parallel) because it modifies the HOME environment variable.
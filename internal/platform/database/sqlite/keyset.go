@@ -0,0 +1,60 @@
+package sqlite
+
+import (
+	"fmt"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+// SortKey is the column a sort field orders by and the check of its cursor
+// values.
+type SortKey struct {
+	Column string
+	Value  domainshared.CursorValue
+}
+
+// Keyset returns the condition that starts page after its cursor, with its
+// arguments, and the ORDER BY clause of page, looking its sort field up in
+// keys. The condition is empty on the first page.
+func Keyset(page domainshared.PageQuery, keys map[string]SortKey) (string, []any, string, error) {
+	key, ok := keys[page.Sort]
+	if !ok {
+		return "", nil, "", fmt.Errorf("%w: unknown sort %q", domainshared.ErrInvalidPage, page.Sort)
+	}
+
+	dir, cmp := "DESC", "<"
+	if page.Direction == domainshared.SortAsc {
+		dir, cmp = "ASC", ">"
+	}
+	orderBy := fmt.Sprintf("%[1]s %[2]s, id %[2]s", key.Column, dir)
+
+	cursor, ok, err := domainshared.DecodeCursor(page, key.Value)
+	if err != nil || !ok {
+		return "", nil, orderBy, err
+	}
+	return fmt.Sprintf("(%s, id) %s (?, ?)", key.Column, cmp), []any{cursor.Value, cursor.ID}, orderBy, nil
+}
+
+// PageLimit returns the number of rows to fetch for page: one more than its
+// limit, so a following page can be detected.
+func PageLimit(page domainshared.PageQuery) int {
+	if page.Limit <= 0 {
+		return domainshared.DefaultPageLimit + 1
+	}
+	return page.Limit + 1
+}
+
+// NextCursor trims the extra row fetched by PageLimit from items and returns
+// the cursor of the following page, or "" when items is the last page.
+// cursorOf returns the sort value and ID of the last item of the page.
+func NextCursor[T any](page domainshared.PageQuery, items []T, cursorOf func(last T) (any, string)) ([]T, string) {
+	limit := PageLimit(page) - 1
+	if len(items) <= limit {
+		return items, ""
+	}
+	items = items[:limit]
+	value, id := cursorOf(items[limit-1])
+
+	cursor := domainshared.Cursor{Sort: page.Sort, Direction: page.Direction, Value: value, ID: id}
+	return items, cursor.Encode()
+}
@@ -0,0 +1,93 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	"github.com/financial-manager/api/internal/platform/database/sqlite"
+)
+
+// testSortKeys sorts by a numeric "amount" column only.
+func testSortKeys() map[string]sqlite.SortKey {
+	return map[string]sqlite.SortKey{"amount": {Column: "amount", Value: domainshared.NumberValue}}
+}
+
+func TestKeyset(t *testing.T) {
+	t.Parallel()
+
+	next := domainshared.Cursor{Sort: "amount", Direction: domainshared.SortAsc, Value: 12.5, ID: "b"}.Encode()
+
+	tests := []struct {
+		name        string
+		page        domainshared.PageQuery
+		wantAfter   string
+		wantArgs    []any
+		wantOrderBy string
+		wantErr     error
+	}{
+		{
+			name:        "first page has no condition",
+			page:        domainshared.PageQuery{Sort: "amount", Direction: domainshared.SortDesc},
+			wantOrderBy: "amount DESC, id DESC",
+		},
+		{
+			name:        "cursor starts after its value and ID",
+			page:        domainshared.PageQuery{Sort: "amount", Direction: domainshared.SortAsc, Cursor: next},
+			wantAfter:   "(amount, id) > (?, ?)",
+			wantArgs:    []any{12.5, "b"},
+			wantOrderBy: "amount ASC, id ASC",
+		},
+		{
+			name:    "unknown sort",
+			page:    domainshared.PageQuery{Sort: "name", Direction: domainshared.SortAsc},
+			wantErr: domainshared.ErrInvalidPage,
+		},
+		{
+			name:    "cursor of another direction",
+			page:    domainshared.PageQuery{Sort: "amount", Direction: domainshared.SortDesc, Cursor: next},
+			wantErr: domainshared.ErrInvalidPage,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			after, args, orderBy, err := sqlite.Keyset(tc.page, testSortKeys())
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAfter, after)
+			assert.Equal(t, tc.wantArgs, args)
+			assert.Equal(t, tc.wantOrderBy, orderBy)
+		})
+	}
+}
+
+func TestPageLimit(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, domainshared.DefaultPageLimit+1, sqlite.PageLimit(domainshared.PageQuery{}))
+	assert.Equal(t, 3, sqlite.PageLimit(domainshared.PageQuery{Limit: 2}))
+}
+
+func TestNextCursor(t *testing.T) {
+	t.Parallel()
+
+	page := domainshared.PageQuery{Limit: 2, Sort: "amount", Direction: domainshared.SortAsc}
+	cursorOf := func(v float64) (any, string) { return v, "id" }
+
+	items, next := sqlite.NextCursor(page, []float64{1, 2}, cursorOf)
+	assert.Equal(t, []float64{1, 2}, items)
+	assert.Empty(t, next)
+
+	items, next = sqlite.NextCursor(page, []float64{1, 2, 3}, cursorOf)
+	assert.Equal(t, []float64{1, 2}, items)
+	assert.Equal(t, domainshared.Cursor{Sort: "amount", Direction: domainshared.SortAsc, Value: 2.0, ID: "id"}.Encode(), next)
+}
@@ -0,0 +1,12 @@
+package sqlite
+
+import "strings"
+
+// likeEscaper escapes the LIKE wildcards and the escape character itself.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// LikeContains returns the pattern for a LIKE ... ESCAPE '\' condition that
+// matches the values containing s, with the wildcards in s taken literally.
+func LikeContains(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
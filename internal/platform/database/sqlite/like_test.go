@@ -0,0 +1,32 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/financial-manager/api/internal/platform/database/sqlite"
+)
+
+func TestLikeContains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain text", in: "rent", want: "%rent%"},
+		{name: "percent is literal", in: "10%", want: `%10\%%`},
+		{name: "underscore is literal", in: "a_b", want: `%a\_b%`},
+		{name: "backslash is literal", in: `a\b`, want: `%a\\b%`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, sqlite.LikeContains(tc.in))
+		})
+	}
+}
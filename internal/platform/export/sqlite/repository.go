@@ -4,10 +4,20 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
+	"time"
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
+)
+
+const (
+	timeLayout = "2006-01-02T15:04:05Z"
+	dateLayout = "2006-01-02"
 )
 
 // ExportRepository implements the export repository interface using SQLite.
@@ -91,48 +101,118 @@ func (r *ExportRepository) ListCategories(ctx context.Context) ([]domaincategory
 
 // ListTransactions returns transactions filtered by type and optional date range.
 func (r *ExportRepository) ListTransactions(ctx context.Context, tType domaintransaction.TransactionType, startDate, endDate string) ([]domaintransaction.Transaction, error) {
+	transactions := make([]domaintransaction.Transaction, 0)
+	filters := domainexport.Filters{Type: string(tType), StartDate: startDate, EndDate: endDate}
+	err := r.StreamTransactions(ctx, filters, func(t domaintransaction.Transaction) error {
+		transactions = append(transactions, t)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// StreamTransactions calls fn for every transaction matching the filters as
+// rows are read, so the result set is never held in memory. It stops at the
+// first error returned by fn or when ctx is done.
+func (r *ExportRepository) StreamTransactions(
+	ctx context.Context,
+	filters domainexport.Filters,
+	fn func(domaintransaction.Transaction) error,
+) error {
 	q := `SELECT id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at
 		FROM transactions WHERE is_active = 1`
 	args := []interface{}{}
 
-	if tType != "" {
+	if filters.Type != "" {
 		q += " AND type = ?"
-		args = append(args, string(tType))
+		args = append(args, filters.Type)
 	}
-	if startDate != "" {
+	if filters.StartDate != "" {
 		q += " AND date >= ?"
-		args = append(args, startDate)
+		args = append(args, filters.StartDate)
 	}
-	if endDate != "" {
+	if filters.EndDate != "" {
 		q += " AND date <= ?"
-		args = append(args, endDate)
+		args = append(args, filters.EndDate)
+	}
+	if filters.AccountID != "" {
+		q += " AND account_id = ?"
+		args = append(args, filters.AccountID)
+	}
+	if filters.CategoryID != "" {
+		q += " AND category_id = ?"
+		args = append(args, filters.CategoryID)
+	}
+	if filters.MinAmount != nil {
+		q += " AND amount >= ?"
+		args = append(args, *filters.MinAmount)
+	}
+	if filters.MaxAmount != nil {
+		q += " AND amount <= ?"
+		args = append(args, *filters.MaxAmount)
+	}
+	if len(filters.AccountIDs) > 0 {
+		q += " AND account_id IN (" + placeholders(len(filters.AccountIDs)) + ")"
+		for _, id := range filters.AccountIDs {
+			args = append(args, id)
+		}
+	}
+	if len(filters.CategoryIDs) > 0 {
+		q += " AND category_id IN (" + placeholders(len(filters.CategoryIDs)) + ")"
+		for _, id := range filters.CategoryIDs {
+			args = append(args, id)
+		}
+	}
+	if filters.Query != "" {
+		q += ` AND description LIKE ? ESCAPE '\'`
+		args = append(args, dbsqlite.LikeContains(filters.Query))
 	}
 	q += " ORDER BY date DESC"
 
 	rows, err := r.transactionsDB.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer rows.Close()
 
-	transactions := make([]domaintransaction.Transaction, 0)
 	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		var t domaintransaction.Transaction
 		var tTypeStr string
 		var isActive int
 		var date, createdAt, updatedAt string
 		err := rows.Scan(&t.ID, &t.AccountID, &t.CategoryID, &tTypeStr, &t.Amount, &t.Description, &date, &isActive, &createdAt, &updatedAt)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		t.Type = domaintransaction.TransactionType(tTypeStr)
 		t.IsActive = isActive == 1
-		transactions = append(transactions, t)
-	}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
+		if t.Date, err = time.Parse(dateLayout, date); err != nil {
+			return fmt.Errorf("parse date: %w", err)
+		}
+		if t.CreatedAt, err = time.Parse(timeLayout, createdAt); err != nil {
+			return fmt.Errorf("parse created_at: %w", err)
+		}
+		if t.UpdatedAt, err = time.Parse(timeLayout, updatedAt); err != nil {
+			return fmt.Errorf("parse updated_at: %w", err)
+		}
+
+		if err := fn(t); err != nil {
+			return err
+		}
 	}
 
-	return transactions, nil
+	return rows.Err()
+}
+
+// placeholders returns n comma-separated bind parameters.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
 }
@@ -3,7 +3,9 @@ package sqlite_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sort"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -14,6 +16,7 @@ import (
 
 	domainaccount "github.com/financial-manager/api/internal/domain/account"
 	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainexport "github.com/financial-manager/api/internal/domain/export"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 	exportsqlite "github.com/financial-manager/api/internal/platform/export/sqlite"
 )
@@ -90,7 +93,7 @@ func TestExportRepository_ListTransactions_WithoutFilters(t *testing.T) {
 	transactionsDB := newExportTestDB(t, transactionsSchema)
 	now := time.Now().UTC().Truncate(time.Second)
 	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t1", "a1", "c1", "income", 100.0, "Test", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+		"t1", "a1", "c1", "income", 100.0, "Test", now.Format("2006-01-02"), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
 
 	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
 	transactions, err := repo.ListTransactions(context.Background(), "", "", "")
@@ -104,9 +107,9 @@ func TestExportRepository_ListTransactions_WithTypeFilter(t *testing.T) {
 	transactionsDB := newExportTestDB(t, transactionsSchema)
 	now := time.Now().UTC().Truncate(time.Second)
 	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t1", "a1", "c1", "income", 100.0, "Income", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+		"t1", "a1", "c1", "income", 100.0, "Income", now.Format("2006-01-02"), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
 	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t2", "a1", "c1", "expense", 50.0, "Expense", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+		"t2", "a1", "c1", "expense", 50.0, "Expense", now.Format("2006-01-02"), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
 
 	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
 	transactions, err := repo.ListTransactions(context.Background(), domaintransaction.TransactionTypeIncome, "", "")
@@ -120,9 +123,9 @@ func TestExportRepository_ListTransactions_WithDateRange(t *testing.T) {
 	transactionsDB := newExportTestDB(t, transactionsSchema)
 	now := time.Now().UTC().Truncate(time.Second)
 	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t1", "a1", "c1", "income", 100.0, "Old", "2025-01-01T00:00:00Z", 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+		"t1", "a1", "c1", "income", 100.0, "Old", "2025-01-01", 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
 	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t2", "a1", "c1", "income", 200.0, "New", "2026-01-01T00:00:00Z", 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+		"t2", "a1", "c1", "income", 200.0, "New", "2026-01-01", 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
 
 	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
 	transactions, err := repo.ListTransactions(context.Background(), "", "2026-01-01", "")
@@ -145,9 +148,9 @@ func TestExportRepository_ListTransactions_ReturnsOnlyActive(t *testing.T) {
 	transactionsDB := newExportTestDB(t, transactionsSchema)
 	now := time.Now().UTC().Truncate(time.Second)
 	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t1", "a1", "c1", "income", 100.0, "Active", now.Format(time.RFC3339), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+		"t1", "a1", "c1", "income", 100.0, "Active", now.Format("2006-01-02"), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
 	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		"t2", "a1", "c1", "income", 50.0, "Inactive", now.Format(time.RFC3339), 0, now.Format(time.RFC3339), now.Format(time.RFC3339))
+		"t2", "a1", "c1", "income", 50.0, "Inactive", now.Format("2006-01-02"), 0, now.Format(time.RFC3339), now.Format(time.RFC3339))
 
 	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
 	transactions, err := repo.ListTransactions(context.Background(), "", "", "")
@@ -156,6 +159,169 @@ func TestExportRepository_ListTransactions_ReturnsOnlyActive(t *testing.T) {
 	require.Equal(t, "t1", transactions[0].ID)
 }
 
+func TestExportRepository_StreamTransactions_CallsFnInOrder(t *testing.T) {
+	t.Parallel()
+	transactionsDB := newExportTestDB(t, transactionsSchema)
+	now := time.Now().UTC().Truncate(time.Second)
+	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		"t1", "a1", "c1", "income", 100.0, "Old", "2025-01-01", 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+	_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		"t2", "a1", "c1", "expense", 200.0, "New", "2026-01-01", 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
+	var ids []string
+	err := repo.StreamTransactions(context.Background(), domainexport.Filters{}, func(tx domaintransaction.Transaction) error {
+		ids = append(ids, tx.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"t2", "t1"}, ids)
+}
+
+func TestExportRepository_StreamTransactions_ParsesDates(t *testing.T) {
+	t.Parallel()
+	transactionsDB := newExportTestDB(t, transactionsSchema)
+	_, err := transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		"t1", "a1", "c1", "expense", 10.0, "Test", "2026-03-14", 1, "2026-03-15T08:30:00Z", "2026-03-16T09:45:00Z")
+	require.NoError(t, err)
+
+	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
+	transactions, err := repo.ListTransactions(context.Background(), "", "", "")
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	require.Equal(t, time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC), transactions[0].Date)
+	require.Equal(t, time.Date(2026, 3, 15, 8, 30, 0, 0, time.UTC), transactions[0].CreatedAt)
+	require.Equal(t, time.Date(2026, 3, 16, 9, 45, 0, 0, time.UTC), transactions[0].UpdatedAt)
+}
+
+func TestExportRepository_StreamTransactions_InvalidDate(t *testing.T) {
+	t.Parallel()
+	transactionsDB := newExportTestDB(t, transactionsSchema)
+	_, err := transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		"t1", "a1", "c1", "expense", 10.0, "Test", "14/03/2026", 1, "2026-03-15T08:30:00Z", "2026-03-15T08:30:00Z")
+	require.NoError(t, err)
+
+	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
+	_, err = repo.ListTransactions(context.Background(), "", "", "")
+	require.ErrorContains(t, err, "parse date")
+}
+
+func TestExportRepository_StreamTransactions_WithAccountCategoryAndAmountFilters(t *testing.T) {
+	t.Parallel()
+	transactionsDB := newExportTestDB(t, transactionsSchema)
+	now := time.Now().UTC().Truncate(time.Second)
+	rows := []struct {
+		id, accountID, categoryID string
+		amount                    float64
+	}{
+		{"t1", "a1", "c1", 10},
+		{"t2", "a1", "c1", 50},
+		{"t3", "a1", "c2", 50},
+		{"t4", "a2", "c1", 50},
+		{"t5", "a1", "c1", 500},
+	}
+	for _, row := range rows {
+		_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			row.id, row.accountID, row.categoryID, "expense", row.amount, "Test", now.Format("2006-01-02"), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+	}
+
+	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
+	minAmount, maxAmount := 20.0, 100.0
+	var ids []string
+	err := repo.StreamTransactions(context.Background(), domainexport.Filters{
+		AccountID:  "a1",
+		CategoryID: "c1",
+		MinAmount:  &minAmount,
+		MaxAmount:  &maxAmount,
+	}, func(tx domaintransaction.Transaction) error {
+		ids = append(ids, tx.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"t2"}, ids)
+}
+
+func TestExportRepository_StreamTransactions_WithIDListsAndQuery(t *testing.T) {
+	t.Parallel()
+	transactionsDB := newExportTestDB(t, transactionsSchema)
+	now := time.Now().UTC().Truncate(time.Second)
+	rows := []struct {
+		id, accountID, categoryID, description string
+	}{
+		{"t1", "a1", "c1", "Farmacia 10% off"},
+		{"t2", "a2", "c2", "FARMACIA"},
+		{"t3", "a3", "c1", "Farmacia"},
+		{"t4", "a1", "c3", "Farmacia"},
+		{"t5", "a2", "c1", "Supermarket"},
+		{"t6", "a1", "c1", "Farmacia 100 off"},
+	}
+	for _, row := range rows {
+		_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			row.id, row.accountID, row.categoryID, "expense", 10.0, row.description, now.Format("2006-01-02"), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+	}
+
+	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
+	collect := func(filters domainexport.Filters) []string {
+		var ids []string
+		err := repo.StreamTransactions(context.Background(), filters, func(tx domaintransaction.Transaction) error {
+			ids = append(ids, tx.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		sort.Strings(ids)
+		return ids
+	}
+
+	require.Equal(t, []string{"t1", "t2", "t6"}, collect(domainexport.Filters{
+		AccountIDs:  []string{"a1", "a2"},
+		CategoryIDs: []string{"c1", "c2"},
+		Query:       "farmacia",
+	}))
+	require.Equal(t, []string{"t1"}, collect(domainexport.Filters{Query: "10%"}))
+}
+
+func TestExportRepository_StreamTransactions_StopsOnCallbackError(t *testing.T) {
+	t.Parallel()
+	transactionsDB := newExportTestDB(t, transactionsSchema)
+	now := time.Now().UTC().Truncate(time.Second)
+	for i := 0; i < 3; i++ {
+		_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			fmt.Sprintf("t%d", i), "a1", "c1", "income", 100.0, "Test", now.Format("2006-01-02"), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+	}
+
+	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
+	wantErr := errors.New("write failed")
+	calls := 0
+	err := repo.StreamTransactions(context.Background(), domainexport.Filters{}, func(domaintransaction.Transaction) error {
+		calls++
+		return wantErr
+	})
+	require.ErrorIs(t, err, wantErr)
+	require.Equal(t, 1, calls)
+}
+
+func TestExportRepository_StreamTransactions_StopsWhenContextIsCanceled(t *testing.T) {
+	t.Parallel()
+	transactionsDB := newExportTestDB(t, transactionsSchema)
+	now := time.Now().UTC().Truncate(time.Second)
+	for i := 0; i < 3; i++ {
+		_, _ = transactionsDB.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			fmt.Sprintf("t%d", i), "a1", "c1", "income", 100.0, "Test", now.Format("2006-01-02"), 1, now.Format(time.RFC3339), now.Format(time.RFC3339))
+	}
+
+	repo := exportsqlite.NewExportRepository(accountsDBForTest(t), categoriesDBForTest(t), transactionsDB)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := 0
+	err := repo.StreamTransactions(ctx, domainexport.Filters{}, func(domaintransaction.Transaction) error {
+		calls++
+		cancel()
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, calls)
+}
+
 func TestExportRepository_ListAccounts_QueryError(t *testing.T) {
 	t.Parallel()
 	accountsDB, err := sql.Open("sqlite", "file:?mode=invalid")
@@ -0,0 +1,226 @@
+// Package sqlite implements the export preset repository using SQLite.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+const timeLayout = "2006-01-02T15:04:05Z"
+
+// PresetRepository implements export preset repository interfaces using SQLite.
+type PresetRepository struct {
+	db *sql.DB
+}
+
+// NewPresetRepository creates a PresetRepository with the provided settings *sql.DB.
+// The caller is responsible for opening and closing the database connection.
+func NewPresetRepository(db *sql.DB) *PresetRepository {
+	return &PresetRepository{db: db}
+}
+
+// storedOptions is the JSON document kept in the options column.
+type storedOptions struct {
+	Type             string   `json:"type,omitempty"`
+	StartDate        string   `json:"start_date,omitempty"`
+	EndDate          string   `json:"end_date,omitempty"`
+	AccountID        string   `json:"account_id,omitempty"`
+	CategoryID       string   `json:"category_id,omitempty"`
+	MinAmount        *float64 `json:"min_amount,omitempty"`
+	MaxAmount        *float64 `json:"max_amount,omitempty"`
+	AccountIDs       []string `json:"account_ids,omitempty"`
+	CategoryIDs      []string `json:"category_ids,omitempty"`
+	Query            string   `json:"query,omitempty"`
+	Columns          []string `json:"columns,omitempty"`
+	Delimiter        string   `json:"delimiter,omitempty"`
+	DecimalSeparator string   `json:"decimal_separator,omitempty"`
+	DateFormat       string   `json:"date_format,omitempty"`
+	BOM              bool     `json:"bom,omitempty"`
+	SignedAmounts    bool     `json:"signed_amounts,omitempty"`
+}
+
+// Create inserts a new preset row.
+func (r *PresetRepository) Create(ctx context.Context, p domainexport.Preset) error {
+	const q = `INSERT INTO export_presets (id, name, options, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`
+
+	options, err := encodeOptions(p.Options)
+	if err != nil {
+		return fmt.Errorf("export preset sqlite: create: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, q,
+		p.ID, p.Name, options,
+		p.CreatedAt.UTC().Format(timeLayout),
+		p.UpdatedAt.UTC().Format(timeLayout),
+	)
+	if err != nil {
+		return fmt.Errorf("export preset sqlite: create: %w", err)
+	}
+
+	return nil
+}
+
+// GetByName retrieves a preset by its name.
+// Returns domainshared.ErrNotFound if no row exists.
+func (r *PresetRepository) GetByName(ctx context.Context, name string) (domainexport.Preset, error) {
+	const q = `SELECT id, name, options, created_at, updated_at FROM export_presets WHERE name = ?`
+
+	p, err := scanPreset(r.db.QueryRowContext(ctx, q, name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domainexport.Preset{}, domainshared.ErrNotFound
+	}
+	if err != nil {
+		return domainexport.Preset{}, fmt.Errorf("export preset sqlite: get by name: %w", err)
+	}
+
+	return p, nil
+}
+
+// List returns all presets ordered by name.
+func (r *PresetRepository) List(ctx context.Context) ([]domainexport.Preset, error) {
+	const q = `SELECT id, name, options, created_at, updated_at FROM export_presets ORDER BY name`
+
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("export preset sqlite: list: %w", err)
+	}
+	defer rows.Close()
+
+	presets := make([]domainexport.Preset, 0)
+	for rows.Next() {
+		p, err := scanPreset(rows)
+		if err != nil {
+			return nil, fmt.Errorf("export preset sqlite: list scan: %w", err)
+		}
+		presets = append(presets, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("export preset sqlite: list rows: %w", err)
+	}
+
+	return presets, nil
+}
+
+// Delete removes a preset by its ID.
+// Returns domainshared.ErrNotFound if no row exists.
+func (r *PresetRepository) Delete(ctx context.Context, id string) error {
+	const q = `DELETE FROM export_presets WHERE id = ?`
+
+	res, err := r.db.ExecContext(ctx, q, id)
+	if err != nil {
+		return fmt.Errorf("export preset sqlite: delete: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("export preset sqlite: delete: %w", err)
+	}
+	if affected == 0 {
+		return domainshared.ErrNotFound
+	}
+
+	return nil
+}
+
+// scanner abstracts *sql.Row and *sql.Rows for the shared scanPreset helper.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPreset(s scanner) (domainexport.Preset, error) {
+	var (
+		p                    domainexport.Preset
+		options              string
+		createdAt, updatedAt string
+	)
+
+	if err := s.Scan(&p.ID, &p.Name, &options, &createdAt, &updatedAt); err != nil {
+		return domainexport.Preset{}, err
+	}
+
+	var err error
+	p.Options, err = decodeOptions(options)
+	if err != nil {
+		return domainexport.Preset{}, fmt.Errorf("parse options: %w", err)
+	}
+
+	p.CreatedAt, err = time.Parse(timeLayout, createdAt)
+	if err != nil {
+		return domainexport.Preset{}, fmt.Errorf("parse created_at: %w", err)
+	}
+
+	p.UpdatedAt, err = time.Parse(timeLayout, updatedAt)
+	if err != nil {
+		return domainexport.Preset{}, fmt.Errorf("parse updated_at: %w", err)
+	}
+
+	return p, nil
+}
+
+func encodeOptions(o domainexport.CSVOptions) (string, error) {
+	stored := storedOptions{
+		Type:             o.Type,
+		StartDate:        o.StartDate,
+		EndDate:          o.EndDate,
+		AccountID:        o.AccountID,
+		CategoryID:       o.CategoryID,
+		MinAmount:        o.MinAmount,
+		MaxAmount:        o.MaxAmount,
+		AccountIDs:       o.AccountIDs,
+		CategoryIDs:      o.CategoryIDs,
+		Query:            o.Query,
+		Delimiter:        o.Delimiter,
+		DecimalSeparator: o.DecimalSeparator,
+		DateFormat:       o.DateFormat,
+		BOM:              o.BOM,
+		SignedAmounts:    o.SignedAmounts,
+	}
+	for _, col := range o.Columns {
+		stored.Columns = append(stored.Columns, string(col))
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func decodeOptions(data string) (domainexport.CSVOptions, error) {
+	var stored storedOptions
+	if err := json.Unmarshal([]byte(data), &stored); err != nil {
+		return domainexport.CSVOptions{}, err
+	}
+
+	o := domainexport.CSVOptions{
+		Filters: domainexport.Filters{
+			Type:        stored.Type,
+			StartDate:   stored.StartDate,
+			EndDate:     stored.EndDate,
+			AccountID:   stored.AccountID,
+			CategoryID:  stored.CategoryID,
+			MinAmount:   stored.MinAmount,
+			MaxAmount:   stored.MaxAmount,
+			AccountIDs:  stored.AccountIDs,
+			CategoryIDs: stored.CategoryIDs,
+			Query:       stored.Query,
+		},
+		Delimiter:        stored.Delimiter,
+		DecimalSeparator: stored.DecimalSeparator,
+		DateFormat:       stored.DateFormat,
+		BOM:              stored.BOM,
+		SignedAmounts:    stored.SignedAmounts,
+	}
+	for _, col := range stored.Columns {
+		o.Columns = append(o.Columns, domainexport.Column(col))
+	}
+	return o, nil
+}
@@ -0,0 +1,82 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	"github.com/financial-manager/api/internal/platform/exportpreset/sqlite"
+)
+
+func TestPresetRepository_CreateAndGetByName(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewPresetRepository(newTestDB(t))
+	preset := buildPreset("p-1", "Spanish spreadsheet")
+
+	require.NoError(t, repo.Create(context.Background(), preset))
+
+	got, err := repo.GetByName(context.Background(), "Spanish spreadsheet")
+	require.NoError(t, err)
+	assert.Equal(t, preset, got)
+}
+
+func TestPresetRepository_Create_DuplicateNameFails(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewPresetRepository(newTestDB(t))
+
+	require.NoError(t, repo.Create(context.Background(), buildPreset("p-1", "Monthly")))
+	assert.Error(t, repo.Create(context.Background(), buildPreset("p-2", "Monthly")))
+}
+
+func TestPresetRepository_GetByName_NotFound(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewPresetRepository(newTestDB(t))
+
+	_, err := repo.GetByName(context.Background(), "missing")
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+}
+
+func TestPresetRepository_List_OrdersByName(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewPresetRepository(newTestDB(t))
+	require.NoError(t, repo.Create(context.Background(), buildPreset("p-1", "Yearly")))
+	require.NoError(t, repo.Create(context.Background(), domainexport.Preset{
+		ID:        "p-2",
+		Name:      "Accountant",
+		CreatedAt: buildPreset("", "").CreatedAt,
+		UpdatedAt: buildPreset("", "").UpdatedAt,
+	}))
+
+	presets, err := repo.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, presets, 2)
+	assert.Equal(t, "Accountant", presets[0].Name)
+	assert.Equal(t, domainexport.CSVOptions{}, presets[0].Options)
+	assert.Equal(t, "Yearly", presets[1].Name)
+}
+
+func TestPresetRepository_List_ReturnsEmptySlice(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewPresetRepository(newTestDB(t))
+
+	presets, err := repo.List(context.Background())
+	require.NoError(t, err)
+	assert.NotNil(t, presets)
+	assert.Empty(t, presets)
+}
+
+func TestPresetRepository_Delete(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewPresetRepository(newTestDB(t))
+	require.NoError(t, repo.Create(context.Background(), buildPreset("p-1", "Monthly")))
+
+	require.NoError(t, repo.Delete(context.Background(), "p-1"))
+
+	_, err := repo.GetByName(context.Background(), "Monthly")
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "p-1"), domainshared.ErrNotFound)
+}
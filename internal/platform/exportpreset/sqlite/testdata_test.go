@@ -0,0 +1,70 @@
+package sqlite_test
+
+import (
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/stretchr/testify/require"
+
+	domainexport "github.com/financial-manager/api/internal/domain/export"
+)
+
+// dbCounter provides unique in-memory database names to avoid shared state between parallel tests.
+var dbCounter atomic.Int64
+
+// newTestDB creates an isolated in-memory SQLite database with the export_presets schema applied.
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	name := fmt.Sprintf("test%d", dbCounter.Add(1))
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared", name))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS export_presets (
+		id         TEXT PRIMARY KEY,
+		name       TEXT NOT NULL UNIQUE,
+		options    TEXT NOT NULL,
+		created_at TEXT NOT NULL,
+		updated_at TEXT NOT NULL
+	)`)
+	require.NoError(t, err)
+
+	return db
+}
+
+// buildPreset returns a preset using every option so round trips cover them all.
+func buildPreset(id, name string) domainexport.Preset {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	minAmount, maxAmount := 10.0, 250.5
+	return domainexport.Preset{
+		ID:   id,
+		Name: name,
+		Options: domainexport.CSVOptions{
+			Filters: domainexport.Filters{
+				Type:        "expense",
+				StartDate:   "2026-01-01",
+				EndDate:     "2026-12-31",
+				AccountID:   "acc-1",
+				CategoryID:  "cat-1",
+				MinAmount:   &minAmount,
+				MaxAmount:   &maxAmount,
+				AccountIDs:  []string{"acc-2", "acc-3"},
+				CategoryIDs: []string{"cat-2"},
+				Query:       "rent",
+			},
+			Columns:          []domainexport.Column{domainexport.ColumnDate, domainexport.ColumnAmount},
+			Delimiter:        ";",
+			DecimalSeparator: ",",
+			DateFormat:       "DD/MM/YYYY",
+			BOM:              true,
+			SignedAmounts:    true,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
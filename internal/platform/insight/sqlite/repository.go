@@ -0,0 +1,129 @@
+// Package sqlite implements the anomaly repository using SQLite.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+const timeLayout = "2006-01-02T15:04:05Z"
+const dateLayout = "2006-01-02"
+
+// AnomalyRepository implements anomaly repository interfaces using SQLite.
+// Anomalies live next to the transactions they flag so listing can join them.
+type AnomalyRepository struct {
+	db *sql.DB
+}
+
+// NewAnomalyRepository creates an AnomalyRepository with the provided transactions *sql.DB.
+// The caller is responsible for opening and closing the database connection.
+func NewAnomalyRepository(db *sql.DB) *AnomalyRepository {
+	return &AnomalyRepository{db: db}
+}
+
+// storedReason is the JSON representation of a reason in the reasons column.
+type storedReason struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Create inserts a flagged anomaly, replacing an earlier one for the same transaction.
+func (r *AnomalyRepository) Create(ctx context.Context, a domaininsight.Anomaly) error {
+	const q = `INSERT OR REPLACE INTO anomalies (transaction_id, score, reasons, created_at) VALUES (?, ?, ?, ?)`
+
+	stored := make([]storedReason, 0, len(a.Reasons))
+	for _, reason := range a.Reasons {
+		stored = append(stored, storedReason{Code: string(reason.Code), Message: reason.Message})
+	}
+	reasons, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("anomaly sqlite: create: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, q, a.TransactionID, a.Score, string(reasons), a.CreatedAt.UTC().Format(timeLayout))
+	if err != nil {
+		return fmt.Errorf("anomaly sqlite: create: %w", err)
+	}
+
+	return nil
+}
+
+// List returns the anomalies of active transactions dated within the optional
+// inclusive range, most recent transaction first.
+func (r *AnomalyRepository) List(ctx context.Context, startDate, endDate string) ([]domaininsight.Anomaly, error) {
+	conditions := []string{"t.is_active = 1"}
+	var args []interface{}
+
+	if startDate != "" {
+		conditions = append(conditions, "substr(t.date, 1, 10) >= ?")
+		args = append(args, startDate)
+	}
+	if endDate != "" {
+		conditions = append(conditions, "substr(t.date, 1, 10) <= ?")
+		args = append(args, endDate)
+	}
+
+	q := fmt.Sprintf(`SELECT a.transaction_id, t.account_id, COALESCE(t.category_id, ''), t.amount, t.description,
+			substr(t.date, 1, 10), a.score, a.reasons, a.created_at
+		FROM anomalies a JOIN transactions t ON t.id = a.transaction_id
+		WHERE %s ORDER BY t.date DESC, a.created_at DESC, a.transaction_id`, strings.Join(conditions, " AND "))
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("anomaly sqlite: list: %w", err)
+	}
+	defer rows.Close()
+
+	anomalies := make([]domaininsight.Anomaly, 0)
+	for rows.Next() {
+		a, err := scanAnomaly(rows)
+		if err != nil {
+			return nil, fmt.Errorf("anomaly sqlite: list scan: %w", err)
+		}
+		anomalies = append(anomalies, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("anomaly sqlite: list rows: %w", err)
+	}
+
+	return anomalies, nil
+}
+
+// scanAnomaly reads one joined anomaly row.
+func scanAnomaly(rows *sql.Rows) (domaininsight.Anomaly, error) {
+	var (
+		a                        domaininsight.Anomaly
+		date, reasons, createdAt string
+	)
+
+	err := rows.Scan(&a.TransactionID, &a.AccountID, &a.CategoryID, &a.Amount, &a.Description,
+		&date, &a.Score, &reasons, &createdAt)
+	if err != nil {
+		return domaininsight.Anomaly{}, err
+	}
+
+	if a.Date, err = time.Parse(dateLayout, date); err != nil {
+		return domaininsight.Anomaly{}, fmt.Errorf("parse date: %w", err)
+	}
+	if a.CreatedAt, err = time.Parse(timeLayout, createdAt); err != nil {
+		return domaininsight.Anomaly{}, fmt.Errorf("parse created_at: %w", err)
+	}
+
+	var stored []storedReason
+	if err := json.Unmarshal([]byte(reasons), &stored); err != nil {
+		return domaininsight.Anomaly{}, fmt.Errorf("parse reasons: %w", err)
+	}
+	a.Reasons = make([]domaininsight.Reason, 0, len(stored))
+	for _, s := range stored {
+		a.Reasons = append(a.Reasons, domaininsight.Reason{Code: domaininsight.ReasonCode(s.Code), Message: s.Message})
+	}
+
+	return a, nil
+}
@@ -0,0 +1,76 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+	"github.com/financial-manager/api/internal/platform/insight/sqlite"
+)
+
+func TestAnomalyRepository_CreateAndList(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	repo := sqlite.NewAnomalyRepository(db)
+	seedExpense(t, db, "tx-1", "2026-05-10", true)
+	seedExpense(t, db, "tx-2", "2026-06-20T09:30:00Z", true)
+	older, newer := buildAnomaly("tx-1", "2026-05-10"), buildAnomaly("tx-2", "2026-06-20")
+
+	require.NoError(t, repo.Create(context.Background(), older))
+	require.NoError(t, repo.Create(context.Background(), newer))
+
+	got, err := repo.List(context.Background(), "", "")
+	require.NoError(t, err)
+	assert.Equal(t, []domaininsight.Anomaly{newer, older}, got)
+}
+
+func TestAnomalyRepository_Create_ReplacesEarlierScore(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	repo := sqlite.NewAnomalyRepository(db)
+	seedExpense(t, db, "tx-1", "2026-05-10", true)
+	a := buildAnomaly("tx-1", "2026-05-10")
+	require.NoError(t, repo.Create(context.Background(), a))
+
+	a.Score = 1.5
+	a.Reasons = a.Reasons[:1]
+	require.NoError(t, repo.Create(context.Background(), a))
+
+	got, err := repo.List(context.Background(), "", "")
+	require.NoError(t, err)
+	assert.Equal(t, []domaininsight.Anomaly{a}, got)
+}
+
+func TestAnomalyRepository_List_FiltersByDateAndSkipsInactive(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	repo := sqlite.NewAnomalyRepository(db)
+	seedExpense(t, db, "tx-1", "2026-05-10", true)
+	seedExpense(t, db, "tx-2", "2026-06-20", true)
+	seedExpense(t, db, "tx-3", "2026-06-25", false)
+	for _, a := range []domaininsight.Anomaly{
+		buildAnomaly("tx-1", "2026-05-10"),
+		buildAnomaly("tx-2", "2026-06-20"),
+		buildAnomaly("tx-3", "2026-06-25"),
+	} {
+		require.NoError(t, repo.Create(context.Background(), a))
+	}
+
+	got, err := repo.List(context.Background(), "2026-06-01", "2026-06-30")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "tx-2", got[0].TransactionID)
+}
+
+func TestAnomalyRepository_List_ReturnsEmptySlice(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewAnomalyRepository(newTestDB(t))
+
+	got, err := repo.List(context.Background(), "", "")
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,84 @@
+package sqlite_test
+
+import (
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/stretchr/testify/require"
+
+	domaininsight "github.com/financial-manager/api/internal/domain/insight"
+)
+
+// dbCounter provides unique in-memory database names to avoid shared state between parallel tests.
+var dbCounter atomic.Int64
+
+// newTestDB creates an isolated in-memory SQLite database with the transactions and anomalies schema applied.
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	name := fmt.Sprintf("test%d", dbCounter.Add(1))
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared", name))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS transactions (
+		id          TEXT PRIMARY KEY,
+		account_id  TEXT NOT NULL,
+		category_id TEXT,
+		type        TEXT NOT NULL,
+		amount      REAL NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		date        TEXT NOT NULL,
+		is_active   INTEGER NOT NULL DEFAULT 1,
+		created_at  TEXT NOT NULL,
+		updated_at  TEXT NOT NULL
+	)`)
+	require.NoError(t, err)
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS anomalies (
+		transaction_id TEXT PRIMARY KEY,
+		score          REAL NOT NULL,
+		reasons        TEXT NOT NULL,
+		created_at     TEXT NOT NULL
+	)`)
+	require.NoError(t, err)
+
+	return db
+}
+
+// seedExpense inserts an expense row dated on date; inactive rows are soft deleted.
+func seedExpense(t *testing.T, db *sql.DB, id, date string, active bool) {
+	t.Helper()
+	isActive := 0
+	if active {
+		isActive = 1
+	}
+	_, err := db.Exec(`INSERT INTO transactions
+		(id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at)
+		VALUES (?, 'acc-1', 'cat-food', 'expense', 500, 'Supermarket', ?, ?, '2026-06-30T10:00:00Z', '2026-06-30T10:00:00Z')`,
+		id, date, isActive)
+	require.NoError(t, err)
+}
+
+// buildAnomaly returns an anomaly for the transaction seeded by seedExpense.
+func buildAnomaly(transactionID, date string) domaininsight.Anomaly {
+	d, _ := time.Parse("2006-01-02", date)
+	return domaininsight.Anomaly{
+		TransactionID: transactionID,
+		AccountID:     "acc-1",
+		CategoryID:    "cat-food",
+		Amount:        500,
+		Description:   "Supermarket",
+		Date:          d,
+		Score:         2.75,
+		Reasons: []domaininsight.Reason{
+			{Code: domaininsight.ReasonUnusualAmount, Message: "amount 500.00 is well above the usual 50.00 for this category"},
+			{Code: domaininsight.ReasonNewPayee, Message: "first expense for this payee"},
+		},
+		CreatedAt: time.Date(2026, 6, 30, 12, 0, 0, 0, time.UTC),
+	}
+}
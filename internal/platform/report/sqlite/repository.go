@@ -0,0 +1,239 @@
+// Package sqlite implements the report repository using SQLite.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domaincategory "github.com/financial-manager/api/internal/domain/category"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+)
+
+const dateLayout = "2006-01-02"
+
+// txDate is the calendar date of a transaction, whether the column holds a
+// plain date or a full timestamp. Filters compare the date column itself
+// against day bounds instead, so they can use the date index.
+const txDate = "substr(date, 1, 10)"
+
+// calendarPeriodStart returns the SQL expression of the first day of the
+// calendar interval period the date expression d falls in.
+func calendarPeriodStart(interval domainreport.Interval, d string) (string, bool) {
+	switch interval {
+	case domainreport.IntervalWeek:
+		return "date(" + d + ", 'weekday 0', '-6 days')", true
+	case domainreport.IntervalMonth:
+		return "substr(" + d + ", 1, 7) || '-01'", true
+	case domainreport.IntervalQuarter:
+		return "substr(" + d + ", 1, 5) || printf('%02d', ((CAST(substr(" + d + ", 6, 2) AS INTEGER) - 1) / 3) * 3 + 1) || '-01'", true
+	case domainreport.IntervalYear:
+		return "substr(" + d + ", 1, 4) || '-01-01'", true
+	default:
+		return "", false
+	}
+}
+
+// periodStart returns the SQL expression of the first day of the rng period
+// txDate falls in. Months, quarters and years starting on a later day are the
+// calendar ones shifted by that many days, the same way period.Start does it.
+func periodStart(rng domainreport.Range) (string, bool) {
+	shift := rng.MonthStartDay - 1
+	if shift <= 0 || rng.Interval == domainreport.IntervalWeek {
+		return calendarPeriodStart(rng.Interval, txDate)
+	}
+
+	shifted := fmt.Sprintf("date(%s, '-%d days')", txDate, shift)
+	start, ok := calendarPeriodStart(rng.Interval, shifted)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("date(%s, '+%d days')", start, shift), true
+}
+
+// dayAfter returns the exclusive upper bound of the dates up to and including
+// to. Timestamps on to sort before it as well as plain dates.
+func dayAfter(to time.Time) string {
+	return to.AddDate(0, 0, 1).Format(dateLayout)
+}
+
+// groupColumn returns the transactions column the grouping g groups by.
+func groupColumn(g domainreport.GroupBy) (string, bool) {
+	switch g {
+	case domainreport.GroupByNone:
+		return "''", true
+	case domainreport.GroupByAccount:
+		return "account_id", true
+	case domainreport.GroupByCategory:
+		return "COALESCE(category_id, '')", true
+	default:
+		return "", false
+	}
+}
+
+// ReportRepository implements the report repository interfaces using SQLite.
+type ReportRepository struct {
+	accountsDB     *sql.DB
+	categoriesDB   *sql.DB
+	transactionsDB *sql.DB
+}
+
+// NewReportRepository creates a ReportRepository with the provided databases.
+func NewReportRepository(accountsDB, categoriesDB, transactionsDB *sql.DB) *ReportRepository {
+	return &ReportRepository{
+		accountsDB:     accountsDB,
+		categoriesDB:   categoriesDB,
+		transactionsDB: transactionsDB,
+	}
+}
+
+// CashFlow returns the income and expense totals of every period, and group
+// when requested, that has active transactions between q.From and q.To. The
+// aggregation runs in SQLite; rows are ordered by period then group.
+func (r *ReportRepository) CashFlow(ctx context.Context, q domainreport.CashFlowQuery) ([]domainreport.CashFlowRow, error) {
+	period, ok := periodStart(q.Range)
+	if !ok {
+		return nil, fmt.Errorf("report sqlite: cash flow: unsupported interval %q", q.Interval)
+	}
+	group, ok := groupColumn(q.GroupBy)
+	if !ok {
+		return nil, fmt.Errorf("report sqlite: cash flow: unsupported grouping %q", q.GroupBy)
+	}
+
+	query := `SELECT ` + period + ` AS period, ` + group + ` AS grp,
+		SUM(CASE WHEN type = 'income' THEN amount ELSE 0 END),
+		SUM(CASE WHEN type = 'expense' THEN amount ELSE 0 END)
+		FROM transactions
+		WHERE is_active = 1 AND date >= ? AND date < ?
+		GROUP BY period, grp
+		ORDER BY period, grp`
+
+	rows, err := r.transactionsDB.QueryContext(ctx, query, q.From.Format(dateLayout), dayAfter(q.To))
+	if err != nil {
+		return nil, fmt.Errorf("report sqlite: cash flow: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domainreport.CashFlowRow, 0)
+	for rows.Next() {
+		var row domainreport.CashFlowRow
+		var start string
+		if err := rows.Scan(&start, &row.GroupID, &row.Income, &row.Expense); err != nil {
+			return nil, fmt.Errorf("report sqlite: cash flow: scan: %w", err)
+		}
+		row.PeriodStart, err = time.Parse(dateLayout, start)
+		if err != nil {
+			return nil, fmt.Errorf("report sqlite: cash flow: parse period %q: %w", start, err)
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("report sqlite: cash flow: %w", err)
+	}
+
+	return result, nil
+}
+
+// BalanceChanges returns, per account, the net amount the active transactions
+// of every period of r added to its balance. Transactions dated before r.From
+// are summed into a single row with a zero PeriodStart so the opening balance
+// can be reconstructed. Rows are ordered by account then period.
+func (r *ReportRepository) BalanceChanges(ctx context.Context, rng domainreport.Range) ([]domainreport.BalanceChange, error) {
+	period, ok := periodStart(rng)
+	if !ok {
+		return nil, fmt.Errorf("report sqlite: balance changes: unsupported interval %q", rng.Interval)
+	}
+
+	query := `SELECT account_id, CASE WHEN ` + txDate + ` < ? THEN '' ELSE ` + period + ` END AS period,
+		SUM(CASE WHEN type = 'income' THEN amount ELSE -amount END)
+		FROM transactions
+		WHERE is_active = 1 AND date < ?
+		GROUP BY account_id, period
+		ORDER BY account_id, period`
+
+	rows, err := r.transactionsDB.QueryContext(ctx, query, rng.From.Format(dateLayout), dayAfter(rng.To))
+	if err != nil {
+		return nil, fmt.Errorf("report sqlite: balance changes: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]domainreport.BalanceChange, 0)
+	for rows.Next() {
+		var change domainreport.BalanceChange
+		var start string
+		if err := rows.Scan(&change.AccountID, &start, &change.Amount); err != nil {
+			return nil, fmt.Errorf("report sqlite: balance changes: scan: %w", err)
+		}
+		if start != "" {
+			change.PeriodStart, err = time.Parse(dateLayout, start)
+			if err != nil {
+				return nil, fmt.Errorf("report sqlite: balance changes: parse period %q: %w", start, err)
+			}
+		}
+		result = append(result, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("report sqlite: balance changes: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListAccounts returns every account, including inactive ones, so reports
+// over past periods can still name them. Only ID, Name, Type,
+// InitialBalance, CurrentBalance, Currency and IsActive are populated.
+func (r *ReportRepository) ListAccounts(ctx context.Context) ([]domainaccount.Account, error) {
+	const q = `SELECT id, name, type, initial_balance, current_balance, currency, is_active FROM accounts ORDER BY name`
+
+	rows, err := r.accountsDB.QueryContext(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("report sqlite: list accounts: %w", err)
+	}
+	defer rows.Close()
+
+	accounts := make([]domainaccount.Account, 0)
+	for rows.Next() {
+		var a domainaccount.Account
+		var isActive int
+		if err := rows.Scan(&a.ID, &a.Name, &a.Type, &a.InitialBalance, &a.CurrentBalance, &a.Currency, &isActive); err != nil {
+			return nil, fmt.Errorf("report sqlite: list accounts: scan: %w", err)
+		}
+		a.IsActive = isActive == 1
+		accounts = append(accounts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("report sqlite: list accounts: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// ListCategories returns every category, including inactive ones. Only ID,
+// Name, Type and IsActive are populated.
+func (r *ReportRepository) ListCategories(ctx context.Context) ([]domaincategory.Category, error) {
+	const q = `SELECT id, name, type, is_active FROM categories ORDER BY name`
+
+	rows, err := r.categoriesDB.QueryContext(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("report sqlite: list categories: %w", err)
+	}
+	defer rows.Close()
+
+	categories := make([]domaincategory.Category, 0)
+	for rows.Next() {
+		var c domaincategory.Category
+		var isActive int
+		if err := rows.Scan(&c.ID, &c.Name, &c.Type, &isActive); err != nil {
+			return nil, fmt.Errorf("report sqlite: list categories: scan: %w", err)
+		}
+		c.IsActive = isActive == 1
+		categories = append(categories, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("report sqlite: list categories: %w", err)
+	}
+
+	return categories, nil
+}
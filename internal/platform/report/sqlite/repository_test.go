@@ -0,0 +1,180 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainaccount "github.com/financial-manager/api/internal/domain/account"
+	domainreport "github.com/financial-manager/api/internal/domain/report"
+	reportsqlite "github.com/financial-manager/api/internal/platform/report/sqlite"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+// seedCashFlow inserts transactions spread over two quarters, including an
+// RFC3339 date, a NULL category, an inactive row and rows outside the range.
+func seedCashFlow(t *testing.T) *sql.DB {
+	t.Helper()
+	db := newTestDB(t, transactionsSchema)
+	seedTransaction(t, db, "t1", "a1", "c1", "income", 1000, "2026-01-05", true)
+	seedTransaction(t, db, "t2", "a1", "c2", "expense", 200, "2026-01-11", true)
+	seedTransaction(t, db, "t3", "a2", "", "expense", 50, "2026-01-12T09:30:00Z", true)
+	seedTransaction(t, db, "t4", "a2", "c2", "expense", 999, "2026-01-20", false)
+	seedTransaction(t, db, "t5", "a1", "c1", "income", 1000, "2026-04-01", true)
+	seedTransaction(t, db, "t6", "a1", "c1", "income", 500, "2025-12-31", true)
+	seedTransaction(t, db, "t7", "a1", "c1", "income", 500, "2026-07-01", true)
+	return db
+}
+
+func TestReportRepository_CashFlow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query domainreport.CashFlowQuery
+		want  []domainreport.CashFlowRow
+	}{
+		{
+			name:  "monthly totals",
+			query: domainreport.CashFlowQuery{Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-06-30"), Interval: domainreport.IntervalMonth}},
+			want: []domainreport.CashFlowRow{
+				{PeriodStart: date("2026-01-01"), Income: 1000, Expense: 250},
+				{PeriodStart: date("2026-04-01"), Income: 1000},
+			},
+		},
+		{
+			name:  "months starting on the 11th",
+			query: domainreport.CashFlowQuery{Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-06-30"), Interval: domainreport.IntervalMonth, MonthStartDay: 11}},
+			want: []domainreport.CashFlowRow{
+				{PeriodStart: date("2025-12-11"), Income: 1000},
+				{PeriodStart: date("2026-01-11"), Expense: 250},
+				{PeriodStart: date("2026-03-11"), Income: 1000},
+			},
+		},
+		{
+			name:  "quarters starting on the 11th",
+			query: domainreport.CashFlowQuery{Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-06-30"), Interval: domainreport.IntervalQuarter, MonthStartDay: 11}},
+			want: []domainreport.CashFlowRow{
+				{PeriodStart: date("2025-10-11"), Income: 1000},
+				{PeriodStart: date("2026-01-11"), Income: 1000, Expense: 250},
+			},
+		},
+		{
+			name:  "timestamps on the last day are included",
+			query: domainreport.CashFlowQuery{Range: domainreport.Range{From: date("2026-01-12"), To: date("2026-01-12"), Interval: domainreport.IntervalMonth}},
+			want: []domainreport.CashFlowRow{
+				{PeriodStart: date("2026-01-01"), Expense: 50},
+			},
+		},
+		{
+			name:  "weekly totals start on monday",
+			query: domainreport.CashFlowQuery{Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-01-31"), Interval: domainreport.IntervalWeek}},
+			want: []domainreport.CashFlowRow{
+				{PeriodStart: date("2026-01-05"), Income: 1000, Expense: 200},
+				{PeriodStart: date("2026-01-12"), Expense: 50},
+			},
+		},
+		{
+			name:  "quarterly totals by account",
+			query: domainreport.CashFlowQuery{Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-06-30"), Interval: domainreport.IntervalQuarter}, GroupBy: domainreport.GroupByAccount},
+			want: []domainreport.CashFlowRow{
+				{PeriodStart: date("2026-01-01"), GroupID: "a1", Income: 1000, Expense: 200},
+				{PeriodStart: date("2026-01-01"), GroupID: "a2", Expense: 50},
+				{PeriodStart: date("2026-04-01"), GroupID: "a1", Income: 1000},
+			},
+		},
+		{
+			name:  "yearly totals by category keep uncategorized rows",
+			query: domainreport.CashFlowQuery{Range: domainreport.Range{From: date("2026-01-01"), To: date("2026-06-30"), Interval: domainreport.IntervalYear}, GroupBy: domainreport.GroupByCategory},
+			want: []domainreport.CashFlowRow{
+				{PeriodStart: date("2026-01-01"), GroupID: "", Expense: 50},
+				{PeriodStart: date("2026-01-01"), GroupID: "c1", Income: 2000},
+				{PeriodStart: date("2026-01-01"), GroupID: "c2", Expense: 200},
+			},
+		},
+	}
+
+	db := seedCashFlow(t)
+	repo := reportsqlite.NewReportRepository(newTestDB(t, accountsSchema), newTestDB(t, categoriesSchema), db)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := repo.CashFlow(context.Background(), tc.query)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestReportRepository_CashFlow_UnsupportedInterval(t *testing.T) {
+	t.Parallel()
+
+	repo := reportsqlite.NewReportRepository(nil, nil, newTestDB(t, transactionsSchema))
+
+	_, err := repo.CashFlow(context.Background(), domainreport.CashFlowQuery{Range: domainreport.Range{Interval: "day"}})
+
+	assert.ErrorContains(t, err, "unsupported interval")
+}
+
+func TestReportRepository_BalanceChanges(t *testing.T) {
+	t.Parallel()
+
+	repo := reportsqlite.NewReportRepository(nil, nil, seedCashFlow(t))
+
+	got, err := repo.BalanceChanges(context.Background(), domainreport.Range{From: date("2026-01-01"), To: date("2026-06-30"), Interval: domainreport.IntervalQuarter})
+
+	require.NoError(t, err)
+	assert.Equal(t, []domainreport.BalanceChange{
+		{AccountID: "a1", Amount: 500},
+		{AccountID: "a1", PeriodStart: date("2026-01-01"), Amount: 800},
+		{AccountID: "a1", PeriodStart: date("2026-04-01"), Amount: 1000},
+		{AccountID: "a2", PeriodStart: date("2026-01-01"), Amount: -50},
+	}, got)
+}
+
+func TestReportRepository_ListAccounts_IncludesInactive(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t, accountsSchema)
+	_, err := db.Exec(`INSERT INTO accounts (id, name, type, initial_balance, current_balance, currency, is_active) VALUES ('a1', 'Wallet', 'cash', 25, 40, 'EUR', 1), ('a2', 'Old bank', 'bank', 0, 0, 'USD', 0)`)
+	require.NoError(t, err)
+	repo := reportsqlite.NewReportRepository(db, nil, nil)
+
+	accounts, err := repo.ListAccounts(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	assert.Equal(t, "Old bank", accounts[0].Name)
+	assert.False(t, accounts[0].IsActive)
+	assert.Equal(t, "EUR", accounts[1].Currency)
+	assert.Equal(t, domainaccount.AccountTypeCash, accounts[1].Type)
+	assert.Equal(t, 25.0, accounts[1].InitialBalance)
+	assert.Equal(t, 40.0, accounts[1].CurrentBalance)
+}
+
+func TestReportRepository_ListCategories_IncludesInactive(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t, categoriesSchema)
+	_, err := db.Exec(`INSERT INTO categories (id, name, type, is_active) VALUES ('c1', 'Food', 'expense', 1), ('c2', 'Bonus', 'income', 0)`)
+	require.NoError(t, err)
+	repo := reportsqlite.NewReportRepository(nil, db, nil)
+
+	categories, err := repo.ListCategories(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, categories, 2)
+	assert.Equal(t, "Bonus", categories[0].Name)
+	assert.False(t, categories[0].IsActive)
+}
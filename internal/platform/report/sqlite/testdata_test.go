@@ -0,0 +1,81 @@
+package sqlite_test
+
+import (
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	accountsSchema = `CREATE TABLE accounts (
+		id              TEXT    PRIMARY KEY,
+		name            TEXT    NOT NULL,
+		type            TEXT    NOT NULL,
+		initial_balance REAL    NOT NULL DEFAULT 0,
+		current_balance REAL    NOT NULL DEFAULT 0,
+		currency        TEXT    NOT NULL DEFAULT 'USD',
+		is_active       INTEGER NOT NULL DEFAULT 1,
+		created_at      TEXT    NOT NULL DEFAULT '',
+		updated_at      TEXT    NOT NULL DEFAULT ''
+	)`
+
+	categoriesSchema = `CREATE TABLE categories (
+		id         TEXT    PRIMARY KEY,
+		name       TEXT    NOT NULL,
+		type       TEXT    NOT NULL,
+		is_active  INTEGER NOT NULL DEFAULT 1,
+		created_at TEXT    NOT NULL DEFAULT '',
+		updated_at TEXT    NOT NULL DEFAULT ''
+	)`
+
+	transactionsSchema = `CREATE TABLE transactions (
+		id          TEXT PRIMARY KEY,
+		account_id  TEXT NOT NULL,
+		category_id TEXT,
+		type        TEXT NOT NULL,
+		amount      REAL NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		date        TEXT NOT NULL,
+		is_active   INTEGER NOT NULL DEFAULT 1,
+		created_at  TEXT NOT NULL DEFAULT '',
+		updated_at  TEXT NOT NULL DEFAULT ''
+	)`
+)
+
+// dbCounter provides unique in-memory database names to avoid shared state between parallel tests.
+var dbCounter atomic.Int64
+
+// newTestDB creates an isolated in-memory SQLite database with schema applied.
+func newTestDB(t *testing.T, schema string) *sql.DB {
+	t.Helper()
+	name := fmt.Sprintf("report%d", dbCounter.Add(1))
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared", name))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.Exec(schema)
+	require.NoError(t, err)
+
+	return db
+}
+
+// seedTransaction inserts a transaction row. An empty categoryID is stored as NULL.
+func seedTransaction(t *testing.T, db *sql.DB, id, accountID, categoryID, tType string, amount float64, date string, active bool) {
+	t.Helper()
+	var category any
+	if categoryID != "" {
+		category = categoryID
+	}
+	isActive := 0
+	if active {
+		isActive = 1
+	}
+	_, err := db.Exec(`INSERT INTO transactions (id, account_id, category_id, type, amount, date, is_active) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		id, accountID, category, tType, amount, date, isActive)
+	require.NoError(t, err)
+}
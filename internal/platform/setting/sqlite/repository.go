@@ -0,0 +1,51 @@
+// Package sqlite implements the settings repository using SQLite.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+)
+
+// SettingRepository implements settings repository interfaces using SQLite.
+type SettingRepository struct {
+	db *sql.DB
+}
+
+// NewSettingRepository creates a SettingRepository with the provided settings *sql.DB.
+// The caller is responsible for opening and closing the database connection.
+func NewSettingRepository(db *sql.DB) *SettingRepository {
+	return &SettingRepository{db: db}
+}
+
+// Get returns the value stored under key.
+// Returns domainshared.ErrNotFound if the setting was never set.
+func (r *SettingRepository) Get(ctx context.Context, key string) (string, error) {
+	const q = `SELECT value FROM settings WHERE key = ?`
+
+	var value string
+	err := r.db.QueryRowContext(ctx, q, key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", domainshared.ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("setting sqlite: get: %w", err)
+	}
+
+	return value, nil
+}
+
+// Set stores value under key, replacing any previous value.
+func (r *SettingRepository) Set(ctx context.Context, key, value string) error {
+	const q = `INSERT INTO settings (key, value) VALUES (?, ?)
+		ON CONFLICT(key) DO UPDATE SET value = excluded.value`
+
+	if _, err := r.db.ExecContext(ctx, q, key, value); err != nil {
+		return fmt.Errorf("setting sqlite: set: %w", err)
+	}
+
+	return nil
+}
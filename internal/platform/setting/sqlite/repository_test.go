@@ -0,0 +1,43 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	"github.com/financial-manager/api/internal/platform/setting/sqlite"
+)
+
+func TestSettingRepository_SetAndGet(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewSettingRepository(newTestDB(t))
+
+	require.NoError(t, repo.Set(context.Background(), "timezone", "America/Bogota"))
+
+	got, err := repo.Get(context.Background(), "timezone")
+	require.NoError(t, err)
+	assert.Equal(t, "America/Bogota", got)
+}
+
+func TestSettingRepository_Set_ReplacesValue(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewSettingRepository(newTestDB(t))
+	require.NoError(t, repo.Set(context.Background(), "timezone", "America/Bogota"))
+
+	require.NoError(t, repo.Set(context.Background(), "timezone", "Europe/Madrid"))
+
+	got, err := repo.Get(context.Background(), "timezone")
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Madrid", got)
+}
+
+func TestSettingRepository_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewSettingRepository(newTestDB(t))
+
+	_, err := repo.Get(context.Background(), "timezone")
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+}
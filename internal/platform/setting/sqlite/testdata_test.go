@@ -0,0 +1,32 @@
+package sqlite_test
+
+import (
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/stretchr/testify/require"
+)
+
+// dbCounter provides unique in-memory database names to avoid shared state between parallel tests.
+var dbCounter atomic.Int64
+
+// newTestDB creates an isolated in-memory SQLite database with the settings schema applied.
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	name := fmt.Sprintf("test%d", dbCounter.Add(1))
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared", name))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS settings (
+		key   TEXT PRIMARY KEY,
+		value TEXT NOT NULL
+	)`)
+	require.NoError(t, err)
+
+	return db
+}
@@ -0,0 +1,165 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+)
+
+// benchRows is the size of the dataset the aggregation benchmarks run against.
+const benchRows = 1_000_000
+
+var (
+	benchOnce sync.Once
+	benchDB   *sql.DB
+	benchErr  error
+)
+
+// loadBenchDB seeds, once per test binary, an in-memory database holding
+// benchRows transactions spread over ten years, five accounts and twenty
+// categories. The schema comes from the real migrations so the benchmarks
+// run against the production indexes.
+func loadBenchDB(b *testing.B) *sql.DB {
+	b.Helper()
+	benchOnce.Do(func() { benchDB, benchErr = seedBenchDB() })
+	if benchErr != nil {
+		b.Fatal(benchErr)
+	}
+	return benchDB
+}
+
+func seedBenchDB() (*sql.DB, error) {
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:bench%d?mode=memory&cache=shared", dbCounter.Add(1)))
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+
+	migrations, err := filepath.Glob("../../database/migrations/transactions/*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range migrations {
+		stmt, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := db.Exec(string(stmt)); err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	insert, err := tx.Prepare(`INSERT INTO transactions
+		(id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, '', ?, 1, ?, ?)`)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	defer insert.Close()
+
+	start := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	now := start.Format(time.RFC3339)
+	for i := range benchRows {
+		tType := domaintransaction.TransactionTypeExpense
+		if i%10 == 0 {
+			tType = domaintransaction.TransactionTypeIncome
+		}
+		date := start.AddDate(0, 0, i%3650).Format("2006-01-02")
+		_, err := insert.Exec(fmt.Sprintf("tx-%07d", i), fmt.Sprintf("acc-%d", i%5), fmt.Sprintf("cat-%02d", i%20),
+			string(tType), float64(i%500)+0.25, date, now, now)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec("ANALYZE"); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// benchRanges are the date windows the summary and dashboard typically ask for.
+var benchRanges = []struct {
+	name       string
+	start, end string
+}{
+	{name: "month", start: "2024-03-01", end: "2024-03-31"},
+	{name: "year", start: "2024-01-01", end: "2024-12-31"},
+	{name: "all", start: "", end: ""},
+}
+
+// BenchmarkSummary_ListByType measures the former approach of loading every
+// matching transaction, a page at a time, and summing amounts in Go.
+func BenchmarkSummary_ListByType(b *testing.B) {
+	repo := transactionsqlite.NewTransactionRepository(loadBenchDB(b))
+	ctx := context.Background()
+
+	for _, rng := range benchRanges {
+		b.Run(rng.name, func(b *testing.B) {
+			for b.Loop() {
+				var total float64
+				for _, tType := range []domaintransaction.TransactionType{
+					domaintransaction.TransactionTypeIncome, domaintransaction.TransactionTypeExpense,
+				} {
+					page := domainshared.PageQuery{Limit: domainshared.MaxPageLimit, Sort: domaintransaction.SortDate, Direction: domainshared.SortDesc}
+					for {
+						txs, next, err := repo.ListByType(ctx, tType, "", "", rng.start, rng.end, page)
+						if err != nil {
+							b.Fatal(err)
+						}
+						for _, tx := range txs {
+							total += tx.Amount
+						}
+						if next == "" {
+							break
+						}
+						page.Cursor = next
+					}
+				}
+				_ = total
+			}
+		})
+	}
+}
+
+// BenchmarkSummary_Totals measures the same totals computed by SQLite.
+func BenchmarkSummary_Totals(b *testing.B) {
+	repo := transactionsqlite.NewTransactionRepository(loadBenchDB(b))
+	ctx := context.Background()
+
+	for _, rng := range benchRanges {
+		for _, groupBy := range []domaintransaction.TotalsGroupBy{
+			domaintransaction.TotalsByType, domaintransaction.TotalsByCategory,
+		} {
+			name := rng.name
+			if groupBy != domaintransaction.TotalsByType {
+				name += "/by_" + string(groupBy)
+			}
+			b.Run(name, func(b *testing.B) {
+				q := domaintransaction.TotalsQuery{StartDate: rng.start, EndDate: rng.end, GroupBy: groupBy}
+				for b.Loop() {
+					if _, err := repo.Totals(ctx, q); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// snapshotKey identifies the balance snapshot of an account on a day.
+type snapshotKey struct {
+	accountID string
+	date      string
+}
+
+// bulkApplier applies the operations of a bulk request within tx, adding up
+// their balance changes per account and per snapshot day so each snapshot day
+// is written once when the request is flushed.
+type bulkApplier struct {
+	tx        *sql.Tx
+	balances  map[string]float64
+	snapshots map[snapshotKey]float64
+}
+
+// ApplyBulk applies ops in order in a single database transaction and returns
+// each resulting transaction with the net balance change of every account.
+// Either every operation is applied or none is: operations on a missing or
+// deleted transaction are reported together in a *domaintransaction.BulkError.
+// Balance snapshots are changed once per account and day by the net amount of
+// all the operations. Account balances live in the accounts database and are
+// left to the caller.
+func (r *TransactionRepository) ApplyBulk(ctx context.Context, ops []domaintransaction.BulkOperation) (domaintransaction.BulkResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domaintransaction.BulkResult{}, fmt.Errorf("transaction sqlite: begin: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("rollback: %v", err)
+		}
+	}()
+
+	b := bulkApplier{
+		tx:        tx,
+		balances:  make(map[string]float64),
+		snapshots: make(map[snapshotKey]float64),
+	}
+
+	results := make([]domaintransaction.Transaction, len(ops))
+	var failed []domaintransaction.BulkItemError
+	for i, op := range ops {
+		t, err := b.apply(ctx, op)
+		if errors.Is(err, domainshared.ErrNotFound) {
+			failed = append(failed, domaintransaction.BulkItemError{Index: i, Err: domaintransaction.ErrTransactionNotFound})
+			continue
+		}
+		if err != nil {
+			return domaintransaction.BulkResult{}, err
+		}
+		results[i] = t
+	}
+	if len(failed) > 0 {
+		return domaintransaction.BulkResult{}, &domaintransaction.BulkError{Items: failed}
+	}
+
+	if err := b.flush(ctx); err != nil {
+		return domaintransaction.BulkResult{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domaintransaction.BulkResult{}, fmt.Errorf("transaction sqlite: commit: %w", err)
+	}
+
+	balances := make(map[string]float64, len(b.balances))
+	for id, delta := range b.balances {
+		if delta != 0 {
+			balances[id] = delta
+		}
+	}
+
+	return domaintransaction.BulkResult{Transactions: results, Balances: balances}, nil
+}
+
+// apply applies op and records its balance changes.
+func (b *bulkApplier) apply(ctx context.Context, op domaintransaction.BulkOperation) (domaintransaction.Transaction, error) {
+	if op.Action == domaintransaction.BulkCreate {
+		return b.create(ctx, op.Transaction)
+	}
+
+	current, err := b.get(ctx, op.Transaction.ID)
+	if err != nil {
+		return domaintransaction.Transaction{}, err
+	}
+
+	switch op.Action {
+	case domaintransaction.BulkUpdate:
+		return b.update(ctx, current, op.Transaction)
+	case domaintransaction.BulkDelete:
+		return b.delete(ctx, current, op.Transaction.UpdatedAt)
+	case domaintransaction.BulkRecategorize:
+		updated := current
+		updated.CategoryID = op.Transaction.CategoryID
+		updated.UpdatedAt = op.Transaction.UpdatedAt
+		return b.update(ctx, current, updated)
+	default:
+		return domaintransaction.Transaction{}, fmt.Errorf("transaction sqlite: unsupported bulk action %q", op.Action)
+	}
+}
+
+// get returns the active transaction with the given id.
+// Returns domainshared.ErrNotFound if no active row exists.
+func (b *bulkApplier) get(ctx context.Context, id string) (domaintransaction.Transaction, error) {
+	const q = `SELECT id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at
+		FROM transactions WHERE id = ? AND is_active = 1`
+
+	t, err := scanTransaction(b.tx.QueryRowContext(ctx, q, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domaintransaction.Transaction{}, domainshared.ErrNotFound
+	}
+	if err != nil {
+		return domaintransaction.Transaction{}, fmt.Errorf("transaction sqlite: bulk get: %w", err)
+	}
+
+	return t, nil
+}
+
+// create inserts t.
+func (b *bulkApplier) create(ctx context.Context, t domaintransaction.Transaction) (domaintransaction.Transaction, error) {
+	const q = `INSERT INTO transactions
+		(id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, 1, ?, ?)`
+
+	t.IsActive = true
+	_, err := b.tx.ExecContext(ctx, q,
+		t.ID, t.AccountID, t.CategoryID, string(t.Type),
+		t.Amount, t.Description,
+		t.Date.Format(dateLayout),
+		t.CreatedAt.UTC().Format(timeLayout),
+		t.UpdatedAt.UTC().Format(timeLayout),
+	)
+	if err != nil {
+		return domaintransaction.Transaction{}, fmt.Errorf("transaction sqlite: bulk create: %w", err)
+	}
+
+	b.add(t, 1)
+	return t, nil
+}
+
+// update overwrites current with the non-zero category, amount, description
+// and date of changes.
+func (b *bulkApplier) update(ctx context.Context, current, changes domaintransaction.Transaction) (domaintransaction.Transaction, error) {
+	const q = `UPDATE transactions SET
+		category_id = ?, amount = ?, description = ?, date = ?, updated_at = ?
+		WHERE id = ?`
+
+	updated := current
+	if changes.CategoryID != "" {
+		updated.CategoryID = changes.CategoryID
+	}
+	if changes.Amount > 0 {
+		updated.Amount = changes.Amount
+	}
+	if changes.Description != "" {
+		updated.Description = changes.Description
+	}
+	if !changes.Date.IsZero() {
+		updated.Date = changes.Date
+	}
+	updated.UpdatedAt = changes.UpdatedAt
+
+	_, err := b.tx.ExecContext(ctx, q,
+		updated.CategoryID, updated.Amount, updated.Description,
+		updated.Date.Format(dateLayout),
+		updated.UpdatedAt.UTC().Format(timeLayout),
+		updated.ID,
+	)
+	if err != nil {
+		return domaintransaction.Transaction{}, fmt.Errorf("transaction sqlite: bulk update: %w", err)
+	}
+
+	b.add(current, -1)
+	b.add(updated, 1)
+	return updated, nil
+}
+
+func (b *bulkApplier) delete(ctx context.Context, current domaintransaction.Transaction, updatedAt time.Time) (domaintransaction.Transaction, error) {
+	const q = `UPDATE transactions SET is_active = 0, updated_at = ? WHERE id = ?`
+
+	if _, err := b.tx.ExecContext(ctx, q, updatedAt.UTC().Format(timeLayout), current.ID); err != nil {
+		return domaintransaction.Transaction{}, fmt.Errorf("transaction sqlite: bulk delete: %w", err)
+	}
+
+	b.add(current, -1)
+	current.IsActive = false
+	current.UpdatedAt = updatedAt
+	return current, nil
+}
+
+// add records sign times the balance change of t.
+func (b *bulkApplier) add(t domaintransaction.Transaction, sign float64) {
+	delta := sign * signedAmount(string(t.Type), t.Amount)
+	b.balances[t.AccountID] += delta
+	b.snapshots[snapshotKey{accountID: t.AccountID, date: t.Date.Format(dateLayout)}] += delta
+}
+
+// flush writes the net balance change of every snapshot day, skipping those
+// the operations cancelled out.
+func (b *bulkApplier) flush(ctx context.Context) error {
+	keys := make([]snapshotKey, 0, len(b.snapshots))
+	for key, delta := range b.snapshots {
+		if delta != 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].accountID != keys[j].accountID {
+			return keys[i].accountID < keys[j].accountID
+		}
+		return keys[i].date < keys[j].date
+	})
+
+	for _, key := range keys {
+		if err := applySnapshotDelta(ctx, b.tx, key.accountID, key.date, b.snapshots[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
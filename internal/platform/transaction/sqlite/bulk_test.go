@@ -0,0 +1,156 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	"github.com/financial-manager/api/internal/platform/database"
+	"github.com/financial-manager/api/internal/platform/database/migrator"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+)
+
+func TestTransactionRepository_ApplyBulk(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	require.NoError(t, buildTestCategory(db, "cat-001"))
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-1", domaintransaction.TransactionTypeExpense, 100, "2026-03-01")))
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-2", domaintransaction.TransactionTypeExpense, 40, "2026-03-02")))
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-3", domaintransaction.TransactionTypeExpense, 10, "2026-03-03")))
+
+	updatedAt := time.Date(2026, 3, 20, 9, 0, 0, 0, time.UTC)
+	newDate, _ := time.Parse("2006-01-02", "2026-03-05")
+	ops := []domaintransaction.BulkOperation{
+		{Action: domaintransaction.BulkCreate, Transaction: buildDatedTransaction("tx-4", domaintransaction.TransactionTypeIncome, 500, "2026-03-04")},
+		{Action: domaintransaction.BulkRecategorize, Transaction: domaintransaction.Transaction{ID: "tx-1", CategoryID: "cat-002", UpdatedAt: updatedAt}},
+		{Action: domaintransaction.BulkUpdate, Transaction: domaintransaction.Transaction{ID: "tx-2", Amount: 60, Date: newDate, UpdatedAt: updatedAt}},
+		{Action: domaintransaction.BulkDelete, Transaction: domaintransaction.Transaction{ID: "tx-3", UpdatedAt: updatedAt}},
+	}
+
+	res, err := repo.ApplyBulk(ctx, ops)
+
+	require.NoError(t, err)
+	got := res.Transactions
+	require.Len(t, got, 4)
+	assert.Equal(t, "tx-4", got[0].ID)
+	assert.True(t, got[0].IsActive)
+	assert.Equal(t, "cat-002", got[1].CategoryID)
+	assert.InDelta(t, 100, got[1].Amount, 0.001)
+	assert.Equal(t, updatedAt, got[1].UpdatedAt)
+	assert.InDelta(t, 60, got[2].Amount, 0.001)
+	assert.Equal(t, newDate, got[2].Date)
+	assert.Equal(t, "Test transaction", got[2].Description)
+	assert.False(t, got[3].IsActive)
+
+	// The net change: +500 created, -20 updated, +10 deleted.
+	assert.Equal(t, map[string]float64{"acc-001": 490}, res.Balances)
+
+	stored, err := repo.GetByID(ctx, "tx-1")
+	require.NoError(t, err)
+	assert.Equal(t, "cat-002", stored.CategoryID)
+	_, err = repo.GetByID(ctx, "tx-3")
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+
+	assertBalances(t, repo, map[string]float64{
+		"2026-03-01": -100,
+		"2026-03-02": -100,
+		"2026-03-03": -100,
+		"2026-03-04": 400,
+		"2026-03-05": 340,
+	})
+}
+
+func TestTransactionRepository_ApplyBulk_MissingTransactionsRollBack(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	require.NoError(t, buildTestCategory(db, "cat-001"))
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-1", domaintransaction.TransactionTypeExpense, 100, "2026-03-01")))
+	require.NoError(t, repo.SoftDelete(ctx, "tx-1"))
+
+	ops := []domaintransaction.BulkOperation{
+		{Action: domaintransaction.BulkCreate, Transaction: buildDatedTransaction("tx-2", domaintransaction.TransactionTypeIncome, 500, "2026-03-04")},
+		{Action: domaintransaction.BulkDelete, Transaction: domaintransaction.Transaction{ID: "tx-1"}},
+		{Action: domaintransaction.BulkRecategorize, Transaction: domaintransaction.Transaction{ID: "missing", CategoryID: "cat-002"}},
+	}
+
+	_, err := repo.ApplyBulk(ctx, ops)
+
+	var bulkErr *domaintransaction.BulkError
+	require.ErrorAs(t, err, &bulkErr)
+	assert.ErrorIs(t, err, domaintransaction.ErrInvalidBulk)
+	assert.Equal(t, []domaintransaction.BulkItemError{
+		{Index: 1, Err: domaintransaction.ErrTransactionNotFound},
+		{Index: 2, Err: domaintransaction.ErrTransactionNotFound},
+	}, bulkErr.Items)
+
+	_, err = repo.GetByID(ctx, "tx-2")
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+	assertBalances(t, repo, map[string]float64{"2026-03-04": 0})
+}
+
+func TestTransactionRepository_ApplyBulk_CancelledChangesSkipTheAccount(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	require.NoError(t, buildTestCategory(db, "cat-001"))
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+
+	res, err := repo.ApplyBulk(context.Background(), []domaintransaction.BulkOperation{
+		{Action: domaintransaction.BulkCreate, Transaction: buildDatedTransaction("tx-1", domaintransaction.TransactionTypeExpense, 75, "2026-03-01")},
+		{Action: domaintransaction.BulkDelete, Transaction: domaintransaction.Transaction{ID: "tx-1"}},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, res.Balances)
+}
+
+func TestTransactionRepository_ApplyBulk_MigratedSchema(t *testing.T) {
+	t.Parallel()
+	dbs := database.New(dbsqlite.NewConnector(), migrator.New())
+	require.NoError(t, dbs.Open(context.Background(), t.TempDir()))
+	t.Cleanup(func() { _ = dbs.Close() })
+
+	// The transactions database has no accounts table.
+	for _, id := range []string{"tx-1", "tx-2"} {
+		_, err := dbs.Transactions.Exec(`INSERT INTO transactions
+			(id, account_id, category_id, type, amount, description, date, created_at, updated_at)
+			VALUES (?, 'acc-001', 'cat-001', 'expense', 100, 'Groceries', '2026-03-01', '2026-03-01T00:00:00Z', '2026-03-01T00:00:00Z')`, id)
+		require.NoError(t, err)
+	}
+	_, err := dbs.Transactions.Exec(`INSERT INTO balance_snapshots (account_id, date, balance) VALUES ('acc-001', '2026-03-01', -200)`)
+	require.NoError(t, err)
+
+	repo := transactionsqlite.NewTransactionRepository(dbs.Transactions)
+	ctx := context.Background()
+
+	res, err := repo.ApplyBulk(ctx, []domaintransaction.BulkOperation{
+		{Action: domaintransaction.BulkCreate, Transaction: buildDatedTransaction("tx-3", domaintransaction.TransactionTypeIncome, 500, "2026-03-02")},
+		{Action: domaintransaction.BulkUpdate, Transaction: domaintransaction.Transaction{ID: "tx-1", Amount: 40}},
+		{Action: domaintransaction.BulkDelete, Transaction: domaintransaction.Transaction{ID: "tx-2"}},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, res.Transactions, 3)
+	assert.Equal(t, map[string]float64{"acc-001": 660}, res.Balances)
+	assertBalances(t, repo, map[string]float64{
+		"2026-03-01": -40,
+		"2026-03-02": 460,
+	})
+}
@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// Snippet match markers: control characters, which cannot come from a
+// description typed by a user, so they survive HTML escaping unambiguously.
+const (
+	matchStart = "\x02"
+	matchEnd   = "\x03"
+)
+
+// snippetTokens is the approximate number of tokens in a snippet.
+const snippetTokens = 12
+
+// markReplacer turns the snippet match markers into <mark> elements.
+var markReplacer = strings.NewReplacer(matchStart, "<mark>", matchEnd, "</mark>")
+
+// FullTextSearch returns up to limit active transactions whose description
+// matches every word of query, most relevant first. Words match as prefixes,
+// ignoring case and accents. A query without words matches nothing.
+func (r *TransactionRepository) FullTextSearch(ctx context.Context, query string, limit int) ([]domaintransaction.SearchHit, error) {
+	match := matchExpression(query)
+	if match == "" {
+		return []domaintransaction.SearchHit{}, nil
+	}
+
+	const q = `SELECT t.id, t.account_id, t.category_id, t.type, t.amount, t.description, t.date, t.is_active, t.created_at, t.updated_at,
+			snippet(transactions_fts, 0, ?, ?, '…', ?), bm25(transactions_fts)
+		FROM transactions_fts
+		JOIN transactions_fts_map m ON m.rowid = transactions_fts.rowid
+		JOIN transactions t ON t.id = m.transaction_id
+		WHERE transactions_fts MATCH ? AND t.is_active = 1
+		ORDER BY bm25(transactions_fts), t.date DESC, t.id
+		LIMIT ?`
+
+	rows, err := r.db.QueryContext(ctx, q, matchStart, matchEnd, snippetTokens, match, limit)
+	if err != nil {
+		return nil, fmt.Errorf("transaction sqlite: full text search: %w", err)
+	}
+	defer rows.Close()
+
+	hits := make([]domaintransaction.SearchHit, 0)
+	for rows.Next() {
+		var hit domaintransaction.SearchHit
+		hit.Transaction, err = scanTransaction(hitScanner{rows: rows, snippet: &hit.Snippet, rank: &hit.Rank})
+		if err != nil {
+			return nil, fmt.Errorf("transaction sqlite: full text search scan: %w", err)
+		}
+		hit.Snippet = markReplacer.Replace(html.EscapeString(hit.Snippet))
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("transaction sqlite: full text search rows: %w", err)
+	}
+
+	return hits, nil
+}
+
+// hitScanner scans a transaction followed by its snippet and rank, so
+// scanTransaction can read search hits.
+type hitScanner struct {
+	rows    *sql.Rows
+	snippet *string
+	rank    *float64
+}
+
+func (s hitScanner) Scan(dest ...any) error {
+	return s.rows.Scan(append(dest, s.snippet, s.rank)...)
+}
+
+// matchExpression turns a user query into an FTS5 expression requiring every
+// word of it as a prefix. Words are split like the unicode61 tokenizer does
+// and quoted, so FTS5 operators and syntax in the query are matched as text.
+func matchExpression(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && !unicode.IsMark(r)
+	})
+
+	terms := make([]string, len(words))
+	for i, w := range words {
+		terms[i] = `"` + w + `"*`
+	}
+	return strings.Join(terms, " ")
+}
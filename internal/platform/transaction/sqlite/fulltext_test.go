@@ -0,0 +1,200 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	"github.com/financial-manager/api/internal/platform/database"
+	"github.com/financial-manager/api/internal/platform/database/migrator"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+)
+
+// seedFullTextTransactions creates expenses with Spanish descriptions; f4 is
+// soft-deleted.
+func seedFullTextTransactions(t *testing.T) *transactionsqlite.TransactionRepository {
+	t.Helper()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	require.NoError(t, buildTestCategory(db, "cat-001"))
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	for _, f := range []struct {
+		id, description, date string
+	}{
+		{"f1", "Pago en la farmacia del centro comercial de la ciudad", "2026-03-05"},
+		{"f2", "Compra en la farmacia: ibuprofeno y farmacia <online>", "2026-03-12"},
+		{"f3", "Café con Ñandú & amigos", "2026-03-20"},
+		{"f4", "Farmacia nocturna", "2026-03-25"},
+	} {
+		tx := buildDatedTransaction(f.id, domaintransaction.TransactionTypeExpense, 10, f.date)
+		tx.Description = f.description
+		require.NoError(t, repo.Create(context.Background(), tx))
+	}
+	require.NoError(t, repo.SoftDelete(context.Background(), "f4"))
+	return repo
+}
+
+func TestTransactionRepository_FullTextSearch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "ranks denser matches first and skips deleted",
+			query: "farmacia",
+			want:  []string{"f2", "f1"},
+		},
+		{
+			name:  "matches prefixes",
+			query: "farm",
+			want:  []string{"f2", "f1"},
+		},
+		{
+			name:  "ignores accents and case",
+			query: "CAFE nandu",
+			want:  []string{"f3"},
+		},
+		{
+			name:  "accented query matches plain text",
+			query: "fármacía céntro",
+			want:  []string{"f1"},
+		},
+		{
+			name:  "every word is required",
+			query: "farmacia ibuprofeno",
+			want:  []string{"f2"},
+		},
+		{
+			name:  "FTS syntax is matched as text",
+			query: `farmacia OR "amigos" NEAR(`,
+			want:  []string{},
+		},
+		{
+			name:  "query without words matches nothing",
+			query: " & * ",
+			want:  []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := seedFullTextTransactions(t)
+
+			hits, err := repo.FullTextSearch(context.Background(), tc.query, 10)
+			require.NoError(t, err)
+
+			ids := make([]string, 0, len(hits))
+			for _, h := range hits {
+				ids = append(ids, h.Transaction.ID)
+			}
+			assert.Equal(t, tc.want, ids)
+		})
+	}
+}
+
+func TestTransactionRepository_FullTextSearch_Snippet(t *testing.T) {
+	t.Parallel()
+	repo := seedFullTextTransactions(t)
+
+	hits, err := repo.FullTextSearch(context.Background(), "ibupro", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+
+	assert.Equal(t, "Compra en la farmacia: <mark>ibuprofeno</mark> y farmacia &lt;online&gt;", hits[0].Snippet)
+	assert.Equal(t, "Compra en la farmacia: ibuprofeno y farmacia <online>", hits[0].Transaction.Description)
+}
+
+func TestTransactionRepository_FullTextSearch_Limit(t *testing.T) {
+	t.Parallel()
+	repo := seedFullTextTransactions(t)
+
+	hits, err := repo.FullTextSearch(context.Background(), "farmacia", 1)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "f2", hits[0].Transaction.ID)
+}
+
+func TestTransactionRepository_FullTextSearch_FollowsUpdates(t *testing.T) {
+	t.Parallel()
+	repo := seedFullTextTransactions(t)
+	ctx := context.Background()
+
+	tx, err := repo.GetByID(ctx, "f1")
+	require.NoError(t, err)
+	tx.Description = "Droguería del Centro"
+	require.NoError(t, repo.Update(ctx, tx))
+
+	hits, err := repo.FullTextSearch(ctx, "drogueria", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "f1", hits[0].Transaction.ID)
+
+	hits, err = repo.FullTextSearch(ctx, "centro", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "f1", hits[0].Transaction.ID)
+
+	hits, err = repo.FullTextSearch(ctx, "farmacia", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "f2", hits[0].Transaction.ID)
+}
+
+func TestTransactionRepository_FullTextSearch_MigratedSchema(t *testing.T) {
+	t.Parallel()
+	dbs := database.New(dbsqlite.NewConnector(), migrator.New())
+	require.NoError(t, dbs.Open(context.Background(), t.TempDir()))
+	t.Cleanup(func() { _ = dbs.Close() })
+	ctx := context.Background()
+
+	for _, id := range []string{"tx-1", "tx-2"} {
+		_, err := dbs.Transactions.Exec(`INSERT INTO transactions
+			(id, account_id, category_id, type, amount, description, date, created_at, updated_at)
+			VALUES (?, 'acc-001', 'cat-001', 'expense', 10, ?, '2026-03-01', '2026-03-01T00:00:00Z', '2026-03-01T00:00:00Z')`,
+			id, "Farmacia "+id)
+		require.NoError(t, err)
+	}
+	_, err := dbs.Transactions.Exec("DELETE FROM transactions WHERE id = 'tx-2'")
+	require.NoError(t, err)
+
+	var indexed, mapped int
+	require.NoError(t, dbs.Transactions.QueryRow("SELECT count(*) FROM transactions_fts").Scan(&indexed))
+	require.NoError(t, dbs.Transactions.QueryRow("SELECT count(*) FROM transactions_fts_map").Scan(&mapped))
+	assert.Equal(t, 1, indexed)
+	assert.Equal(t, 1, mapped)
+
+	// A VACUUM INTO copy, as made by backups, keeps the index in step.
+	backupPath := filepath.Join(t.TempDir(), "transactions.db")
+	_, err = dbs.Transactions.Exec("VACUUM INTO ?", backupPath)
+	require.NoError(t, err)
+	backup, err := sql.Open("sqlite", backupPath)
+	require.NoError(t, err)
+	defer backup.Close()
+
+	hits, err := transactionsqlite.NewTransactionRepository(backup).FullTextSearch(ctx, "farmacia", 10)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "tx-1", hits[0].Transaction.ID)
+}
+
+func TestTransactionRepository_FullTextSearch_QueryError(t *testing.T) {
+	t.Parallel()
+	db, err := sql.Open("sqlite", "file:?mode=invalid")
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	_, err = repo.FullTextSearch(context.Background(), "farmacia", 10)
+	require.Error(t, err)
+}
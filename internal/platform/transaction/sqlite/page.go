@@ -0,0 +1,31 @@
+package sqlite
+
+import (
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
+)
+
+// sortKeys returns the keyset sort key of each transaction sort field.
+func sortKeys() map[string]dbsqlite.SortKey {
+	return map[string]dbsqlite.SortKey{
+		domaintransaction.SortDate:      {Column: "date", Value: domainshared.TimeValue(dateLayout)},
+		domaintransaction.SortAmount:    {Column: "amount", Value: domainshared.NumberValue},
+		domaintransaction.SortCreatedAt: {Column: "created_at", Value: domainshared.TimeValue(timeLayout)},
+	}
+}
+
+// cursorOf returns a function giving the sort value and ID of a transaction
+// for a cursor of page.
+func cursorOf(page domainshared.PageQuery) func(domaintransaction.Transaction) (any, string) {
+	return func(t domaintransaction.Transaction) (any, string) {
+		switch page.Sort {
+		case domaintransaction.SortAmount:
+			return t.Amount, t.ID
+		case domaintransaction.SortCreatedAt:
+			return t.CreatedAt.UTC().Format(timeLayout), t.ID
+		default:
+			return t.Date.Format(dateLayout), t.ID
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+)
+
+// seedPagedTransactions creates five income transactions whose dates and
+// amounts tie in pairs, so ordering relies on the ID tiebreaker.
+func seedPagedTransactions(t *testing.T) *transactionsqlite.TransactionRepository {
+	t.Helper()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	require.NoError(t, buildTestCategory(db, "cat-001"))
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	for i, f := range []struct {
+		id     string
+		amount float64
+		date   string
+	}{
+		{"tx-1", 30, "2026-03-02"},
+		{"tx-2", 10, "2026-03-01"},
+		{"tx-3", 20, "2026-03-02"},
+		{"tx-4", 30, "2026-03-03"},
+		{"tx-5", 10, "2026-03-01"},
+	} {
+		tx := buildDatedTransaction(f.id, domaintransaction.TransactionTypeIncome, f.amount, f.date)
+		tx.CreatedAt = created.Add(time.Duration(i) * time.Minute)
+		tx.UpdatedAt = tx.CreatedAt
+		require.NoError(t, repo.Create(context.Background(), tx))
+	}
+	return repo
+}
+
+func TestTransactionRepository_ListByType_Pages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		sort      string
+		direction domainshared.SortDirection
+		want      []string
+	}{
+		{
+			name:      "date descending",
+			sort:      domaintransaction.SortDate,
+			direction: domainshared.SortDesc,
+			want:      []string{"tx-4", "tx-3", "tx-1", "tx-5", "tx-2"},
+		},
+		{
+			name:      "date ascending",
+			sort:      domaintransaction.SortDate,
+			direction: domainshared.SortAsc,
+			want:      []string{"tx-2", "tx-5", "tx-1", "tx-3", "tx-4"},
+		},
+		{
+			name:      "amount descending",
+			sort:      domaintransaction.SortAmount,
+			direction: domainshared.SortDesc,
+			want:      []string{"tx-4", "tx-1", "tx-3", "tx-5", "tx-2"},
+		},
+		{
+			name:      "created_at ascending",
+			sort:      domaintransaction.SortCreatedAt,
+			direction: domainshared.SortAsc,
+			want:      []string{"tx-1", "tx-2", "tx-3", "tx-4", "tx-5"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := seedPagedTransactions(t)
+			page := domainshared.PageQuery{Limit: 2, Sort: tc.sort, Direction: tc.direction}
+
+			var got []string
+			var pages int
+			for {
+				txs, next, err := repo.ListByType(context.Background(), domaintransaction.TransactionTypeIncome, "", "", "", "", page)
+				require.NoError(t, err)
+				pages++
+				for _, tx := range txs {
+					got = append(got, tx.ID)
+				}
+				if next == "" {
+					break
+				}
+				page.Cursor = next
+			}
+
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, 3, pages)
+		})
+	}
+}
+
+func TestTransactionRepository_ListByType_LastPageHasNoCursor(t *testing.T) {
+	t.Parallel()
+	repo := seedPagedTransactions(t)
+
+	page := firstPage
+	page.Limit = 5
+	txs, next, err := repo.ListByType(context.Background(), domaintransaction.TransactionTypeIncome, "", "", "", "", page)
+
+	require.NoError(t, err)
+	assert.Len(t, txs, 5)
+	assert.Empty(t, next)
+}
+
+func TestTransactionRepository_ListByType_InvalidCursor(t *testing.T) {
+	t.Parallel()
+	repo := seedPagedTransactions(t)
+	ctx := context.Background()
+
+	page := firstPage
+	page.Limit = 2
+	_, next, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "", "", "", page)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		page domainshared.PageQuery
+	}{
+		{
+			name: "malformed cursor",
+			page: domainshared.PageQuery{Limit: 2, Cursor: "not a cursor", Sort: domaintransaction.SortDate, Direction: domainshared.SortDesc},
+		},
+		{
+			name: "cursor of another sort",
+			page: domainshared.PageQuery{Limit: 2, Cursor: next, Sort: domaintransaction.SortAmount, Direction: domainshared.SortDesc},
+		},
+		{
+			name: "cursor of another direction",
+			page: domainshared.PageQuery{Limit: 2, Cursor: next, Sort: domaintransaction.SortDate, Direction: domainshared.SortAsc},
+		},
+		{
+			name: "unknown sort",
+			page: domainshared.PageQuery{Limit: 2, Sort: "description", Direction: domainshared.SortAsc},
+		},
+		{
+			name: "number value for a date sort",
+			page: pageAfter(domaintransaction.SortDate, 20260301),
+		},
+		{
+			name: "text value that is not a date",
+			page: pageAfter(domaintransaction.SortDate, "yesterday"),
+		},
+		{
+			name: "text value for an amount sort",
+			page: pageAfter(domaintransaction.SortAmount, "30"),
+		},
+		{
+			name: "object value",
+			page: pageAfter(domaintransaction.SortCreatedAt, map[string]any{"a": 1}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "", "", "", tc.page)
+			assert.ErrorIs(t, err, domainshared.ErrInvalidPage)
+		})
+	}
+}
+
+// pageAfter returns a descending page of sort whose cursor carries value.
+func pageAfter(sort string, value any) domainshared.PageQuery {
+	cursor := domainshared.Cursor{Sort: sort, Direction: domainshared.SortDesc, Value: value, ID: "tx-1"}
+	return domainshared.PageQuery{Limit: 2, Cursor: cursor.Encode(), Sort: sort, Direction: domainshared.SortDesc}
+}
@@ -12,6 +12,7 @@ import (
 
 	domainshared "github.com/financial-manager/api/internal/domain/shared"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
 )
 
 const timeLayout = "2006-01-02T15:04:05Z"
@@ -27,7 +28,8 @@ func NewTransactionRepository(db *sql.DB) *TransactionRepository {
 	return &TransactionRepository{db: db}
 }
 
-// Create inserts a new transaction row and updates the account balance.
+// Create inserts a new transaction row and updates the account balance and its
+// balance snapshots.
 func (r *TransactionRepository) Create(ctx context.Context, t domaintransaction.Transaction) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -61,12 +63,7 @@ func (r *TransactionRepository) Create(ctx context.Context, t domaintransaction.
 	}
 
 	// Update account balance
-	var balanceDelta float64
-	if t.Type == domaintransaction.TransactionTypeIncome {
-		balanceDelta = t.Amount
-	} else {
-		balanceDelta = -t.Amount
-	}
+	balanceDelta := signedAmount(string(t.Type), t.Amount)
 
 	const updateBalanceQ = `UPDATE accounts SET current_balance = current_balance + ?, updated_at = ? WHERE id = ?`
 	now := time.Now().UTC()
@@ -75,6 +72,12 @@ func (r *TransactionRepository) Create(ctx context.Context, t domaintransaction.
 		return fmt.Errorf("transaction sqlite: update account balance: %w", err)
 	}
 
+	if t.IsActive {
+		if err := applySnapshotDelta(ctx, tx, t.AccountID, t.Date.Format(dateLayout), balanceDelta); err != nil {
+			return err
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("transaction sqlite: commit: %w", err)
 	}
@@ -99,15 +102,40 @@ func (r *TransactionRepository) GetByID(ctx context.Context, id string) (domaint
 	return t, nil
 }
 
-// Update modifies an existing transaction.
+// Update modifies an existing transaction and moves its amount between the
+// balance snapshots of its old and new date. The account balance lives in the
+// accounts database and is left to the caller.
 func (r *TransactionRepository) Update(ctx context.Context, t domaintransaction.Transaction) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("transaction sqlite: begin: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("rollback: %v", err)
+		}
+	}()
+
+	// Get the values the snapshots currently account for
+	const getQ = `SELECT account_id, type, amount, substr(date, 1, 10) FROM transactions WHERE id = ? AND is_active = 1`
+	var accountID, tType, oldDate string
+	var oldAmount float64
+	err = tx.QueryRowContext(ctx, getQ, t.ID).Scan(&accountID, &tType, &oldAmount, &oldDate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domainshared.ErrNotFound
+		}
+		return fmt.Errorf("transaction sqlite: get for update: %w", err)
+	}
+
 	const q = `UPDATE transactions SET 
 		category_id = ?, amount = ?, description = ?, date = ?, updated_at = ? 
 		WHERE id = ?`
 
-	_, err := r.db.ExecContext(ctx, q,
+	date := t.Date.Format(dateLayout)
+	_, err = tx.ExecContext(ctx, q,
 		t.CategoryID, t.Amount, t.Description,
-		t.Date.Format(dateLayout),
+		date,
 		t.UpdatedAt.UTC().Format(timeLayout),
 		t.ID,
 	)
@@ -115,10 +143,24 @@ func (r *TransactionRepository) Update(ctx context.Context, t domaintransaction.
 		return fmt.Errorf("transaction sqlite: update: %w", err)
 	}
 
+	if oldDate != date || oldAmount != t.Amount {
+		if err := applySnapshotDelta(ctx, tx, accountID, oldDate, -signedAmount(tType, oldAmount)); err != nil {
+			return err
+		}
+		if err := applySnapshotDelta(ctx, tx, accountID, date, signedAmount(tType, t.Amount)); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("transaction sqlite: commit: %w", err)
+	}
+
 	return nil
 }
 
-// SoftDelete marks a transaction as inactive and reverts the account balance.
+// SoftDelete marks a transaction as inactive and reverts the account balance
+// and its balance snapshots.
 func (r *TransactionRepository) SoftDelete(ctx context.Context, id string) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -131,11 +173,12 @@ func (r *TransactionRepository) SoftDelete(ctx context.Context, id string) error
 	}()
 
 	// Get transaction info before deleting
-	const getQ = `SELECT account_id, type, amount FROM transactions WHERE id = ? AND is_active = 1`
+	const getQ = `SELECT account_id, type, amount, substr(date, 1, 10) FROM transactions WHERE id = ? AND is_active = 1`
 	var accountID string
 	var tType string
 	var amount float64
-	err = tx.QueryRowContext(ctx, getQ, id).Scan(&accountID, &tType, &amount)
+	var date string
+	err = tx.QueryRowContext(ctx, getQ, id).Scan(&accountID, &tType, &amount, &date)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domainshared.ErrNotFound
@@ -152,12 +195,7 @@ func (r *TransactionRepository) SoftDelete(ctx context.Context, id string) error
 	}
 
 	// Revert account balance
-	var balanceDelta float64
-	if tType == string(domaintransaction.TransactionTypeIncome) {
-		balanceDelta = -amount // Revert income by subtracting
-	} else {
-		balanceDelta = amount // Revert expense by adding
-	}
+	balanceDelta := -signedAmount(tType, amount)
 
 	const updateBalanceQ = `UPDATE accounts SET current_balance = current_balance + ?, updated_at = ? WHERE id = ?`
 	_, err = tx.ExecContext(ctx, updateBalanceQ, balanceDelta, now.Format(timeLayout), accountID)
@@ -165,6 +203,10 @@ func (r *TransactionRepository) SoftDelete(ctx context.Context, id string) error
 		return fmt.Errorf("transaction sqlite: revert account balance: %w", err)
 	}
 
+	if err := applySnapshotDelta(ctx, tx, accountID, date, balanceDelta); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("transaction sqlite: commit: %w", err)
 	}
@@ -172,8 +214,10 @@ func (r *TransactionRepository) SoftDelete(ctx context.Context, id string) error
 	return nil
 }
 
-// ListByType returns transactions filtered by type, account, category, and date range.
-func (r *TransactionRepository) ListByType(ctx context.Context, tType domaintransaction.TransactionType, accountID, categoryID, startDate, endDate string) ([]domaintransaction.Transaction, error) {
+// ListByType returns one page of transactions filtered by type, account,
+// category, and date range, ordered by the page's sort field and then by ID.
+// It also returns the cursor of the next page, or "" on the last one.
+func (r *TransactionRepository) ListByType(ctx context.Context, tType domaintransaction.TransactionType, accountID, categoryID, startDate, endDate string, page domainshared.PageQuery) ([]domaintransaction.Transaction, string, error) {
 	var conditions []string
 	var args []interface{}
 
@@ -197,12 +241,22 @@ func (r *TransactionRepository) ListByType(ctx context.Context, tType domaintran
 		args = append(args, endDate)
 	}
 
+	after, afterArgs, orderBy, err := dbsqlite.Keyset(page, sortKeys())
+	if err != nil {
+		return nil, "", fmt.Errorf("transaction sqlite: list: %w", err)
+	}
+	if after != "" {
+		conditions = append(conditions, after)
+		args = append(args, afterArgs...)
+	}
+	args = append(args, dbsqlite.PageLimit(page))
+
 	q := fmt.Sprintf(`SELECT id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at
-		FROM transactions WHERE %s ORDER BY date DESC`, strings.Join(conditions, " AND "))
+		FROM transactions WHERE %s ORDER BY %s LIMIT ?`, strings.Join(conditions, " AND "), orderBy)
 
 	rows, err := r.db.QueryContext(ctx, q, args...)
 	if err != nil {
-		return nil, fmt.Errorf("transaction sqlite: list: %w", err)
+		return nil, "", fmt.Errorf("transaction sqlite: list: %w", err)
 	}
 	defer rows.Close()
 
@@ -210,16 +264,87 @@ func (r *TransactionRepository) ListByType(ctx context.Context, tType domaintran
 	for rows.Next() {
 		t, err := scanTransaction(rows)
 		if err != nil {
-			return nil, fmt.Errorf("transaction sqlite: list scan: %w", err)
+			return nil, "", fmt.Errorf("transaction sqlite: list scan: %w", err)
 		}
 		transactions = append(transactions, t)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("transaction sqlite: list rows: %w", err)
+		return nil, "", fmt.Errorf("transaction sqlite: list rows: %w", err)
 	}
 
-	return transactions, nil
+	transactions, next := dbsqlite.NextCursor(page, transactions, cursorOf(page))
+	return transactions, next, nil
+}
+
+// totalsColumn returns the column the totals grouping g groups by.
+func totalsColumn(g domaintransaction.TotalsGroupBy) (string, bool) {
+	switch g {
+	case domaintransaction.TotalsByType:
+		return "''", true
+	case domaintransaction.TotalsByCategory:
+		return "COALESCE(category_id, '')", true
+	case domaintransaction.TotalsByAccount:
+		return "account_id", true
+	default:
+		return "", false
+	}
+}
+
+// Totals sums the active transactions matching q per type, and per group when
+// q is grouped. The aggregation runs in SQLite; rows are ordered by type then
+// group and only combinations with transactions are returned.
+func (r *TransactionRepository) Totals(ctx context.Context, q domaintransaction.TotalsQuery) ([]domaintransaction.Total, error) {
+	group, ok := totalsColumn(q.GroupBy)
+	if !ok {
+		return nil, fmt.Errorf("transaction sqlite: totals: unsupported grouping %q", q.GroupBy)
+	}
+
+	conditions := []string{"is_active = 1"}
+	var args []interface{}
+	if q.AccountID != "" {
+		conditions = append(conditions, "account_id = ?")
+		args = append(args, q.AccountID)
+	}
+	if q.CategoryID != "" {
+		conditions = append(conditions, "category_id = ?")
+		args = append(args, q.CategoryID)
+	}
+	if q.StartDate != "" {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, q.StartDate)
+	}
+	if q.EndDate != "" {
+		conditions = append(conditions, "date <= ?")
+		args = append(args, q.EndDate)
+	}
+
+	query := fmt.Sprintf(`SELECT type, %[1]s AS grp, SUM(amount), COUNT(*)
+		FROM transactions WHERE %[2]s
+		GROUP BY type, grp ORDER BY type, grp`, group, strings.Join(conditions, " AND "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("transaction sqlite: totals: %w", err)
+	}
+	defer rows.Close()
+
+	totals := make([]domaintransaction.Total, 0)
+	for rows.Next() {
+		var t domaintransaction.Total
+		var tType string
+		if err := rows.Scan(&tType, &t.GroupID, &t.Amount, &t.Count); err != nil {
+			return nil, fmt.Errorf("transaction sqlite: totals scan: %w", err)
+		}
+		t.Type = domaintransaction.TransactionType(tType)
+		totals = append(totals, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("transaction sqlite: totals rows: %w", err)
+	}
+
+	return totals, nil
 }
 
 // ListRecent returns the most recent active transactions up to the limit.
@@ -249,6 +374,46 @@ func (r *TransactionRepository) ListRecent(ctx context.Context, limit int) ([]do
 	return transactions, nil
 }
 
+// ListActiveBetween returns all active transactions of both types dated within the
+// optional inclusive range, ordered by account and date.
+func (r *TransactionRepository) ListActiveBetween(ctx context.Context, startDate, endDate string) ([]domaintransaction.Transaction, error) {
+	conditions := []string{"is_active = 1"}
+	var args []interface{}
+
+	if startDate != "" {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, startDate)
+	}
+	if endDate != "" {
+		conditions = append(conditions, "date <= ?")
+		args = append(args, endDate)
+	}
+
+	q := fmt.Sprintf(`SELECT id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at
+		FROM transactions WHERE %s ORDER BY account_id, date, id`, strings.Join(conditions, " AND "))
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("transaction sqlite: list active: %w", err)
+	}
+	defer rows.Close()
+
+	transactions := make([]domaintransaction.Transaction, 0)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("transaction sqlite: list active scan: %w", err)
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("transaction sqlite: list active rows: %w", err)
+	}
+
+	return transactions, nil
+}
+
 // scanner abstracts *sql.Row and *sql.Rows for the shared scanTransaction helper.
 type scanner interface {
 	Scan(dest ...any) error
@@ -94,7 +94,7 @@ func TestTransactionRepository_ListByType_IncomeOnly(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, income2))
 	require.NoError(t, repo.Create(ctx, expense))
 
-	incomes, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "", "", "")
+	incomes, _, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "", "", "", firstPage)
 	require.NoError(t, err)
 	require.Len(t, incomes, 2)
 	assert.Equal(t, domaintransaction.TransactionTypeIncome, incomes[0].Type)
@@ -118,7 +118,7 @@ func TestTransactionRepository_ListByType_ExpenseOnly(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, expense1))
 	require.NoError(t, repo.Create(ctx, expense2))
 
-	expenses, err := repo.ListByType(ctx, domaintransaction.TransactionTypeExpense, "", "", "", "")
+	expenses, _, err := repo.ListByType(ctx, domaintransaction.TransactionTypeExpense, "", "", "", "", firstPage)
 	require.NoError(t, err)
 	require.Len(t, expenses, 2)
 	assert.Equal(t, domaintransaction.TransactionTypeExpense, expenses[0].Type)
@@ -143,7 +143,7 @@ func TestTransactionRepository_ListByType_WithFilters(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, tx2))
 
 	// Filter by account_id
-	filtered, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "acc-001", "", "", "")
+	filtered, _, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "acc-001", "", "", "", firstPage)
 	require.NoError(t, err)
 	require.Len(t, filtered, 1)
 	assert.Equal(t, "acc-001", filtered[0].AccountID)
@@ -165,7 +165,7 @@ func TestTransactionRepository_ListByType_OnlyActive(t *testing.T) {
 	require.NoError(t, repo.SoftDelete(ctx, "tx-1"))
 
 	// Should not appear in list
-	transactions, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "", "", "")
+	transactions, _, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "", "", "", firstPage)
 	require.NoError(t, err)
 	assert.Empty(t, transactions)
 }
@@ -352,7 +352,7 @@ func TestTransactionRepository_ListByType_WithDateRange(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, newTx))
 
 	// Filter by date range
-	transactions, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "", "2026-01-01", "")
+	transactions, _, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "", "2026-01-01", "", firstPage)
 	require.NoError(t, err)
 	require.Len(t, transactions, 1)
 	assert.Equal(t, "tx-2", transactions[0].ID)
@@ -377,7 +377,7 @@ func TestTransactionRepository_ListByType_WithCategoryFilter(t *testing.T) {
 	require.NoError(t, repo.Create(ctx, tx2))
 
 	// Filter by category
-	transactions, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "cat-001", "", "")
+	transactions, _, err := repo.ListByType(ctx, domaintransaction.TransactionTypeIncome, "", "cat-001", "", "", firstPage)
 	require.NoError(t, err)
 	require.Len(t, transactions, 1)
 	assert.Equal(t, "cat-001", transactions[0].CategoryID)
@@ -390,6 +390,145 @@ func TestTransactionRepository_ListByType_QueryError(t *testing.T) {
 	defer db.Close()
 
 	repo := transactionsqlite.NewTransactionRepository(db)
-	_, err = repo.ListByType(context.Background(), domaintransaction.TransactionTypeIncome, "", "", "", "")
+	_, _, err = repo.ListByType(context.Background(), domaintransaction.TransactionTypeIncome, "", "", "", "", firstPage)
+	require.Error(t, err)
+}
+
+func TestTransactionRepository_ListActiveBetween_BothTypesInRange(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	require.NoError(t, buildTestCategory(db, "cat-001"))
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	income := buildTestTransaction("tx-1", "acc-001", domaintransaction.TransactionTypeIncome, 100.0)
+	income.Date = time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Create(ctx, income))
+
+	expense := buildTestTransaction("tx-2", "acc-001", domaintransaction.TransactionTypeExpense, 20.0)
+	expense.Date = time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Create(ctx, expense))
+
+	outside := buildTestTransaction("tx-3", "acc-001", domaintransaction.TransactionTypeExpense, 20.0)
+	outside.Date = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Create(ctx, outside))
+
+	deleted := buildTestTransaction("tx-4", "acc-001", domaintransaction.TransactionTypeExpense, 20.0)
+	deleted.Date = time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Create(ctx, deleted))
+	require.NoError(t, repo.SoftDelete(ctx, "tx-4"))
+
+	transactions, err := repo.ListActiveBetween(ctx, "2026-02-01", "2026-02-28")
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	assert.Equal(t, "tx-1", transactions[0].ID)
+	assert.Equal(t, "tx-2", transactions[1].ID)
+}
+
+func TestTransactionRepository_ListActiveBetween_QueryError(t *testing.T) {
+	t.Parallel()
+	db, err := sql.Open("sqlite", "file:?mode=invalid")
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	_, err = repo.ListActiveBetween(context.Background(), "", "")
+	require.Error(t, err)
+}
+
+func TestTransactionRepository_Totals(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	for _, id := range []string{"acc-001", "acc-002"} {
+		require.NoError(t, buildTestAccount(db, id))
+	}
+	for _, id := range []string{"cat-001", "cat-002"} {
+		require.NoError(t, buildTestCategory(db, id))
+	}
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	ctx := context.Background()
+	seed := func(id, accountID, categoryID string, tType domaintransaction.TransactionType, amount float64, date string) {
+		tx := buildTestTransaction(id, accountID, tType, amount)
+		tx.CategoryID = categoryID
+		tx.Date, _ = time.Parse("2006-01-02", date)
+		require.NoError(t, repo.Create(ctx, tx))
+	}
+	seed("tx-1", "acc-001", "cat-001", domaintransaction.TransactionTypeIncome, 1000, "2026-02-01")
+	seed("tx-2", "acc-001", "cat-002", domaintransaction.TransactionTypeExpense, 40, "2026-02-10")
+	seed("tx-3", "acc-002", "cat-002", domaintransaction.TransactionTypeExpense, 60, "2026-02-28")
+	seed("tx-4", "acc-002", "", domaintransaction.TransactionTypeExpense, 5, "2026-02-15")
+	seed("tx-5", "acc-001", "cat-001", domaintransaction.TransactionTypeIncome, 999, "2026-03-01")
+	seed("tx-6", "acc-001", "cat-002", domaintransaction.TransactionTypeExpense, 999, "2026-02-12")
+	require.NoError(t, repo.SoftDelete(ctx, "tx-6"))
+
+	february := domaintransaction.TotalsQuery{StartDate: "2026-02-01", EndDate: "2026-02-28"}
+	byCategory, byAccount, forAccount := february, february, february
+	byCategory.GroupBy = domaintransaction.TotalsByCategory
+	byAccount.GroupBy = domaintransaction.TotalsByAccount
+	forAccount.AccountID = "acc-002"
+
+	tests := []struct {
+		name  string
+		query domaintransaction.TotalsQuery
+		want  []domaintransaction.Total
+	}{
+		{
+			name:  "totals per type within the range",
+			query: february,
+			want: []domaintransaction.Total{
+				{Type: domaintransaction.TransactionTypeExpense, Amount: 105, Count: 3},
+				{Type: domaintransaction.TransactionTypeIncome, Amount: 1000, Count: 1},
+			},
+		},
+		{
+			name:  "totals per category keep uncategorized transactions",
+			query: byCategory,
+			want: []domaintransaction.Total{
+				{Type: domaintransaction.TransactionTypeExpense, GroupID: "", Amount: 5, Count: 1},
+				{Type: domaintransaction.TransactionTypeExpense, GroupID: "cat-002", Amount: 100, Count: 2},
+				{Type: domaintransaction.TransactionTypeIncome, GroupID: "cat-001", Amount: 1000, Count: 1},
+			},
+		},
+		{
+			name:  "totals per account",
+			query: byAccount,
+			want: []domaintransaction.Total{
+				{Type: domaintransaction.TransactionTypeExpense, GroupID: "acc-001", Amount: 40, Count: 1},
+				{Type: domaintransaction.TransactionTypeExpense, GroupID: "acc-002", Amount: 65, Count: 2},
+				{Type: domaintransaction.TransactionTypeIncome, GroupID: "acc-001", Amount: 1000, Count: 1},
+			},
+		},
+		{
+			name:  "account filter",
+			query: forAccount,
+			want: []domaintransaction.Total{
+				{Type: domaintransaction.TransactionTypeExpense, Amount: 65, Count: 2},
+			},
+		},
+		{
+			name:  "no matching transactions",
+			query: domaintransaction.TotalsQuery{StartDate: "2030-01-01"},
+			want:  []domaintransaction.Total{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := repo.Totals(ctx, tc.query)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestTransactionRepository_Totals_UnsupportedGrouping(t *testing.T) {
+	t.Parallel()
+	repo := transactionsqlite.NewTransactionRepository(newTestDB(t))
+
+	_, err := repo.Totals(context.Background(), domaintransaction.TotalsQuery{GroupBy: "payee"})
 	require.Error(t, err)
 }
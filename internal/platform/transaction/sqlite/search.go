@@ -0,0 +1,150 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	dbsqlite "github.com/financial-manager/api/internal/platform/database/sqlite"
+)
+
+// Search returns one page of the transactions matching f, ordered by the
+// page's sort field and then by ID, and the cursor of the next page, or "" on
+// the last one.
+func (r *TransactionRepository) Search(ctx context.Context, f domaintransaction.SearchFilter, page domainshared.PageQuery) ([]domaintransaction.Transaction, string, error) {
+	conditions, args := searchConditions(f)
+
+	after, afterArgs, orderBy, err := dbsqlite.Keyset(page, sortKeys())
+	if err != nil {
+		return nil, "", fmt.Errorf("transaction sqlite: search: %w", err)
+	}
+	if after != "" {
+		conditions = append(conditions, after)
+		args = append(args, afterArgs...)
+	}
+	args = append(args, dbsqlite.PageLimit(page))
+
+	q := fmt.Sprintf(`SELECT id, account_id, category_id, type, amount, description, date, is_active, created_at, updated_at
+		FROM transactions %s ORDER BY %s LIMIT ?`, where(conditions), orderBy)
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("transaction sqlite: search: %w", err)
+	}
+	defer rows.Close()
+
+	transactions := make([]domaintransaction.Transaction, 0)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, "", fmt.Errorf("transaction sqlite: search scan: %w", err)
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("transaction sqlite: search rows: %w", err)
+	}
+
+	transactions, next := dbsqlite.NextCursor(page, transactions, cursorOf(page))
+	return transactions, next, nil
+}
+
+// SearchTotals sums the transactions matching f per type, across all pages.
+// Only types with transactions are returned, ordered by type.
+func (r *TransactionRepository) SearchTotals(ctx context.Context, f domaintransaction.SearchFilter) ([]domaintransaction.Total, error) {
+	conditions, args := searchConditions(f)
+
+	q := fmt.Sprintf(`SELECT type, SUM(amount), COUNT(*)
+		FROM transactions %s
+		GROUP BY type ORDER BY type`, where(conditions))
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("transaction sqlite: search totals: %w", err)
+	}
+	defer rows.Close()
+
+	totals := make([]domaintransaction.Total, 0)
+	for rows.Next() {
+		var t domaintransaction.Total
+		var tType string
+		if err := rows.Scan(&tType, &t.Amount, &t.Count); err != nil {
+			return nil, fmt.Errorf("transaction sqlite: search totals scan: %w", err)
+		}
+		t.Type = domaintransaction.TransactionType(tType)
+		totals = append(totals, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("transaction sqlite: search totals rows: %w", err)
+	}
+
+	return totals, nil
+}
+
+// searchConditions returns the WHERE conditions selecting the transactions
+// matching f, with their arguments.
+func searchConditions(f domaintransaction.SearchFilter) ([]string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	if !f.IncludeDeleted {
+		conditions = append(conditions, "is_active = 1")
+	}
+	if len(f.Types) > 0 {
+		types := make([]string, len(f.Types))
+		for i, t := range f.Types {
+			types[i] = string(t)
+		}
+		conditions, args = appendIn(conditions, args, "type", types)
+	}
+	if len(f.AccountIDs) > 0 {
+		conditions, args = appendIn(conditions, args, "account_id", f.AccountIDs)
+	}
+	if len(f.CategoryIDs) > 0 {
+		conditions, args = appendIn(conditions, args, "category_id", f.CategoryIDs)
+	}
+	if f.MinAmount != nil {
+		conditions = append(conditions, "amount >= ?")
+		args = append(args, *f.MinAmount)
+	}
+	if f.MaxAmount != nil {
+		conditions = append(conditions, "amount <= ?")
+		args = append(args, *f.MaxAmount)
+	}
+	if f.Query != "" {
+		conditions = append(conditions, `description LIKE ? ESCAPE '\'`)
+		args = append(args, dbsqlite.LikeContains(f.Query))
+	}
+	if f.StartDate != "" {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, f.StartDate)
+	}
+	if f.EndDate != "" {
+		conditions = append(conditions, "date <= ?")
+		args = append(args, f.EndDate)
+	}
+
+	return conditions, args
+}
+
+// where returns the WHERE clause joining conditions, or "" when there are none.
+func where(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(conditions, " AND ")
+}
+
+// appendIn appends a "column IN (...)" condition matching values.
+func appendIn(conditions []string, args []interface{}, column string, values []string) ([]string, []interface{}) {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+	conditions = append(conditions, fmt.Sprintf("%s IN (%s)", column, placeholders))
+	for _, v := range values {
+		args = append(args, v)
+	}
+	return conditions, args
+}
@@ -0,0 +1,174 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+)
+
+// seedSearchTransactions creates one income and three expenses over two
+// accounts and two categories; the last expense is soft-deleted.
+func seedSearchTransactions(t *testing.T) *transactionsqlite.TransactionRepository {
+	t.Helper()
+	db := newTestDB(t)
+	for _, id := range []string{"acc-001", "acc-002"} {
+		require.NoError(t, buildTestAccount(db, id))
+	}
+	for _, id := range []string{"cat-001", "cat-002"} {
+		require.NoError(t, buildTestCategory(db, id))
+	}
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	for _, f := range []struct {
+		id, accountID, categoryID string
+		tType                     domaintransaction.TransactionType
+		amount                    float64
+		description, date         string
+	}{
+		{"s1", "acc-001", "cat-001", domaintransaction.TransactionTypeIncome, 100, "Monthly salary", "2026-03-01"},
+		{"s2", "acc-001", "cat-002", domaintransaction.TransactionTypeExpense, 25.5, "Coffee beans", "2026-03-02"},
+		{"s3", "acc-002", "cat-001", domaintransaction.TransactionTypeExpense, 60, "Groceries 50% off", "2026-03-03"},
+		{"s4", "acc-002", "cat-002", domaintransaction.TransactionTypeExpense, 10, "coffee", "2026-03-04"},
+	} {
+		tx := buildDatedTransaction(f.id, f.tType, f.amount, f.date)
+		tx.AccountID, tx.CategoryID, tx.Description = f.accountID, f.categoryID, f.description
+		require.NoError(t, repo.Create(context.Background(), tx))
+	}
+	require.NoError(t, repo.SoftDelete(context.Background(), "s4"))
+	return repo
+}
+
+func TestTransactionRepository_Search(t *testing.T) {
+	t.Parallel()
+
+	minAmount, maxAmount := 20.0, 60.0
+
+	tests := []struct {
+		name   string
+		filter domaintransaction.SearchFilter
+		want   []string
+	}{
+		{
+			name: "no filter returns active transactions",
+			want: []string{"s3", "s2", "s1"},
+		},
+		{
+			name:   "several types",
+			filter: domaintransaction.SearchFilter{Types: []domaintransaction.TransactionType{domaintransaction.TransactionTypeIncome, domaintransaction.TransactionTypeExpense}},
+			want:   []string{"s3", "s2", "s1"},
+		},
+		{
+			name:   "one type",
+			filter: domaintransaction.SearchFilter{Types: []domaintransaction.TransactionType{domaintransaction.TransactionTypeIncome}},
+			want:   []string{"s1"},
+		},
+		{
+			name:   "accounts and categories combine",
+			filter: domaintransaction.SearchFilter{AccountIDs: []string{"acc-001", "acc-002"}, CategoryIDs: []string{"cat-002"}},
+			want:   []string{"s2"},
+		},
+		{
+			name:   "amount range is inclusive",
+			filter: domaintransaction.SearchFilter{MinAmount: &minAmount, MaxAmount: &maxAmount},
+			want:   []string{"s3", "s2"},
+		},
+		{
+			name:   "query ignores case",
+			filter: domaintransaction.SearchFilter{Query: "COFFEE"},
+			want:   []string{"s2"},
+		},
+		{
+			name:   "query wildcards are literal",
+			filter: domaintransaction.SearchFilter{Query: "50%"},
+			want:   []string{"s3"},
+		},
+		{
+			name:   "date range is inclusive",
+			filter: domaintransaction.SearchFilter{StartDate: "2026-03-02", EndDate: "2026-03-03"},
+			want:   []string{"s3", "s2"},
+		},
+		{
+			name:   "include deleted",
+			filter: domaintransaction.SearchFilter{Query: "coffee", IncludeDeleted: true},
+			want:   []string{"s4", "s2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := seedSearchTransactions(t)
+
+			got, next, err := repo.Search(context.Background(), tc.filter, firstPage)
+			require.NoError(t, err)
+			assert.Empty(t, next)
+
+			ids := make([]string, 0, len(got))
+			for _, tx := range got {
+				ids = append(ids, tx.ID)
+			}
+			assert.Equal(t, tc.want, ids)
+		})
+	}
+}
+
+func TestTransactionRepository_Search_Pages(t *testing.T) {
+	t.Parallel()
+	repo := seedSearchTransactions(t)
+	page := domainshared.PageQuery{Limit: 2, Sort: domaintransaction.SortAmount, Direction: domainshared.SortAsc}
+	filter := domaintransaction.SearchFilter{IncludeDeleted: true}
+
+	first, next, err := repo.Search(context.Background(), filter, page)
+	require.NoError(t, err)
+	require.NotEmpty(t, next)
+
+	page.Cursor = next
+	second, next, err := repo.Search(context.Background(), filter, page)
+	require.NoError(t, err)
+	assert.Empty(t, next)
+
+	var ids []string
+	for _, tx := range append(first, second...) {
+		ids = append(ids, tx.ID)
+	}
+	assert.Equal(t, []string{"s4", "s2", "s3", "s1"}, ids)
+}
+
+func TestTransactionRepository_SearchTotals(t *testing.T) {
+	t.Parallel()
+	repo := seedSearchTransactions(t)
+
+	got, err := repo.SearchTotals(context.Background(), domaintransaction.SearchFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []domaintransaction.Total{
+		{Type: domaintransaction.TransactionTypeExpense, Amount: 85.5, Count: 2},
+		{Type: domaintransaction.TransactionTypeIncome, Amount: 100, Count: 1},
+	}, got)
+
+	got, err = repo.SearchTotals(context.Background(), domaintransaction.SearchFilter{Query: "coffee", IncludeDeleted: true})
+	require.NoError(t, err)
+	assert.Equal(t, []domaintransaction.Total{
+		{Type: domaintransaction.TransactionTypeExpense, Amount: 35.5, Count: 2},
+	}, got)
+}
+
+func TestTransactionRepository_Search_QueryError(t *testing.T) {
+	t.Parallel()
+	db, err := sql.Open("sqlite", "file:?mode=invalid")
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	_, _, err = repo.Search(context.Background(), domaintransaction.SearchFilter{}, firstPage)
+	require.Error(t, err)
+
+	_, err = repo.SearchTotals(context.Background(), domaintransaction.SearchFilter{})
+	require.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+)
+
+// balance_snapshots holds, per account and per day with transactions, the net
+// amount of every active transaction of the account up to and including that
+// day. Create, Update and SoftDelete keep it current; RebuildSnapshots
+// recomputes it from scratch.
+
+const rebuildSnapshotsQ = `INSERT INTO balance_snapshots (account_id, date, balance)
+	SELECT account_id, substr(date, 1, 10) AS day,
+		SUM(SUM(CASE type WHEN 'income' THEN amount ELSE -amount END))
+			OVER (PARTITION BY account_id ORDER BY substr(date, 1, 10))
+	FROM transactions
+	WHERE is_active = 1
+	GROUP BY account_id, day`
+
+// signedAmount returns the amount a transaction adds to its account balance.
+func signedAmount(tType string, amount float64) float64 {
+	if tType == string(domaintransaction.TransactionTypeIncome) {
+		return amount
+	}
+	return -amount
+}
+
+// applySnapshotDelta adds delta to the snapshot of accountID on date and on
+// every later day, creating the date's snapshot from the previous one first.
+func applySnapshotDelta(ctx context.Context, tx *sql.Tx, accountID, date string, delta float64) error {
+	const insertQ = `INSERT INTO balance_snapshots (account_id, date, balance)
+		VALUES (?, ?, COALESCE((SELECT balance FROM balance_snapshots
+			WHERE account_id = ? AND date < ? ORDER BY date DESC LIMIT 1), 0))
+		ON CONFLICT (account_id, date) DO NOTHING`
+	if _, err := tx.ExecContext(ctx, insertQ, accountID, date, accountID, date); err != nil {
+		return fmt.Errorf("transaction sqlite: insert snapshot: %w", err)
+	}
+
+	const updateQ = `UPDATE balance_snapshots SET balance = balance + ? WHERE account_id = ? AND date >= ?`
+	if _, err := tx.ExecContext(ctx, updateQ, delta, accountID, date); err != nil {
+		return fmt.Errorf("transaction sqlite: update snapshots: %w", err)
+	}
+
+	return nil
+}
+
+// BalanceOn returns the net amount of the active transactions of accountID
+// dated on or before date (YYYY-MM-DD), read from a single snapshot row.
+// An account without transactions by then nets zero.
+func (r *TransactionRepository) BalanceOn(ctx context.Context, accountID, date string) (float64, error) {
+	const q = `SELECT balance FROM balance_snapshots
+		WHERE account_id = ? AND date <= ? ORDER BY date DESC LIMIT 1`
+
+	var balance float64
+	err := r.db.QueryRowContext(ctx, q, accountID, date).Scan(&balance)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("transaction sqlite: balance on: %w", err)
+	}
+
+	return balance, nil
+}
+
+// RebuildSnapshots recomputes every balance snapshot from the active
+// transactions and returns the number of snapshots written.
+func (r *TransactionRepository) RebuildSnapshots(ctx context.Context) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("transaction sqlite: begin: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("rollback: %v", err)
+		}
+	}()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM balance_snapshots`); err != nil {
+		return 0, fmt.Errorf("transaction sqlite: clear snapshots: %w", err)
+	}
+
+	res, err := tx.ExecContext(ctx, rebuildSnapshotsQ)
+	if err != nil {
+		return 0, fmt.Errorf("transaction sqlite: rebuild snapshots: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("transaction sqlite: rebuild snapshots: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("transaction sqlite: commit: %w", err)
+	}
+
+	return int(n), nil
+}
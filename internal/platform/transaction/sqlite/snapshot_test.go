@@ -0,0 +1,167 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
+	transactionsqlite "github.com/financial-manager/api/internal/platform/transaction/sqlite"
+)
+
+// buildDatedTransaction returns a transaction fixture of acc-001 dated date.
+func buildDatedTransaction(id string, tType domaintransaction.TransactionType, amount float64, date string) domaintransaction.Transaction {
+	tx := buildTestTransaction(id, "acc-001", tType, amount)
+	tx.Date, _ = time.Parse("2006-01-02", date)
+	return tx
+}
+
+// assertBalances checks BalanceOn for acc-001 against want, keyed by date.
+func assertBalances(t *testing.T, repo *transactionsqlite.TransactionRepository, want map[string]float64) {
+	t.Helper()
+	for date, balance := range want {
+		got, err := repo.BalanceOn(context.Background(), "acc-001", date)
+		require.NoError(t, err)
+		assert.InDelta(t, balance, got, 0.001, "balance on %s", date)
+	}
+}
+
+func TestTransactionRepository_BalanceOn_FollowsWrites(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	require.NoError(t, buildTestCategory(db, "cat-001"))
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-1", domaintransaction.TransactionTypeIncome, 1000, "2026-03-01")))
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-2", domaintransaction.TransactionTypeExpense, 200, "2026-03-10")))
+	// Backdated: every later snapshot moves.
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-3", domaintransaction.TransactionTypeExpense, 50, "2026-03-05")))
+
+	assertBalances(t, repo, map[string]float64{
+		"2026-02-28": 0,
+		"2026-03-01": 1000,
+		"2026-03-04": 1000,
+		"2026-03-05": 950,
+		"2026-03-10": 750,
+		"2026-12-31": 750,
+	})
+
+	// Moving tx-2 earlier and raising it.
+	moved := buildDatedTransaction("tx-2", domaintransaction.TransactionTypeExpense, 300, "2026-03-03")
+	require.NoError(t, repo.Update(ctx, moved))
+
+	assertBalances(t, repo, map[string]float64{
+		"2026-03-01": 1000,
+		"2026-03-03": 700,
+		"2026-03-05": 650,
+		"2026-03-10": 650,
+	})
+
+	require.NoError(t, repo.SoftDelete(ctx, "tx-1"))
+
+	assertBalances(t, repo, map[string]float64{
+		"2026-03-01": 0,
+		"2026-03-03": -300,
+		"2026-03-10": -350,
+	})
+}
+
+func TestTransactionRepository_BalanceOn_OtherAccountsUnaffected(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	require.NoError(t, buildTestAccount(db, "acc-002"))
+	require.NoError(t, buildTestCategory(db, "cat-001"))
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	other := buildDatedTransaction("tx-1", domaintransaction.TransactionTypeIncome, 500, "2026-03-01")
+	other.AccountID = "acc-002"
+	require.NoError(t, repo.Create(ctx, other))
+
+	got, err := repo.BalanceOn(ctx, "acc-001", "2026-03-31")
+	require.NoError(t, err)
+	assert.Zero(t, got)
+
+	got, err = repo.BalanceOn(ctx, "acc-002", "2026-03-31")
+	require.NoError(t, err)
+	assert.InDelta(t, 500.0, got, 0.001)
+}
+
+func TestTransactionRepository_Update_MovesSnapshots(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	repo := transactionsqlite.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-1", domaintransaction.TransactionTypeExpense, 100, "2026-03-01")))
+	require.NoError(t, repo.Update(ctx, buildDatedTransaction("tx-1", domaintransaction.TransactionTypeExpense, 300, "2026-03-04")))
+
+	assertBalances(t, repo, map[string]float64{
+		"2026-03-01": 0,
+		"2026-03-04": -300,
+	})
+}
+
+func TestTransactionRepository_Update_NotFound(t *testing.T) {
+	t.Parallel()
+	repo := transactionsqlite.NewTransactionRepository(newTestDB(t))
+
+	err := repo.Update(context.Background(), buildDatedTransaction("missing", domaintransaction.TransactionTypeIncome, 10, "2026-03-01"))
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+}
+
+func TestTransactionRepository_RebuildSnapshots(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	require.NoError(t, buildTestAccount(db, "acc-001"))
+	require.NoError(t, buildTestCategory(db, "cat-001"))
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-1", domaintransaction.TransactionTypeIncome, 1000, "2026-03-01")))
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-2", domaintransaction.TransactionTypeExpense, 200, "2026-03-10")))
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-3", domaintransaction.TransactionTypeExpense, 50, "2026-03-10")))
+	require.NoError(t, repo.Create(ctx, buildDatedTransaction("tx-4", domaintransaction.TransactionTypeExpense, 99, "2026-03-20")))
+	require.NoError(t, repo.SoftDelete(ctx, "tx-4"))
+
+	// Drift the snapshots the way a manual edit of the database would.
+	_, err := db.Exec(`UPDATE balance_snapshots SET balance = 12345`)
+	require.NoError(t, err)
+
+	n, err := repo.RebuildSnapshots(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	assertBalances(t, repo, map[string]float64{
+		"2026-02-28": 0,
+		"2026-03-01": 1000,
+		"2026-03-10": 750,
+		"2026-03-20": 750,
+	})
+}
+
+func TestTransactionRepository_BalanceOn_QueryError(t *testing.T) {
+	t.Parallel()
+	db := newTestDB(t)
+	_, err := db.Exec(`DROP TABLE balance_snapshots`)
+	require.NoError(t, err)
+
+	repo := transactionsqlite.NewTransactionRepository(db)
+
+	_, err = repo.BalanceOn(context.Background(), "acc-001", "2026-03-01")
+	require.Error(t, err)
+
+	_, err = repo.RebuildSnapshots(context.Background())
+	require.Error(t, err)
+}
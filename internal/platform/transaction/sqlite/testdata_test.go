@@ -11,9 +11,17 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
 	domaintransaction "github.com/financial-manager/api/internal/domain/transaction"
 )
 
+// firstPage is the first page of a list in its default order.
+var firstPage = domainshared.PageQuery{
+	Limit:     domainshared.DefaultPageLimit,
+	Sort:      domaintransaction.SortDate,
+	Direction: domainshared.SortDesc,
+}
+
 // dbCounter provides unique in-memory database names to avoid shared state between parallel tests.
 var dbCounter atomic.Int64
 
@@ -72,6 +80,42 @@ func newTestDB(t *testing.T) *sql.DB {
 	)`)
 	require.NoError(t, err)
 
+	// Create balance snapshots table
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS balance_snapshots (
+		account_id TEXT NOT NULL,
+		date       TEXT NOT NULL,
+		balance    REAL NOT NULL,
+		PRIMARY KEY (account_id, date)
+	) WITHOUT ROWID`)
+	require.NoError(t, err)
+
+	// Create the full-text index and the triggers keeping it in sync
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS transactions_fts_map (
+		rowid          INTEGER PRIMARY KEY,
+		transaction_id TEXT NOT NULL UNIQUE
+	);
+	CREATE VIRTUAL TABLE IF NOT EXISTS transactions_fts USING fts5(
+		description,
+		tokenize = 'unicode61 remove_diacritics 2',
+		prefix = '2 3'
+	);
+	CREATE TRIGGER IF NOT EXISTS transactions_fts_insert AFTER INSERT ON transactions BEGIN
+		INSERT INTO transactions_fts_map (transaction_id) VALUES (new.id);
+		INSERT INTO transactions_fts (rowid, description)
+		SELECT rowid, new.description FROM transactions_fts_map WHERE transaction_id = new.id;
+	END;
+	CREATE TRIGGER IF NOT EXISTS transactions_fts_update AFTER UPDATE OF description ON transactions
+	WHEN old.description IS NOT new.description BEGIN
+		UPDATE transactions_fts SET description = new.description
+		WHERE rowid = (SELECT rowid FROM transactions_fts_map WHERE transaction_id = old.id);
+	END;
+	CREATE TRIGGER IF NOT EXISTS transactions_fts_delete AFTER DELETE ON transactions BEGIN
+		DELETE FROM transactions_fts
+		WHERE rowid = (SELECT rowid FROM transactions_fts_map WHERE transaction_id = old.id);
+		DELETE FROM transactions_fts_map WHERE transaction_id = old.id;
+	END`)
+	require.NoError(t, err)
+
 	return db
 }
 
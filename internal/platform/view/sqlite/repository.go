@@ -0,0 +1,212 @@
+// Package sqlite implements the saved view repository using SQLite.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+const timeLayout = "2006-01-02T15:04:05Z"
+
+// ViewRepository implements view repository interfaces using SQLite.
+type ViewRepository struct {
+	db *sql.DB
+}
+
+// NewViewRepository creates a ViewRepository with the provided settings *sql.DB.
+// The caller is responsible for opening and closing the database connection.
+func NewViewRepository(db *sql.DB) *ViewRepository {
+	return &ViewRepository{db: db}
+}
+
+// storedFilter is the JSON document kept in the filter column.
+type storedFilter struct {
+	Types       []string `json:"types,omitempty"`
+	AccountIDs  []string `json:"account_ids,omitempty"`
+	CategoryIDs []string `json:"category_ids,omitempty"`
+	Range       string   `json:"range,omitempty"`
+	StartDate   string   `json:"start_date,omitempty"`
+	EndDate     string   `json:"end_date,omitempty"`
+	MinAmount   *float64 `json:"min_amount,omitempty"`
+	MaxAmount   *float64 `json:"max_amount,omitempty"`
+	Query       string   `json:"query,omitempty"`
+}
+
+// Create inserts a new view row.
+func (r *ViewRepository) Create(ctx context.Context, v domainview.View) error {
+	const q = `INSERT INTO views (id, name, filter, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`
+
+	filter, err := encodeFilter(v.Filter)
+	if err != nil {
+		return fmt.Errorf("view sqlite: create: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, q,
+		v.ID, v.Name, filter,
+		v.CreatedAt.UTC().Format(timeLayout),
+		v.UpdatedAt.UTC().Format(timeLayout),
+	)
+	if err != nil {
+		return fmt.Errorf("view sqlite: create: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a view by its ID.
+// Returns domainshared.ErrNotFound if no row exists.
+func (r *ViewRepository) GetByID(ctx context.Context, id string) (domainview.View, error) {
+	const q = `SELECT id, name, filter, created_at, updated_at FROM views WHERE id = ?`
+
+	v, err := scanView(r.db.QueryRowContext(ctx, q, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domainview.View{}, domainshared.ErrNotFound
+	}
+	if err != nil {
+		return domainview.View{}, fmt.Errorf("view sqlite: get by id: %w", err)
+	}
+
+	return v, nil
+}
+
+// GetByName retrieves a view by its name.
+// Returns domainshared.ErrNotFound if no row exists.
+func (r *ViewRepository) GetByName(ctx context.Context, name string) (domainview.View, error) {
+	const q = `SELECT id, name, filter, created_at, updated_at FROM views WHERE name = ?`
+
+	v, err := scanView(r.db.QueryRowContext(ctx, q, name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return domainview.View{}, domainshared.ErrNotFound
+	}
+	if err != nil {
+		return domainview.View{}, fmt.Errorf("view sqlite: get by name: %w", err)
+	}
+
+	return v, nil
+}
+
+// List returns all views ordered by name.
+func (r *ViewRepository) List(ctx context.Context) ([]domainview.View, error) {
+	const q = `SELECT id, name, filter, created_at, updated_at FROM views ORDER BY name`
+
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("view sqlite: list: %w", err)
+	}
+	defer rows.Close()
+
+	views := make([]domainview.View, 0)
+	for rows.Next() {
+		v, err := scanView(rows)
+		if err != nil {
+			return nil, fmt.Errorf("view sqlite: list scan: %w", err)
+		}
+		views = append(views, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("view sqlite: list rows: %w", err)
+	}
+
+	return views, nil
+}
+
+// Delete removes a view by its ID.
+// Returns domainshared.ErrNotFound if no row exists.
+func (r *ViewRepository) Delete(ctx context.Context, id string) error {
+	const q = `DELETE FROM views WHERE id = ?`
+
+	res, err := r.db.ExecContext(ctx, q, id)
+	if err != nil {
+		return fmt.Errorf("view sqlite: delete: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("view sqlite: delete: %w", err)
+	}
+	if affected == 0 {
+		return domainshared.ErrNotFound
+	}
+
+	return nil
+}
+
+// scanner abstracts *sql.Row and *sql.Rows for the shared scanView helper.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanView(s scanner) (domainview.View, error) {
+	var (
+		v                    domainview.View
+		filter               string
+		createdAt, updatedAt string
+	)
+
+	if err := s.Scan(&v.ID, &v.Name, &filter, &createdAt, &updatedAt); err != nil {
+		return domainview.View{}, err
+	}
+
+	var err error
+	v.Filter, err = decodeFilter(filter)
+	if err != nil {
+		return domainview.View{}, fmt.Errorf("parse filter: %w", err)
+	}
+
+	v.CreatedAt, err = time.Parse(timeLayout, createdAt)
+	if err != nil {
+		return domainview.View{}, fmt.Errorf("parse created_at: %w", err)
+	}
+
+	v.UpdatedAt, err = time.Parse(timeLayout, updatedAt)
+	if err != nil {
+		return domainview.View{}, fmt.Errorf("parse updated_at: %w", err)
+	}
+
+	return v, nil
+}
+
+func encodeFilter(f domainview.Filter) (string, error) {
+	data, err := json.Marshal(storedFilter{
+		Types:       f.Types,
+		AccountIDs:  f.AccountIDs,
+		CategoryIDs: f.CategoryIDs,
+		Range:       string(f.Range),
+		StartDate:   f.StartDate,
+		EndDate:     f.EndDate,
+		MinAmount:   f.MinAmount,
+		MaxAmount:   f.MaxAmount,
+		Query:       f.Query,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func decodeFilter(data string) (domainview.Filter, error) {
+	var stored storedFilter
+	if err := json.Unmarshal([]byte(data), &stored); err != nil {
+		return domainview.Filter{}, err
+	}
+
+	return domainview.Filter{
+		Types:       stored.Types,
+		AccountIDs:  stored.AccountIDs,
+		CategoryIDs: stored.CategoryIDs,
+		Range:       domainview.Range(stored.Range),
+		StartDate:   stored.StartDate,
+		EndDate:     stored.EndDate,
+		MinAmount:   stored.MinAmount,
+		MaxAmount:   stored.MaxAmount,
+		Query:       stored.Query,
+	}, nil
+}
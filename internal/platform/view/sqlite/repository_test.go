@@ -0,0 +1,89 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainshared "github.com/financial-manager/api/internal/domain/shared"
+	domainview "github.com/financial-manager/api/internal/domain/view"
+	"github.com/financial-manager/api/internal/platform/view/sqlite"
+)
+
+func TestViewRepository_CreateAndGet(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewViewRepository(newTestDB(t))
+	view := buildView("v-1", "Groceries")
+
+	require.NoError(t, repo.Create(context.Background(), view))
+
+	got, err := repo.GetByName(context.Background(), "Groceries")
+	require.NoError(t, err)
+	assert.Equal(t, view, got)
+
+	got, err = repo.GetByID(context.Background(), "v-1")
+	require.NoError(t, err)
+	assert.Equal(t, view, got)
+}
+
+func TestViewRepository_Create_DuplicateNameFails(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewViewRepository(newTestDB(t))
+
+	require.NoError(t, repo.Create(context.Background(), buildView("v-1", "Groceries")))
+	assert.Error(t, repo.Create(context.Background(), buildView("v-2", "Groceries")))
+}
+
+func TestViewRepository_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewViewRepository(newTestDB(t))
+
+	_, err := repo.GetByName(context.Background(), "missing")
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+
+	_, err = repo.GetByID(context.Background(), "missing")
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+}
+
+func TestViewRepository_List_OrdersByName(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewViewRepository(newTestDB(t))
+	require.NoError(t, repo.Create(context.Background(), buildView("v-1", "Yearly")))
+	require.NoError(t, repo.Create(context.Background(), domainview.View{
+		ID:        "v-2",
+		Name:      "All",
+		CreatedAt: buildView("", "").CreatedAt,
+		UpdatedAt: buildView("", "").UpdatedAt,
+	}))
+
+	views, err := repo.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, views, 2)
+	assert.Equal(t, "All", views[0].Name)
+	assert.Equal(t, domainview.Filter{}, views[0].Filter)
+	assert.Equal(t, "Yearly", views[1].Name)
+}
+
+func TestViewRepository_List_ReturnsEmptySlice(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewViewRepository(newTestDB(t))
+
+	views, err := repo.List(context.Background())
+	require.NoError(t, err)
+	assert.NotNil(t, views)
+	assert.Empty(t, views)
+}
+
+func TestViewRepository_Delete(t *testing.T) {
+	t.Parallel()
+	repo := sqlite.NewViewRepository(newTestDB(t))
+	require.NoError(t, repo.Create(context.Background(), buildView("v-1", "Groceries")))
+
+	require.NoError(t, repo.Delete(context.Background(), "v-1"))
+
+	_, err := repo.GetByID(context.Background(), "v-1")
+	assert.ErrorIs(t, err, domainshared.ErrNotFound)
+	assert.ErrorIs(t, repo.Delete(context.Background(), "v-1"), domainshared.ErrNotFound)
+}
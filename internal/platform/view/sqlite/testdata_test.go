@@ -0,0 +1,59 @@
+package sqlite_test
+
+import (
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/stretchr/testify/require"
+
+	domainview "github.com/financial-manager/api/internal/domain/view"
+)
+
+// dbCounter provides unique in-memory database names to avoid shared state between parallel tests.
+var dbCounter atomic.Int64
+
+// newTestDB creates an isolated in-memory SQLite database with the views schema applied.
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	name := fmt.Sprintf("test%d", dbCounter.Add(1))
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=memory&cache=shared", name))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS views (
+		id         TEXT PRIMARY KEY,
+		name       TEXT NOT NULL UNIQUE,
+		filter     TEXT NOT NULL,
+		created_at TEXT NOT NULL,
+		updated_at TEXT NOT NULL
+	)`)
+	require.NoError(t, err)
+
+	return db
+}
+
+// buildView returns a view using every filter so round trips cover them all.
+func buildView(id, name string) domainview.View {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	minAmount, maxAmount := 10.0, 250.5
+	return domainview.View{
+		ID:   id,
+		Name: name,
+		Filter: domainview.Filter{
+			Types:       []string{"expense"},
+			AccountIDs:  []string{"acc-1", "acc-2"},
+			CategoryIDs: []string{"cat-1"},
+			Range:       domainview.RangeThisQuarter,
+			MinAmount:   &minAmount,
+			MaxAmount:   &maxAmount,
+			Query:       "supermercado",
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}